@@ -2,6 +2,10 @@ package cmd
 
 import "github.com/a3tai/bspec/cli/internal/commands"
 
+// ErrReported is returned when a command fails because of findings it has
+// already printed
+var ErrReported = commands.ErrReported
+
 // Execute runs the root command and handles errors
 func Execute() error {
 	return commands.Execute()
@@ -2,12 +2,14 @@ module github.com/a3tai/bspec/cli
 
 go 1.24.4
 
-replace github.com/a3tai/bspec/sdk => ../v1/go
+replace github.com/bspec-foundation/bspec-go => ../v1/go
 
 require (
 	github.com/alperdrsnn/clime v1.1.2
+	github.com/bspec-foundation/bspec-go v0.0.0-00010101000000-000000000000
 	github.com/sashabaranov/go-openai v1.41.2
 	github.com/spf13/cobra v1.10.2
+	github.com/spf13/pflag v1.0.10
 	github.com/spf13/viper v1.21.0
 	gopkg.in/yaml.v3 v3.0.1
 )
@@ -21,7 +23,6 @@ require (
 	github.com/sourcegraph/conc v0.3.1-0.20240121214520-5f936abd7ae8 // indirect
 	github.com/spf13/afero v1.15.0 // indirect
 	github.com/spf13/cast v1.10.0 // indirect
-	github.com/spf13/pflag v1.0.10 // indirect
 	github.com/subosito/gotenv v1.6.0 // indirect
 	go.yaml.in/yaml/v3 v3.0.4 // indirect
 	golang.org/x/sys v0.34.0 // indirect
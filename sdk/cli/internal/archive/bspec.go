@@ -10,6 +10,8 @@ import (
 	"path/filepath"
 	"strings"
 	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
 // BSpecArchive represents a .bspec file structure
@@ -34,6 +36,17 @@ type Manifest struct {
 	Domains         []string  `json:"domains"`
 	ConformanceLevel string   `json:"conformance_level"`
 	IndustryProfile string    `json:"industry_profile"`
+	Validation      *Validation `json:"validation,omitempty"`
+}
+
+// Validation represents the validation results recorded in manifest.json
+type Validation struct {
+	SchemaCompliance      bool     `json:"schema_compliance"`
+	RelationshipIntegrity bool     `json:"relationship_integrity"`
+	AssetReferences       bool     `json:"asset_references"`
+	CircularDependencies  bool     `json:"circular_dependencies"`
+	Warnings              []string `json:"warnings"`
+	Errors                []string `json:"errors"`
 }
 
 // BSpecDocument represents a BSpec document with frontmatter and content
@@ -49,6 +62,7 @@ type BSpecDocument struct {
 	Domain   string                 `json:"domain,omitempty"`
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	Content  string                 `json:"content"`
+	BodyLine int                    `json:"-"` // Line of the file where Content starts
 }
 
 // Extract extracts a .bspec file to a directory structure
@@ -310,6 +324,13 @@ func Read(bspecPath string) (*BSpecArchive, error) {
 	return archive, nil
 }
 
+// ParseDocument parses a markdown document with YAML frontmatter.
+// Core fields are mapped onto the document; all other frontmatter keys
+// are kept in Metadata.
+func ParseDocument(content []byte) (*BSpecDocument, error) {
+	return parseDocument(content)
+}
+
 // parseDocument parses a markdown document with YAML frontmatter
 func parseDocument(content []byte) (*BSpecDocument, error) {
 	contentStr := string(content)
@@ -328,48 +349,105 @@ func parseDocument(content []byte) (*BSpecDocument, error) {
 	frontmatter := parts[0]
 	markdownContent := parts[1]
 
-	// Parse frontmatter as JSON for simplicity (in real implementation, use YAML parser)
-	var metadata map[string]interface{}
-
-	// For now, create a basic document structure
-	// In a real implementation, you'd use a proper YAML parser
 	doc := &BSpecDocument{
 		Content:  markdownContent,
-		Metadata: metadata,
+		BodyLine: strings.Count(frontmatter, "\n") + 4,
 	}
 
-	// Extract basic fields from frontmatter (simplified parsing)
-	lines := strings.Split(frontmatter, "\n")
-	for _, line := range lines {
-		if strings.Contains(line, ":") {
-			parts := strings.SplitN(line, ":", 2)
-			if len(parts) == 2 {
-				key := strings.TrimSpace(parts[0])
-				value := strings.TrimSpace(strings.Trim(parts[1], "\"'"))
-
-				switch key {
-				case "id":
-					doc.ID = value
-				case "title":
-					doc.Title = value
-				case "type":
-					doc.Type = value
-				case "status":
-					doc.Status = value
-				case "version":
-					doc.Version = value
-				case "owner":
-					doc.Owner = value
-				case "created":
-					doc.Created = value
-				case "updated":
-					doc.Updated = value
-				case "domain":
-					doc.Domain = value
-				}
+	var metadata map[string]interface{}
+	if err := yaml.Unmarshal([]byte(frontmatter), &metadata); err != nil {
+		// Fall back to line-based parsing for frontmatter that is not valid YAML,
+		// such as unfilled templates with {placeholder} values
+		metadata = parseFrontmatterLines(frontmatter)
+	}
+
+	for key, value := range metadata {
+		switch key {
+		case "id":
+			doc.ID = scalarString(value)
+		case "title":
+			doc.Title = scalarString(value)
+		case "type":
+			doc.Type = scalarString(value)
+		case "status":
+			doc.Status = scalarString(value)
+		case "version":
+			doc.Version = scalarString(value)
+		case "owner":
+			doc.Owner = scalarString(value)
+		case "created":
+			doc.Created = scalarString(value)
+		case "updated":
+			doc.Updated = scalarString(value)
+		case "domain":
+			doc.Domain = scalarString(value)
+		default:
+			if doc.Metadata == nil {
+				doc.Metadata = make(map[string]interface{})
 			}
+			doc.Metadata[key] = normalizeValue(value)
 		}
 	}
 
 	return doc, nil
-}
\ No newline at end of file
+}
+
+// parseFrontmatterLines extracts top-level "key: value" pairs from frontmatter line by line
+func parseFrontmatterLines(frontmatter string) map[string]interface{} {
+	metadata := make(map[string]interface{})
+	for _, line := range strings.Split(frontmatter, "\n") {
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") || !strings.Contains(line, ":") {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(strings.Trim(strings.TrimSpace(parts[1]), "\"'"))
+		if key != "" && value != "" {
+			metadata[key] = value
+		}
+	}
+	return metadata
+}
+
+// scalarString converts a decoded YAML scalar to its string form
+func scalarString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		return formatDate(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// normalizeValue converts decoded YAML values into JSON-friendly values,
+// rendering dates as YYYY-MM-DD strings
+func normalizeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case time.Time:
+		return formatDate(v)
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeValue(item)
+		}
+		return v
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizeValue(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// formatDate renders a timestamp as a date when it has no time component
+func formatDate(t time.Time) string {
+	if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0 {
+		return t.Format("2006-01-02")
+	}
+	return t.Format(time.RFC3339)
+}
@@ -112,6 +112,65 @@ This is a valid test document with proper frontmatter.
 				if doc.Content == "" {
 					t.Error("Expected content to have value")
 				}
+				// The body starts on the line after the closing ---
+				if doc.BodyLine != 10 {
+					t.Errorf("Expected body line 10, got %d", doc.BodyLine)
+				}
+			},
+		},
+		{
+			name: "document with relationships and metadata",
+			content: `---
+id: STR-growth
+title: Growth Strategy
+type: STR
+status: Review
+version: 1.2
+created: 2025-01-15
+depends_on: [MSN-mission, VSN-vision]
+enables:
+  - PRD-platform
+priority: High
+---
+
+# Growth Strategy
+`,
+			wantErr: false,
+			verify: func(doc *BSpecDocument, t *testing.T) {
+				if doc.Version != "1.2" {
+					t.Errorf("Expected version '1.2', got '%s'", doc.Version)
+				}
+				if doc.Created != "2025-01-15" {
+					t.Errorf("Expected created '2025-01-15', got '%s'", doc.Created)
+				}
+				if doc.Metadata["priority"] != "High" {
+					t.Errorf("Expected priority metadata 'High', got '%v'", doc.Metadata["priority"])
+				}
+				deps := doc.References("depends_on")
+				if len(deps) != 2 || deps[0] != "MSN-mission" || deps[1] != "VSN-vision" {
+					t.Errorf("Expected depends_on [MSN-mission VSN-vision], got %v", deps)
+				}
+				if enables := doc.References("enables"); len(enables) != 1 || enables[0] != "PRD-platform" {
+					t.Errorf("Expected enables [PRD-platform], got %v", enables)
+				}
+			},
+		},
+		{
+			name: "unfilled template frontmatter",
+			content: `---
+id: {TYPE}-{name}
+title: {title}
+type: RSK
+depends_on: [{dependencies}
+---
+
+# Template
+`,
+			wantErr: false,
+			verify: func(doc *BSpecDocument, t *testing.T) {
+				if doc.Type != "RSK" {
+					t.Errorf("Expected type 'RSK', got '%s'", doc.Type)
+				}
 			},
 		},
 		{
@@ -0,0 +1,37 @@
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// UpdateManifestField sets a top-level field of the manifest.json in an
+// archive directory. Fields that are not part of the Manifest struct are
+// preserved.
+func UpdateManifestField(dir, field string, value interface{}) error {
+	manifestPath := filepath.Join(dir, "manifest.json")
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return fmt.Errorf("failed to read manifest.json: %w", err)
+	}
+
+	var manifest map[string]interface{}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return fmt.Errorf("failed to parse manifest.json: %w", err)
+	}
+	if manifest == nil {
+		manifest = make(map[string]interface{})
+	}
+	manifest[field] = value
+
+	data, err = json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	if err := os.WriteFile(manifestPath, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write manifest.json: %w", err)
+	}
+	return nil
+}
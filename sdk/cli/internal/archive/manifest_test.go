@@ -0,0 +1,37 @@
+package archive
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUpdateManifestField(t *testing.T) {
+	dir := t.TempDir()
+	manifestPath := filepath.Join(dir, "manifest.json")
+	os.WriteFile(manifestPath, []byte(`{"name":"Test","statistics":{"documents":3}}`), 0644)
+
+	if err := UpdateManifestField(dir, "validation", Validation{RelationshipIntegrity: true}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data, _ := os.ReadFile(manifestPath)
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		t.Fatalf("Failed to parse manifest: %v", err)
+	}
+	if manifest.Validation == nil || !manifest.Validation.RelationshipIntegrity {
+		t.Errorf("Expected validation to be written, got %+v", manifest.Validation)
+	}
+
+	var raw map[string]interface{}
+	json.Unmarshal(data, &raw)
+	if _, ok := raw["statistics"]; !ok {
+		t.Error("Expected unknown manifest fields to be preserved")
+	}
+
+	if err := UpdateManifestField(t.TempDir(), "validation", nil); err == nil {
+		t.Error("Expected error for missing manifest.json")
+	}
+}
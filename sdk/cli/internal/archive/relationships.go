@@ -0,0 +1,54 @@
+package archive
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RelationshipFields lists the frontmatter fields that reference other documents
+var RelationshipFields = []string{
+	"depends_on",
+	"enables",
+	"conflicts_with",
+	"related",
+	"parent",
+	"supersedes",
+	"risks",
+	"metrics",
+}
+
+// References returns the document IDs listed in a relationship field.
+// Both list and single-value fields are supported.
+func (d BSpecDocument) References(field string) []string {
+	value, ok := d.Metadata[field]
+	if !ok || value == nil {
+		return nil
+	}
+
+	var refs []string
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if ref := strings.TrimSpace(fmt.Sprint(item)); ref != "" {
+				refs = append(refs, ref)
+			}
+		}
+	case []string:
+		for _, item := range v {
+			if ref := strings.TrimSpace(item); ref != "" {
+				refs = append(refs, ref)
+			}
+		}
+	case string:
+		// Tolerate inline lists written as plain strings, e.g. "[A, B]" or "A, B"
+		for _, item := range strings.Split(strings.Trim(v, "[]"), ",") {
+			if ref := strings.Trim(strings.TrimSpace(item), "\"'"); ref != "" {
+				refs = append(refs, ref)
+			}
+		}
+	default:
+		refs = append(refs, fmt.Sprint(v))
+	}
+
+	return refs
+}
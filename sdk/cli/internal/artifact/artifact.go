@@ -0,0 +1,308 @@
+package artifact
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+	"regexp"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/export"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// Artifact is a generated business artifact
+type Artifact struct {
+	Kind      Kind      `json:"kind"`
+	Title     string    `json:"title"`
+	Project   string    `json:"project"`
+	Generated string    `json:"generated"`
+	Sections  []Section `json:"sections"`
+	Sources   []string  `json:"sources"`
+	Missing   []Missing `json:"missing"`
+}
+
+// Section is an assembled part of an artifact
+type Section struct {
+	Title    string    `json:"title"`
+	Extracts []Extract `json:"extracts"`
+}
+
+// Extract is content taken from one section of a source document
+type Extract struct {
+	DocumentID    string `json:"document_id"`
+	DocumentTitle string `json:"document_title"`
+	Type          string `json:"type"`
+	Heading       string `json:"heading"`
+	Content       string `json:"content"`
+}
+
+// Missing describes a source that could not be found in the archive
+type Missing struct {
+	Section    string `json:"section"`
+	Type       string `json:"type"`
+	DocumentID string `json:"document_id,omitempty"` // Set when the document exists but lacks the headings
+	Headings   string `json:"headings,omitempty"`
+	Required   bool   `json:"required"`
+}
+
+var headingPattern = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+
+// Generate assembles an artifact from the documents in an archive, dated
+// generated. Documents are processed in ID order and deprecated documents
+// are skipped, so the same archive and date always produce the same
+// artifact.
+func Generate(arch *archive.BSpecArchive, kind Kind, generated time.Time) (*Artifact, error) {
+	def, ok := Lookup(kind)
+	if !ok {
+		return nil, fmt.Errorf("unknown artifact kind: %s (supported: %s)", kind, kindList())
+	}
+
+	byType := documentsByType(arch)
+	used := make(map[string]bool)
+
+	result := &Artifact{
+		Kind:      kind,
+		Title:     def.Title,
+		Project:   arch.Manifest.Name,
+		Generated: generated.Format("2006-01-02"),
+		Sources:   []string{},
+		Missing:   []Missing{},
+	}
+
+	for _, sectionDef := range def.Sections {
+		section := Section{Title: sectionDef.Title}
+
+		for _, source := range sectionDef.Sources {
+			docs := byType[source.Type]
+			if len(docs) == 0 {
+				result.Missing = append(result.Missing, Missing{
+					Section:  sectionDef.Title,
+					Type:     source.Type,
+					Required: source.Required,
+				})
+				continue
+			}
+
+			for _, doc := range docs {
+				heading, content, found := extractSection(doc.Content, source.Headings)
+				if !found {
+					result.Missing = append(result.Missing, Missing{
+						Section:    sectionDef.Title,
+						Type:       source.Type,
+						DocumentID: doc.ID,
+						Headings:   strings.Join(source.Headings, ", "),
+						Required:   source.Required,
+					})
+					heading, content = "", leadParagraph(doc.Content)
+				}
+				if def.MaxLines > 0 {
+					content = condense(content, def.MaxLines)
+				}
+				if strings.TrimSpace(content) == "" {
+					continue
+				}
+
+				section.Extracts = append(section.Extracts, Extract{
+					DocumentID:    doc.ID,
+					DocumentTitle: doc.Title,
+					Type:          doc.Type,
+					Heading:       heading,
+					Content:       shiftHeadings(content, 4),
+				})
+				if !used[doc.ID] {
+					used[doc.ID] = true
+					result.Sources = append(result.Sources, doc.ID)
+				}
+			}
+		}
+
+		result.Sections = append(result.Sections, section)
+	}
+
+	sort.Strings(result.Sources)
+	return result, nil
+}
+
+// MissingRequired returns the missing sources the artifact requires
+func (a *Artifact) MissingRequired() []Missing {
+	var missing []Missing
+	for _, m := range a.Missing {
+		if m.Required && m.DocumentID == "" {
+			missing = append(missing, m)
+		}
+	}
+	return missing
+}
+
+// Markdown renders the artifact as markdown
+func (a *Artifact) Markdown() (string, error) {
+	name := "document.md.tmpl"
+	if a.Kind == KindPitchDeck {
+		name = "pitch-deck.md.tmpl"
+	}
+
+	tmpl, err := texttemplate.New("artifact").Funcs(texttemplate.FuncMap{
+		"add": func(a, b int) int { return a + b },
+	}).ParseFS(templateFS, "templates/*.tmpl")
+	if err != nil {
+		return "", fmt.Errorf("failed to parse artifact templates: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, name, a); err != nil {
+		return "", fmt.Errorf("failed to render artifact: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// HTML renders the artifact as a standalone HTML page
+func (a *Artifact) HTML() (string, error) {
+	markdown, err := a.Markdown()
+	if err != nil {
+		return "", err
+	}
+
+	tmpl, err := template.ParseFS(templateFS, "templates/page.html.tmpl")
+	if err != nil {
+		return "", fmt.Errorf("failed to parse artifact templates: %w", err)
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, map[string]interface{}{
+		"Title": strings.TrimSpace(a.Project + " " + a.Title),
+		"Body":  template.HTML(export.MarkdownToHTML(markdown)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to render artifact: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// documentsByType groups the non-deprecated documents of an archive by type, sorted by ID
+func documentsByType(arch *archive.BSpecArchive) map[string][]archive.BSpecDocument {
+	byType := make(map[string][]archive.BSpecDocument)
+	for _, doc := range arch.Documents {
+		if strings.EqualFold(doc.Status, "Deprecated") {
+			continue
+		}
+		docType := strings.ToUpper(doc.Type)
+		byType[docType] = append(byType[docType], doc)
+	}
+	for _, docs := range byType {
+		sort.Slice(docs, func(i, j int) bool { return docs[i].ID < docs[j].ID })
+	}
+	return byType
+}
+
+// extractSection returns the body of the first heading that matches one of
+// the candidates, up to the next heading of the same or a higher level
+func extractSection(content string, candidates []string) (string, string, bool) {
+	lines := strings.Split(content, "\n")
+
+	for _, candidate := range candidates {
+		inFence := false
+		for i, line := range lines {
+			if strings.HasPrefix(strings.TrimSpace(line), "```") {
+				inFence = !inFence
+			}
+			match := headingPattern.FindStringSubmatch(line)
+			if inFence || match == nil || !strings.EqualFold(match[2], candidate) {
+				continue
+			}
+
+			level := len(match[1])
+			var body []string
+			fence := false
+			for _, next := range lines[i+1:] {
+				if strings.HasPrefix(strings.TrimSpace(next), "```") {
+					fence = !fence
+				}
+				if m := headingPattern.FindStringSubmatch(next); !fence && m != nil && len(m[1]) <= level {
+					break
+				}
+				body = append(body, next)
+			}
+			return match[2], strings.TrimSpace(strings.Join(body, "\n")), true
+		}
+	}
+	return "", "", false
+}
+
+// leadParagraph returns the first paragraph of a document that is not a heading
+func leadParagraph(content string) string {
+	var paragraph []string
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case headingPattern.MatchString(trimmed):
+			if len(paragraph) > 0 {
+				return strings.Join(paragraph, "\n")
+			}
+		case trimmed == "":
+			if len(paragraph) > 0 {
+				return strings.Join(paragraph, "\n")
+			}
+		default:
+			paragraph = append(paragraph, line)
+		}
+	}
+	return strings.Join(paragraph, "\n")
+}
+
+// condense keeps the first non-blank lines of an extract, dropping headings
+func condense(content string, maxLines int) string {
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) == "" || headingPattern.MatchString(strings.TrimSpace(line)) {
+			continue
+		}
+		lines = append(lines, line)
+		if len(lines) == maxLines {
+			break
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// shiftHeadings demotes headings so that the shallowest one is at minLevel
+func shiftHeadings(content string, minLevel int) string {
+	lines := strings.Split(content, "\n")
+
+	shallowest := 7
+	for _, line := range lines {
+		if match := headingPattern.FindStringSubmatch(line); match != nil && len(match[1]) < shallowest {
+			shallowest = len(match[1])
+		}
+	}
+	if shallowest == 7 || shallowest >= minLevel {
+		return content
+	}
+
+	shift := minLevel - shallowest
+	for i, line := range lines {
+		if match := headingPattern.FindStringSubmatch(line); match != nil {
+			level := len(match[1]) + shift
+			if level > 6 {
+				level = 6
+			}
+			lines[i] = strings.Repeat("#", level) + " " + match[2]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func kindList() string {
+	names := make([]string, len(Kinds))
+	for i, kind := range Kinds {
+		names[i] = string(kind)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,154 @@
+package artifact
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+var testDate = time.Date(2026, 3, 14, 9, 30, 0, 0, time.UTC)
+
+func testArchive() *archive.BSpecArchive {
+	return &archive.BSpecArchive{
+		Manifest: archive.Manifest{Name: "Acme"},
+		Documents: map[string]archive.BSpecDocument{
+			"01-strategic/MSN-mission.md": {ID: "MSN-mission", Type: "MSN", Title: "Mission", Status: "Accepted",
+				Content: "# Mission\n\n## Overview\n\nWhy we exist.\n\n## Mission Statement\n\nWe make widgets for everyone.\n\n### Detail\n\nMore detail.\n\n## Core Purpose\n\nPurpose text.\n"},
+			"01-strategic/VSN-vision.md": {ID: "VSN-vision", Type: "VSN", Title: "Vision", Status: "Draft",
+				Content: "# Vision\n\nA world of widgets.\n"},
+			"09-risk/RSK-b.md": {ID: "RSK-b", Type: "RSK", Title: "Supply", Status: "Draft",
+				Content: "## Executive Summary\n\nSupply risk.\n"},
+			"09-risk/RSK-a.md": {ID: "RSK-a", Type: "RSK", Title: "Churn", Status: "Draft",
+				Content: "## Executive Summary\n\nChurn risk.\n"},
+			"09-risk/RSK-old.md": {ID: "RSK-old", Type: "RSK", Title: "Old", Status: "Deprecated",
+				Content: "## Executive Summary\n\nOld risk.\n"},
+		},
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	result, err := Generate(testArchive(), KindExecutiveSummary, testDate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result.Generated != "2026-03-14" {
+		t.Errorf("Expected the given date, got %s", result.Generated)
+	}
+
+	mission := result.Sections[0]
+	if mission.Title != "Mission" || len(mission.Extracts) != 1 {
+		t.Fatalf("Unexpected mission section: %+v", mission)
+	}
+	extract := mission.Extracts[0]
+	if extract.Heading != "Mission Statement" {
+		t.Errorf("Expected Mission Statement heading, got %q", extract.Heading)
+	}
+	if !strings.Contains(extract.Content, "We make widgets") || strings.Contains(extract.Content, "Purpose text") {
+		t.Errorf("Expected only the Mission Statement section, got %q", extract.Content)
+	}
+	if !strings.Contains(extract.Content, "#### Detail") {
+		t.Errorf("Expected nested headings to be demoted, got %q", extract.Content)
+	}
+
+	// Documents without the headings fall back to their opening paragraph
+	vision := result.Sections[1].Extracts[0]
+	if vision.Content != "A world of widgets." {
+		t.Errorf("Expected opening paragraph fallback, got %q", vision.Content)
+	}
+
+	// Risks come from every non-deprecated RSK document in ID order
+	var risks Section
+	for _, section := range result.Sections {
+		if section.Title == "Key Risks" {
+			risks = section
+		}
+	}
+	if len(risks.Extracts) != 2 || risks.Extracts[0].DocumentID != "RSK-a" || risks.Extracts[1].DocumentID != "RSK-b" {
+		t.Errorf("Unexpected risk extracts: %+v", risks.Extracts)
+	}
+
+	expectedSources := []string{"MSN-mission", "RSK-a", "RSK-b", "VSN-vision"}
+	if strings.Join(result.Sources, ",") != strings.Join(expectedSources, ",") {
+		t.Errorf("Expected sources %v, got %v", expectedSources, result.Sources)
+	}
+
+	missing := make(map[string]bool)
+	for _, m := range result.MissingRequired() {
+		missing[m.Type] = true
+	}
+	for _, docType := range []string{"STR", "MKT", "SEG", "REV", "FIN"} {
+		if !missing[docType] {
+			t.Errorf("Expected %s to be reported as a missing required source", docType)
+		}
+	}
+	if missing["PER"] || missing["MSN"] {
+		t.Error("Expected only missing required documents in MissingRequired")
+	}
+}
+
+func TestGenerateUnknownKind(t *testing.T) {
+	if _, err := Generate(testArchive(), Kind("annual-report"), testDate); err == nil {
+		t.Error("Expected error for unknown artifact kind")
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	for _, kind := range Kinds {
+		result, err := Generate(testArchive(), kind, testDate)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s: %v", kind, err)
+		}
+		markdown, err := result.Markdown()
+		if err != nil {
+			t.Fatalf("Unexpected error rendering %s: %v", kind, err)
+		}
+		if !strings.HasPrefix(markdown, "# Acme: ") {
+			t.Errorf("Expected %s to start with the project title, got %q", kind, markdown[:20])
+		}
+		if !strings.Contains(markdown, "## Sources") || !strings.Contains(markdown, "no STR document (required)") {
+			t.Errorf("Expected %s to list sources and missing documents", kind)
+		}
+	}
+
+	result, _ := Generate(testArchive(), KindPitchDeck, testDate)
+	markdown, _ := result.Markdown()
+	if !strings.Contains(markdown, "## Slide 1: Mission") || !strings.Contains(markdown, "## Slide 12: Vision") {
+		t.Errorf("Expected numbered slides, got:\n%s", markdown)
+	}
+	if strings.Contains(markdown, "#### Detail") {
+		t.Error("Expected pitch deck extracts to be condensed without headings")
+	}
+}
+
+func TestHTML(t *testing.T) {
+	result, _ := Generate(testArchive(), KindBusinessPlan, testDate)
+	page, err := result.HTML()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(page, "<title>Acme Business Plan</title>") {
+		t.Error("Expected page title")
+	}
+	if !strings.Contains(page, "We make widgets for everyone.") {
+		t.Error("Expected rendered document content")
+	}
+}
+
+func TestExtractSection(t *testing.T) {
+	content := "## Overview\n\nIntro\n\n```\n## Not a heading\n```\n\n## Mission Statement\n\nStatement\n"
+
+	heading, body, ok := extractSection(content, []string{"missing", "overview"})
+	if !ok || heading != "Overview" {
+		t.Fatalf("Expected Overview, got %q (%v)", heading, ok)
+	}
+	if !strings.Contains(body, "## Not a heading") || strings.Contains(body, "Statement") {
+		t.Errorf("Expected fenced headings to stay in the section, got %q", body)
+	}
+
+	if _, _, ok := extractSection(content, []string{"Not a heading"}); ok {
+		t.Error("Expected headings inside code fences to be ignored")
+	}
+}
@@ -0,0 +1,174 @@
+package artifact
+
+// Kind identifies a business artifact that can be generated from an archive
+type Kind string
+
+// Artifact kinds listed in the manifest export_options.artifacts
+const (
+	KindExecutiveSummary Kind = "executive-summary"
+	KindBusinessPlan     Kind = "business-plan"
+	KindPitchDeck        Kind = "pitch-deck"
+)
+
+// Kinds lists the supported artifact kinds
+var Kinds = []Kind{KindExecutiveSummary, KindBusinessPlan, KindPitchDeck}
+
+// Source pulls content from the documents of one type
+type Source struct {
+	Type     string   // Document type, e.g. "MSN"
+	Headings []string // Headings to extract, in order of preference
+	Required bool     // Whether the artifact is incomplete without this source
+}
+
+// SectionDefinition is a part of an artifact assembled from one or more sources
+type SectionDefinition struct {
+	Title   string
+	Sources []Source
+}
+
+// Definition describes how an artifact is assembled
+type Definition struct {
+	Kind     Kind
+	Title    string
+	MaxLines int // Condense each extract to this many lines; 0 keeps the full section
+	Sections []SectionDefinition
+}
+
+// definitions holds the built-in artifact definitions
+var definitions = map[Kind]Definition{
+	KindExecutiveSummary: {
+		Kind:  KindExecutiveSummary,
+		Title: "Executive Summary",
+		Sections: []SectionDefinition{
+			{Title: "Mission", Sources: []Source{
+				{Type: "MSN", Headings: []string{"Mission Statement", "Overview"}, Required: true},
+			}},
+			{Title: "Vision", Sources: []Source{
+				{Type: "VSN", Headings: []string{"Vision Statement", "Overview"}, Required: true},
+			}},
+			{Title: "Strategy", Sources: []Source{
+				{Type: "STR", Headings: []string{"Overview", "Strategic Framework"}, Required: true},
+			}},
+			{Title: "Market Opportunity", Sources: []Source{
+				{Type: "MKT", Headings: []string{"Market Sizing", "Overview"}, Required: true},
+			}},
+			{Title: "Target Customers", Sources: []Source{
+				{Type: "SEG", Headings: []string{"Target Segment Selection", "Overview"}, Required: true},
+				{Type: "PER", Headings: []string{"Overview"}},
+			}},
+			{Title: "Business Model", Sources: []Source{
+				{Type: "BMC", Headings: []string{"Overview"}},
+				{Type: "REV", Headings: []string{"Revenue Overview", "Revenue Stream Definition"}, Required: true},
+			}},
+			{Title: "Financial Highlights", Sources: []Source{
+				{Type: "FIN", Headings: []string{"Executive Summary", "Key Metrics and KPIs"}, Required: true},
+			}},
+			{Title: "Key Risks", Sources: []Source{
+				{Type: "RSK", Headings: []string{"Executive Summary", "Risk Assessment"}, Required: true},
+			}},
+		},
+	},
+	KindBusinessPlan: {
+		Kind:  KindBusinessPlan,
+		Title: "Business Plan",
+		Sections: []SectionDefinition{
+			{Title: "Company Overview", Sources: []Source{
+				{Type: "MSN", Headings: []string{"Mission Statement", "Core Purpose"}, Required: true},
+				{Type: "VSN", Headings: []string{"Vision Statement", "Future State Description"}, Required: true},
+				{Type: "VAL", Headings: []string{"Overview"}},
+			}},
+			{Title: "Strategy", Sources: []Source{
+				{Type: "STR", Headings: []string{"Strategic Framework", "Strategic Choices", "Overview"}, Required: true},
+				{Type: "OBJ", Headings: []string{"Overview"}},
+			}},
+			{Title: "Market Analysis", Sources: []Source{
+				{Type: "MKT", Headings: []string{"Market Framework", "Market Sizing", "Overview"}, Required: true},
+				{Type: "SEG", Headings: []string{"Market Segments", "Segment Prioritization", "Overview"}, Required: true},
+				{Type: "CMP", Headings: []string{"Overview"}},
+			}},
+			{Title: "Customers", Sources: []Source{
+				{Type: "PER", Headings: []string{"Overview"}},
+				{Type: "JTB", Headings: []string{"Overview"}},
+				{Type: "VPR", Headings: []string{"Overview"}},
+			}},
+			{Title: "Products and Services", Sources: []Source{
+				{Type: "PRD", Headings: []string{"Overview"}},
+			}},
+			{Title: "Business Model", Sources: []Source{
+				{Type: "BMC", Headings: []string{"Overview"}},
+				{Type: "REV", Headings: []string{"Revenue Stream Definition", "Revenue Mechanics", "Revenue Overview"}, Required: true},
+				{Type: "PRI", Headings: []string{"Overview"}},
+				{Type: "CST", Headings: []string{"Overview"}},
+			}},
+			{Title: "Go-to-Market", Sources: []Source{
+				{Type: "CHN", Headings: []string{"Overview"}},
+				{Type: "CAM", Headings: []string{"Overview"}},
+			}},
+			{Title: "Operations and Technology", Sources: []Source{
+				{Type: "OPS", Headings: []string{"Overview"}},
+				{Type: "ORG", Headings: []string{"Overview"}},
+				{Type: "ARC", Headings: []string{"Overview"}},
+			}},
+			{Title: "Financial Plan", Sources: []Source{
+				{Type: "FIN", Headings: []string{"Financial Overview", "Revenue Projections", "Cost Structure", "Key Metrics and KPIs"}, Required: true},
+				{Type: "FND", Headings: []string{"Overview"}},
+			}},
+			{Title: "Risks and Mitigation", Sources: []Source{
+				{Type: "RSK", Headings: []string{"Risk Assessment", "Risk Treatment and Mitigation", "Executive Summary"}, Required: true},
+				{Type: "MIT", Headings: []string{"Overview"}},
+			}},
+		},
+	},
+	KindPitchDeck: {
+		Kind:     KindPitchDeck,
+		Title:    "Pitch Deck Outline",
+		MaxLines: 6,
+		Sections: []SectionDefinition{
+			{Title: "Mission", Sources: []Source{
+				{Type: "MSN", Headings: []string{"Mission Statement"}, Required: true},
+			}},
+			{Title: "Problem", Sources: []Source{
+				{Type: "PAI", Headings: []string{"Overview"}},
+				{Type: "JTB", Headings: []string{"Overview"}},
+			}},
+			{Title: "Solution", Sources: []Source{
+				{Type: "VPR", Headings: []string{"Overview"}},
+				{Type: "PRD", Headings: []string{"Overview"}},
+			}},
+			{Title: "Market Opportunity", Sources: []Source{
+				{Type: "MKT", Headings: []string{"Market Sizing", "Overview"}, Required: true},
+			}},
+			{Title: "Target Customers", Sources: []Source{
+				{Type: "SEG", Headings: []string{"Target Segment Selection", "Overview"}, Required: true},
+			}},
+			{Title: "Business Model", Sources: []Source{
+				{Type: "BMC", Headings: []string{"Overview"}},
+				{Type: "REV", Headings: []string{"Revenue Overview", "Pricing Mechanism"}, Required: true},
+			}},
+			{Title: "Competition", Sources: []Source{
+				{Type: "CMP", Headings: []string{"Overview"}},
+			}},
+			{Title: "Strategy", Sources: []Source{
+				{Type: "STR", Headings: []string{"How to Win", "Overview"}, Required: true},
+			}},
+			{Title: "Financials", Sources: []Source{
+				{Type: "FIN", Headings: []string{"Key Metrics and KPIs", "Executive Summary"}, Required: true},
+			}},
+			{Title: "Risks", Sources: []Source{
+				{Type: "RSK", Headings: []string{"Executive Summary"}, Required: true},
+			}},
+			{Title: "The Ask", Sources: []Source{
+				{Type: "FND", Headings: []string{"Overview"}},
+			}},
+			{Title: "Vision", Sources: []Source{
+				{Type: "VSN", Headings: []string{"Vision Statement"}, Required: true},
+			}},
+		},
+	},
+}
+
+// Lookup returns the definition of an artifact kind
+func Lookup(kind Kind) (Definition, bool) {
+	def, ok := definitions[kind]
+	return def, ok
+}
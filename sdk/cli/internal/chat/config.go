@@ -8,6 +8,8 @@ import (
 	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/a3tai/bspec/cli/internal/layout"
 )
 
 // Config holds the configuration for chat functionality
@@ -339,13 +341,7 @@ func (c *Config) LoadProjectContext() (string, error) {
 func (c *Config) scanBSpecDocuments(projectPath string) string {
 	var result strings.Builder
 
-	bspecDirs := []string{
-		"contexts", "capabilities", "processes", "personas", "offerings",
-		"architecture", "compliance", "metrics", "risks", "decisions",
-		"experiments", "profiles",
-	}
-
-	for _, dir := range bspecDirs {
+	for _, dir := range layout.Folders() {
 		dirPath := filepath.Join(projectPath, dir)
 		if entries, err := os.ReadDir(dirPath); err == nil && len(entries) > 0 {
 			result.WriteString(fmt.Sprintf("\n**%s/**: ", dir))
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/a3tai/bspec/cli/internal/layout"
 )
 
 // FileOperation represents a file operation parsed from LLM output
@@ -86,16 +88,10 @@ func (p *StructuredOutputParser) ValidateFileOperations(operations []FileOperati
 			return fmt.Errorf("file operation %d is not a markdown file: %s", i+1, op.FilePath)
 		}
 
-		// Check if it's in a valid BSpec directory
-		validDirs := []string{
-			"contexts/", "capabilities/", "processes/", "personas/", "offerings/",
-			"architecture/", "compliance/", "metrics/", "risks/", "decisions/",
-			"experiments/", "profiles/",
-		}
-
+		// Check if it's in a domain folder of the standard layout
 		validDir := false
-		for _, dir := range validDirs {
-			if strings.HasPrefix(op.FilePath, dir) {
+		for _, dir := range layout.Folders() {
+			if strings.HasPrefix(op.FilePath, dir+"/") {
 				validDir = true
 				break
 			}
@@ -21,7 +21,7 @@ func (t *BSpecQueryTool) Name() string {
 }
 
 func (t *BSpecQueryTool) Description() string {
-	return "Execute bspec query command to analyze project documents with optional filtering by type, domain, and status"
+	return "Execute bspec query command to analyze project documents with optional filtering by type, domain, status, text and body section"
 }
 
 func (t *BSpecQueryTool) Parameters() map[string]interface{} {
@@ -47,6 +47,14 @@ func (t *BSpecQueryTool) Parameters() map[string]interface{} {
 				"description": "Filter by status (Draft, Accepted, Deprecated)",
 				"enum":        []string{"Draft", "Accepted", "Deprecated"},
 			},
+			"search": map[string]interface{}{
+				"type":        "string",
+				"description": "Text to search for in the documents, or in the section if one is given",
+			},
+			"section": map[string]interface{}{
+				"type":        "string",
+				"description": "Heading or anchor of a body section (e.g., 'Risk Assessment'); only documents with the section match and only the section is returned",
+			},
 		},
 	}
 }
@@ -77,6 +85,14 @@ func (t *BSpecQueryTool) Execute(params map[string]interface{}) (string, error)
 		args = append(args, "--status="+status)
 	}
 
+	if search, ok := params["search"].(string); ok && search != "" {
+		args = append(args, "--search="+search)
+	}
+
+	if section, ok := params["section"].(string); ok && section != "" {
+		args = append(args, "--section="+section)
+	}
+
 	// Execute the command
 	cmd := exec.Command("bspec", args...)
 	cmd.Dir = t.validator.WorkingDir
@@ -6,7 +6,9 @@ import (
 	"path/filepath"
 	"strings"
 
+	bspec "github.com/bspec-foundation/bspec-go"
 	"gopkg.in/yaml.v3"
+	"github.com/a3tai/bspec/cli/internal/archive"
 	"github.com/a3tai/bspec/cli/internal/sdk"
 )
 
@@ -25,7 +27,7 @@ func (t *ReadBSpecFileTool) Name() string {
 }
 
 func (t *ReadBSpecFileTool) Description() string {
-	return "Read the contents of a BSpec markdown file within the current project. Only works with .md files in standard BSpec directories (contexts/, capabilities/, etc.)"
+	return "Read the contents of a BSpec markdown file within the current project, or only one section of its body. Only works with .md files in the domain folders under documents/ (documents/01-strategic/, documents/09-risk/, etc.)"
 }
 
 func (t *ReadBSpecFileTool) Parameters() map[string]interface{} {
@@ -34,7 +36,11 @@ func (t *ReadBSpecFileTool) Parameters() map[string]interface{} {
 		"properties": map[string]interface{}{
 			"file_path": map[string]interface{}{
 				"type":        "string",
-				"description": "Path to the BSpec markdown file relative to project root (e.g., 'contexts/CTX-example-v1.0.0.md')",
+				"description": "Path to the BSpec markdown file relative to project root (e.g., 'documents/01-strategic/STR-growth-v1.0.0.md')",
+			},
+			"section": map[string]interface{}{
+				"type":        "string",
+				"description": "Heading or anchor of a section to read instead of the whole file (e.g., 'Risk Assessment'); the section ends at the next heading of the same or a higher level",
 			},
 		},
 		"required": []string{"file_path"},
@@ -63,9 +69,33 @@ func (t *ReadBSpecFileTool) Execute(params map[string]interface{}) (string, erro
 		return "", fmt.Errorf("failed to read file %s: %w", filePath, err)
 	}
 
+	if name, ok := params["section"].(string); ok && name != "" {
+		return readSection(filePath, content, name)
+	}
+
 	return string(content), nil
 }
 
+// readSection returns a section of a document body, or an error listing the
+// headings of the body if it has no such section
+func readSection(filePath string, content []byte, name string) (string, error) {
+	doc, err := archive.ParseDocument(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse document %s: %w", filePath, err)
+	}
+
+	body := bspec.ParseBody(doc.Content)
+	if section := body.Find(name); section != nil {
+		return section.String(), nil
+	}
+
+	var headings []string
+	for _, section := range body.All() {
+		headings = append(headings, strings.Repeat("#", section.Level)+" "+section.Heading)
+	}
+	return "", fmt.Errorf("section %q not found in %s; sections: %s", name, filePath, strings.Join(headings, ", "))
+}
+
 // WriteBSpecFileTool writes BSpec markdown files with validation
 type WriteBSpecFileTool struct {
 	validator *SecurityValidator
@@ -90,7 +120,7 @@ func (t *WriteBSpecFileTool) Parameters() map[string]interface{} {
 		"properties": map[string]interface{}{
 			"file_path": map[string]interface{}{
 				"type":        "string",
-				"description": "Path to the BSpec markdown file relative to project root (e.g., 'contexts/CTX-example-v1.0.0.md')",
+				"description": "Path to the BSpec markdown file relative to project root (e.g., 'documents/01-strategic/STR-growth-v1.0.0.md')",
 			},
 			"content": map[string]interface{}{
 				"type":        "string",
@@ -151,7 +181,7 @@ func (t *ListBSpecDirectoryTool) Name() string {
 }
 
 func (t *ListBSpecDirectoryTool) Description() string {
-	return "List contents of a BSpec project directory. Only works with standard BSpec directories (contexts/, capabilities/, processes/, etc.)"
+	return "List contents of a BSpec project directory. Only works with documents/ and its domain folders (documents/01-strategic/, documents/09-risk/, etc.)"
 }
 
 func (t *ListBSpecDirectoryTool) Parameters() map[string]interface{} {
@@ -160,7 +190,7 @@ func (t *ListBSpecDirectoryTool) Parameters() map[string]interface{} {
 		"properties": map[string]interface{}{
 			"directory_path": map[string]interface{}{
 				"type":        "string",
-				"description": "Path to the BSpec directory relative to project root (e.g., 'contexts', 'capabilities')",
+				"description": "Path to the BSpec directory relative to project root (e.g., 'documents', 'documents/09-risk')",
 			},
 		},
 		"required": []string{"directory_path"},
@@ -322,16 +352,11 @@ func validateBSpecContent(content string) error {
 		return fmt.Errorf("status field must be a string")
 	}
 
-	validStatuses := []string{"Draft", "Accepted", "Deprecated"}
-	statusValid := false
-	for _, validStatus := range validStatuses {
-		if status == validStatus {
-			statusValid = true
-			break
+	if !bspec.DocumentStatus(status).IsValid() {
+		var validStatuses []string
+		for _, validStatus := range bspec.DocumentStatuses() {
+			validStatuses = append(validStatuses, string(validStatus))
 		}
-	}
-
-	if !statusValid {
 		return fmt.Errorf("status must be one of: %s", strings.Join(validStatuses, ", "))
 	}
 
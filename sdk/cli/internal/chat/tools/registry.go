@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/a3tai/bspec/cli/internal/layout"
 )
 
 // Tool represents a callable tool that can be invoked by the LLM
@@ -45,11 +47,7 @@ type SecurityValidator struct {
 func NewRegistry(workingDir string) *Registry {
 	validator := &SecurityValidator{
 		WorkingDir: workingDir,
-		AllowedDirs: []string{
-			"contexts", "capabilities", "processes", "personas", "offerings",
-			"architecture", "compliance", "metrics", "risks", "decisions",
-			"experiments", "profiles",
-		},
+		AllowedDirs: append([]string{layout.DocumentsDir}, layout.Folders()...),
 		AllowedExtensions: []string{".md"},
 	}
 
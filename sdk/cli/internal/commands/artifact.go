@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/artifact"
+)
+
+// artifactCmd represents the artifact command
+var artifactCmd = &cobra.Command{
+	Use:   "artifact <kind> <bspec-file|directory>",
+	Short: "Generate a business artifact from an archive",
+	Long: `Generate a business artifact by assembling sections of the archive documents.
+
+Artifacts are built deterministically from fixed sections of the source
+documents (for example the Mission Statement of the MSN document or the
+Market Sizing of the MKT document); no content is invented. Sources that are
+missing from the archive are listed at the end of the artifact and on stderr.
+
+Available kinds:
+  executive-summary  One-page summary of mission, strategy, market, model and risks
+  business-plan      Full plan covering market, customers, model, operations and financials
+  pitch-deck         Slide-by-slide outline with condensed content
+
+Examples:
+  bspec artifact executive-summary project.bspec
+  bspec artifact business-plan ./project --format=html --out=plan.html
+  bspec artifact pitch-deck project.bspec --strict   # Fail when required sources are missing`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		kind, inputPath := artifact.Kind(args[0]), args[1]
+
+		if _, ok := artifact.Lookup(kind); !ok {
+			return fmt.Errorf("unknown artifact kind: %s (supported: executive-summary, business-plan, pitch-deck)", kind)
+		}
+
+		// Check if path exists
+		if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+			return fmt.Errorf("path does not exist: %s", inputPath)
+		}
+
+		arch, err := readArchiveFromPath(inputPath)
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		result, err := artifact.Generate(arch, kind, time.Now())
+		if err != nil {
+			return err
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		var content string
+		switch format {
+		case "markdown", "md":
+			content, err = result.Markdown()
+		case "html":
+			content, err = result.HTML()
+		default:
+			return fmt.Errorf("unsupported format: %s (supported: markdown, html)", format)
+		}
+		if err != nil {
+			return err
+		}
+
+		outFile, _ := cmd.Flags().GetString("out")
+		if outFile != "" {
+			if err := os.MkdirAll(filepath.Dir(outFile), 0755); err != nil {
+				return fmt.Errorf("failed to create output directory: %w", err)
+			}
+			if err := os.WriteFile(outFile, []byte(content), 0644); err != nil {
+				return fmt.Errorf("failed to write artifact: %w", err)
+			}
+		} else {
+			fmt.Print(content)
+		}
+
+		if !viper.GetBool("quiet") {
+			printMissingSources(os.Stderr, result)
+			if outFile != "" {
+				fmt.Fprintf(os.Stderr, "Wrote %s (%d source documents)\n", outFile, len(result.Sources))
+			}
+		}
+
+		strict, _ := cmd.Flags().GetBool("strict")
+		if missing := result.MissingRequired(); strict && len(missing) > 0 {
+			return fmt.Errorf("%d required source documents are missing", len(missing))
+		}
+		return nil
+	},
+}
+
+// printMissingSources reports the sources an artifact could not be built from
+func printMissingSources(w io.Writer, result *artifact.Artifact) {
+	for _, missing := range result.Missing {
+		label := "optional"
+		if missing.Required {
+			label = "required"
+		}
+		if missing.DocumentID != "" {
+			fmt.Fprintf(w, "Warning: %s: %s has none of the sections %s, using its opening paragraph\n",
+				missing.Section, missing.DocumentID, missing.Headings)
+			continue
+		}
+		fmt.Fprintf(w, "Missing %s source for %s: no %s document\n", label, missing.Section, missing.Type)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(artifactCmd)
+
+	artifactCmd.Flags().String("format", "markdown", "Output format (markdown, html)")
+	artifactCmd.Flags().String("out", "", "Write the artifact to a file instead of stdout")
+	artifactCmd.Flags().Bool("strict", false, "Exit with an error when required source documents are missing")
+}
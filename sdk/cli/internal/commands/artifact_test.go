@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/artifact"
+)
+
+func TestArtifactCommand(t *testing.T) {
+	tmpDir := t.TempDir()
+	archiveDir := filepath.Join(tmpDir, "project")
+	docsDir := filepath.Join(archiveDir, "documents", "01-strategic")
+	if err := os.MkdirAll(docsDir, 0755); err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	os.WriteFile(filepath.Join(archiveDir, "manifest.json"), []byte(`{"name":"Test Project","conformance_level":"bronze"}`), 0644)
+	os.WriteFile(filepath.Join(docsDir, "MSN-mission.md"), []byte("---\nid: MSN-mission\ntitle: Mission\ntype: MSN\nstatus: Draft\n---\n\n# Mission\n\n## Mission Statement\n\nWe make widgets.\n"), 0644)
+
+	outFile := filepath.Join(tmpDir, "out", "summary.md")
+	artifactCmd.Flags().Set("out", outFile)
+	defer artifactCmd.Flags().Set("out", "")
+
+	if err := artifactCmd.RunE(artifactCmd, []string{"executive-summary", archiveDir}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	content, err := os.ReadFile(outFile)
+	if err != nil {
+		t.Fatalf("Expected artifact to be written: %v", err)
+	}
+	if !strings.Contains(string(content), "We make widgets.") {
+		t.Error("Expected artifact to contain the mission statement")
+	}
+
+	// Unknown kinds and formats are rejected
+	if err := artifactCmd.RunE(artifactCmd, []string{"annual-report", archiveDir}); err == nil {
+		t.Error("Expected error for unknown artifact kind")
+	}
+	artifactCmd.Flags().Set("format", "pdf")
+	if err := artifactCmd.RunE(artifactCmd, []string{"pitch-deck", archiveDir}); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+	artifactCmd.Flags().Set("format", "markdown")
+
+	// Strict mode fails when required sources are missing
+	artifactCmd.Flags().Set("strict", "true")
+	defer artifactCmd.Flags().Set("strict", "false")
+	if err := artifactCmd.RunE(artifactCmd, []string{"executive-summary", archiveDir}); err == nil {
+		t.Error("Expected error for missing required sources in strict mode")
+	}
+}
+
+func TestPrintMissingSources(t *testing.T) {
+	result := &artifact.Artifact{Missing: []artifact.Missing{
+		{Section: "Strategy", Type: "STR", Required: true},
+		{Section: "Mission", Type: "MSN", DocumentID: "MSN-mission", Headings: "Mission Statement"},
+	}}
+
+	var buf bytes.Buffer
+	printMissingSources(&buf, result)
+
+	output := buf.String()
+	if !strings.Contains(output, "Missing required source for Strategy: no STR document") {
+		t.Errorf("Expected missing document report, got %q", output)
+	}
+	if !strings.Contains(output, "MSN-mission has none of the sections Mission Statement") {
+		t.Errorf("Expected missing section report, got %q", output)
+	}
+}
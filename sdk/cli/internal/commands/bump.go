@@ -0,0 +1,200 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/docfile"
+	"github.com/a3tai/bspec/cli/internal/layout"
+	"github.com/a3tai/bspec/cli/internal/rename"
+)
+
+// versionedFilePattern matches file names of the form {TYPE}-{name}-v{version}.md
+var versionedFilePattern = regexp.MustCompile(`^(.+)-v\d+\.\d+\.\d+\.md$`)
+
+// bumpCmd represents the bump command
+var bumpCmd = &cobra.Command{
+	Use:   "bump <document-id> <major|minor|patch> [directory]",
+	Short: "Bump a document's version and record a changelog entry",
+	Long: `Increment a document's semantic version in one step:
+
+  - update version and updated in the frontmatter
+  - append a changelog entry with the message, author and date
+  - rename {TYPE}-{name}-v{version}.md to the new version, updating the
+    markdown links of other documents to it
+
+The author is taken from --author, or from git config user.name. A breaking
+change (--breaking) requires a major bump.
+
+Examples:
+  bspec bump STR-growth minor -m "Add 2026 targets"
+  bspec bump STR-growth major ./project -m "Replace pricing strategy" --breaking
+  bspec bump STR-growth patch ./project -m "Fix typos" --author="Jane Doe" --dry-run`,
+	Args: cobra.RangeArgs(2, 3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, bump := args[0], bspec.VersionBump(strings.ToLower(args[1]))
+		dir := "."
+		if len(args) > 2 {
+			dir = args[2]
+		}
+
+		message, _ := cmd.Flags().GetString("message")
+		if strings.TrimSpace(message) == "" {
+			return fmt.Errorf("a changelog message is required (-m)")
+		}
+		breaking, _ := cmd.Flags().GetBool("breaking")
+		if breaking && bump != bspec.VersionBumpMajor {
+			return fmt.Errorf("breaking changes require a major bump, not %s", bump)
+		}
+
+		path, err := docfile.Find(dir, id)
+		if err != nil {
+			return err
+		}
+		file, err := docfile.Read(path)
+		if err != nil {
+			return err
+		}
+
+		current := file.Get("version")
+		version, err := bspec.BumpVersion(current, bump)
+		if err != nil {
+			return fmt.Errorf("cannot bump %s: %w", id, err)
+		}
+
+		author, _ := cmd.Flags().GetString("author")
+		if author == "" {
+			author = gitUserName(filepath.Dir(path))
+		}
+		if author == "" {
+			return fmt.Errorf("no author: set --author or git config user.name")
+		}
+
+		now := time.Now()
+		file.Set("version", version)
+		file.Touch(now)
+		if err := file.Append("changelog", bspec.ChangelogEntry{
+			Version:         version,
+			Date:            now.Format(docfile.DateLayout),
+			Author:          author,
+			Changes:         message,
+			BreakingChanges: breaking,
+		}); err != nil {
+			return err
+		}
+
+		plan, err := bumpPlan(dir, path, versionedPath(path, version), file)
+		if err != nil {
+			return err
+		}
+
+		quiet := viper.GetBool("quiet")
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			if !quiet {
+				fmt.Printf("Would bump %s: %s -> %s\n", id, current, version)
+				printBumpChanges(plan)
+			}
+			return nil
+		}
+
+		if err := plan.Apply(); err != nil {
+			return err
+		}
+
+		if !quiet {
+			fmt.Printf("Bumped %s: %s -> %s\n", id, current, version)
+			printBumpChanges(plan)
+			if plan.Moves() == 0 && !versionedFilePattern.MatchString(filepath.Base(path)) {
+				fmt.Fprintf(os.Stderr, "Note: %s does not follow {TYPE}-{name}-v{version}.md and was not renamed\n", path)
+			}
+		}
+		return nil
+	},
+}
+
+// bumpPlan computes the changes that write a bumped document file and rename
+// it to newPath, with the markdown links of the archive following the rename
+func bumpPlan(dir, path, newPath string, file *docfile.File) (*layout.Plan, error) {
+	before, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	after, err := file.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	relPath, err := filepath.Rel(dir, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate %s: %w", path, err)
+	}
+	relPath = filepath.ToSlash(relPath)
+	if newPath == path {
+		return &layout.Plan{Dir: dir, Changes: []rename.Change{{Path: relPath, NewPath: relPath, Before: before, After: after}}}, nil
+	}
+
+	newRelPath := filepath.ToSlash(filepath.Join(filepath.Dir(relPath), filepath.Base(newPath)))
+	moves := map[string]string{relPath: newRelPath}
+	plan, err := layout.MovePlan(dir, moves)
+	if err != nil {
+		return nil, err
+	}
+	for i, change := range plan.Changes {
+		if change.Path == relPath {
+			plan.Changes[i].After = layout.Relink(after, relPath, newRelPath, moves)
+		}
+	}
+	return plan, nil
+}
+
+// printBumpChanges prints the rename of a bumped document and the documents
+// whose links follow it
+func printBumpChanges(plan *layout.Plan) {
+	for _, change := range plan.Changes {
+		switch {
+		case change.Renamed():
+			fmt.Printf("  %s -> %s\n", change.Path, change.NewPath)
+		case len(change.Lines) > 0:
+			fmt.Printf("  %s: links updated\n", change.Path)
+		}
+	}
+}
+
+// versionedPath returns the path of a {TYPE}-{name}-v{version}.md file for
+// another version; other file names are returned unchanged
+func versionedPath(path, version string) string {
+	match := versionedFilePattern.FindStringSubmatch(filepath.Base(path))
+	if match == nil {
+		return path
+	}
+	return filepath.Join(filepath.Dir(path), fmt.Sprintf("%s-v%s.md", match[1], version))
+}
+
+// gitUserName returns git config user.name for a directory, or "" if unset
+func gitUserName(dir string) string {
+	gitCmd := exec.Command("git", "config", "user.name")
+	gitCmd.Dir = dir
+	output, err := gitCmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+func init() {
+	rootCmd.AddCommand(bumpCmd)
+
+	bumpCmd.Flags().StringP("message", "m", "", "Changelog message (required)")
+	bumpCmd.Flags().String("author", "", "Changelog author (default: git config user.name)")
+	bumpCmd.Flags().Bool("breaking", false, "Mark the change as breaking (requires a major bump)")
+	bumpCmd.Flags().Bool("dry-run", false, "Show the new version without writing files")
+}
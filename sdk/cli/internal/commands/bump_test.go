@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/docfile"
+)
+
+func TestBumpCommand(t *testing.T) {
+	archiveDir := t.TempDir()
+	docsDir := filepath.Join(archiveDir, "documents", "01-strategic")
+	if err := os.MkdirAll(docsDir, 0755); err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	oldPath := filepath.Join(docsDir, "STR-growth-v1.2.3.md")
+	os.WriteFile(oldPath, []byte("---\nid: STR-growth\ntitle: Growth\ntype: STR\nstatus: Draft\nversion: 1.2.3\nowner: alice\n---\n\n# Growth\n"), 0644)
+	objPath := filepath.Join(docsDir, "OBJ-targets.md")
+	os.WriteFile(objPath, []byte("---\nid: OBJ-targets\ntitle: Targets\ntype: OBJ\nstatus: Draft\nversion: 1.0.0\nowner: alice\n---\n\nSee the [strategy](STR-growth-v1.2.3.md#goals).\n"), 0644)
+
+	bumpCmd.Flags().Set("author", "Jane Doe")
+	defer bumpCmd.Flags().Set("author", "")
+	defer bumpCmd.Flags().Set("message", "")
+	defer bumpCmd.Flags().Set("breaking", "false")
+
+	if err := bumpCmd.RunE(bumpCmd, []string{"STR-growth", "minor", archiveDir}); err == nil || !strings.Contains(err.Error(), "message is required") {
+		t.Errorf("Expected missing message error, got %v", err)
+	}
+
+	bumpCmd.Flags().Set("message", "Rework pricing")
+	bumpCmd.Flags().Set("breaking", "true")
+	if err := bumpCmd.RunE(bumpCmd, []string{"STR-growth", "minor", archiveDir}); err == nil || !strings.Contains(err.Error(), "require a major bump") {
+		t.Errorf("Expected breaking change error, got %v", err)
+	}
+
+	if err := bumpCmd.RunE(bumpCmd, []string{"STR-growth", "major", archiveDir}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := os.Stat(oldPath); !os.IsNotExist(err) {
+		t.Error("Expected the old file to be renamed")
+	}
+	file, err := docfile.Read(filepath.Join(docsDir, "STR-growth-v2.0.0.md"))
+	if err != nil {
+		t.Fatalf("Expected renamed file: %v", err)
+	}
+	if file.Get("version") != "2.0.0" || file.Get("updated") == "" {
+		t.Errorf("Expected version 2.0.0 and updated date, got %q, %q", file.Get("version"), file.Get("updated"))
+	}
+	content, _ := file.Bytes()
+	for _, expected := range []string{"- version: 2.0.0", "author: Jane Doe", "changes: Rework pricing", "breaking_changes: true"} {
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Expected %q in changelog:\n%s", expected, content)
+		}
+	}
+
+	// Links of other documents follow the rename
+	linking, _ := os.ReadFile(objPath)
+	if !strings.Contains(string(linking), "[strategy](STR-growth-v2.0.0.md#goals)") {
+		t.Errorf("Expected the link to follow the rename:\n%s", linking)
+	}
+
+	bumpCmd.Flags().Set("breaking", "false")
+	if err := bumpCmd.RunE(bumpCmd, []string{"STR-growth", "huge", archiveDir}); err == nil || !strings.Contains(err.Error(), "unknown version bump") {
+		t.Errorf("Expected unknown bump error, got %v", err)
+	}
+}
+
+func TestVersionedPath(t *testing.T) {
+	tests := map[string]string{
+		"docs/STR-growth-v1.0.0.md":      "docs/STR-growth-v1.1.0.md",
+		"docs/STR-growth-v2-v10.0.12.md": "docs/STR-growth-v2-v1.1.0.md",
+		"docs/STR-growth.md":             "docs/STR-growth.md",
+	}
+	for path, expected := range tests {
+		if got := versionedPath(path, "1.1.0"); got != expected {
+			t.Errorf("versionedPath(%q) = %q, expected %q", path, got, expected)
+		}
+	}
+}
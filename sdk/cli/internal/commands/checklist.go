@@ -0,0 +1,148 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/conformance"
+)
+
+// checklistCmd represents the checklist command
+var checklistCmd = &cobra.Command{
+	Use:   "checklist <bspec-file|directory>",
+	Short: "Report the Quality Standards checklist completion of each document",
+	Long: `Read the Quality Standards checklists ("### Bronze Level", "### Silver
+Level", "### Gold Level") and the "## Validation Checklist" of every document
+body and report how many items are checked.
+
+A document is ready for a level when its checklist for that level and the
+checklists of the levels below it have items and are all checked. Deprecated
+documents are left out.
+
+With --level, the command exits with an error when a document with a
+checklist for that level is not ready for it, so it can be used in CI.
+
+Examples:
+  bspec checklist project.bspec
+  bspec checklist ./project -o json
+  bspec checklist ./project --level=silver
+  bspec checklist ./project --write    # Write computed/analysis/checklist-report.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		inputPath := args[0]
+
+		if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+			return fmt.Errorf("path does not exist: %s", inputPath)
+		}
+
+		level, _ := cmd.Flags().GetString("level")
+		level = strings.ToLower(level)
+		if level != "" && conformance.LevelRank(level) == 0 {
+			return fmt.Errorf("unknown conformance level: %s", level)
+		}
+
+		arch, err := readArchiveFromPath(inputPath)
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+		report := conformance.Checklists(arch)
+
+		write, _ := cmd.Flags().GetBool("write")
+		if write {
+			if !isDirectory(inputPath) {
+				return fmt.Errorf("--write requires an archive directory; extract the .bspec file first")
+			}
+			path := filepath.Join(inputPath, conformance.ChecklistReportPath)
+			if err := writeJSONFile(path, report); err != nil {
+				return err
+			}
+			if !viper.GetBool("quiet") {
+				fmt.Fprintf(os.Stderr, "Wrote %s\n", path)
+			}
+		}
+
+		if viper.GetString("output") == "json" {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(report); err != nil {
+				return fmt.Errorf("failed to encode report: %w", err)
+			}
+		} else if !viper.GetBool("quiet") {
+			printChecklistReport(os.Stdout, report)
+		}
+
+		if level != "" {
+			if notReady := notReadyDocuments(report, level); len(notReady) > 0 {
+				return fmt.Errorf("%d documents are not %s-ready: %s", len(notReady), level, strings.Join(notReady, ", "))
+			}
+		}
+		return nil
+	},
+}
+
+// notReadyDocuments returns the IDs of the documents with a checklist for a
+// level that are not ready for it
+func notReadyDocuments(report *conformance.ChecklistReport, level string) []string {
+	var notReady []string
+	for _, doc := range report.Documents {
+		if _, ok := doc.Levels[level]; ok && conformance.LevelRank(doc.Ready) < conformance.LevelRank(level) {
+			notReady = append(notReady, doc.ID)
+		}
+	}
+	return notReady
+}
+
+// printChecklistReport writes a human-readable checklist report
+func printChecklistReport(w io.Writer, report *conformance.ChecklistReport) {
+	if len(report.Documents) == 0 {
+		fmt.Fprintln(w, "No documents found")
+		return
+	}
+
+	fmt.Fprintf(w, "%-32s %-5s %-8s %-8s %-8s %-10s %s\n", "ID", "TYPE", "BRONZE", "SILVER", "GOLD", "VALIDATION", "READY")
+	for _, doc := range report.Documents {
+		fmt.Fprintf(w, "%-32s %-5s", doc.ID, doc.Type)
+		for _, level := range bspec.QualityLevels() {
+			fmt.Fprintf(w, " %-8s", formatCompletion(doc.Levels[string(level)]))
+		}
+		validation := conformance.Completion{}
+		if doc.Validation != nil {
+			validation = *doc.Validation
+		}
+		fmt.Fprintf(w, " %-10s %s\n", formatCompletion(validation), doc.Ready)
+	}
+
+	fmt.Fprintln(w)
+	for _, level := range bspec.QualityLevels() {
+		totals := report.Levels[string(level)]
+		fmt.Fprintf(w, "%-10s %d/%d items checked, %d/%d checklists complete, %d documents ready\n",
+			level, totals.Checked, totals.Total, totals.Complete, totals.Documents, totals.Ready)
+	}
+	fmt.Fprintf(w, "%-10s %d/%d items checked, %d/%d checklists complete\n",
+		conformance.ValidationChecklist, report.Validation.Checked, report.Validation.Total,
+		report.Validation.Complete, report.Validation.Documents)
+}
+
+// formatCompletion formats a checklist as "checked/total", or "-" if the
+// document has none
+func formatCompletion(completion conformance.Completion) string {
+	if completion.Total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d/%d", completion.Checked, completion.Total)
+}
+
+func init() {
+	rootCmd.AddCommand(checklistCmd)
+
+	checklistCmd.Flags().String("level", "", "Fail when documents are not ready for this level (bronze, silver, gold)")
+	checklistCmd.Flags().Bool("write", false, "Write the report to computed/analysis/checklist-report.json")
+}
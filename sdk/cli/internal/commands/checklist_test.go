@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/conformance"
+)
+
+func TestChecklistCommand(t *testing.T) {
+	archiveDir := t.TempDir()
+	docsDir := filepath.Join(archiveDir, "documents", "01-strategic")
+	if err := os.MkdirAll(docsDir, 0755); err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	os.WriteFile(filepath.Join(archiveDir, "manifest.json"), []byte(`{"name":"Test Project"}`), 0644)
+	os.WriteFile(filepath.Join(docsDir, "MSN-mission.md"), []byte("---\nid: MSN-mission\ntitle: Mission\ntype: MSN\nstatus: Draft\n---\n\n# Mission\n\n### Bronze Level\n- [x] Purpose stated\n\n### Silver Level\n- [ ] Evidence cited\n"), 0644)
+
+	checklistCmd.Flags().Set("write", "true")
+	defer checklistCmd.Flags().Set("write", "false")
+	checklistCmd.Flags().Set("level", "bronze")
+	defer checklistCmd.Flags().Set("level", "")
+
+	if err := checklistCmd.RunE(checklistCmd, []string{archiveDir}); err != nil {
+		t.Fatalf("Expected the document to be bronze-ready, got %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(archiveDir, conformance.ChecklistReportPath))
+	if err != nil {
+		t.Fatalf("Expected checklist report to be written: %v", err)
+	}
+	var report conformance.ChecklistReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("Failed to parse report: %v", err)
+	}
+	if len(report.Documents) != 1 || report.Documents[0].Ready != "bronze" {
+		t.Errorf("Unexpected report: %+v", report.Documents)
+	}
+
+	checklistCmd.Flags().Set("level", "silver")
+	if err := checklistCmd.RunE(checklistCmd, []string{archiveDir}); err == nil || !strings.Contains(err.Error(), "not silver-ready: MSN-mission") {
+		t.Errorf("Expected not ready error, got %v", err)
+	}
+
+	checklistCmd.Flags().Set("level", "platinum")
+	if err := checklistCmd.RunE(checklistCmd, []string{archiveDir}); err == nil || !strings.Contains(err.Error(), "unknown conformance level") {
+		t.Errorf("Expected unknown level error, got %v", err)
+	}
+}
+
+func TestPrintChecklistReport(t *testing.T) {
+	report := &conformance.ChecklistReport{
+		Documents: []conformance.DocumentChecklists{{
+			ID: "MSN-mission", Type: "MSN", Ready: "bronze",
+			Levels:     map[string]conformance.Completion{"bronze": {Checked: 2, Total: 2}, "silver": {Checked: 1, Total: 3}},
+			Validation: &conformance.Completion{Checked: 0, Total: 4},
+		}},
+		Levels: map[string]*conformance.ChecklistTotals{
+			"bronze": {Documents: 1, Complete: 1, Ready: 1, Checked: 2, Total: 2},
+			"silver": {Documents: 1, Checked: 1, Total: 3},
+			"gold":   {},
+		},
+		Validation: &conformance.ChecklistTotals{Documents: 1, Total: 4},
+	}
+
+	var buf bytes.Buffer
+	printChecklistReport(&buf, report)
+
+	output := buf.String()
+	for _, expected := range []string{"MSN-mission", "2/2      1/3      -        0/4        bronze", "bronze     2/2 items checked, 1/1 checklists complete, 1 documents ready", "validation 0/4 items checked, 0/1 checklists complete"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %q in output:\n%s", expected, output)
+		}
+	}
+}
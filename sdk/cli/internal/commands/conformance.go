@@ -0,0 +1,157 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/conformance"
+)
+
+// conformanceCmd represents the conformance command
+var conformanceCmd = &cobra.Command{
+	Use:   "conformance <bspec-file|directory>",
+	Short: "Check whether an archive meets its conformance level",
+	Long: `Evaluate an archive against the Bronze, Silver and Gold conformance levels.
+
+Each level requires a set of document types (plus the documents of the
+industry profile from Silver up), a minimum document status (any for Bronze,
+Review for Silver, Accepted for Gold), a completed Quality Standards checklist
+for the level in each required document, and a minimum number of documents
+(12 for Bronze, 25 for Silver, 45 for Gold).
+
+The target level and industry profile default to the manifest values. The
+command exits with an error when the target level is not met, so it can be
+used in CI.
+
+Examples:
+  bspec conformance project.bspec
+  bspec conformance ./project --level=silver
+  bspec conformance ./project --profile=nonprofit -o json
+  bspec conformance ./project --write    # Write computed/analysis/conformance-report.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		inputPath := args[0]
+
+		// Check if path exists
+		if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+			return fmt.Errorf("path does not exist: %s", inputPath)
+		}
+
+		arch, err := readArchiveFromPath(inputPath)
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		level, _ := cmd.Flags().GetString("level")
+		profile, _ := cmd.Flags().GetString("profile")
+		report, err := conformance.Evaluate(arch, conformance.Options{
+			Level:   bspec.ConformanceLevel(strings.ToLower(level)),
+			Profile: bspec.IndustryProfile(strings.ToLower(profile)),
+		})
+		if err != nil {
+			return err
+		}
+
+		write, _ := cmd.Flags().GetBool("write")
+		if write {
+			if !isDirectory(inputPath) {
+				return fmt.Errorf("--write requires an archive directory; extract the .bspec file first")
+			}
+			path := filepath.Join(inputPath, conformance.ReportPath)
+			if err := writeJSONFile(path, report); err != nil {
+				return err
+			}
+			if !viper.GetBool("quiet") {
+				fmt.Fprintf(os.Stderr, "Wrote %s\n", path)
+			}
+		}
+
+		if viper.GetString("output") == "json" {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(report); err != nil {
+				return fmt.Errorf("failed to encode report: %w", err)
+			}
+		} else if !viper.GetBool("quiet") {
+			verbose := viper.GetBool("verbose")
+			printConformanceReport(os.Stdout, report, verbose)
+		}
+
+		if !report.Met() {
+			return reported(cmd, "conformance level %s not met (current level: %s)", report.TargetLevel, report.CurrentLevel)
+		}
+		return nil
+	},
+}
+
+// printConformanceReport writes a human-readable conformance report
+func printConformanceReport(w io.Writer, report *conformance.Report, verbose bool) {
+	profile := report.IndustryProfile
+	if profile == "" {
+		profile = "none"
+	}
+	fmt.Fprintf(w, "Target level:  %s\n", report.TargetLevel)
+	fmt.Fprintf(w, "Current level: %s\n", report.CurrentLevel)
+	fmt.Fprintf(w, "Profile:       %s\n\n", profile)
+
+	for _, requirements := range conformance.Levels() {
+		assessment := report.Level(requirements.Level)
+		fmt.Fprintf(w, "%-7s %-12s %3.0f%% (%d/%d requirements)\n",
+			requirements.Level, assessment.Status, assessment.Score*100,
+			assessment.RequirementsMet, assessment.RequirementsTotal)
+		if len(assessment.Missing) > 0 {
+			fmt.Fprintf(w, "        missing: %s\n", strings.Join(assessment.Missing, ", "))
+		}
+		if verbose {
+			for _, requirement := range assessment.Requirements {
+				mark := "✗"
+				if requirement.Met {
+					mark = "✓"
+				}
+				fmt.Fprintf(w, "        %s %s", mark, requirement.Description)
+				if requirement.Detail != "" {
+					fmt.Fprintf(w, " (%s)", requirement.Detail)
+				}
+				fmt.Fprintln(w)
+			}
+		}
+	}
+
+	if len(report.Recommendations) > 0 {
+		fmt.Fprintf(w, "\nRecommendations:\n")
+		for _, recommendation := range report.Recommendations {
+			fmt.Fprintf(w, "  [%s] %s\n", recommendation.Priority, recommendation.Suggestion)
+		}
+	}
+}
+
+// writeJSONFile writes a value as indented JSON, creating parent directories
+func writeJSONFile(path string, value interface{}) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", filepath.Base(path), err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(conformanceCmd)
+
+	conformanceCmd.Flags().String("level", "", "Target conformance level (default: manifest conformance_level)")
+	conformanceCmd.Flags().String("profile", "", "Industry profile (default: manifest industry_profile)")
+	conformanceCmd.Flags().Bool("write", false, "Write the report to computed/analysis/conformance-report.json (directories only)")
+}
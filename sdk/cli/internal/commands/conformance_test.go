@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/conformance"
+)
+
+func TestConformanceCommand(t *testing.T) {
+	archiveDir := t.TempDir()
+	docsDir := filepath.Join(archiveDir, "documents", "01-strategic")
+	if err := os.MkdirAll(docsDir, 0755); err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	os.WriteFile(filepath.Join(archiveDir, "manifest.json"), []byte(`{"name":"Test Project","conformance_level":"bronze","industry_profile":"software-saas"}`), 0644)
+	os.WriteFile(filepath.Join(docsDir, "MSN-mission.md"), []byte("---\nid: MSN-mission\ntitle: Mission\ntype: MSN\nstatus: Draft\n---\n\n# Mission\n"), 0644)
+
+	conformanceCmd.Flags().Set("write", "true")
+	defer conformanceCmd.Flags().Set("write", "false")
+
+	// The archive is far from Bronze, so the command fails
+	if err := conformanceCmd.RunE(conformanceCmd, []string{archiveDir}); !errors.Is(err, ErrReported) || !conformanceCmd.SilenceUsage {
+		t.Errorf("Expected a reported error when the target level is not met, got %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(archiveDir, conformance.ReportPath))
+	if err != nil {
+		t.Fatalf("Expected conformance report to be written: %v", err)
+	}
+	var report conformance.Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("Failed to parse report: %v", err)
+	}
+	if report.TargetLevel != "bronze" || report.CurrentLevel != conformance.LevelNone {
+		t.Errorf("Unexpected levels: target %s, current %s", report.TargetLevel, report.CurrentLevel)
+	}
+	if len(report.MissingForGold) == 0 {
+		t.Error("Expected missing_for_gold to be populated")
+	}
+
+	conformanceCmd.Flags().Set("level", "platinum")
+	defer conformanceCmd.Flags().Set("level", "")
+	if err := conformanceCmd.RunE(conformanceCmd, []string{archiveDir}); err == nil || !strings.Contains(err.Error(), "unknown conformance level") {
+		t.Errorf("Expected unknown level error, got %v", err)
+	}
+}
+
+func TestPrintConformanceReport(t *testing.T) {
+	report := &conformance.Report{
+		TargetLevel:  "silver",
+		CurrentLevel: "bronze",
+		Assessment: conformance.Assessment{
+			Bronze: &conformance.LevelAssessment{Status: conformance.StatusAchieved, Score: 1, RequirementsMet: 4, RequirementsTotal: 4},
+			Silver: &conformance.LevelAssessment{Status: conformance.StatusInProgress, Score: 0.5, RequirementsMet: 2, RequirementsTotal: 4, Missing: []string{"MKT"},
+				Requirements: []conformance.Requirement{{Description: "MKT document exists"}}},
+			Gold: &conformance.LevelAssessment{Status: conformance.StatusNotStarted, RequirementsTotal: 4},
+		},
+		Recommendations: []conformance.Recommendation{{Priority: "high", Suggestion: "Create the missing MKT document"}},
+	}
+
+	var buf bytes.Buffer
+	printConformanceReport(&buf, report, true)
+
+	output := buf.String()
+	for _, expected := range []string{"Profile:       none", "silver  in_progress   50% (2/4 requirements)", "missing: MKT", "✗ MKT document exists", "[high] Create the missing MKT document"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %q in output:\n%s", expected, output)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/export"
+)
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a BSpec archive to other formats",
+	Long: `Export a BSpec archive to formats that can be shared without the CLI.
+
+Available formats:
+  html    Self-contained static HTML site`,
+}
+
+// exportHTMLCmd represents the export html command
+var exportHTMLCmd = &cobra.Command{
+	Use:   "html <bspec-file|directory> <output-directory>",
+	Short: "Export an archive as a static HTML site",
+	Long: `Export an archive as a self-contained static HTML site.
+
+The site contains:
+  - index.html: documents grouped by domain, with the conformance level
+  - documents/: one page per document with a metadata card and links to the
+    documents it references and the documents that reference it
+  - assets/: copies of the archive assets
+  - client-side search that also works when the site is opened from disk
+
+Examples:
+  bspec export html project.bspec ./site
+  bspec export html ./project ./site --title="Acme Business Specification"
+  bspec export html project.bspec ./site --force    # Overwrite existing directory`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		inputPath, outputDir := args[0], args[1]
+
+		// Check if path exists
+		if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+			return fmt.Errorf("path does not exist: %s", inputPath)
+		}
+
+		arch, err := readArchiveFromPath(inputPath)
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		// Check if output directory exists
+		force, _ := cmd.Flags().GetBool("force")
+		if _, err := os.Stat(outputDir); err == nil {
+			if !force {
+				return fmt.Errorf("output directory already exists: %s (use --force to overwrite)", outputDir)
+			}
+			contains, err := pathContains(outputDir, inputPath)
+			if err != nil {
+				return err
+			}
+			if contains {
+				return fmt.Errorf("refusing to overwrite %s: it contains the archive %s", outputDir, inputPath)
+			}
+			if err := os.RemoveAll(outputDir); err != nil {
+				return fmt.Errorf("failed to remove existing directory: %w", err)
+			}
+		}
+
+		title, _ := cmd.Flags().GetString("title")
+		result, err := export.ExportHTML(arch, outputDir, export.HTMLOptions{Title: title})
+		if err != nil {
+			return fmt.Errorf("failed to export HTML: %w", err)
+		}
+
+		if !viper.GetBool("quiet") {
+			fmt.Printf("Exported %d pages and %d assets to %s\n", result.Pages, result.Assets, outputDir)
+		}
+		return nil
+	},
+}
+
+// pathContains reports whether path is dir or below it, after resolving
+// both to absolute paths without symbolic links
+func pathContains(dir, path string) (bool, error) {
+	resolve := func(p string) (string, error) {
+		abs, err := filepath.Abs(p)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve %s: %w", p, err)
+		}
+		if resolved, err := filepath.EvalSymlinks(abs); err == nil {
+			abs = resolved
+		}
+		return filepath.Clean(abs), nil
+	}
+	dir, err := resolve(dir)
+	if err != nil {
+		return false, err
+	}
+	path, err = resolve(path)
+	if err != nil {
+		return false, err
+	}
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false, nil
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))), nil
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.AddCommand(exportHTMLCmd)
+
+	exportHTMLCmd.Flags().String("title", "", "Site title (default: manifest name)")
+	exportHTMLCmd.Flags().BoolP("force", "f", false, "Overwrite existing output directory")
+}
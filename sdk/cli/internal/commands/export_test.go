@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExportHTMLCommand(t *testing.T) {
+	tmpDir := t.TempDir()
+	archiveDir := filepath.Join(tmpDir, "project")
+	docsDir := filepath.Join(archiveDir, "documents", "01-strategic")
+	if err := os.MkdirAll(docsDir, 0755); err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	os.WriteFile(filepath.Join(archiveDir, "manifest.json"), []byte(`{"name":"Test Project","conformance_level":"bronze"}`), 0644)
+	os.WriteFile(filepath.Join(docsDir, "MSN-mission.md"), []byte("---\nid: MSN-mission\ntitle: Mission\ntype: MSN\nstatus: Draft\n---\n\n# Mission\n"), 0644)
+
+	siteDir := filepath.Join(tmpDir, "site")
+	if err := exportHTMLCmd.RunE(exportHTMLCmd, []string{archiveDir, siteDir}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	index, err := os.ReadFile(filepath.Join(siteDir, "index.html"))
+	if err != nil {
+		t.Fatalf("Expected index.html to exist: %v", err)
+	}
+	if !strings.Contains(string(index), "Test Project") {
+		t.Error("Expected index.html to use the manifest name as title")
+	}
+	if _, err := os.Stat(filepath.Join(siteDir, "documents", "MSN-mission.html")); err != nil {
+		t.Errorf("Expected document page to exist: %v", err)
+	}
+
+	// Existing output directories are not overwritten without --force
+	if err := exportHTMLCmd.RunE(exportHTMLCmd, []string{archiveDir, siteDir}); err == nil {
+		t.Error("Expected error for existing output directory")
+	}
+
+	// --force never removes the archive or a directory containing it
+	exportHTMLCmd.Flags().Set("force", "true")
+	defer exportHTMLCmd.Flags().Set("force", "false")
+	for _, outputDir := range []string{archiveDir, filepath.Join(archiveDir, "documents", ".."), tmpDir} {
+		if err := exportHTMLCmd.RunE(exportHTMLCmd, []string{archiveDir, outputDir}); err == nil || !strings.Contains(err.Error(), "contains the archive") {
+			t.Errorf("Expected %s to be refused, got %v", outputDir, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(archiveDir, "manifest.json")); err != nil {
+		t.Fatalf("Expected the archive to be kept: %v", err)
+	}
+	if err := exportHTMLCmd.RunE(exportHTMLCmd, []string{archiveDir, siteDir}); err != nil {
+		t.Errorf("Expected --force to overwrite the site, got %v", err)
+	}
+}
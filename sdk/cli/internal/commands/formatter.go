@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/output"
+)
+
+// addTemplateFlags registers the template flags shared by commands that render through output.Formatter
+func addTemplateFlags(cmd *cobra.Command) {
+	cmd.Flags().String("template", "", "Render output with a Go template file or built-in template name")
+	cmd.Flags().String("template-string", "", "Render output with an inline Go template")
+}
+
+// newFormatter creates the output formatter for a command from the global
+// output format and the command's pretty and template flags
+func newFormatter(cmd *cobra.Command) (*output.Formatter, error) {
+	outputFormat := viper.GetString("output")
+	prettyFlag, _ := cmd.Flags().GetBool("pretty")
+
+	formatter, err := output.NewFormatter(outputFormat, prettyFlag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create formatter: %w", err)
+	}
+
+	templateName, _ := cmd.Flags().GetString("template")
+	templateString, _ := cmd.Flags().GetString("template-string")
+
+	switch {
+	case templateName != "" && templateString != "":
+		return nil, fmt.Errorf("--template and --template-string cannot be used together")
+	case templateName != "":
+		tmpl, err := output.LoadTemplate(templateName)
+		if err != nil {
+			return nil, err
+		}
+		formatter.SetTemplate(tmpl)
+	case templateString != "":
+		tmpl, err := output.ParseTemplate("template-string", templateString)
+		if err != nil {
+			return nil, err
+		}
+		formatter.SetTemplate(tmpl)
+	}
+
+	return formatter, nil
+}
@@ -0,0 +1,146 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/gaps"
+)
+
+// gapsCmd represents the gaps command
+var gapsCmd = &cobra.Command{
+	Use:   "gaps <bspec-file|directory>",
+	Short: "Suggest the next documents to write and missing relationships",
+	Long: `Analyze which documents an archive is missing and which relationships
+between existing documents are expected but absent.
+
+Suggestions combine the conformance requirements with the relationship
+guidelines of each document type in the specification. The types required up
+to the target level come first, then those of the following levels, then the
+other types; within each group a document type is listed before the suggested
+types that depend on it.
+
+Missing links list, for every document, the typical dependencies of its type
+that it is not linked to, the existing documents of the types it typically
+enables that it is not linked to, and the references its validation expects,
+such as an RSK to a MIT in related.
+
+Examples:
+  bspec gaps project.bspec
+  bspec gaps ./project --level=silver --limit=5
+  bspec gaps ./project --links=false -o json
+  bspec gaps ./project --write    # Write computed/analysis/gap-analysis.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		inputPath := args[0]
+
+		// Check if path exists
+		if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+			return fmt.Errorf("path does not exist: %s", inputPath)
+		}
+
+		arch, err := readArchiveFromPath(inputPath)
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		level, _ := cmd.Flags().GetString("level")
+		profile, _ := cmd.Flags().GetString("profile")
+		report, err := gaps.Analyze(arch, gaps.Options{
+			Level:   bspec.ConformanceLevel(strings.ToLower(level)),
+			Profile: bspec.IndustryProfile(strings.ToLower(profile)),
+		})
+		if err != nil {
+			return err
+		}
+
+		write, _ := cmd.Flags().GetBool("write")
+		if write {
+			if !isDirectory(inputPath) {
+				return fmt.Errorf("--write requires an archive directory; extract the .bspec file first")
+			}
+			path := filepath.Join(inputPath, gaps.ReportPath)
+			if err := writeJSONFile(path, report); err != nil {
+				return err
+			}
+			if !viper.GetBool("quiet") {
+				fmt.Fprintf(os.Stderr, "Wrote %s\n", path)
+			}
+		}
+
+		limit, _ := cmd.Flags().GetInt("limit")
+		if limit > 0 && len(report.Suggestions) > limit {
+			report.Suggestions = report.Suggestions[:limit]
+		}
+		links, _ := cmd.Flags().GetBool("links")
+		if !links {
+			report.MissingLinks = nil
+		}
+
+		if viper.GetString("output") == "json" {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(report); err != nil {
+				return fmt.Errorf("failed to encode report: %w", err)
+			}
+			return nil
+		}
+
+		if !viper.GetBool("quiet") {
+			printGapReport(os.Stdout, report)
+		}
+		return nil
+	},
+}
+
+// printGapReport writes a human-readable gap analysis
+func printGapReport(w io.Writer, report *gaps.Report) {
+	fmt.Fprintf(w, "Target level: %s (current: %s)\n", report.TargetLevel, report.CurrentLevel)
+
+	fmt.Fprintf(w, "\nSuggested next documents:\n")
+	if len(report.Suggestions) == 0 {
+		fmt.Fprintf(w, "  None\n")
+	}
+	for i, suggestion := range report.Suggestions {
+		name := suggestion.Name
+		if suggestion.Domain != "" {
+			name += " (" + suggestion.Domain + ")"
+		}
+		fmt.Fprintf(w, "  %2d. [%s] %s  %s\n", i+1, suggestion.Priority, suggestion.DocumentType, name)
+		if len(suggestion.Reasons) > 0 {
+			fmt.Fprintf(w, "      %s\n", strings.Join(suggestion.Reasons, "; "))
+		}
+		if len(suggestion.After) > 0 {
+			fmt.Fprintf(w, "      write after: %s\n", strings.Join(suggestion.After, ", "))
+		}
+	}
+
+	if report.MissingLinks == nil {
+		return
+	}
+	fmt.Fprintf(w, "\nMissing links:\n")
+	if len(report.MissingLinks) == 0 {
+		fmt.Fprintf(w, "  None\n")
+	}
+	for _, missing := range report.MissingLinks {
+		fmt.Fprintf(w, "  %-11s %s\n", missing.Relationship, missing.Message)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(gapsCmd)
+
+	gapsCmd.Flags().String("level", "", "Target conformance level (default: manifest conformance_level)")
+	gapsCmd.Flags().String("profile", "", "Industry profile (default: manifest industry_profile)")
+	gapsCmd.Flags().Int("limit", 10, "Maximum number of suggestions to show (0 for all)")
+	gapsCmd.Flags().Bool("links", true, "Show missing links between existing documents")
+	gapsCmd.Flags().Bool("write", false, "Write the analysis to computed/analysis/gap-analysis.json (directories only)")
+}
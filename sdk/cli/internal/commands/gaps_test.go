@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/gaps"
+)
+
+func TestGapsCommand(t *testing.T) {
+	archiveDir := t.TempDir()
+	docsDir := filepath.Join(archiveDir, "documents", "01-strategic")
+	if err := os.MkdirAll(docsDir, 0755); err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	os.WriteFile(filepath.Join(archiveDir, "manifest.json"), []byte(`{"name":"Test Project","conformance_level":"bronze","industry_profile":"software-saas"}`), 0644)
+	os.WriteFile(filepath.Join(docsDir, "VSN-vision.md"), []byte("---\nid: VSN-vision\ntitle: Vision\ntype: VSN\nstatus: Draft\n---\n\n# Vision\n"), 0644)
+
+	gapsCmd.Flags().Set("write", "true")
+	defer gapsCmd.Flags().Set("write", "false")
+
+	if err := gapsCmd.RunE(gapsCmd, []string{archiveDir}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(archiveDir, gaps.ReportPath))
+	if err != nil {
+		t.Fatalf("Expected gap analysis to be written: %v", err)
+	}
+	var report gaps.Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("Failed to parse report: %v", err)
+	}
+	if len(report.Suggestions) <= 10 {
+		t.Errorf("Expected the written report not to be limited, got %d suggestions", len(report.Suggestions))
+	}
+	found := false
+	for _, missing := range report.MissingLinks {
+		if missing.Document == "VSN-vision" && missing.ExpectedType == "MSN" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected VSN-vision to miss a link to MSN, got %+v", report.MissingLinks)
+	}
+
+	if err := gapsCmd.RunE(gapsCmd, []string{filepath.Join(archiveDir, "missing")}); err == nil {
+		t.Error("Expected error for missing path")
+	}
+}
+
+func TestPrintGapReport(t *testing.T) {
+	report := &gaps.Report{
+		TargetLevel:  "bronze",
+		CurrentLevel: "none",
+		Suggestions: []gaps.Suggestion{
+			{DocumentType: "MSN", Name: "Mission Statement", Domain: "Strategic Foundation", Priority: gaps.PriorityHigh,
+				Reasons: []string{"dependency of VSN", "required for bronze conformance"}},
+			{DocumentType: "STR", Name: "Business Strategy", Priority: gaps.PriorityMedium, After: []string{"MSN"}},
+		},
+		MissingLinks: []gaps.MissingLink{{Relationship: "depends_on", Message: "VSN-vision has no link to a MSN document"}},
+	}
+
+	var buf bytes.Buffer
+	printGapReport(&buf, report)
+
+	output := buf.String()
+	for _, expected := range []string{
+		"Target level: bronze (current: none)",
+		"1. [high] MSN  Mission Statement (Strategic Foundation)",
+		"dependency of VSN; required for bronze conformance",
+		"2. [medium] STR  Business Strategy\n",
+		"write after: MSN",
+		"depends_on  VSN-vision has no link to a MSN document",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %q in output:\n%s", expected, output)
+		}
+	}
+}
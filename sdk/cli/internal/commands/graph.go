@@ -0,0 +1,199 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/graph"
+)
+
+// dependencyGraphPath is where the dependency graph is stored inside an archive directory
+const dependencyGraphPath = "computed/relationships/dependency-graph.json"
+
+// diagramsDir is where rendered diagrams are stored inside an archive directory
+const diagramsDir = "assets/diagrams"
+
+// graphCmd represents the graph command
+var graphCmd = &cobra.Command{
+	Use:   "graph <bspec-file|directory>",
+	Short: "Export the document relationship graph",
+	Long: `Export the relationships between BSpec documents as a graph.
+
+Edges are built from the relationship fields in document frontmatter
+(depends_on, enables, conflicts_with, related, parent, supersedes, risks,
+metrics). Nodes are colored by document status, and references to documents
+that are not in the archive are drawn as dashed "missing" nodes.
+
+Supported formats:
+  dot       Graphviz DOT
+  mermaid   Mermaid flowchart (renders in GitHub and most docs tools)
+  graphml   GraphML for yEd, Gephi and similar tools
+  json      The dependency-graph.json format from the .bspec specification
+  svg       Laid-out SVG image, one column per domain (no Graphviz needed)
+
+Use --domain-map to collapse documents into one node per domain.
+
+Examples:
+  bspec graph project.bspec                                   # DOT to stdout
+  bspec graph project.bspec --format=mermaid                  # Mermaid flowchart
+  bspec graph project.bspec --format=dot | dot -Tpng > graph.png
+  bspec graph project.bspec --domain=strategic,product        # Only these domains
+  bspec graph project.bspec --type=RSK,MIT --edge-type=depends_on
+  bspec graph project.bspec --format=graphml --out=graph.graphml
+  bspec graph project.bspec --format=svg > relationships.svg
+  bspec graph ./project --format=svg --save                   # assets/diagrams/relationship-map.svg
+  bspec graph ./project --format=svg --domain-map --save      # assets/diagrams/domain-map.svg
+  bspec graph ./project --write                               # Update computed/relationships/dependency-graph.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		inputPath := args[0]
+
+		// Check if path exists
+		if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+			return fmt.Errorf("path does not exist: %s", inputPath)
+		}
+
+		arch, err := readArchiveFromPath(inputPath)
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		domains, _ := cmd.Flags().GetStringSlice("domain")
+		types, _ := cmd.Flags().GetStringSlice("type")
+		edgeTypes, _ := cmd.Flags().GetStringSlice("edge-type")
+		outFile, _ := cmd.Flags().GetString("out")
+		write, _ := cmd.Flags().GetBool("write")
+		save, _ := cmd.Flags().GetBool("save")
+		domainMap, _ := cmd.Flags().GetBool("domain-map")
+
+		if err := validateEdgeTypes(edgeTypes); err != nil {
+			return err
+		}
+
+		g := graph.Build(arch, graph.Options{
+			Domains:   domains,
+			Types:     types,
+			EdgeTypes: edgeTypes,
+		})
+
+		if (write || save) && !isDirectory(inputPath) {
+			return fmt.Errorf("--write and --save require an archive directory; extract the .bspec file first")
+		}
+
+		// Store the JSON graph inside an archive directory
+		if write {
+			path := filepath.Join(inputPath, dependencyGraphPath)
+			if err := writeGraphFile(path, g, graph.FormatJSON); err != nil {
+				return err
+			}
+			if !viper.GetBool("quiet") {
+				fmt.Fprintf(os.Stderr, "Wrote %s (%d nodes, %d edges)\n", path, len(g.Nodes), len(g.Edges))
+			}
+			if outFile == "" && !save && !cmd.Flags().Changed("format") {
+				return nil
+			}
+		}
+
+		if domainMap {
+			g = g.DomainMap()
+		}
+
+		// Store the rendered diagram with the archive assets
+		if save {
+			if outFile != "" {
+				return fmt.Errorf("--save and --out cannot be used together")
+			}
+			path := filepath.Join(inputPath, diagramsDir, diagramFileName(domainMap, format))
+			if err := writeGraphFile(path, g, format); err != nil {
+				return err
+			}
+			if !viper.GetBool("quiet") {
+				fmt.Fprintf(os.Stderr, "Wrote %s\n", path)
+			}
+			return nil
+		}
+
+		if outFile != "" {
+			return writeGraphFile(outFile, g, format)
+		}
+
+		return graph.Write(os.Stdout, g, format)
+	},
+}
+
+// writeGraphFile renders the graph in the given format and writes it to path
+func writeGraphFile(path string, g *graph.Graph, format string) error {
+	var buf bytes.Buffer
+	if err := graph.Write(&buf, g, format); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write graph: %w", err)
+	}
+	return nil
+}
+
+// diagramFileName returns the file name a diagram is saved under in assets/diagrams
+func diagramFileName(domainMap bool, format string) string {
+	name := "relationship-map"
+	if domainMap {
+		name = "domain-map"
+	}
+	ext := strings.ToLower(format)
+	switch ext {
+	case graph.FormatMermaid:
+		ext = "mmd"
+	case graph.FormatDOT:
+		ext = "gv"
+	}
+	return name + "." + ext
+}
+
+func isDirectory(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// validateEdgeTypes checks that each edge type is a known relationship field
+func validateEdgeTypes(edgeTypes []string) error {
+	for _, edgeType := range edgeTypes {
+		if !containsFold(archive.RelationshipFields, edgeType) {
+			return fmt.Errorf("unknown edge type: %s (supported: %s)", edgeType, strings.Join(archive.RelationshipFields, ", "))
+		}
+	}
+	return nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	rootCmd.AddCommand(graphCmd)
+
+	graphCmd.Flags().String("format", graph.FormatDOT, "Graph format ("+strings.Join(graph.Formats, "|")+")")
+	graphCmd.Flags().StringSlice("domain", []string{}, "Only include documents in these domains")
+	graphCmd.Flags().StringSlice("type", []string{}, "Only include documents of these types")
+	graphCmd.Flags().StringSlice("edge-type", []string{}, "Only include these relationship types")
+	graphCmd.Flags().String("out", "", "Write the graph to a file instead of stdout")
+	graphCmd.Flags().Bool("write", false, "Write "+dependencyGraphPath+" into the archive directory")
+	graphCmd.Flags().Bool("save", false, "Save the rendered graph under "+diagramsDir+" in the archive directory")
+	graphCmd.Flags().Bool("domain-map", false, "Collapse documents into one node per domain")
+}
@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/graph"
+)
+
+func TestWriteGraphFile(t *testing.T) {
+	arch := &archive.BSpecArchive{
+		Documents: map[string]archive.BSpecDocument{
+			"MSN-mission.md": {ID: "MSN-mission", Type: "MSN", Status: "Accepted"},
+			"STR-growth.md": {ID: "STR-growth", Type: "STR", Status: "Draft",
+				Metadata: map[string]interface{}{"depends_on": []interface{}{"MSN-mission"}}},
+		},
+	}
+	g := graph.Build(arch, graph.Options{})
+
+	path := filepath.Join(t.TempDir(), dependencyGraphPath)
+	if err := writeGraphFile(path, g, graph.FormatJSON); err != nil {
+		t.Fatalf("Failed to write graph: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read graph: %v", err)
+	}
+	var doc graph.Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Failed to parse graph: %v", err)
+	}
+	if doc.Analysis.TotalNodes != 2 || doc.Analysis.TotalEdges != 1 {
+		t.Errorf("Expected 2 nodes and 1 edge, got %+v", doc.Analysis)
+	}
+
+	if err := writeGraphFile(path, g, "svg2"); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+}
+
+func TestValidateEdgeTypes(t *testing.T) {
+	if err := validateEdgeTypes([]string{"depends_on", "ENABLES"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := validateEdgeTypes([]string{"blocks"}); err == nil {
+		t.Error("Expected error for unknown edge type")
+	}
+}
+
+func TestDiagramFileName(t *testing.T) {
+	tests := []struct {
+		domainMap bool
+		format    string
+		expected  string
+	}{
+		{false, "svg", "relationship-map.svg"},
+		{true, "svg", "domain-map.svg"},
+		{false, "mermaid", "relationship-map.mmd"},
+		{false, "dot", "relationship-map.gv"},
+	}
+
+	for _, tt := range tests {
+		if got := diagramFileName(tt.domainMap, tt.format); got != tt.expected {
+			t.Errorf("diagramFileName(%v, %q) = %q, expected %q", tt.domainMap, tt.format, got, tt.expected)
+		}
+	}
+}
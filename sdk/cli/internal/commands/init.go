@@ -3,13 +3,17 @@ package commands
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	bspec "github.com/bspec-foundation/bspec-go"
 	"github.com/spf13/cobra"
 
 	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/scaffold"
 )
 
 // initCmd represents the init command
@@ -25,15 +29,32 @@ This creates:
   - computed/: Directory for computed analysis files
   - README.md: Project documentation
 
+With --scaffold, init also creates the placeholder documents the conformance
+level and industry profile require, such as two PER and two RSK for Bronze, in
+the standard domain folders (01-strategic ... 11-learning). Each placeholder
+contains the section skeleton of its specification template, depends_on links
+to the placeholders of the types it typically depends on, and the references
+its validation expects, such as an RSK to its MIT. Required fields get
+placeholder values and a MET placeholder is created for the metrics of REV and
+CST, so the new project passes validate. A checklist of the documents to write
+is printed in dependency order.
+
 Examples:
   bspec init myproject                           # Create myproject/ directory
   bspec init myproject --author "John Doe"      # Set author
   bspec init myproject --conformance bronze     # Set conformance level
-  bspec init myproject --industry software-saas # Set industry profile`,
+  bspec init myproject --industry software-saas # Set industry profile
+  bspec init myproject --conformance silver --scaffold`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		projectName := args[0]
 
+		createSamples, _ := cmd.Flags().GetBool("samples")
+		scaffoldDocs, _ := cmd.Flags().GetBool("scaffold")
+		if createSamples && scaffoldDocs {
+			return fmt.Errorf("--samples and --scaffold cannot be combined")
+		}
+
 		// Check if directory already exists
 		if _, err := os.Stat(projectName); err == nil {
 			force, _ := cmd.Flags().GetBool("force")
@@ -85,13 +106,35 @@ Examples:
 			}
 		}
 
+		// Build the placeholders before writing anything, so that an unknown
+		// level or profile fails early
+		var placeholders []scaffold.Placeholder
+		var missing []string
+		if scaffoldDocs {
+			var err error
+			placeholders, missing, err = scaffold.Project(scaffold.ProjectOptions{
+				Level:   bspec.ConformanceLevel(strings.ToLower(conformance)),
+				Profile: bspec.IndustryProfile(strings.ToLower(industry)),
+				Owner:   author,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
 		// Create manifest.json
 		if err := createManifest(projectName, projectName, description, author, conformance, industry); err != nil {
 			return fmt.Errorf("failed to create manifest: %w", err)
 		}
 
+		// Create placeholder documents
+		if scaffoldDocs {
+			if err := createPlaceholders(projectName, placeholders); err != nil {
+				return fmt.Errorf("failed to create placeholder documents: %w", err)
+			}
+		}
+
 		// Create sample documents
-		createSamples, _ := cmd.Flags().GetBool("samples")
 		if createSamples {
 			if err := createSampleDocuments(projectName); err != nil {
 				return fmt.Errorf("failed to create sample documents: %w", err)
@@ -109,6 +152,9 @@ Examples:
 		}
 
 		fmt.Printf("Successfully initialized BSpec project: %s\n", projectName)
+		if scaffoldDocs {
+			printPlaceholderChecklist(os.Stdout, placeholders, missing)
+		}
 
 		// Show what was created
 		if verbose, _ := cmd.Flags().GetBool("verbose"); verbose {
@@ -146,6 +192,48 @@ func createManifest(projectDir, name, description, author, conformance, industry
 	return os.WriteFile(manifestPath, manifestData, 0644)
 }
 
+// createPlaceholders writes the placeholder documents and creates every domain folder
+func createPlaceholders(projectDir string, placeholders []scaffold.Placeholder) error {
+	for _, domain := range bspec.Domains() {
+		if domain.Folder == "" {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Join(projectDir, "documents", domain.Folder), 0755); err != nil {
+			return fmt.Errorf("failed to create directory %s: %w", domain.Folder, err)
+		}
+	}
+	for _, placeholder := range placeholders {
+		path := filepath.Join(projectDir, filepath.FromSlash(placeholder.Path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := os.WriteFile(path, placeholder.Content, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", placeholder.Path, err)
+		}
+	}
+	return nil
+}
+
+// printPlaceholderChecklist writes the documents to complete, grouped by
+// conformance level, with the documents to write first
+func printPlaceholderChecklist(w io.Writer, placeholders []scaffold.Placeholder, missing []string) {
+	fmt.Fprintf(w, "\nDocuments to write (%d):\n", len(placeholders))
+	var level bspec.ConformanceLevel
+	for _, placeholder := range placeholders {
+		if placeholder.Level != level {
+			level = placeholder.Level
+			fmt.Fprintf(w, "\n  %s:\n", strings.ToUpper(string(level[:1]))+string(level[1:]))
+		}
+		fmt.Fprintf(w, "  [ ] %-4s %s  %s\n", placeholder.Type, placeholder.Title, placeholder.Path)
+		if len(placeholder.Links) > 0 {
+			fmt.Fprintf(w, "           after: %s\n", strings.Join(placeholder.Links, ", "))
+		}
+	}
+	if len(missing) > 0 {
+		fmt.Fprintf(w, "\nNot in the specification, create manually: %s\n", strings.Join(missing, ", "))
+	}
+}
+
 func createSampleDocuments(projectDir string) error {
 	samples := []struct {
 		filename string
@@ -303,6 +391,7 @@ func init() {
 	initCmd.Flags().StringP("conformance", "c", "bronze", "Conformance level (bronze|silver|gold)")
 	initCmd.Flags().StringP("industry", "i", "software-saas", "Industry profile (software-saas|physical-product|service-business|nonprofit)")
 	initCmd.Flags().BoolP("samples", "s", false, "Create sample documents")
+	initCmd.Flags().Bool("scaffold", false, "Create placeholder documents for the types the conformance level and industry profile require")
 	initCmd.Flags().BoolP("force", "f", false, "Overwrite existing directory")
 	initCmd.Flags().BoolP("verbose", "v", false, "Show detailed summary")
 }
\ No newline at end of file
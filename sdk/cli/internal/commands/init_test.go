@@ -5,7 +5,13 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/conformance"
+	"github.com/a3tai/bspec/cli/internal/integrity"
+	"github.com/a3tai/bspec/cli/internal/lint"
+	bspec "github.com/bspec-foundation/bspec-go"
 )
 
 func TestInitCommand(t *testing.T) {
@@ -262,4 +268,75 @@ func TestInitFlags(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestInitScaffold(t *testing.T) {
+	projectDir := filepath.Join(t.TempDir(), "scaffolded")
+
+	initCmd.Flags().Set("scaffold", "true")
+	initCmd.Flags().Set("author", "alice")
+	initCmd.Flags().Set("conformance", "bronze")
+	initCmd.Flags().Set("industry", "software-saas")
+	defer initCmd.Flags().Set("scaffold", "false")
+	defer initCmd.Flags().Set("author", "")
+
+	initCmd.Flags().Set("samples", "true")
+	if err := initCmd.RunE(initCmd, []string{projectDir}); err == nil {
+		t.Error("Expected --samples and --scaffold to be rejected")
+	}
+	initCmd.Flags().Set("samples", "false")
+
+	if err := initCmd.RunE(initCmd, []string{projectDir}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, path := range []string{
+		"documents/01-strategic/MSN-mission-statement-v1.0.0.md",
+		"documents/09-risk/RSK-risks-v1.0.0.md",
+		"documents/09-risk/MIT-mitigations-v1.0.0.md",
+		"documents/11-learning",
+	} {
+		if _, err := os.Stat(filepath.Join(projectDir, path)); err != nil {
+			t.Errorf("Expected %s: %v", path, err)
+		}
+	}
+
+	arch, err := readArchiveFromDirectory(projectDir)
+	if err != nil {
+		t.Fatalf("Failed to read project: %v", err)
+	}
+	// The documented Bronze set: MSN, VSN, VAL, 2 PER with JTB, PRD, REV,
+	// CST, 2 RSK with MIT, and the MET the metrics of REV and CST reference
+	counts := make(map[string]int)
+	for _, doc := range arch.Documents {
+		counts[doc.Type]++
+		if doc.ID == "VSN-vision-statement" && len(doc.References("depends_on")) == 0 {
+			t.Error("Expected VSN to depend on the strategic placeholders")
+		}
+	}
+	expected := map[string]int{"MSN": 1, "VSN": 1, "VAL": 1, "PER": 2, "JTB": 1, "PRD": 1, "REV": 1, "CST": 1, "RSK": 2, "MIT": 1, "MET": 1}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("Expected the bronze placeholders %v, got %v", expected, counts)
+	}
+
+	report, err := conformance.Evaluate(arch, conformance.Options{})
+	if err != nil {
+		t.Fatalf("Failed to evaluate the project: %v", err)
+	}
+	// Only the unchecked checklists keep the placeholders from Bronze
+	for _, requirement := range report.Assessment.Bronze.Requirements {
+		if !requirement.Met && requirement.Kind != conformance.KindChecklist {
+			t.Errorf("Expected the placeholders to meet %q (%s)", requirement.Description, requirement.Detail)
+		}
+	}
+
+	// The scaffolded project passes its own validation
+	_, validation, err := validateArchive(projectDir, nil)
+	if err != nil {
+		t.Fatalf("Failed to validate the project: %v", err)
+	}
+	for _, issue := range validation.Issues {
+		if issue.Severity == integrity.SeverityError || issue.Code == bspec.CodeLegacyType || issue.Code == lint.CodeUnsortedList {
+			t.Errorf("Expected the scaffolded project to validate, got %s %s: %s", issue.Code, issue.Document, issue.Message)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/docfile"
+)
+
+// promoteCmd represents the promote command
+var promoteCmd = &cobra.Command{
+	Use:   "promote <document-id> [directory]",
+	Short: "Move a document to the next lifecycle status",
+	Long: `Move a document forward in its lifecycle: Draft to Review, or Review to
+Accepted. Use --to for other allowed transitions, such as returning a document
+in Review to Draft.
+
+Each status has preconditions. Review requires an owner. Accepted requires
+reviewers and a changelog entry for the current version, which can be added
+in the same step with --reviewer and --changelog. The document's updated date
+is set to today.
+
+Examples:
+  bspec promote MSN-001                      # Draft -> Review in the current directory
+  bspec promote MSN-001 ./project --reviewer=alice --changelog="Approved by board"
+  bspec promote MSN-001 ./project --to=Draft
+  bspec promote MSN-001 ./project --dry-run`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, dir := args[0], lifecycleDirectory(args)
+
+		path, err := docfile.Find(dir, id)
+		if err != nil {
+			return err
+		}
+		file, err := docfile.Read(path)
+		if err != nil {
+			return err
+		}
+
+		reviewers, _ := cmd.Flags().GetStringSlice("reviewer")
+		existing := file.List("reviewers")
+		for _, reviewer := range reviewers {
+			if reviewer = strings.TrimSpace(reviewer); reviewer != "" && !containsString(existing, reviewer) {
+				existing = append(existing, reviewer)
+				if err := file.Append("reviewers", reviewer); err != nil {
+					return err
+				}
+			}
+		}
+		if changes, _ := cmd.Flags().GetString("changelog"); changes != "" {
+			author, _ := cmd.Flags().GetString("author")
+			if author == "" {
+				author = file.Get("owner")
+			}
+			entry := bspec.ChangelogEntry{
+				Version: file.Get("version"),
+				Date:    time.Now().Format(docfile.DateLayout),
+				Author:  author,
+				Changes: changes,
+			}
+			if err := file.Append("changelog", entry); err != nil {
+				return err
+			}
+		}
+
+		var doc bspec.BaseBSpecDocument
+		if err := file.Decode(&doc); err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		from := doc.Status
+		if from == "" {
+			from = bspec.DocumentStatusDraft
+		}
+
+		to, _ := cmd.Flags().GetString("to")
+		target := bspec.DocumentStatus(lifecycleStatus(to))
+		if to == "" {
+			next, ok := bspec.NextStatus(from)
+			if !ok {
+				return fmt.Errorf("%s is %s and has no next status; use --to for other transitions", id, from)
+			}
+			target = next
+		}
+
+		if err := doc.Transition(target, bspec.TransitionOptions{}); err != nil {
+			return err
+		}
+		file.Set("status", string(target))
+		file.Touch(time.Now())
+
+		return writeLifecycleFiles(cmd, fmt.Sprintf("%s: %s -> %s", id, from, target), file)
+	},
+}
+
+// deprecateCmd represents the deprecate command
+var deprecateCmd = &cobra.Command{
+	Use:   "deprecate <document-id> [directory]",
+	Short: "Deprecate a document, optionally in favour of a replacement",
+	Long: `Set a document's status to Deprecated. A deprecated document requires a
+superseding document (--superseded-by) or a reason (--reason).
+
+With --superseded-by, the replacement document gets a supersedes reference to
+the deprecated document. The replacement must exist and must not be
+deprecated itself. A reason is stored as deprecation_reason; a document that
+already has one needs neither flag.
+
+Examples:
+  bspec deprecate STR-2024 --superseded-by=STR-2025
+  bspec deprecate PRD-legacy ./project --reason="Product discontinued"
+  bspec deprecate STR-2024 ./project --superseded-by=STR-2025 --dry-run`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, dir := args[0], lifecycleDirectory(args)
+		supersededBy, _ := cmd.Flags().GetString("superseded-by")
+		reason, _ := cmd.Flags().GetString("reason")
+
+		path, err := docfile.Find(dir, id)
+		if err != nil {
+			return err
+		}
+		file, err := docfile.Read(path)
+		if err != nil {
+			return err
+		}
+
+		var doc bspec.BaseBSpecDocument
+		if err := file.Decode(&doc); err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		from := doc.Status
+		if err := doc.Transition(bspec.DocumentStatusDeprecated, bspec.TransitionOptions{SupersededBy: supersededBy, Reason: reason}); err != nil {
+			return err
+		}
+
+		files := []*docfile.File{file}
+		if supersededBy != "" {
+			replacementPath, err := docfile.Find(dir, supersededBy)
+			if err != nil {
+				return fmt.Errorf("superseding document: %w", err)
+			}
+			replacement, err := docfile.Read(replacementPath)
+			if err != nil {
+				return err
+			}
+			if strings.EqualFold(replacement.Get("status"), string(bspec.DocumentStatusDeprecated)) {
+				return fmt.Errorf("superseding document %s is deprecated", supersededBy)
+			}
+			if current := replacement.Get("supersedes"); current != "" && current != id {
+				return fmt.Errorf("%s already supersedes %s", supersededBy, current)
+			}
+			if replacement.Get("supersedes") != id {
+				replacement.Set("supersedes", id)
+				replacement.Touch(time.Now())
+				files = append(files, replacement)
+			}
+		}
+
+		file.Set("status", string(bspec.DocumentStatusDeprecated))
+		if reason != "" {
+			file.Set("deprecation_reason", reason)
+		}
+		file.Touch(time.Now())
+
+		summary := fmt.Sprintf("%s: %s -> %s", id, from, bspec.DocumentStatusDeprecated)
+		if supersededBy != "" {
+			summary += " (superseded by " + supersededBy + ")"
+		}
+		return writeLifecycleFiles(cmd, summary, files...)
+	},
+}
+
+// lifecycleDirectory returns the archive directory argument, defaulting to the current directory
+func lifecycleDirectory(args []string) string {
+	if len(args) > 1 {
+		return args[1]
+	}
+	return "."
+}
+
+// lifecycleStatus normalizes a status flag, e.g. "accepted" to "Accepted"
+func lifecycleStatus(status string) string {
+	for _, valid := range bspec.DocumentStatuses() {
+		if strings.EqualFold(status, string(valid)) {
+			return string(valid)
+		}
+	}
+	return status
+}
+
+// writeLifecycleFiles saves edited documents unless --dry-run is set
+func writeLifecycleFiles(cmd *cobra.Command, summary string, files ...*docfile.File) error {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	if dryRun {
+		if !viper.GetBool("quiet") {
+			fmt.Printf("Would update %s\n", summary)
+			for _, file := range files {
+				fmt.Printf("  %s\n", file.Path)
+			}
+		}
+		return nil
+	}
+
+	for _, file := range files {
+		if err := file.Write(); err != nil {
+			return err
+		}
+	}
+	if !viper.GetBool("quiet") {
+		fmt.Printf("Updated %s\n", summary)
+		for _, file := range files {
+			fmt.Printf("  %s\n", file.Path)
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	rootCmd.AddCommand(promoteCmd)
+	rootCmd.AddCommand(deprecateCmd)
+
+	promoteCmd.Flags().String("to", "", "Target status (default: next status in the lifecycle)")
+	promoteCmd.Flags().StringSlice("reviewer", nil, "Add a reviewer (repeatable)")
+	promoteCmd.Flags().String("changelog", "", "Add a changelog entry for the current version")
+	promoteCmd.Flags().String("author", "", "Changelog entry author (default: document owner)")
+	promoteCmd.Flags().Bool("dry-run", false, "Check the transition without writing files")
+
+	deprecateCmd.Flags().String("superseded-by", "", "ID of the document that replaces this one")
+	deprecateCmd.Flags().String("reason", "", "Why the document is deprecated")
+	deprecateCmd.Flags().Bool("dry-run", false, "Check the transition without writing files")
+}
@@ -0,0 +1,141 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+
+	"github.com/a3tai/bspec/cli/internal/docfile"
+)
+
+func lifecycleArchive(t *testing.T) string {
+	t.Helper()
+	archiveDir := t.TempDir()
+	docsDir := filepath.Join(archiveDir, "documents", "01-strategic")
+	if err := os.MkdirAll(docsDir, 0755); err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	os.WriteFile(filepath.Join(archiveDir, "manifest.json"), []byte(`{"name":"Test Project"}`), 0644)
+	os.WriteFile(filepath.Join(docsDir, "STR-2024.md"), []byte("---\nid: STR-2024\ntitle: Strategy 2024\ntype: STR\nstatus: Review\nversion: 1.0.0\nowner: alice\n---\n\n# Strategy\n"), 0644)
+	os.WriteFile(filepath.Join(docsDir, "STR-2025.md"), []byte("---\nid: STR-2025\ntitle: Strategy 2025\ntype: STR\nstatus: Draft\nversion: 1.0.0\nowner: bob\n---\n\n# Strategy\n"), 0644)
+	return archiveDir
+}
+
+func readLifecycleDocument(t *testing.T, archiveDir, id string) *docfile.File {
+	t.Helper()
+	path, err := docfile.Find(archiveDir, id)
+	if err != nil {
+		t.Fatalf("Failed to find %s: %v", id, err)
+	}
+	file, err := docfile.Read(path)
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", id, err)
+	}
+	return file
+}
+
+func TestPromoteCommand(t *testing.T) {
+	archiveDir := lifecycleArchive(t)
+
+	// Accepted requires reviewers and a changelog entry
+	err := promoteCmd.RunE(promoteCmd, []string{"STR-2024", archiveDir})
+	if err == nil || !strings.Contains(err.Error(), "reviewers must not be empty") || !strings.Contains(err.Error(), "changelog has no entry") {
+		t.Fatalf("Expected precondition error, got %v", err)
+	}
+	if status := readLifecycleDocument(t, archiveDir, "STR-2024").Get("status"); status != "Review" {
+		t.Errorf("Expected status to be unchanged, got %s", status)
+	}
+
+	promoteCmd.Flags().Set("reviewer", "bob")
+	promoteCmd.Flags().Set("changelog", "Approved")
+	defer promoteCmd.Flags().Set("changelog", "")
+	defer promoteCmd.Flags().Lookup("reviewer").Value.(pflag.SliceValue).Replace(nil)
+
+	if err := promoteCmd.RunE(promoteCmd, []string{"STR-2024", archiveDir}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	file := readLifecycleDocument(t, archiveDir, "STR-2024")
+	if file.Get("status") != "Accepted" || file.Get("updated") == "" {
+		t.Errorf("Expected Accepted with updated date, got %q, %q", file.Get("status"), file.Get("updated"))
+	}
+	if reviewers := file.List("reviewers"); len(reviewers) != 1 || reviewers[0] != "bob" {
+		t.Errorf("Expected reviewer bob, got %v", reviewers)
+	}
+
+	// Accepted has no next status
+	if err := promoteCmd.RunE(promoteCmd, []string{"STR-2024", archiveDir}); err == nil || !strings.Contains(err.Error(), "no next status") {
+		t.Errorf("Expected no next status error, got %v", err)
+	}
+
+	promoteCmd.Flags().Set("to", "draft")
+	defer promoteCmd.Flags().Set("to", "")
+	if err := promoteCmd.RunE(promoteCmd, []string{"STR-2024", archiveDir}); err == nil || !strings.Contains(err.Error(), "allowed transitions from Accepted: Review, Deprecated") {
+		t.Errorf("Expected disallowed transition error, got %v", err)
+	}
+}
+
+func TestDeprecateCommand(t *testing.T) {
+	archiveDir := lifecycleArchive(t)
+
+	if err := deprecateCmd.RunE(deprecateCmd, []string{"STR-2024", archiveDir}); err == nil || !strings.Contains(err.Error(), "superseding document or a reason") {
+		t.Fatalf("Expected precondition error, got %v", err)
+	}
+
+	deprecateCmd.Flags().Set("superseded-by", "STR-missing")
+	defer deprecateCmd.Flags().Set("superseded-by", "")
+	if err := deprecateCmd.RunE(deprecateCmd, []string{"STR-2024", archiveDir}); err == nil || !strings.Contains(err.Error(), "document not found: STR-missing") {
+		t.Fatalf("Expected missing replacement error, got %v", err)
+	}
+
+	deprecateCmd.Flags().Set("superseded-by", "STR-2025")
+	if err := deprecateCmd.RunE(deprecateCmd, []string{"STR-2024", archiveDir}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if status := readLifecycleDocument(t, archiveDir, "STR-2024").Get("status"); status != "Deprecated" {
+		t.Errorf("Expected Deprecated, got %s", status)
+	}
+	if supersedes := readLifecycleDocument(t, archiveDir, "STR-2025").Get("supersedes"); supersedes != "STR-2024" {
+		t.Errorf("Expected STR-2025 to supersede STR-2024, got %q", supersedes)
+	}
+
+	// A deprecated document cannot replace another one
+	deprecateCmd.Flags().Set("superseded-by", "STR-2024")
+	if err := deprecateCmd.RunE(deprecateCmd, []string{"STR-2025", archiveDir}); err == nil || !strings.Contains(err.Error(), "is deprecated") {
+		t.Errorf("Expected deprecated replacement error, got %v", err)
+	}
+}
+
+func TestDeprecateExistingReason(t *testing.T) {
+	archiveDir := lifecycleArchive(t)
+	path := filepath.Join(archiveDir, "documents", "01-strategic", "STR-2024.md")
+	os.WriteFile(path, []byte("---\nid: STR-2024\ntitle: Strategy 2024\ntype: STR\nstatus: Review\nversion: 1.0.0\nowner: alice\ndeprecation_reason: Merged into the 2025 plan\n---\n\n# Strategy\n"), 0644)
+
+	// The deprecation_reason of the document satisfies the precondition
+	if err := deprecateCmd.RunE(deprecateCmd, []string{"STR-2024", archiveDir}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	file := readLifecycleDocument(t, archiveDir, "STR-2024")
+	if file.Get("status") != "Deprecated" || file.Get("deprecation_reason") != "Merged into the 2025 plan" {
+		t.Errorf("Expected Deprecated with the existing reason, got %q, %q", file.Get("status"), file.Get("deprecation_reason"))
+	}
+}
+
+func TestDeprecateDryRun(t *testing.T) {
+	archiveDir := lifecycleArchive(t)
+
+	deprecateCmd.Flags().Set("reason", "Replaced by the 2025 plan")
+	deprecateCmd.Flags().Set("dry-run", "true")
+	defer deprecateCmd.Flags().Set("reason", "")
+	defer deprecateCmd.Flags().Set("dry-run", "false")
+
+	if err := deprecateCmd.RunE(deprecateCmd, []string{"STR-2024", archiveDir}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	file := readLifecycleDocument(t, archiveDir, "STR-2024")
+	if file.Get("status") != "Review" || file.Has("deprecation_reason") {
+		t.Error("Expected dry run not to change the document")
+	}
+}
@@ -0,0 +1,190 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/integrity"
+	"github.com/a3tai/bspec/cli/internal/lint"
+)
+
+// specFilePattern matches specification file names, e.g. "RSK-spec.md"
+var specFilePattern = regexp.MustCompile(`^([A-Z]{3})-spec\.md$`)
+
+// lintReport is the result of the lint command
+type lintReport struct {
+	Documents int               `json:"documents"`
+	Issues    []integrity.Issue `json:"issues"`
+}
+
+// lintCmd represents the lint command
+var lintCmd = &cobra.Command{
+	Use:   "lint <bspec-file|directory>",
+	Short: "Check documents against the lint rules or the writing standards",
+	Long: `Check the documents of an archive against the lint rules of validate,
+without the relationship checks.
+
+With --prose, the document bodies are checked against the writing standards
+of the specification instead:
+  - missing_section, section_order: the "##" sections of the content
+    template of the document's type, in order
+  - empty_section: template sections with no text other than comments
+  - informal_phrase: phrases of the professional vocabulary table, such as
+    "figure out" or "make sure"
+  - normative_keyword: must, shall and should that are not written as the
+    RFC 2119 keywords MUST, SHALL and SHOULD
+  - long_sentence: sentences over 25 words (info)
+  - placeholder_text: placeholders such as "TBD" or "Coming soon"
+
+Quoted text, code and tables are not checked. Every finding has the line of
+the document file.
+
+A directory of specification files (*-spec.md, as in spec/v1) is checked
+with --prose against the header and the required sections of the writing
+standards (header_format, missing_section, empty_section, section_order) and
+the same text checks.
+
+Severities and inline suppression come from .bspec.yaml as for validate:
+
+  lint:
+    rules:
+      long_sentence: off
+    overrides:
+      - paths: ["01-strategic/**"]
+        rules:
+          normative_keyword: info
+
+Examples:
+  bspec lint ./project
+  bspec lint ./project --prose
+  bspec lint project.bspec --prose -o json
+  bspec lint ../spec/v1 --prose --strict`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		inputPath := args[0]
+
+		if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+			return fmt.Errorf("path does not exist: %s", inputPath)
+		}
+
+		config, err := lint.LoadConfig(projectConfigPath(inputPath))
+		if err != nil {
+			return err
+		}
+
+		prose, _ := cmd.Flags().GetBool("prose")
+		var (
+			arch   *archive.BSpecArchive
+			linter *lint.Linter
+		)
+		if isSpecPath(inputPath) {
+			if !prose {
+				return fmt.Errorf("specification files are only checked with --prose")
+			}
+			if arch, err = readSpecFiles(inputPath); err != nil {
+				return err
+			}
+			linter = lint.NewSpecProse(config)
+		} else {
+			if arch, err = readArchiveFromPath(inputPath); err != nil {
+				return fmt.Errorf("failed to read archive: %w", err)
+			}
+			linter = lint.New(config)
+			if prose {
+				linter = lint.NewProse(config)
+			}
+		}
+
+		report := &integrity.Report{Documents: len(arch.Documents), Issues: linter.Run(arch, nil)}
+		if viper.GetString("output") == "json" {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(lintReport{Documents: report.Documents, Issues: report.Issues}); err != nil {
+				return fmt.Errorf("failed to encode report: %w", err)
+			}
+		} else if !viper.GetBool("quiet") || len(report.Issues) > 0 {
+			printLintReport(os.Stdout, report)
+		}
+
+		strict, _ := cmd.Flags().GetBool("strict")
+		if errors := len(report.Errors()); errors > 0 {
+			return reported(cmd, "lint failed with %d errors", errors)
+		}
+		if warnings := len(report.Warnings()); strict && warnings > 0 {
+			return reported(cmd, "lint failed with %d warnings", warnings)
+		}
+		return nil
+	},
+}
+
+// isSpecPath reports whether a path is a specification file or a directory
+// of them rather than an archive
+func isSpecPath(inputPath string) bool {
+	if !isDirectory(inputPath) {
+		return specFilePattern.MatchString(filepath.Base(inputPath))
+	}
+	if _, err := os.Stat(filepath.Join(inputPath, "manifest.json")); err == nil {
+		return false
+	}
+	found := false
+	filepath.Walk(inputPath, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() && specFilePattern.MatchString(info.Name()) {
+			found = true
+			return filepath.SkipAll
+		}
+		return nil
+	})
+	return found
+}
+
+// readSpecFiles reads a specification file, or the specification files
+// below a directory, as documents keyed by path relative to the directory
+func readSpecFiles(inputPath string) (*archive.BSpecArchive, error) {
+	arch := &archive.BSpecArchive{Documents: make(map[string]archive.BSpecDocument)}
+	root := inputPath
+	if !isDirectory(inputPath) {
+		root = filepath.Dir(inputPath)
+	}
+	err := filepath.Walk(inputPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		match := specFilePattern.FindStringSubmatch(info.Name())
+		if info.IsDir() || match == nil {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read specification %s: %w", path, err)
+		}
+		relPath, _ := filepath.Rel(root, path)
+		arch.Documents[filepath.ToSlash(relPath)] = archive.BSpecDocument{Type: match[1], Content: string(content), BodyLine: 1}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read specifications: %w", err)
+	}
+	return arch, nil
+}
+
+// printLintReport writes a human-readable lint report
+func printLintReport(w io.Writer, report *integrity.Report) {
+	printIssues(w, report.Issues)
+	fmt.Fprintf(w, "Checked %d documents: %d errors, %d warnings, %d info\n",
+		report.Documents, len(report.Errors()), len(report.Warnings()), len(report.Issues)-len(report.Errors())-len(report.Warnings()))
+}
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+
+	lintCmd.Flags().Bool("prose", false, "Check the document bodies against the writing standards")
+	lintCmd.Flags().Bool("strict", false, "Treat warnings as errors")
+}
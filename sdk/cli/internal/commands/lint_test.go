@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLintCommandProse(t *testing.T) {
+	archiveDir := t.TempDir()
+	docsDir := filepath.Join(archiveDir, "documents", "01-strategic")
+	if err := os.MkdirAll(docsDir, 0755); err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	os.WriteFile(filepath.Join(archiveDir, "manifest.json"), []byte(`{"name":"Test Project"}`), 0644)
+	os.WriteFile(filepath.Join(docsDir, "MSN-acme.md"), []byte("---\nid: MSN-acme\ntitle: Mission\ntype: MSN\nstatus: Draft\nversion: 1.0.0\nowner: alice\ncreated: 2025-01-01\nupdated: 2025-01-01\nsuccess_criteria: [Customers renew]\n---\n\n# Mission\n\nWe make sure customers renew.\n"), 0644)
+
+	lintCmd.Flags().Set("prose", "false")
+	lintCmd.Flags().Set("strict", "true")
+	defer lintCmd.Flags().Set("strict", "false")
+	if err := lintCmd.RunE(lintCmd, []string{archiveDir}); err != nil {
+		t.Errorf("Expected the lint rules to pass, got %v", err)
+	}
+
+	lintCmd.Flags().Set("prose", "true")
+	defer lintCmd.Flags().Set("prose", "false")
+	if err := lintCmd.RunE(lintCmd, []string{archiveDir}); err == nil || !strings.Contains(err.Error(), "warnings") {
+		t.Errorf("Expected prose warnings to fail in strict mode, got %v", err)
+	}
+
+	config := "lint:\n  rules:\n    missing_section: off\n    informal_phrase: info\n"
+	os.WriteFile(filepath.Join(archiveDir, ".bspec.yaml"), []byte(config), 0644)
+	if err := lintCmd.RunE(lintCmd, []string{archiveDir}); err != nil {
+		t.Errorf("Expected the re-graded prose rules to pass, got %v", err)
+	}
+}
+
+func TestLintCommandSpecFiles(t *testing.T) {
+	specDir := t.TempDir()
+	os.MkdirAll(filepath.Join(specDir, "risk-governance"), 0755)
+	os.WriteFile(filepath.Join(specDir, "risk-governance", "RSK-spec.md"), []byte("# RSK: Risks Specification\n\n## Abstract\n"), 0644)
+
+	lintCmd.Flags().Set("strict", "false")
+	lintCmd.Flags().Set("prose", "false")
+	if err := lintCmd.RunE(lintCmd, []string{specDir}); err == nil || !strings.Contains(err.Error(), "--prose") {
+		t.Errorf("Expected specification files to require --prose, got %v", err)
+	}
+
+	lintCmd.Flags().Set("prose", "true")
+	defer lintCmd.Flags().Set("prose", "false")
+	arch, err := readSpecFiles(specDir)
+	if err != nil {
+		t.Fatalf("readSpecFiles failed: %v", err)
+	}
+	if doc, ok := arch.Documents["risk-governance/RSK-spec.md"]; !ok || doc.Type != "RSK" {
+		t.Errorf("Expected RSK-spec.md as an RSK document, got %v", arch.Documents)
+	}
+	if err := lintCmd.RunE(lintCmd, []string{specDir}); err != nil {
+		t.Errorf("Expected warnings only, got %v", err)
+	}
+}
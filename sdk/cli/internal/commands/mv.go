@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/rename"
+)
+
+// mvCmd represents the mv command
+var mvCmd = &cobra.Command{
+	Use:   "mv <old-id> <new-id> [bspec-file|directory]",
+	Short: "Rename a document ID and rewrite every reference to it",
+	Long: `Rename a document ID across an archive directory or .bspec file.
+
+The command updates the document's id, every relationship field that
+references it (depends_on, enables, conflicts_with, related, parent,
+supersedes, risks, metrics), markdown links to the document, and its file
+name when the file name starts with the ID. The new ID must keep the
+{TYPE}- prefix of the document type and must not be in use.
+
+All edits are prepared before any file is written. A .bspec file is
+rewritten through a temporary archive that replaces the original only when
+every edit succeeded.
+
+Examples:
+  bspec mv STR-growth STR-growth-2025
+  bspec mv STR-growth STR-growth-2025 ./project --dry-run   # Show a diff
+  bspec mv STR-growth STR-growth-2025 project.bspec`,
+	Args: cobra.RangeArgs(2, 3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		oldID, newID := args[0], args[1]
+		inputPath := "."
+		if len(args) > 2 {
+			inputPath = args[2]
+		}
+
+		// Check if path exists
+		if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+			return fmt.Errorf("path does not exist: %s", inputPath)
+		}
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if isDirectory(inputPath) {
+			return renameDocument(inputPath, oldID, newID, dryRun)
+		}
+
+		// Rewrite a .bspec file through an extracted copy
+		workDir, err := os.MkdirTemp("", "bspec-mv-*")
+		if err != nil {
+			return fmt.Errorf("failed to create temporary directory: %w", err)
+		}
+		defer os.RemoveAll(workDir)
+
+		if err := archive.Extract(inputPath, workDir); err != nil {
+			return fmt.Errorf("failed to extract archive: %w", err)
+		}
+		if err := renameDocument(workDir, oldID, newID, dryRun); err != nil || dryRun {
+			return err
+		}
+
+		temp, err := os.CreateTemp(filepath.Dir(inputPath), ".bspec-mv-*.bspec")
+		if err != nil {
+			return fmt.Errorf("failed to create temporary archive: %w", err)
+		}
+		temp.Close()
+		if err := archive.Pack(workDir, temp.Name()); err != nil {
+			os.Remove(temp.Name())
+			return fmt.Errorf("failed to pack archive: %w", err)
+		}
+		if err := os.Rename(temp.Name(), inputPath); err != nil {
+			os.Remove(temp.Name())
+			return fmt.Errorf("failed to replace %s: %w", inputPath, err)
+		}
+		return nil
+	},
+}
+
+// renameDocument plans and applies, or prints, an ID rename in an archive directory
+func renameDocument(dir, oldID, newID string, dryRun bool) error {
+	plan, err := rename.NewPlan(dir, oldID, newID)
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		fmt.Print(plan.Diff())
+		if !viper.GetBool("quiet") {
+			fmt.Fprintf(os.Stderr, "Dry run: %d files would change\n", len(plan.Changes))
+		}
+		return nil
+	}
+
+	if err := plan.Apply(); err != nil {
+		return err
+	}
+	if !viper.GetBool("quiet") {
+		fmt.Printf("Renamed %s to %s (%d files updated)\n", oldID, newID, len(plan.Changes))
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(mvCmd)
+
+	mvCmd.Flags().Bool("dry-run", false, "Show a diff of the changes without writing files")
+}
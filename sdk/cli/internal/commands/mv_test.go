@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+func TestMvCommand(t *testing.T) {
+	archiveDir := t.TempDir()
+	docsDir := filepath.Join(archiveDir, "documents", "01-strategic")
+	if err := os.MkdirAll(docsDir, 0755); err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	os.WriteFile(filepath.Join(archiveDir, "manifest.json"), []byte(`{"name":"Test Project"}`), 0644)
+	os.WriteFile(filepath.Join(docsDir, "MSN-mission.md"), []byte("---\nid: MSN-mission\ntitle: Mission\ntype: MSN\nstatus: Draft\n---\n\n# Mission\n"), 0644)
+	os.WriteFile(filepath.Join(docsDir, "VSN-vision.md"), []byte("---\nid: VSN-vision\ntitle: Vision\ntype: VSN\nstatus: Draft\ndepends_on: [MSN-mission]\n---\n\n# Vision\n"), 0644)
+
+	// Dry run leaves the files untouched
+	mvCmd.Flags().Set("dry-run", "true")
+	if err := mvCmd.RunE(mvCmd, []string{"MSN-mission", "MSN-purpose", archiveDir}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	mvCmd.Flags().Set("dry-run", "false")
+	if _, err := os.Stat(filepath.Join(docsDir, "MSN-mission.md")); err != nil {
+		t.Fatalf("Expected dry run not to rename: %v", err)
+	}
+
+	// Rename inside a packed archive
+	bspecPath := filepath.Join(t.TempDir(), "project.bspec")
+	if err := archive.Pack(archiveDir, bspecPath); err != nil {
+		t.Fatalf("Failed to pack archive: %v", err)
+	}
+	if err := mvCmd.RunE(mvCmd, []string{"MSN-mission", "MSN-purpose", bspecPath}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	arch, err := archive.Read(bspecPath)
+	if err != nil {
+		t.Fatalf("Failed to read archive: %v", err)
+	}
+	mission, ok := arch.Documents["01-strategic/MSN-purpose.md"]
+	if !ok || mission.ID != "MSN-purpose" {
+		t.Errorf("Expected renamed document in archive, got %v", arch.Documents)
+	}
+	vision := arch.Documents["01-strategic/VSN-vision.md"]
+	if refs := vision.References("depends_on"); len(refs) != 1 || refs[0] != "MSN-purpose" {
+		t.Errorf("Expected rewritten depends_on, got %v", refs)
+	}
+
+	if err := mvCmd.RunE(mvCmd, []string{"MSN-mission", "VSN-mission", archiveDir}); err == nil || !strings.Contains(err.Error(), "MSN-") {
+		t.Errorf("Expected prefix error, got %v", err)
+	}
+}
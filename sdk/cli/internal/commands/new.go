@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/scaffold"
+)
+
+// newCmd represents the new command
+var newCmd = &cobra.Command{
+	Use:   "new <TYPE> <title> [directory]",
+	Short: "Create a document from its specification template",
+	Long: `Create a new document of a type from the specification.
+
+The document is written to the folder of the type's domain, e.g. 09-risk/ for
+RSK, with a compliant ID and file name ({TYPE}-{name}-v{version}.md). The
+frontmatter is filled with the SDK defaults and the body contains the section
+skeleton of the type's content template.
+
+With --link, depends_on is pre-populated with the existing documents of the
+types the new type typically depends on, and the lists its validation checks
+with the documents they should reference, such as the MIT documents in the
+related list of an RSK.
+
+The owner is taken from --owner, or from git config user.name.
+
+Examples:
+  bspec new RSK "Vendor lock-in" --owner alice
+  bspec new OKR "2026 growth" ./project --owner alice --link
+  bspec new ADR "Use PostgreSQL" --id ADR-001 --dry-run`,
+	Args: cobra.RangeArgs(2, 3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := "."
+		if len(args) > 2 {
+			dir = args[2]
+		}
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			return fmt.Errorf("path does not exist: %s", dir)
+		}
+		if !isDirectory(dir) {
+			return fmt.Errorf("new requires an archive directory; extract the .bspec file first")
+		}
+
+		arch, err := readArchiveFromDirectory(dir)
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		owner, _ := cmd.Flags().GetString("owner")
+		if owner == "" {
+			owner = gitUserName(dir)
+		}
+		if owner == "" {
+			return fmt.Errorf("no owner: set --owner or git config user.name")
+		}
+		id, _ := cmd.Flags().GetString("id")
+		link, _ := cmd.Flags().GetBool("link")
+
+		doc, err := scaffold.New(arch, scaffold.Options{
+			Type:  args[0],
+			Title: args[1],
+			Owner: owner,
+			ID:    id,
+			Link:  link,
+		})
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(dir, filepath.FromSlash(doc.Path))
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s already exists", path)
+		}
+
+		quiet := viper.GetBool("quiet")
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			if !quiet {
+				fmt.Printf("Would create %s\n\n", path)
+				os.Stdout.Write(doc.Content)
+			}
+			return nil
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := os.WriteFile(path, doc.Content, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+
+		if !quiet {
+			fmt.Printf("Created %s (%s)\n", doc.ID, path)
+			if len(doc.Links) > 0 {
+				fmt.Printf("  depends_on: %s\n", strings.Join(doc.Links, ", "))
+			}
+			keys := make([]string, 0, len(doc.References))
+			for key := range doc.References {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				fmt.Printf("  %s: %s\n", key, strings.Join(doc.References[key], ", "))
+			}
+			if len(doc.Unlinked) > 0 {
+				fmt.Fprintf(os.Stderr, "Note: no %s documents to link yet\n", strings.Join(doc.Unlinked, ", "))
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(newCmd)
+
+	newCmd.Flags().String("owner", "", "Document owner (default: git config user.name)")
+	newCmd.Flags().String("id", "", "Document ID (default: generated from the type and title)")
+	newCmd.Flags().Bool("link", false, "Pre-populate depends_on and expected references with existing documents")
+	newCmd.Flags().Bool("dry-run", false, "Print the document without writing it")
+}
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/docfile"
+)
+
+func TestNewCommand(t *testing.T) {
+	archiveDir := t.TempDir()
+	os.MkdirAll(filepath.Join(archiveDir, "documents"), 0755)
+	os.WriteFile(filepath.Join(archiveDir, "manifest.json"), []byte(`{"bspec_version":"1.0.0","name":"test"}`), 0644)
+
+	newCmd.Flags().Set("owner", "alice")
+	defer newCmd.Flags().Set("owner", "")
+
+	if err := newCmd.RunE(newCmd, []string{"RSK", "Vendor lock-in", archiveDir}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	file, err := docfile.Read(filepath.Join(archiveDir, "documents", "09-risk", "RSK-vendor-lock-in-v1.0.0.md"))
+	if err != nil {
+		t.Fatalf("Expected the new document: %v", err)
+	}
+	if file.Get("id") != "RSK-vendor-lock-in" || file.Get("owner") != "alice" {
+		t.Errorf("Unexpected frontmatter: id %q, owner %q", file.Get("id"), file.Get("owner"))
+	}
+
+	newCmd.Flags().Set("id", "RSK-vendor-lock-in")
+	defer newCmd.Flags().Set("id", "")
+	if err := newCmd.RunE(newCmd, []string{"RSK", "Vendor lock-in", archiveDir}); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("Expected duplicate ID error, got %v", err)
+	}
+
+	if err := newCmd.RunE(newCmd, []string{"RSK", "Vendor lock-in", filepath.Join(archiveDir, "missing")}); err == nil || !strings.Contains(err.Error(), "path does not exist") {
+		t.Errorf("Expected missing path error, got %v", err)
+	}
+}
@@ -5,9 +5,9 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 
 	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/conformance"
 	"github.com/a3tai/bspec/cli/internal/output"
 	"github.com/a3tai/bspec/cli/internal/query"
 )
@@ -22,7 +22,8 @@ Examples:
   bspec open myproject.bspec                    # Show archive info
   bspec open myproject.bspec --info             # Show detailed archive info
   bspec open myproject.bspec --list             # List all documents
-  bspec open myproject.bspec --stats            # Show statistics`,
+  bspec open myproject.bspec --stats            # Show statistics
+  bspec open myproject.bspec --list --template=weekly-status`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		bspecFile := args[0]
@@ -39,12 +40,9 @@ Examples:
 		}
 
 		// Get output format
-		outputFormat := viper.GetString("output")
-		prettyFlag, _ := cmd.Flags().GetBool("pretty")
-
-		formatter, err := output.NewFormatter(outputFormat, prettyFlag)
+		formatter, err := newFormatter(cmd)
 		if err != nil {
-			return fmt.Errorf("failed to create formatter: %w", err)
+			return err
 		}
 
 		// Determine what to display
@@ -102,6 +100,7 @@ func displayStats(arch *archive.BSpecArchive, formatter *output.Formatter) error
 	// Create query engine to get stats
 	qe := query.NewQueryEngine(arch)
 	stats := qe.GetStats()
+	stats["checklists"] = conformance.Checklists(arch).Totals()
 
 	result, err := formatter.FormatStats(stats)
 	if err != nil {
@@ -120,4 +119,5 @@ func init() {
 	openCmd.Flags().BoolP("list", "l", false, "List all documents in the archive")
 	openCmd.Flags().BoolP("stats", "s", false, "Show archive statistics")
 	openCmd.Flags().BoolP("pretty", "p", true, "Pretty print output (JSON only)")
+	addTemplateFlags(openCmd)
 }
\ No newline at end of file
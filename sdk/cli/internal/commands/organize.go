@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/layout"
+)
+
+// organizeResult is the JSON output of organize
+type organizeResult struct {
+	Moved     []organizeMove `json:"moved"`
+	Rewritten []string       `json:"rewritten"` // Documents whose links are rewritten
+	Skipped   []layout.Issue `json:"skipped"`
+	DryRun    bool           `json:"dry_run"`
+}
+
+type organizeMove struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// organizeCmd represents the organize command
+var organizeCmd = &cobra.Command{
+	Use:   "organize [bspec-file|directory]",
+	Short: "Move documents into the standard folder layout and file names",
+	Long: `Move documents into their domain folders (documents/01-strategic/ ...)
+and rename them to {TYPE}-{kebab-name}-v{version}.md.
+
+The domain of a document is its domain field, or the domain of its type.
+Relative markdown links in moved documents, such as links to assets, and
+links to moved documents are rewritten so they keep working. Documents that
+cannot be placed, e.g. without a type or a semantic version, are reported
+and left in place.
+
+With --check, the layout is only checked and the command fails if any
+document is out of place. --check also works on .bspec files.
+
+Examples:
+  bspec organize ./project --dry-run
+  bspec organize ./project
+  bspec organize project.bspec --check`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := "."
+		if len(args) > 0 {
+			dir = args[0]
+		}
+
+		// Check if path exists
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			return fmt.Errorf("path does not exist: %s", dir)
+		}
+
+		jsonOutput := viper.GetString("output") == "json"
+		quiet := viper.GetBool("quiet")
+
+		if check, _ := cmd.Flags().GetBool("check"); check {
+			arch, err := readArchiveFromPath(dir)
+			if err != nil {
+				return fmt.Errorf("failed to read archive: %w", err)
+			}
+			issues := layout.Check(arch)
+			if jsonOutput {
+				if issues == nil {
+					issues = []layout.Issue{}
+				}
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				if err := encoder.Encode(issues); err != nil {
+					return fmt.Errorf("failed to encode issues: %w", err)
+				}
+			} else if !quiet || len(issues) > 0 {
+				for _, issue := range issues {
+					fmt.Printf("%-15s %s\n", issue.Code, issue.Message)
+				}
+				fmt.Printf("Checked %d documents: %d layout issues\n", len(arch.Documents), len(issues))
+			}
+			if len(issues) > 0 {
+				return fmt.Errorf("layout check failed with %d issues", len(issues))
+			}
+			return nil
+		}
+
+		if !isDirectory(dir) {
+			return fmt.Errorf("organize requires an archive directory; extract the .bspec file first or use --check")
+		}
+		plan, err := layout.NewPlan(dir)
+		if err != nil {
+			return err
+		}
+
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if !dryRun && len(plan.Changes) > 0 {
+			if err := plan.Apply(); err != nil {
+				return err
+			}
+		}
+
+		if jsonOutput {
+			result := organizeResult{Moved: []organizeMove{}, Rewritten: []string{}, Skipped: plan.Skipped, DryRun: dryRun}
+			if result.Skipped == nil {
+				result.Skipped = []layout.Issue{}
+			}
+			for _, change := range plan.Changes {
+				if change.Renamed() {
+					result.Moved = append(result.Moved, organizeMove{From: change.Path, To: change.NewPath})
+				}
+				if len(change.Lines) > 0 {
+					result.Rewritten = append(result.Rewritten, change.NewPath)
+				}
+			}
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(result); err != nil {
+				return fmt.Errorf("failed to encode result: %w", err)
+			}
+			return nil
+		}
+
+		if dryRun {
+			fmt.Print(plan.Diff())
+		}
+		for _, skipped := range plan.Skipped {
+			fmt.Fprintf(os.Stderr, "Skipped: %s\n", skipped.Message)
+		}
+		if !quiet {
+			verb := "Moved"
+			if dryRun {
+				verb = "Would move"
+			}
+			fmt.Printf("%s %d documents, %d files changed\n", verb, plan.Moves(), len(plan.Changes))
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(organizeCmd)
+
+	organizeCmd.Flags().Bool("dry-run", false, "Show the moves and link changes without writing files")
+	organizeCmd.Flags().Bool("check", false, "Only check the layout and fail if documents are out of place")
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOrganizeCommand(t *testing.T) {
+	archiveDir := t.TempDir()
+	os.WriteFile(filepath.Join(archiveDir, "manifest.json"), []byte(`{"bspec_version":"1.0.0","name":"test"}`), 0644)
+	os.MkdirAll(filepath.Join(archiveDir, "documents"), 0755)
+	oldPath := filepath.Join(archiveDir, "documents", "risk.md")
+	os.WriteFile(oldPath, []byte("---\nid: RSK-lockin\ntitle: Lock-in\ntype: RSK\nstatus: Draft\nversion: 1.0.0\nowner: alice\n---\n\n![map](../assets/map.png)\n"), 0644)
+
+	defer organizeCmd.Flags().Set("check", "false")
+	defer organizeCmd.Flags().Set("dry-run", "false")
+
+	organizeCmd.Flags().Set("check", "true")
+	if err := organizeCmd.RunE(organizeCmd, []string{archiveDir}); err == nil || !strings.Contains(err.Error(), "layout check failed") {
+		t.Errorf("Expected layout check failure, got %v", err)
+	}
+
+	organizeCmd.Flags().Set("check", "false")
+	organizeCmd.Flags().Set("dry-run", "true")
+	if err := organizeCmd.RunE(organizeCmd, []string{archiveDir}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := os.Stat(oldPath); err != nil {
+		t.Error("Expected --dry-run to leave the document in place")
+	}
+
+	organizeCmd.Flags().Set("dry-run", "false")
+	if err := organizeCmd.RunE(organizeCmd, []string{archiveDir}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	content, err := os.ReadFile(filepath.Join(archiveDir, "documents", "09-risk", "RSK-lockin-v1.0.0.md"))
+	if err != nil {
+		t.Fatalf("Expected moved document: %v", err)
+	}
+	if !strings.Contains(string(content), "![map](../../assets/map.png)") {
+		t.Errorf("Expected rewritten asset link, got:\n%s", content)
+	}
+
+	organizeCmd.Flags().Set("check", "true")
+	if err := organizeCmd.RunE(organizeCmd, []string{archiveDir}); err != nil {
+		t.Errorf("Expected organized archive to pass the check, got %v", err)
+	}
+}
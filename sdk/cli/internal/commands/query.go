@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -40,6 +41,10 @@ Examples:
   # Combine multiple filters
   bspec query project.bspec --type=CAP --domain=product --status=Accepted
 
+  # Search within a section; the content of each result is the section
+  bspec query project.bspec --section="Risk Assessment" --search=vendor
+  bspec query project.bspec --type=RSK --section=mitigation-strategy --fields=id,content
+
   # Select specific fields only
   bspec query project.bspec --fields=id,title,type,status
 
@@ -47,7 +52,15 @@ Examples:
   bspec query project.bspec --limit=10
 
   # Use JSON query (advanced)
-  bspec query project.bspec --json='{"type":"MSN","domain":"strategic"}'`,
+  bspec query project.bspec --json='{"type":"MSN","domain":"strategic"}'
+
+  # Stream one JSON object per document (summary goes to stderr)
+  bspec query project.bspec --type=RSK --output=ndjson | jq -r .id
+
+  # Render results with a built-in or custom Go template
+  bspec query project.bspec --status=Review --template=weekly-status
+  bspec query project.bspec --template=report.tmpl
+  bspec query project.bspec --template-string='{{range .Documents}}{{.ID}} {{.Owner}}{{"\n"}}{{end}}'`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		inputPath := args[0]
@@ -74,20 +87,23 @@ Examples:
 			return fmt.Errorf("invalid query: %w", err)
 		}
 
-		// Execute query
-		qe := query.NewQueryEngine(arch)
-		result, err := qe.Execute(q)
+		// Format and display results
+		formatter, err := newFormatter(cmd)
 		if err != nil {
-			return fmt.Errorf("query execution failed: %w", err)
+			return err
 		}
 
-		// Format and display results
-		outputFormat := viper.GetString("output")
-		prettyFlag, _ := cmd.Flags().GetBool("pretty")
+		qe := query.NewQueryEngine(arch)
+
+		// Stream NDJSON output one document at a time
+		if formatter.Streaming() {
+			return streamQueryResult(qe, q, formatter)
+		}
 
-		formatter, err := output.NewFormatter(outputFormat, prettyFlag)
+		// Execute query
+		result, err := qe.Execute(q)
 		if err != nil {
-			return fmt.Errorf("failed to create formatter: %w", err)
+			return fmt.Errorf("query execution failed: %w", err)
 		}
 
 		formattedResult, err := formatter.FormatQueryResult(result)
@@ -100,6 +116,36 @@ Examples:
 	},
 }
 
+// streamQueryResult writes each matching document to stdout as a line of JSON
+// as soon as it matches, and a summary of the query to stderr
+func streamQueryResult(qe *query.QueryEngine, q query.Query, formatter *output.Formatter) error {
+	writer := bufio.NewWriter(os.Stdout)
+	defer writer.Flush()
+
+	total, err := qe.Stream(q, func(doc archive.BSpecDocument) error {
+		if err := formatter.WriteDocumentNDJSON(writer, doc); err != nil {
+			return err
+		}
+		return writer.Flush()
+	})
+	if err != nil {
+		return fmt.Errorf("query execution failed: %w", err)
+	}
+
+	if !viper.GetBool("quiet") {
+		summary, err := json.Marshal(map[string]interface{}{
+			"total": total,
+			"query": q,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to format summary: %w", err)
+		}
+		fmt.Fprintln(os.Stderr, string(summary))
+	}
+
+	return nil
+}
+
 func buildQueryFromFlags(cmd *cobra.Command) (query.Query, error) {
 	var q query.Query
 
@@ -133,6 +179,10 @@ func buildQueryFromFlags(cmd *cobra.Command) (query.Query, error) {
 		q.Search, _ = cmd.Flags().GetString("search")
 	}
 
+	if cmd.Flags().Changed("section") {
+		q.Section, _ = cmd.Flags().GetString("section")
+	}
+
 	if cmd.Flags().Changed("tags") {
 		tagsStr, _ := cmd.Flags().GetString("tags")
 		if tagsStr != "" {
@@ -312,66 +362,7 @@ func readArchiveFromDirectory(dirPath string) (*archive.BSpecArchive, error) {
 
 // parseDocumentFromPath parses a markdown document with YAML frontmatter
 func parseDocumentFromPath(content []byte) (*archive.BSpecDocument, error) {
-	contentStr := string(content)
-
-	// Check for frontmatter
-	if !strings.HasPrefix(contentStr, "---\n") {
-		return nil, fmt.Errorf("document missing YAML frontmatter")
-	}
-
-	// Find the end of frontmatter
-	parts := strings.SplitN(contentStr[4:], "\n---\n", 2)
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("invalid frontmatter format")
-	}
-
-	frontmatter := parts[0]
-	markdownContent := parts[1]
-
-	// Parse frontmatter as JSON for simplicity (in real implementation, use YAML parser)
-	var metadata map[string]interface{}
-
-	// For now, create a basic document structure
-	// In a real implementation, you'd use a proper YAML parser
-	doc := &archive.BSpecDocument{
-		Content:  markdownContent,
-		Metadata: metadata,
-	}
-
-	// Extract basic fields from frontmatter (simplified parsing)
-	lines := strings.Split(frontmatter, "\n")
-	for _, line := range lines {
-		if strings.Contains(line, ":") {
-			parts := strings.SplitN(line, ":", 2)
-			if len(parts) == 2 {
-				key := strings.TrimSpace(parts[0])
-				value := strings.TrimSpace(strings.Trim(parts[1], "\"'"))
-
-				switch key {
-				case "id":
-					doc.ID = value
-				case "title":
-					doc.Title = value
-				case "type":
-					doc.Type = value
-				case "status":
-					doc.Status = value
-				case "version":
-					doc.Version = value
-				case "owner":
-					doc.Owner = value
-				case "created":
-					doc.Created = value
-				case "updated":
-					doc.Updated = value
-				case "domain":
-					doc.Domain = value
-				}
-			}
-		}
-	}
-
-	return doc, nil
+	return archive.ParseDocument(content)
 }
 
 func init() {
@@ -384,6 +375,7 @@ func init() {
 	queryCmd.Flags().StringP("owner", "", "", "Filter by owner")
 	queryCmd.Flags().StringP("search", "", "", "Text search in content")
 	queryCmd.Flags().StringP("tags", "", "", "Filter by tags (comma-separated)")
+	queryCmd.Flags().StringP("section", "", "", "Scope search and content to a section (heading or anchor)")
 
 	// Output control flags
 	queryCmd.Flags().StringP("fields", "f", "", "Select specific fields (comma-separated)")
@@ -397,4 +389,5 @@ func init() {
 	queryCmd.Flags().StringSliceP("metadata", "m", []string{}, "Filter by metadata (key=value)")
 	queryCmd.Flags().StringP("json", "j", "", "JSON query string (advanced)")
 	queryCmd.Flags().BoolP("pretty", "p", true, "Pretty print output")
+	addTemplateFlags(queryCmd)
 }
\ No newline at end of file
@@ -0,0 +1,145 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/review"
+)
+
+// reviewDueCmd represents the review-due command
+var reviewDueCmd = &cobra.Command{
+	Use:   "review-due <bspec-file|directory>",
+	Short: "List documents past their review cycle or expiry, grouped by owner",
+	Long: `List the documents that are due for review, grouped by owner.
+
+A document is due when its review_cycle (weekly, monthly, quarterly,
+semi-annually, annually, ...) has elapsed since its updated date, or when its
+expires date has passed. Deprecated documents are ignored. Use --within to
+include documents that become due in the coming days, for example in a weekly
+digest.
+
+With -o markdown the report is a digest to share, and with -o json it is
+machine-readable. The expiry dates of all documents can be exported as an
+iCalendar (.ics) feed with --ics.
+
+Examples:
+  bspec review-due project.bspec
+  bspec review-due ./project --as-of=2025-01-01
+  bspec review-due ./project --within=7 -o markdown   # Weekly digest
+  bspec review-due ./project --ics=expiry.ics
+  bspec review-due ./project -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		inputPath := args[0]
+
+		// Check if path exists
+		if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+			return fmt.Errorf("path does not exist: %s", inputPath)
+		}
+
+		opts := review.Options{AsOf: time.Now()}
+		if asOf, _ := cmd.Flags().GetString("as-of"); asOf != "" {
+			date, err := time.Parse(review.DateLayout, asOf)
+			if err != nil {
+				return fmt.Errorf("invalid --as-of date %q: expected YYYY-MM-DD", asOf)
+			}
+			opts.AsOf = date
+		}
+		opts.Within, _ = cmd.Flags().GetInt("within")
+		if opts.Within < 0 {
+			return fmt.Errorf("--within must not be negative")
+		}
+		arch, err := readArchiveFromPath(inputPath)
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		if icsPath, _ := cmd.Flags().GetString("ics"); icsPath != "" {
+			if err := writeICSFile(icsPath, func(w io.Writer) error {
+				return review.WriteICS(w, arch, time.Now())
+			}); err != nil {
+				return err
+			}
+			if !viper.GetBool("quiet") {
+				fmt.Fprintf(os.Stderr, "Wrote %s\n", icsPath)
+			}
+		}
+
+		report := review.Due(arch, opts)
+
+		switch viper.GetString("output") {
+		case "json":
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(report); err != nil {
+				return fmt.Errorf("failed to encode report: %w", err)
+			}
+			return nil
+		case "markdown", "md":
+			formatter, err := newFormatter(cmd)
+			if err != nil {
+				return err
+			}
+			digest, err := formatter.FormatReviewReport(report)
+			if err != nil {
+				return fmt.Errorf("failed to format report: %w", err)
+			}
+			fmt.Print(digest)
+			return nil
+		}
+
+		if !viper.GetBool("quiet") {
+			printReviewReport(os.Stdout, report)
+		}
+		return nil
+	},
+}
+
+// writeICSFile writes a calendar to a file, creating its directory
+func writeICSFile(path string, write func(io.Writer) error) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", path, err)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	if err := write(file); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// printReviewReport writes the due documents as plain text
+func printReviewReport(w io.Writer, report *review.Report) {
+	fmt.Fprintf(w, "Due for review as of %s: %d items\n", report.AsOf, report.Total)
+	for _, group := range report.Owners {
+		fmt.Fprintf(w, "\n%s (%d)\n", group.Owner, len(group.Items))
+		for _, item := range group.Items {
+			fmt.Fprintf(w, "  %-10s %-24s %-9s %s  %s\n", item.Due, item.Document, item.Status, item.Title, item.Description())
+		}
+	}
+	if len(report.Unchecked) > 0 {
+		fmt.Fprintf(w, "\nUnrecognized review_cycle or dates: %v\n", report.Unchecked)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(reviewDueCmd)
+
+	reviewDueCmd.Flags().String("as-of", "", "Reference date (YYYY-MM-DD, default: today)")
+	reviewDueCmd.Flags().Int("within", 0, "Also list documents that become due within this many days")
+	reviewDueCmd.Flags().String("ics", "", "Write document expiry dates to an iCalendar (.ics) file")
+}
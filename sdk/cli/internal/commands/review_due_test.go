@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/review"
+)
+
+func TestReviewDueCommand(t *testing.T) {
+	archiveDir := t.TempDir()
+	docsDir := filepath.Join(archiveDir, "documents", "01-strategic")
+	if err := os.MkdirAll(docsDir, 0755); err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	os.WriteFile(filepath.Join(archiveDir, "manifest.json"), []byte(`{"name":"Test Project"}`), 0644)
+	os.WriteFile(filepath.Join(docsDir, "MSN-mission.md"), []byte("---\nid: MSN-mission\ntitle: Mission\ntype: MSN\nstatus: Accepted\nowner: alice\nupdated: 2023-01-10\nreview_cycle: quarterly\nexpires: 2024-06-01\n---\n\n# Mission\n"), 0644)
+
+	icsPath := filepath.Join(t.TempDir(), "calendar", "expiry.ics")
+	reviewDueCmd.Flags().Set("as-of", "2025-01-10")
+	reviewDueCmd.Flags().Set("ics", icsPath)
+	defer reviewDueCmd.Flags().Set("as-of", "")
+	defer reviewDueCmd.Flags().Set("ics", "")
+
+	if err := reviewDueCmd.RunE(reviewDueCmd, []string{archiveDir}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	data, err := os.ReadFile(icsPath)
+	if err != nil {
+		t.Fatalf("Expected calendar to be written: %v", err)
+	}
+	if !strings.Contains(string(data), "DTSTART;VALUE=DATE:20240601") {
+		t.Errorf("Expected expiry event in calendar:\n%s", data)
+	}
+
+	reviewDueCmd.Flags().Set("as-of", "01/10/2025")
+	if err := reviewDueCmd.RunE(reviewDueCmd, []string{archiveDir}); err == nil || !strings.Contains(err.Error(), "invalid --as-of") {
+		t.Errorf("Expected invalid date error, got %v", err)
+	}
+}
+
+func TestPrintReviewReport(t *testing.T) {
+	report := &review.Report{
+		AsOf:   "2025-01-10",
+		Within: 7,
+		Total:  2,
+		Owners: []review.OwnerGroup{{Owner: "alice", Items: []review.Item{
+			{Document: "MSN-001", Title: "Mission", Status: "Accepted", Path: "01-strategic/MSN-001.md", Due: "2023-04-10",
+				Reason: review.ReasonReviewOverdue, ReviewCycle: "quarterly", Updated: "2023-01-10", DaysOverdue: 641},
+			{Document: "VSN-001", Title: "Vision", Status: "Draft", Due: "2025-01-15", Reason: review.ReasonExpiring, DaysOverdue: -5},
+		}}},
+	}
+
+	var text bytes.Buffer
+	printReviewReport(&text, report)
+	for _, expected := range []string{"Due for review as of 2025-01-10: 2 items", "alice (2)", "review overdue by 641 days (quarterly, updated 2023-01-10)", "expires in 5 days"} {
+		if !strings.Contains(text.String(), expected) {
+			t.Errorf("Expected %q in output:\n%s", expected, text.String())
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -26,6 +27,19 @@ This tool allows you to:
 	Version: "1.0.0",
 }
 
+// ErrReported is returned by commands that fail because of findings they have
+// already printed, such as validation errors. The caller only sets the exit
+// code.
+var ErrReported = errors.New("findings reported")
+
+// reported fails a command on findings it has already printed: cobra prints
+// neither the usage nor the error
+func reported(cmd *cobra.Command, format string, args ...interface{}) error {
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	return fmt.Errorf("%w: %s", ErrReported, fmt.Sprintf(format, args...))
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() error {
@@ -37,7 +51,7 @@ func init() {
 
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.bspec.yaml)")
-	rootCmd.PersistentFlags().StringP("output", "o", "yaml", "output format (json|yaml|markdown)")
+	rootCmd.PersistentFlags().StringP("output", "o", "yaml", "output format (json|yaml|markdown|ndjson)")
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "verbose output")
 	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "quiet output")
 
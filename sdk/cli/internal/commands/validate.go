@@ -0,0 +1,244 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/integrity"
+	"github.com/a3tai/bspec/cli/internal/lint"
+)
+
+// validateFixResult is the JSON output of validate --fix --dry-run
+type validateFixResult struct {
+	Fixed  []integrity.Issue `json:"fixed"`
+	Files  []string          `json:"files"` // Changed files, relative to the archive directory
+	DryRun bool              `json:"dry_run"`
+}
+
+// validateCmd represents the validate command
+var validateCmd = &cobra.Command{
+	Use:   "validate <bspec-file|directory>",
+	Short: "Check documents and the relationships between them",
+	Long: `Check the documents of an archive and the relationships between them.
+
+Each document is validated against the fields of its type in the SDK, and
+against the custom rules of the project configuration.
+
+Errors:
+  - references in depends_on, enables, conflicts_with, related, parent,
+    supersedes, risks and metrics that resolve to no document
+  - circular depends_on chains
+
+Warnings:
+  - conflicts_with pairs where both documents are Accepted
+  - superseded documents that are not Deprecated
+  - risks and mitigations that do not reference each other
+  - documents outside the folder of their domain
+
+The lint section of .bspec.yaml, in the archive directory or next to the
+.bspec file, defines custom rules as expressions over frontmatter fields and
+sets the severity (error, warning, info or off) of any rule per path:
+
+  lint:
+    custom:
+      - id: critical-needs-metrics
+        when: priority == "critical"
+        assert: "!empty(metrics)"
+        message: critical documents must list metrics
+    overrides:
+      - paths: ["09-risk/**"]
+        rules:
+          missing_related: off
+
+A document disables rules with a comment in its body:
+  <!-- bspec-disable missing_related, critical-needs-metrics -->
+
+With --fix, the issues that have a mechanical fix are fixed in place: missing
+created and updated dates, IDs without their type prefix (references, links
+and the file name follow), versions that are not semantic versions,
+documents outside their domain folder, unsorted relationship lists, missing
+back-references between risks and mitigations, and custom rules with a set
+section. Otherwise only the affected frontmatter fields are edited. A
+document whose ID or version is fixed is also moved to its domain folder in
+the same pass. Add --dry-run to see the diff first.
+
+With --write, the relationship_integrity and circular_dependencies fields of
+the manifest validation section are updated from the result.
+
+Examples:
+  bspec validate project.bspec
+  bspec validate ./project --write     # Record the result in manifest.json
+  bspec validate ./project --strict    # Treat warnings as errors
+  bspec validate ./project --fix --dry-run
+  bspec validate ./project -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		inputPath := args[0]
+
+		// Check if path exists
+		if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+			return fmt.Errorf("path does not exist: %s", inputPath)
+		}
+
+		config, err := lint.LoadConfig(projectConfigPath(inputPath))
+		if err != nil {
+			return err
+		}
+		arch, report, err := validateArchive(inputPath, config)
+		if err != nil {
+			return err
+		}
+
+		fix, _ := cmd.Flags().GetBool("fix")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if dryRun && !fix {
+			return fmt.Errorf("--dry-run requires --fix")
+		}
+		if fix {
+			if !isDirectory(inputPath) {
+				return fmt.Errorf("--fix requires an archive directory; extract the .bspec file first")
+			}
+			plan, err := lint.New(config).Fix(inputPath, report.Issues, time.Now())
+			if err != nil {
+				return err
+			}
+			if dryRun {
+				return printFixPlan(plan)
+			}
+			if err := plan.Apply(); err != nil {
+				return err
+			}
+			if !viper.GetBool("quiet") {
+				fmt.Fprintf(os.Stderr, "Fixed %s in %s\n", countOf(len(plan.Fixed), "issue"), countOf(len(plan.Changes), "file"))
+			}
+			// Report what is left
+			if arch, report, err = validateArchive(inputPath, config); err != nil {
+				return err
+			}
+		}
+
+		write, _ := cmd.Flags().GetBool("write")
+		if write {
+			if !isDirectory(inputPath) {
+				return fmt.Errorf("--write requires an archive directory; extract the .bspec file first")
+			}
+			validation := report.Validation(arch.Manifest.Validation)
+			if err := archive.UpdateManifestField(inputPath, "validation", validation); err != nil {
+				return err
+			}
+		}
+
+		if viper.GetString("output") == "json" {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(report); err != nil {
+				return fmt.Errorf("failed to encode report: %w", err)
+			}
+		} else if !viper.GetBool("quiet") || len(report.Issues) > 0 {
+			printIntegrityReport(os.Stdout, report)
+		}
+
+		strict, _ := cmd.Flags().GetBool("strict")
+		if errors := len(report.Errors()); errors > 0 {
+			return reported(cmd, "validation failed with %d errors", errors)
+		}
+		if warnings := len(report.Warnings()); strict && warnings > 0 {
+			return reported(cmd, "validation failed with %d warnings", warnings)
+		}
+		return nil
+	},
+}
+
+// validateArchive reads an archive and checks its relationships and
+// documents
+func validateArchive(inputPath string, config *lint.Config) (*archive.BSpecArchive, *integrity.Report, error) {
+	arch, err := readArchiveFromPath(inputPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	report := integrity.Check(arch)
+	report.Issues = lint.New(config).Run(arch, report.Issues)
+	return arch, report, nil
+}
+
+// printFixPlan prints the diff of the fixes, or the fixed issues as JSON
+func printFixPlan(plan *lint.FixPlan) error {
+	if viper.GetString("output") == "json" {
+		result := validateFixResult{Fixed: plan.Fixed, Files: []string{}, DryRun: true}
+		if result.Fixed == nil {
+			result.Fixed = []integrity.Issue{}
+		}
+		for _, change := range plan.Changes {
+			result.Files = append(result.Files, change.NewPath)
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(result); err != nil {
+			return fmt.Errorf("failed to encode result: %w", err)
+		}
+		return nil
+	}
+	fmt.Print(plan.Diff())
+	if !viper.GetBool("quiet") {
+		fmt.Fprintf(os.Stderr, "Would fix %s in %s\n", countOf(len(plan.Fixed), "issue"), countOf(len(plan.Changes), "file"))
+	}
+	return nil
+}
+
+// countOf formats a count with a singular or plural noun, such as 1 issue
+// or 2 issues
+func countOf(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// projectConfigPath returns the project configuration file of an archive
+// directory or .bspec file
+func projectConfigPath(inputPath string) string {
+	if isDirectory(inputPath) {
+		return filepath.Join(inputPath, lint.ConfigFile)
+	}
+	return filepath.Join(filepath.Dir(inputPath), lint.ConfigFile)
+}
+
+// printIntegrityReport writes a human-readable integrity report
+func printIntegrityReport(w io.Writer, report *integrity.Report) {
+	printIssues(w, report.Issues)
+	fmt.Fprintf(w, "Checked %d documents and %d relationships: %d errors, %d warnings\n",
+		report.Documents, report.Relationships, len(report.Errors()), len(report.Warnings()))
+	fmt.Fprintf(w, "  relationship_integrity: %t\n", report.RelationshipIntegrity)
+	fmt.Fprintf(w, "  circular_dependencies:  %t\n", report.CircularDependencies)
+}
+
+// printIssues writes one line per issue, with its fix, and a blank line
+// after them
+func printIssues(w io.Writer, issues []integrity.Issue) {
+	for _, issue := range issues {
+		fmt.Fprintf(w, "%-7s %s: %s\n", issue.Severity, issue.Code, issue.Message)
+		if issue.Fix != "" {
+			fmt.Fprintf(w, "        fix: %s\n", issue.Fix)
+		}
+	}
+	if len(issues) > 0 {
+		fmt.Fprintln(w)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+
+	validateCmd.Flags().Bool("write", false, "Record the result in the manifest validation section (directories only)")
+	validateCmd.Flags().Bool("strict", false, "Treat warnings as errors")
+	validateCmd.Flags().Bool("fix", false, "Apply the fixes of the issues that have one (directories only)")
+	validateCmd.Flags().Bool("dry-run", false, "With --fix, show a diff of the fixes without writing files")
+}
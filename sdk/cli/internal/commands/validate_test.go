@@ -0,0 +1,145 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/integrity"
+)
+
+func TestValidateCommand(t *testing.T) {
+	archiveDir := t.TempDir()
+	docsDir := filepath.Join(archiveDir, "documents", "01-strategic")
+	if err := os.MkdirAll(docsDir, 0755); err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	os.WriteFile(filepath.Join(archiveDir, "manifest.json"), []byte(`{"name":"Test Project"}`), 0644)
+	os.WriteFile(filepath.Join(docsDir, "STR-growth.md"), []byte("---\nid: STR-growth\ntitle: Growth\ntype: STR\nstatus: Draft\ndepends_on: [MSN-missing]\n---\n\n# Growth\n"), 0644)
+
+	validateCmd.Flags().Set("write", "true")
+	defer validateCmd.Flags().Set("write", "false")
+
+	// The findings are printed once, without the usage
+	err := validateCmd.RunE(validateCmd, []string{archiveDir})
+	if !errors.Is(err, ErrReported) || !validateCmd.SilenceUsage || !validateCmd.SilenceErrors {
+		t.Errorf("Expected a reported error for dangling reference, got %v", err)
+	}
+
+	data, _ := os.ReadFile(filepath.Join(archiveDir, "manifest.json"))
+	var manifest archive.Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		t.Fatalf("Failed to parse manifest: %v", err)
+	}
+	if manifest.Validation == nil || manifest.Validation.RelationshipIntegrity {
+		t.Errorf("Expected relationship_integrity to be recorded as false, got %+v", manifest.Validation)
+	}
+}
+
+func TestPrintIntegrityReport(t *testing.T) {
+	report := &integrity.Report{
+		Documents:             2,
+		Relationships:         1,
+		RelationshipIntegrity: false,
+		Issues: []integrity.Issue{
+			{Code: integrity.CodeDanglingReference, Severity: integrity.SeverityError, Message: "STR-a references MSN-b"},
+		},
+	}
+
+	var buf bytes.Buffer
+	printIntegrityReport(&buf, report)
+
+	output := buf.String()
+	if !strings.Contains(output, "error   dangling_reference: STR-a references MSN-b") {
+		t.Errorf("Expected issue line, got %q", output)
+	}
+	if !strings.Contains(output, "1 errors, 0 warnings") || !strings.Contains(output, "relationship_integrity: false") {
+		t.Errorf("Expected summary, got %q", output)
+	}
+}
+
+func TestValidateCommandLintRules(t *testing.T) {
+	archiveDir := t.TempDir()
+	docsDir := filepath.Join(archiveDir, "documents", "01-strategic")
+	if err := os.MkdirAll(docsDir, 0755); err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	os.WriteFile(filepath.Join(archiveDir, "manifest.json"), []byte(`{"name":"Test Project"}`), 0644)
+	os.WriteFile(filepath.Join(docsDir, "MSN-acme.md"), []byte("---\nid: MSN-acme\ntitle: Mission\ntype: MSN\nstatus: Draft\nversion: 1.0.0\nowner: alice\ncreated: 2025-01-01\nupdated: 2025-01-01\nsuccess_criteria: [Customers renew]\n---\n\n# Mission\n"), 0644)
+	config := "lint:\n  custom:\n    - id: owner-email\n      assert: owner matches \"@\"\n      message: owner must be an email address\n"
+	os.WriteFile(filepath.Join(archiveDir, ".bspec.yaml"), []byte(config), 0644)
+
+	validateCmd.Flags().Set("write", "false")
+	validateCmd.Flags().Set("strict", "false")
+
+	if err := validateCmd.RunE(validateCmd, []string{archiveDir}); err == nil || !strings.Contains(err.Error(), "1 errors") {
+		t.Errorf("Expected the custom rule to fail validation, got %v", err)
+	}
+
+	os.WriteFile(filepath.Join(archiveDir, ".bspec.yaml"), []byte(config+"  overrides:\n    - paths: [\"01-strategic/**\"]\n      rules:\n        owner-email: warning\n"), 0644)
+	if err := validateCmd.RunE(validateCmd, []string{archiveDir}); err != nil {
+		t.Errorf("Expected the re-graded rule to pass, got %v", err)
+	}
+
+	os.WriteFile(filepath.Join(archiveDir, ".bspec.yaml"), []byte("lint:\n  rules:\n    owner-email: fatal\n"), 0644)
+	if err := validateCmd.RunE(validateCmd, []string{archiveDir}); err == nil || !strings.Contains(err.Error(), "unknown severity") {
+		t.Errorf("Expected invalid configuration error, got %v", err)
+	}
+}
+
+func TestValidateCommandFix(t *testing.T) {
+	archiveDir := t.TempDir()
+	docsDir := filepath.Join(archiveDir, "documents", "01-strategic")
+	if err := os.MkdirAll(docsDir, 0755); err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+	os.WriteFile(filepath.Join(archiveDir, "manifest.json"), []byte(`{"name":"Test Project"}`), 0644)
+	docPath := filepath.Join(docsDir, "MSN-acme.md")
+	content := "---\nid: MSN-acme\ntitle: Mission\ntype: MSN\nstatus: Draft\nversion: \"1.0\" # first draft\nowner: alice\nsuccess_criteria: [Customers renew]\n---\n\n# Mission\n"
+	os.WriteFile(docPath, []byte(content), 0644)
+
+	validateCmd.Flags().Set("write", "false")
+	validateCmd.Flags().Set("strict", "false")
+	validateCmd.Flags().Set("fix", "true")
+	validateCmd.Flags().Set("dry-run", "true")
+	defer validateCmd.Flags().Set("fix", "false")
+	defer validateCmd.Flags().Set("dry-run", "false")
+
+	if err := validateCmd.RunE(validateCmd, []string{archiveDir}); err != nil {
+		t.Fatalf("Dry run failed: %v", err)
+	}
+	if data, _ := os.ReadFile(docPath); string(data) != content {
+		t.Errorf("Expected --dry-run to leave the document unchanged, got:\n%s", data)
+	}
+
+	validateCmd.Flags().Set("dry-run", "false")
+	if err := validateCmd.RunE(validateCmd, []string{archiveDir}); err != nil {
+		t.Fatalf("Expected the fixed archive to validate, got %v", err)
+	}
+	data, _ := os.ReadFile(docPath)
+	for _, expected := range []string{`version: "1.0.0" # first draft`, "created: ", "updated: "} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("Expected %q in fixed document:\n%s", expected, data)
+		}
+	}
+
+	validateCmd.Flags().Set("fix", "false")
+	validateCmd.Flags().Set("dry-run", "true")
+	if err := validateCmd.RunE(validateCmd, []string{archiveDir}); err == nil || !strings.Contains(err.Error(), "requires --fix") {
+		t.Errorf("Expected --dry-run without --fix to fail, got %v", err)
+	}
+}
+
+func TestCountOf(t *testing.T) {
+	tests := map[int]string{0: "0 issues", 1: "1 issue", 2: "2 issues"}
+	for n, expected := range tests {
+		if got := countOf(n, "issue"); got != expected {
+			t.Errorf("countOf(%d) = %q, expected %q", n, got, expected)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package conformance
+
+import (
+	"sort"
+	"time"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+// ChecklistReportPath is the location of the checklist report inside an archive
+const ChecklistReportPath = "computed/analysis/checklist-report.json"
+
+// ValidationChecklist is the key of the validation checklist in the totals
+const ValidationChecklist = "validation"
+
+// ChecklistReport is the checklist completion of the documents of an archive
+type ChecklistReport struct {
+	ReportVersion string                      `json:"report_version"`
+	Generated     string                      `json:"generated"`
+	Documents     []DocumentChecklists        `json:"documents"`
+	Levels        map[string]*ChecklistTotals `json:"levels"` // Quality Standards checklists by level
+	Validation    *ChecklistTotals            `json:"validation"`
+}
+
+// DocumentChecklists is the checklist completion of one document
+type DocumentChecklists struct {
+	ID         string                `json:"id"`
+	Type       string                `json:"type"`
+	Path       string                `json:"path"` // Relative to documents/
+	Levels     map[string]Completion `json:"levels"`
+	Validation *Completion           `json:"validation,omitempty"`
+	Ready      string                `json:"ready"` // Highest level whose checklists are complete, or "none"
+}
+
+// Completion counts the checked items of a checklist
+type Completion struct {
+	Checked int `json:"checked"`
+	Total   int `json:"total"`
+}
+
+// Complete reports whether the checklist has items and all are checked
+func (c Completion) Complete() bool {
+	return c.Total > 0 && c.Checked == c.Total
+}
+
+// ChecklistTotals sums a checklist over the documents of an archive
+type ChecklistTotals struct {
+	Documents int `json:"documents"` // Documents with the checklist
+	Complete  int `json:"complete"`  // Documents whose checklist is complete
+	Ready     int `json:"ready"`     // Documents whose checklists are complete up to this level
+	Checked   int `json:"checked"`
+	Total     int `json:"total"`
+}
+
+// Checklists reads the Quality Standards and validation checklists of every
+// document of an archive. Deprecated documents are left out.
+func Checklists(arch *archive.BSpecArchive) *ChecklistReport {
+	report := &ChecklistReport{
+		ReportVersion: ReportVersion,
+		Generated:     time.Now().UTC().Format(time.RFC3339),
+		Documents:     []DocumentChecklists{},
+		Levels:        make(map[string]*ChecklistTotals),
+		Validation:    &ChecklistTotals{},
+	}
+	for _, level := range bspec.QualityLevels() {
+		report.Levels[string(level)] = &ChecklistTotals{}
+	}
+
+	paths := make([]string, 0, len(arch.Documents))
+	for path, doc := range arch.Documents {
+		if normalizeStatus(doc.Status) != bspec.DocumentStatusDeprecated {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		doc := arch.Documents[path]
+		checklists := bspec.ParseChecklists(doc.Content)
+		entry := DocumentChecklists{
+			ID:     doc.ID,
+			Type:   doc.Type,
+			Path:   path,
+			Levels: make(map[string]Completion),
+			Ready:  LevelNone,
+		}
+		if ready := checklists.ReadyLevel(); ready != "" {
+			entry.Ready = string(ready)
+		}
+
+		for _, level := range bspec.QualityLevels() {
+			totals := report.Levels[string(level)]
+			if LevelRank(entry.Ready) >= LevelRank(string(level)) {
+				totals.Ready++
+			}
+			checklist := checklists.Level(level)
+			if checklist == nil {
+				continue
+			}
+			completion := Completion{Checked: checklist.Checked(), Total: len(checklist.Items)}
+			entry.Levels[string(level)] = completion
+			totals.add(completion)
+		}
+		if checklists.Validation != nil {
+			completion := Completion{Checked: checklists.Validation.Checked(), Total: len(checklists.Validation.Items)}
+			entry.Validation = &completion
+			report.Validation.add(completion)
+		}
+		report.Documents = append(report.Documents, entry)
+	}
+	return report
+}
+
+// add counts a document's checklist
+func (t *ChecklistTotals) add(completion Completion) {
+	t.Documents++
+	if completion.Complete() {
+		t.Complete++
+	}
+	t.Checked += completion.Checked
+	t.Total += completion.Total
+}
+
+// Totals returns the totals of each level and of the validation checklist,
+// keyed by level name and "validation"
+func (r *ChecklistReport) Totals() map[string]*ChecklistTotals {
+	totals := map[string]*ChecklistTotals{ValidationChecklist: r.Validation}
+	for level, levelTotals := range r.Levels {
+		totals[level] = levelTotals
+	}
+	return totals
+}
@@ -0,0 +1,56 @@
+package conformance
+
+import (
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+func TestChecklists(t *testing.T) {
+	arch := &archive.BSpecArchive{Documents: map[string]archive.BSpecDocument{
+		"01-strategic/MSN-mission.md": {ID: "MSN-mission", Type: "MSN", Status: "Accepted", Content: `# Mission
+
+## Quality Standards
+
+### Bronze Level (Minimum Viable)
+- [x] Purpose stated
+- [x] Audience named
+
+### Silver Level (Investment Ready)
+- [x] Values listed
+- [ ] Evidence cited
+
+## Validation Checklist
+- [x] Reviewed
+`},
+		"01-strategic/VSN-vision.md": {ID: "VSN-vision", Type: "VSN", Status: "Draft", Content: "# Vision\n\n### Bronze Level\n- [ ] Horizon set\n"},
+		"01-strategic/OLD-plan.md":   {ID: "OLD-plan", Type: "STR", Status: "Deprecated", Content: "### Bronze Level\n- [x] Done\n"},
+	}}
+
+	report := Checklists(arch)
+
+	if len(report.Documents) != 2 {
+		t.Fatalf("Expected the deprecated document to be left out, got %d documents", len(report.Documents))
+	}
+	mission, vision := report.Documents[0], report.Documents[1]
+	if mission.ID != "MSN-mission" || mission.Ready != "bronze" || vision.Ready != LevelNone {
+		t.Errorf("Unexpected readiness: %s %s, %s %s", mission.ID, mission.Ready, vision.ID, vision.Ready)
+	}
+	if silver := mission.Levels["silver"]; silver.Checked != 1 || silver.Total != 2 || silver.Complete() {
+		t.Errorf("Unexpected silver completion: %+v", silver)
+	}
+	if _, ok := mission.Levels["gold"]; ok {
+		t.Error("Expected no gold checklist")
+	}
+	if mission.Validation == nil || !mission.Validation.Complete() || vision.Validation != nil {
+		t.Errorf("Unexpected validation checklists: %+v, %+v", mission.Validation, vision.Validation)
+	}
+
+	bronze := report.Levels["bronze"]
+	if *bronze != (ChecklistTotals{Documents: 2, Complete: 1, Ready: 1, Checked: 2, Total: 3}) {
+		t.Errorf("Unexpected bronze totals: %+v", bronze)
+	}
+	if totals := report.Totals(); totals[ValidationChecklist].Documents != 1 || totals["gold"].Documents != 0 {
+		t.Errorf("Unexpected totals: %+v", totals)
+	}
+}
@@ -0,0 +1,426 @@
+package conformance
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/graph"
+)
+
+// ReportVersion is the version of the conformance report format
+const ReportVersion = "1.0.0"
+
+// ReportPath is the location of the conformance report inside an archive
+const ReportPath = "computed/analysis/conformance-report.json"
+
+// LevelNone is reported as the current level when not even Bronze is achieved
+const LevelNone = "none"
+
+// Assessment statuses
+const (
+	StatusAchieved   = "achieved"
+	StatusInProgress = "in_progress"
+	StatusNotStarted = "not_started"
+)
+
+// Requirement kinds
+const (
+	KindDocument      = "document"
+	KindStatus        = "status"
+	KindChecklist     = "checklist"
+	KindDocumentCount = "document_count"
+)
+
+// Options controls how an archive is evaluated
+type Options struct {
+	Level   bspec.ConformanceLevel // Target level; defaults to the manifest conformance level
+	Profile bspec.IndustryProfile  // Industry profile; defaults to the manifest industry profile
+}
+
+// Report is the conformance report stored in computed/analysis/conformance-report.json
+type Report struct {
+	ReportVersion   string                    `json:"report_version"`
+	Generated       string                    `json:"generated"`
+	TargetLevel     string                    `json:"target_level"`
+	CurrentLevel    string                    `json:"current_level"`
+	IndustryProfile string                    `json:"industry_profile"`
+	Assessment      Assessment                `json:"assessment"`
+	MissingForGold  []string                  `json:"missing_for_gold"`
+	DomainCoverage  map[string]DomainCoverage `json:"domain_coverage"`
+	Recommendations []Recommendation          `json:"recommendations"`
+}
+
+// Assessment holds the evaluation of each level
+type Assessment struct {
+	Bronze *LevelAssessment `json:"bronze"`
+	Silver *LevelAssessment `json:"silver"`
+	Gold   *LevelAssessment `json:"gold"`
+}
+
+// LevelAssessment is the evaluation of one conformance level
+type LevelAssessment struct {
+	Status            string        `json:"status"`
+	Score             float64       `json:"score"`
+	RequirementsMet   int           `json:"requirements_met"`
+	RequirementsTotal int           `json:"requirements_total"`
+	Missing           []string      `json:"missing,omitempty"`
+	Requirements      []Requirement `json:"requirements"`
+}
+
+// Requirement is a single check of a conformance level
+type Requirement struct {
+	Kind         string `json:"kind"`
+	DocumentType string `json:"document_type,omitempty"`
+	Description  string `json:"description"`
+	Met          bool   `json:"met"`
+	Detail       string `json:"detail,omitempty"`
+}
+
+// DomainCoverage summarizes the documents of a domain
+type DomainCoverage struct {
+	Documents int    `json:"documents"`
+	Coverage  string `json:"coverage"`
+}
+
+// Recommendation is a suggested step towards a higher level
+type Recommendation struct {
+	Priority     string `json:"priority"`
+	Type         string `json:"type"`
+	DocumentType string `json:"document_type,omitempty"`
+	Suggestion   string `json:"suggestion"`
+	Impact       string `json:"impact"`
+}
+
+// Evaluate assesses an archive against every conformance level
+func Evaluate(arch *archive.BSpecArchive, opts Options) (*Report, error) {
+	target := opts.Level
+	if target == "" {
+		target = bspec.ConformanceLevel(strings.ToLower(arch.Manifest.ConformanceLevel))
+	}
+	if target == "" {
+		target = bspec.ConformanceLevelBronze
+	}
+	if _, ok := LookupLevel(target); !ok {
+		return nil, fmt.Errorf("unknown conformance level: %s (supported: bronze, silver, gold)", target)
+	}
+
+	profile := opts.Profile
+	if profile == "" {
+		profile = bspec.IndustryProfile(strings.ToLower(arch.Manifest.IndustryProfile))
+	}
+	if _, ok := ProfileDocuments(profile); profile != "" && !ok {
+		return nil, fmt.Errorf("unknown industry profile: %s (supported: software-saas, physical-product, service-business, nonprofit)", profile)
+	}
+
+	byType := documentsByType(arch)
+	total := 0
+	for _, docs := range byType {
+		total += len(docs)
+	}
+
+	report := &Report{
+		ReportVersion:   ReportVersion,
+		Generated:       time.Now().UTC().Format(time.RFC3339),
+		TargetLevel:     string(target),
+		CurrentLevel:    LevelNone,
+		IndustryProfile: string(profile),
+		MissingForGold:  []string{},
+		DomainCoverage:  domainCoverage(arch),
+		Recommendations: []Recommendation{},
+	}
+
+	achievedSoFar := true
+	for _, requirements := range levels {
+		assessment := assessLevel(requirements, profile, byType, total)
+		switch requirements.Level {
+		case bspec.ConformanceLevelBronze:
+			report.Assessment.Bronze = assessment
+		case bspec.ConformanceLevelSilver:
+			report.Assessment.Silver = assessment
+		case bspec.ConformanceLevelGold:
+			report.Assessment.Gold = assessment
+			report.MissingForGold = append(report.MissingForGold, assessment.Missing...)
+		}
+
+		if achievedSoFar && assessment.Status == StatusAchieved {
+			report.CurrentLevel = string(requirements.Level)
+		} else {
+			achievedSoFar = false
+		}
+	}
+
+	report.Recommendations = recommendations(report, target)
+	return report, nil
+}
+
+// Met reports whether the archive reaches its target level
+func (r *Report) Met() bool {
+	return LevelRank(r.CurrentLevel) >= LevelRank(r.TargetLevel)
+}
+
+// Level returns the assessment of a level
+func (r *Report) Level(level bspec.ConformanceLevel) *LevelAssessment {
+	switch level {
+	case bspec.ConformanceLevelBronze:
+		return r.Assessment.Bronze
+	case bspec.ConformanceLevelSilver:
+		return r.Assessment.Silver
+	case bspec.ConformanceLevelGold:
+		return r.Assessment.Gold
+	}
+	return nil
+}
+
+// assessLevel evaluates the requirements of one level
+func assessLevel(requirements LevelRequirements, profile bspec.IndustryProfile, byType map[string][]archive.BSpecDocument, total int) *LevelAssessment {
+	assessment := &LevelAssessment{}
+	levelName := titleCase(string(requirements.Level))
+
+	for _, required := range Requirements(requirements.Level, profile) {
+		var docs []archive.BSpecDocument
+		for _, docType := range required.Types {
+			docs = append(docs, byType[docType]...)
+		}
+		docType := required.String()
+
+		document := Requirement{
+			Kind:         KindDocument,
+			DocumentType: docType,
+			Description:  fmt.Sprintf("%s document exists", docType),
+			Met:          len(docs) >= required.Count,
+		}
+		if required.Count > 1 {
+			document.Description = fmt.Sprintf("at least %d %s documents exist", required.Count, docType)
+			document.Detail = fmt.Sprintf("%d of %d", len(docs), required.Count)
+		}
+		status := Requirement{
+			Kind:         KindStatus,
+			DocumentType: docType,
+			Description:  fmt.Sprintf("%s document is %s or later", docType, requirements.MinStatus),
+		}
+		checklist := Requirement{
+			Kind:         KindChecklist,
+			DocumentType: docType,
+			Description:  fmt.Sprintf("%s document completes the %s checklist", docType, levelName),
+		}
+
+		if !document.Met {
+			assessment.Missing = append(assessment.Missing, docType)
+		}
+		if len(docs) == 0 {
+			status.Detail = "no document"
+			checklist.Detail = "no document"
+		} else {
+			status.Met, status.Detail = bestStatus(docs, requirements.MinStatus)
+			checklist.Met, checklist.Detail = bestChecklist(docs, requirements.Level, requirements.Checklist)
+		}
+
+		assessment.Requirements = append(assessment.Requirements, document, status, checklist)
+	}
+
+	assessment.Requirements = append(assessment.Requirements, Requirement{
+		Kind:        KindDocumentCount,
+		Description: fmt.Sprintf("at least %d documents", requirements.MinDocuments),
+		Met:         total >= requirements.MinDocuments,
+		Detail:      fmt.Sprintf("%d documents", total),
+	})
+
+	for _, requirement := range assessment.Requirements {
+		if requirement.Met {
+			assessment.RequirementsMet++
+		}
+	}
+	assessment.RequirementsTotal = len(assessment.Requirements)
+	assessment.Score = math.Round(float64(assessment.RequirementsMet)/float64(assessment.RequirementsTotal)*100) / 100
+
+	switch {
+	case assessment.RequirementsMet == assessment.RequirementsTotal:
+		assessment.Status = StatusAchieved
+	case assessment.RequirementsMet > 0:
+		assessment.Status = StatusInProgress
+	default:
+		assessment.Status = StatusNotStarted
+	}
+	return assessment
+}
+
+// bestStatus checks whether any of the documents has at least the given status
+func bestStatus(docs []archive.BSpecDocument, minStatus bspec.DocumentStatus) (bool, string) {
+	best := archive.BSpecDocument{}
+	for _, doc := range docs {
+		if statusRank[normalizeStatus(doc.Status)] > statusRank[normalizeStatus(best.Status)] {
+			best = doc
+		}
+	}
+	if best.ID == "" {
+		return false, "no document has a valid status"
+	}
+	met := statusRank[normalizeStatus(best.Status)] >= statusRank[minStatus]
+	return met, fmt.Sprintf("%s is %s", best.ID, best.Status)
+}
+
+// bestChecklist checks whether any of the documents has completed the
+// Quality Standards checklist of a level
+func bestChecklist(docs []archive.BSpecDocument, level bspec.ConformanceLevel, name string) (bool, string) {
+	detail := fmt.Sprintf("no %s checklist", name)
+	bestRatio := -1.0
+	for _, doc := range docs {
+		checklists := bspec.ParseChecklists(doc.Content)
+		checklist := checklists.Level(level)
+		if checklist == nil || len(checklist.Items) == 0 {
+			continue
+		}
+		if checklist.Complete() {
+			return true, fmt.Sprintf("%s: %d of %d items checked", doc.ID, checklist.Checked(), len(checklist.Items))
+		}
+		if ratio := checklist.Completion(); ratio > bestRatio {
+			bestRatio = ratio
+			detail = fmt.Sprintf("%s: %d of %d items checked", doc.ID, checklist.Checked(), len(checklist.Items))
+		}
+	}
+	return false, detail
+}
+
+// recommendations lists the unmet requirements from the lowest unachieved
+// level up, each requirement once at the lowest level that needs it
+func recommendations(report *Report, target bspec.ConformanceLevel) []Recommendation {
+	result := []Recommendation{}
+	seen := make(map[string]bool)
+
+	for _, requirements := range levels {
+		assessment := report.Level(requirements.Level)
+		if assessment.Status == StatusAchieved {
+			continue
+		}
+
+		priority := "low"
+		switch rank := LevelRank(string(requirements.Level)); {
+		case rank <= LevelRank(string(target)):
+			priority = "high"
+		case rank == LevelRank(string(target))+1:
+			priority = "medium"
+		}
+		impact := fmt.Sprintf("Required for %s conformance", titleCase(string(requirements.Level)))
+
+		for _, requirement := range assessment.Requirements {
+			key := requirement.Kind + ":" + requirement.DocumentType
+			if requirement.Met || seen[key] {
+				continue
+			}
+			// A missing document already implies its status and checklist
+			if requirement.Kind != KindDocument && requirement.Detail == "no document" {
+				continue
+			}
+			seen[key] = true
+
+			recommendation := Recommendation{
+				Priority:     priority,
+				DocumentType: requirement.DocumentType,
+				Impact:       impact,
+			}
+			switch requirement.Kind {
+			case KindDocument:
+				recommendation.Type = "missing_document"
+				recommendation.Suggestion = fmt.Sprintf("Create the missing %s document", requirement.DocumentType)
+				if requirement.Detail != "" {
+					recommendation.Suggestion = fmt.Sprintf("Create more %s documents (%s)", requirement.DocumentType, requirement.Detail)
+				}
+			case KindStatus:
+				recommendation.Type = "status"
+				recommendation.Suggestion = fmt.Sprintf("Move the %s document to %s (%s)", requirement.DocumentType, requirements.MinStatus, requirement.Detail)
+			case KindChecklist:
+				recommendation.Type = "checklist"
+				recommendation.Suggestion = fmt.Sprintf("Complete the %s checklist of the %s document (%s)", requirements.Checklist, requirement.DocumentType, requirement.Detail)
+			case KindDocumentCount:
+				recommendation.Type = "document_count"
+				recommendation.Suggestion = fmt.Sprintf("Add documents to reach %s (%s)", requirement.Description, requirement.Detail)
+			}
+			result = append(result, recommendation)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return priorityRank(result[i].Priority) < priorityRank(result[j].Priority)
+	})
+	return result
+}
+
+// documentsByType groups the non-deprecated documents of an archive by type
+func documentsByType(arch *archive.BSpecArchive) map[string][]archive.BSpecDocument {
+	byType := make(map[string][]archive.BSpecDocument)
+	for _, doc := range arch.Documents {
+		if normalizeStatus(doc.Status) == bspec.DocumentStatusDeprecated {
+			continue
+		}
+		docType := strings.ToUpper(doc.Type)
+		byType[docType] = append(byType[docType], doc)
+	}
+	for _, docs := range byType {
+		sort.Slice(docs, func(i, j int) bool { return docs[i].ID < docs[j].ID })
+	}
+	return byType
+}
+
+// domainCoverage counts the documents of each domain
+func domainCoverage(arch *archive.BSpecArchive) map[string]DomainCoverage {
+	counts := make(map[string]int)
+	for _, node := range graph.Build(arch, graph.Options{}).Nodes {
+		if !node.Dangling && normalizeStatus(node.Status) != bspec.DocumentStatusDeprecated {
+			counts[node.Domain]++
+		}
+	}
+
+	coverage := make(map[string]DomainCoverage)
+	for _, info := range bspec.Domains() {
+		count := counts[string(info.Domain)]
+		label := "none"
+		switch {
+		case count >= 4:
+			label = "excellent"
+		case count >= 2:
+			label = "good"
+		case count == 1:
+			label = "minimal"
+		}
+		coverage[string(info.Domain)] = DomainCoverage{Documents: count, Coverage: label}
+	}
+	return coverage
+}
+
+func normalizeStatus(status string) bspec.DocumentStatus {
+	return bspec.DocumentStatus(titleCase(status))
+}
+
+// LevelRank returns the position of a level from 1 (bronze) to 3 (gold), or 0 for none
+func LevelRank(level string) int {
+	for i, requirements := range levels {
+		if string(requirements.Level) == level {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func priorityRank(priority string) int {
+	switch priority {
+	case "high":
+		return 0
+	case "medium":
+		return 1
+	}
+	return 2
+}
+
+func titleCase(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,192 @@
+package conformance
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+const checkedBronze = "## Quality Standards\n\n### Bronze Level (Minimum Viable)\n- [x] Core components\n- [X] Metadata completed\n\n### Silver Level (Investment Ready)\n- [ ] Governance\n"
+
+// bronzeArchive returns an archive that meets the Bronze level
+func bronzeArchive() *archive.BSpecArchive {
+	arch := &archive.BSpecArchive{
+		Manifest:  archive.Manifest{ConformanceLevel: "bronze", IndustryProfile: "software-saas"},
+		Documents: make(map[string]archive.BSpecDocument),
+	}
+	for _, required := range levels[0].Documents {
+		for i := 0; i < required.Count; i++ {
+			id := required.Type() + "-main"
+			if i > 0 {
+				id = fmt.Sprintf("%s-%d", id, i+1)
+			}
+			arch.Documents[id+".md"] = archive.BSpecDocument{ID: id, Type: required.Type(), Status: "Draft", Content: checkedBronze}
+		}
+	}
+	return arch
+}
+
+func TestEvaluateBronze(t *testing.T) {
+	report, err := Evaluate(bronzeArchive(), Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if report.CurrentLevel != "bronze" || !report.Met() {
+		t.Errorf("Expected bronze to be met, got current level %s", report.CurrentLevel)
+	}
+	if report.Assessment.Bronze.Status != StatusAchieved || report.Assessment.Bronze.Score != 1 {
+		t.Errorf("Unexpected bronze assessment: %+v", report.Assessment.Bronze)
+	}
+	if report.Assessment.Silver.Status != StatusInProgress {
+		t.Errorf("Expected silver to be in progress, got %s", report.Assessment.Silver.Status)
+	}
+	if report.IndustryProfile != "software-saas" {
+		t.Errorf("Expected profile from manifest, got %s", report.IndustryProfile)
+	}
+
+	missing := strings.Join(report.MissingForGold, ",")
+	for _, docType := range []string{"MKT", "API", "SUP", "ORG", "RND"} {
+		if !strings.Contains(missing, docType) {
+			t.Errorf("Expected %s in missing_for_gold, got %s", docType, missing)
+		}
+	}
+	if strings.Contains(missing, "MSN") {
+		t.Error("Expected present documents not to be missing")
+	}
+
+	if report.DomainCoverage["strategic"].Documents == 0 {
+		t.Errorf("Expected strategic domain coverage, got %+v", report.DomainCoverage["strategic"])
+	}
+}
+
+func TestEvaluateTargetNotMet(t *testing.T) {
+	arch := bronzeArchive()
+	// An unchecked item and a deprecated document break Bronze
+	arch.Documents["MSN-main.md"] = archive.BSpecDocument{ID: "MSN-main", Type: "MSN", Status: "Draft",
+		Content: "### Bronze Level\n- [x] One\n- [ ] Two\n"}
+	arch.Documents["RSK-main.md"] = archive.BSpecDocument{ID: "RSK-main", Type: "RSK", Status: "Deprecated", Content: checkedBronze}
+
+	report, err := Evaluate(arch, Options{Level: bspec.ConformanceLevelSilver})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if report.Met() || report.CurrentLevel != LevelNone || report.TargetLevel != "silver" {
+		t.Errorf("Expected silver target not to be met, got current %s", report.CurrentLevel)
+	}
+
+	var checklist *Requirement
+	for i, requirement := range report.Assessment.Bronze.Requirements {
+		if requirement.Kind == KindChecklist && requirement.DocumentType == "MSN" {
+			checklist = &report.Assessment.Bronze.Requirements[i]
+		}
+	}
+	if checklist == nil || checklist.Met || checklist.Detail != "MSN-main: 1 of 2 items checked" {
+		t.Errorf("Unexpected MSN checklist requirement: %+v", checklist)
+	}
+
+	if len(report.Recommendations) == 0 || report.Recommendations[0].Priority != "high" {
+		t.Fatalf("Expected high priority recommendations first, got %+v", report.Recommendations)
+	}
+	found := false
+	for _, recommendation := range report.Recommendations {
+		if recommendation.Type == "missing_document" && recommendation.DocumentType == "RSK" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected a recommendation to create the RSK document")
+	}
+}
+
+func TestEvaluateInvalidOptions(t *testing.T) {
+	if _, err := Evaluate(bronzeArchive(), Options{Level: "platinum"}); err == nil {
+		t.Error("Expected error for unknown level")
+	}
+	if _, err := Evaluate(bronzeArchive(), Options{Profile: "retail"}); err == nil {
+		t.Error("Expected error for unknown profile")
+	}
+}
+
+func TestRequiredTypes(t *testing.T) {
+	bronze := RequiredTypes(bspec.ConformanceLevelBronze, bspec.IndustryProfileNonprofit)
+	if len(bronze) != 10 || strings.Contains(strings.Join(bronze, ","), "PUR") {
+		t.Errorf("Expected profile documents to start at silver, got %v", bronze)
+	}
+
+	gold := RequiredTypes(bspec.ConformanceLevelGold, bspec.IndustryProfileNonprofit)
+	// MET is both a silver and a nonprofit document but is only required once
+	if len(gold) != 10+10+3+6 {
+		t.Errorf("Expected 29 gold document types, got %d: %v", len(gold), gold)
+	}
+
+	if level, ok := RequiredLevel("SVC", bspec.IndustryProfileSoftwareSaas); !ok || level != bspec.ConformanceLevelBronze {
+		t.Errorf("Expected SVC to count towards bronze as an alternative to PRD, got %s", level)
+	}
+}
+
+func TestBronzeRequirements(t *testing.T) {
+	// Conformance Levels in the README: strategic core (MSN, VSN, VAL),
+	// 2+ PER with JTB, 1+ PRD/SVC, REV and CST, 2+ RSK with MIT, 12+ documents
+	bronze, _ := LookupLevel(bspec.ConformanceLevelBronze)
+	var got []string
+	documents := 0
+	for _, required := range bronze.Documents {
+		got = append(got, fmt.Sprintf("%d %s", required.Count, required))
+		documents += required.Count
+	}
+	expected := "1 MSN, 1 VSN, 1 VAL, 2 PER, 1 JTB, 1 PRD/SVC, 1 REV, 1 CST, 2 RSK, 1 MIT"
+	if strings.Join(got, ", ") != expected {
+		t.Errorf("Expected bronze documents %s, got %s", expected, strings.Join(got, ", "))
+	}
+	if bronze.MinDocuments != 12 || documents != bronze.MinDocuments {
+		t.Errorf("Expected the 12 bronze documents to meet the minimum, got %d of %d", documents, bronze.MinDocuments)
+	}
+
+	silver, _ := LookupLevel(bspec.ConformanceLevelSilver)
+	gold, _ := LookupLevel(bspec.ConformanceLevelGold)
+	if silver.MinDocuments != 25 || gold.MinDocuments != 45 {
+		t.Errorf("Expected 25 silver and 45 gold documents, got %d and %d", silver.MinDocuments, gold.MinDocuments)
+	}
+}
+
+func TestEvaluateAlternativesAndCounts(t *testing.T) {
+	arch := bronzeArchive()
+	// SVC stands in for PRD, but one PER is not enough
+	delete(arch.Documents, "PRD-main.md")
+	delete(arch.Documents, "PER-main-2.md")
+	arch.Documents["SVC-main.md"] = archive.BSpecDocument{ID: "SVC-main", Type: "SVC", Status: "Draft", Content: checkedBronze}
+
+	report, err := Evaluate(arch, Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Join(report.Assessment.Bronze.Missing, ",") != "PER" {
+		t.Errorf("Expected only PER to be missing, got %v", report.Assessment.Bronze.Missing)
+	}
+	for _, requirement := range report.Assessment.Bronze.Requirements {
+		if requirement.Kind == KindDocument && requirement.DocumentType == "PER" && (requirement.Met || requirement.Detail != "1 of 2") {
+			t.Errorf("Unexpected PER requirement: %+v", requirement)
+		}
+	}
+	if report.Recommendations[0].Suggestion != "Create more PER documents (1 of 2)" {
+		t.Errorf("Unexpected recommendation: %+v", report.Recommendations[0])
+	}
+}
+
+func TestBestChecklist(t *testing.T) {
+	content := "## Quality Standards\n\n### Silver Level\n- [x] A\n* [ ] B\n  - [x] C\n\n### Gold Level\n- [ ] D\n"
+	docs := []archive.BSpecDocument{{ID: "RSK-a", Content: content}, {ID: "RSK-b", Content: "### Silver Level\n- [ ] A\n"}}
+
+	met, detail := bestChecklist(docs, bspec.ConformanceLevelSilver, "Silver Level")
+	if met || detail != "RSK-a: 2 of 3 items checked" {
+		t.Errorf("Expected 2 of 3 items of RSK-a, got %v %q", met, detail)
+	}
+	if met, detail := bestChecklist(docs, bspec.ConformanceLevelBronze, "Bronze Level"); met || detail != "no Bronze Level checklist" {
+		t.Errorf("Expected missing checklist not to be found, got %v %q", met, detail)
+	}
+}
@@ -0,0 +1,218 @@
+package conformance
+
+import (
+	"strings"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+)
+
+// LevelRequirements declares what an archive must contain to reach a level.
+// Requirements are cumulative: a level also requires the documents of the
+// levels below it, at its own status and checklist bar.
+type LevelRequirements struct {
+	Level        bspec.ConformanceLevel
+	DisplayName  string
+	Documents    []TypeRequirement    // Documents introduced at this level
+	MinDocuments int                  // Minimum number of documents in the archive
+	MinStatus    bspec.DocumentStatus // Lowest status the required documents may have
+	Checklist    string               // Quality Standards checklist that must be complete
+	Profiles     bool                 // Whether industry profile documents are required
+}
+
+// TypeRequirement is a number of documents of a type, or of the types that
+// may stand in for it
+type TypeRequirement struct {
+	Types []string // The type first, then its alternatives, e.g. PRD or SVC
+	Count int      // Minimum number of documents
+}
+
+// levels lists the conformance levels from lowest to highest, as defined in
+// the Conformance Levels section of the README
+var levels = []LevelRequirements{
+	{
+		Level:       bspec.ConformanceLevelBronze,
+		DisplayName: "Bronze Level (Minimum Viable)",
+		Documents: []TypeRequirement{
+			// Strategic core
+			{Types: []string{"MSN"}, Count: 1},
+			{Types: []string{"VSN"}, Count: 1},
+			{Types: []string{"VAL"}, Count: 1},
+			// Customer understanding: 2+ PER with JTB
+			{Types: []string{"PER"}, Count: 2},
+			{Types: []string{"JTB"}, Count: 1},
+			// Solution definition: 1+ PRD/SVC
+			{Types: []string{"PRD", "SVC"}, Count: 1},
+			// Business model
+			{Types: []string{"REV"}, Count: 1},
+			{Types: []string{"CST"}, Count: 1},
+			// Risk awareness: 2+ RSK with MIT
+			{Types: []string{"RSK"}, Count: 2},
+			{Types: []string{"MIT"}, Count: 1},
+		},
+		MinDocuments: 12,
+		MinStatus:    bspec.DocumentStatusDraft,
+		Checklist:    "Bronze Level",
+	},
+	{
+		Level:       bspec.ConformanceLevelSilver,
+		DisplayName: "Silver Level (Investment Ready)",
+		// The README names phrases, not types. Each maps to the types the
+		// README's type list names for it, with the spec/v1 code where the
+		// README uses an older one.
+		Documents: requireTypes(
+			// "strategic depth": STR Strategy, OBJ Objectives
+			"STR", "OBJ",
+			// "market analysis": MKT Market Definition, SEG Market Segments,
+			// CMP Competitive Analysis
+			"MKT", "SEG", "CMP",
+			// "Complete business model": CHN Channels, PRC Pricing (PRI in
+			// spec/v1)
+			"CHN", "PRI",
+			// "financial foundation": FIN Financial Model, BUD Budget
+			"FIN", "BUD",
+			// "key metrics": MET Metrics. For "Growth strategy" the README
+			// names only GTM and GRW, which spec/v1 does not define.
+			"MET",
+		),
+		MinDocuments: 25,
+		MinStatus:    bspec.DocumentStatusReview,
+		Checklist:    "Silver Level",
+		Profiles:     true,
+	},
+	{
+		Level:       bspec.ConformanceLevelGold,
+		DisplayName: "Gold Level (Operational Excellence)",
+		Documents: requireTypes(
+			// "operational processes": PRC Processes (PRO in spec/v1)
+			"PRO",
+			// "Organization design": ORG Organization
+			"ORG",
+			// "technology architecture": ARC Architecture
+			"ARC",
+			// "Compliance framework": CMP Compliance (COM in spec/v1)
+			"COM",
+			// "innovation pipeline": RND Research, EXP Experiments
+			"RND", "EXP",
+		),
+		MinDocuments: 45,
+		MinStatus:    bspec.DocumentStatusAccepted,
+		Checklist:    "Gold Level",
+		Profiles:     true,
+	},
+}
+
+// profileDocuments lists the additional document types of each industry
+// profile. The README's PRC and GVN are the legacy codes of PRO and GOV.
+var profileDocuments = map[bspec.IndustryProfile][]string{
+	bspec.IndustryProfileSoftwareSaas:    {"ARC", "API", "SEC", "SUP"},
+	bspec.IndustryProfilePhysicalProduct: {"INF", "QUA", "VND", "REG"},
+	bspec.IndustryProfileServiceBusiness: {"PRO", "SLA", "SKI", "QUA"},
+	bspec.IndustryProfileNonprofit:       {"PUR", "STA", "MET", "GOV"},
+}
+
+// requireTypes requires one document of each type
+func requireTypes(types ...string) []TypeRequirement {
+	requirements := make([]TypeRequirement, len(types))
+	for i, docType := range types {
+		requirements[i] = TypeRequirement{Types: []string{docType}, Count: 1}
+	}
+	return requirements
+}
+
+// Type returns the type to create when the requirement is not met
+func (r TypeRequirement) Type() string {
+	return r.Types[0]
+}
+
+// String returns the types of the requirement, e.g. "PRD/SVC"
+func (r TypeRequirement) String() string {
+	return strings.Join(r.Types, "/")
+}
+
+// Matches reports whether documents of a type count towards the requirement
+func (r TypeRequirement) Matches(docType string) bool {
+	for _, t := range r.Types {
+		if t == docType {
+			return true
+		}
+	}
+	return false
+}
+
+// statusRank orders document statuses by maturity; Deprecated documents never count
+var statusRank = map[bspec.DocumentStatus]int{
+	bspec.DocumentStatusDraft:    1,
+	bspec.DocumentStatusReview:   2,
+	bspec.DocumentStatusAccepted: 3,
+}
+
+// Levels returns the conformance levels from lowest to highest
+func Levels() []LevelRequirements {
+	return levels
+}
+
+// LookupLevel returns the requirements of a conformance level
+func LookupLevel(level bspec.ConformanceLevel) (LevelRequirements, bool) {
+	for _, requirements := range levels {
+		if requirements.Level == level {
+			return requirements, true
+		}
+	}
+	return LevelRequirements{}, false
+}
+
+// ProfileDocuments returns the additional document types of an industry profile
+func ProfileDocuments(profile bspec.IndustryProfile) ([]string, bool) {
+	types, ok := profileDocuments[profile]
+	return types, ok
+}
+
+// Requirements returns the documents a level requires for a profile,
+// including those of the levels below it, without duplicates
+func Requirements(level bspec.ConformanceLevel, profile bspec.IndustryProfile) []TypeRequirement {
+	seen := make(map[string]bool)
+	var result []TypeRequirement
+	add := func(requirements []TypeRequirement) {
+		for _, requirement := range requirements {
+			if !seen[requirement.String()] {
+				seen[requirement.String()] = true
+				result = append(result, requirement)
+			}
+		}
+	}
+
+	for _, requirements := range levels {
+		add(requirements.Documents)
+		if requirements.Profiles {
+			add(requireTypes(profileDocuments[profile]...))
+		}
+		if requirements.Level == level {
+			break
+		}
+	}
+	return result
+}
+
+// RequiredTypes returns the document types a level requires for a profile,
+// including those of the levels below it, without duplicates. Of types with
+// alternatives only the first is returned.
+func RequiredTypes(level bspec.ConformanceLevel, profile bspec.IndustryProfile) []string {
+	var types []string
+	for _, requirement := range Requirements(level, profile) {
+		types = append(types, requirement.Type())
+	}
+	return types
+}
+
+// RequiredLevel returns the lowest level that requires a document type for a
+// profile, directly or as an alternative
+func RequiredLevel(docType string, profile bspec.IndustryProfile) (bspec.ConformanceLevel, bool) {
+	for _, requirements := range levels {
+		for _, requirement := range Requirements(requirements.Level, profile) {
+			if requirement.Matches(docType) {
+				return requirements.Level, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,340 @@
+package docfile
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DateLayout is the date format of frontmatter dates
+const DateLayout = "2006-01-02"
+
+// File is a markdown document whose YAML frontmatter is edited in place.
+// Edits keep the order, comments and style of the fields that are not changed.
+type File struct {
+	Path        string
+	frontmatter *yaml.Node // Mapping node
+	body        string     // Content after the closing ---
+}
+
+// Read loads a document from disk
+func Read(path string) (*File, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document: %w", err)
+	}
+	file, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	file.Path = path
+	return file, nil
+}
+
+// Parse splits a document into its frontmatter and body
+func Parse(content []byte) (*File, error) {
+	contentStr := string(content)
+	if !strings.HasPrefix(contentStr, "---\n") {
+		return nil, fmt.Errorf("document missing YAML frontmatter")
+	}
+	parts := strings.SplitN(contentStr[4:], "\n---\n", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid frontmatter format")
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(parts[0]), &node); err != nil {
+		return nil, fmt.Errorf("invalid YAML frontmatter: %w", err)
+	}
+	frontmatter := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	if len(node.Content) > 0 {
+		frontmatter = node.Content[0]
+	}
+	if frontmatter.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("frontmatter must be a mapping")
+	}
+	return &File{frontmatter: frontmatter, body: parts[1]}, nil
+}
+
+// New creates a document whose frontmatter is a value encoded as YAML, such
+// as a bspec.BaseBSpecDocument
+func New(frontmatter interface{}, body string) (*File, error) {
+	node := &yaml.Node{}
+	if err := node.Encode(frontmatter); err != nil {
+		return nil, fmt.Errorf("failed to encode frontmatter: %w", err)
+	}
+	if node.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("frontmatter must be a mapping")
+	}
+	unquoteDates(node)
+	return &File{frontmatter: node, body: body}, nil
+}
+
+// Body returns the markdown content after the frontmatter
+func (f *File) Body() string {
+	return f.body
+}
+
+// SetBody replaces the markdown content after the frontmatter
+func (f *File) SetBody(body string) {
+	f.body = body
+}
+
+// Has returns true if the frontmatter has a field
+func (f *File) Has(key string) bool {
+	return f.value(key) != nil
+}
+
+// Get returns a scalar field, or "" if it is missing or not a scalar
+func (f *File) Get(key string) string {
+	if value := f.value(key); value != nil && value.Kind == yaml.ScalarNode {
+		return value.Value
+	}
+	return ""
+}
+
+// List returns the scalar items of a list field; a scalar field is a list of one
+func (f *File) List(key string) []string {
+	value := f.value(key)
+	if value == nil {
+		return nil
+	}
+	switch value.Kind {
+	case yaml.SequenceNode:
+		var items []string
+		for _, item := range value.Content {
+			if item.Kind == yaml.ScalarNode && item.Value != "" {
+				items = append(items, item.Value)
+			}
+		}
+		return items
+	case yaml.ScalarNode:
+		if value.Value != "" && value.Tag != "!!null" {
+			return []string{value.Value}
+		}
+	}
+	return nil
+}
+
+// Set sets a scalar field, adding it at the end if it is missing. A quoted
+// value stays quoted.
+func (f *File) Set(key, value string) {
+	node := scalar(value)
+	if existing := f.value(key); existing != nil && existing.Kind == yaml.ScalarNode {
+		node.HeadComment, node.LineComment, node.FootComment = existing.HeadComment, existing.LineComment, existing.FootComment
+		if existing.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle) != 0 {
+			node.Style = existing.Style
+		}
+	}
+	f.setNode(key, node)
+}
+
+// SetList sets a list field, keeping the style of an existing list
+func (f *File) SetList(key string, values []string) {
+	node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq", Style: yaml.FlowStyle}
+	if existing := f.value(key); existing != nil && existing.Kind == yaml.SequenceNode {
+		node.Style = existing.Style
+		node.HeadComment, node.LineComment, node.FootComment = existing.HeadComment, existing.LineComment, existing.FootComment
+	}
+	for _, value := range values {
+		node.Content = append(node.Content, scalar(value))
+	}
+	f.setNode(key, node)
+}
+
+// Append adds a value to a list field, creating the list if it is missing.
+// Values other than strings are encoded as YAML, e.g. changelog entries.
+func (f *File) Append(key string, value interface{}) error {
+	item := &yaml.Node{}
+	if s, ok := value.(string); ok {
+		item = scalar(s)
+	} else if err := item.Encode(value); err != nil {
+		return fmt.Errorf("failed to encode %s: %w", key, err)
+	} else {
+		unquoteDates(item)
+	}
+
+	list := f.value(key)
+	if list == nil || list.Kind != yaml.SequenceNode {
+		existing := f.List(key)
+		list = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		if item.Kind == yaml.ScalarNode {
+			list.Style = yaml.FlowStyle
+		}
+		for _, value := range existing {
+			list.Content = append(list.Content, scalar(value))
+		}
+		f.setNode(key, list)
+	}
+	list.Content = append(list.Content, item)
+	return nil
+}
+
+// Keys returns the frontmatter fields in order
+func (f *File) Keys() []string {
+	keys := make([]string, 0, len(f.frontmatter.Content)/2)
+	for i := 0; i+1 < len(f.frontmatter.Content); i += 2 {
+		keys = append(keys, f.frontmatter.Content[i].Value)
+	}
+	return keys
+}
+
+// Delete removes a field
+func (f *File) Delete(key string) {
+	for i := 0; i+1 < len(f.frontmatter.Content); i += 2 {
+		if f.frontmatter.Content[i].Value == key {
+			f.frontmatter.Content = append(f.frontmatter.Content[:i], f.frontmatter.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+// Touch sets the updated field to a date
+func (f *File) Touch(now time.Time) {
+	f.Set("updated", now.Format(DateLayout))
+}
+
+// Decode decodes the frontmatter into a value, such as a bspec.BaseBSpecDocument
+func (f *File) Decode(v interface{}) error {
+	if err := f.frontmatter.Decode(v); err != nil {
+		return fmt.Errorf("failed to decode frontmatter: %w", err)
+	}
+	return nil
+}
+
+// Bytes renders the document
+func (f *File) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	if len(f.frontmatter.Content) > 0 {
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(f.frontmatter); err != nil {
+			return nil, fmt.Errorf("failed to encode frontmatter: %w", err)
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, fmt.Errorf("failed to encode frontmatter: %w", err)
+		}
+	}
+	buf.WriteString("---\n")
+	buf.WriteString(f.body)
+	return buf.Bytes(), nil
+}
+
+// Write saves the document to its path
+func (f *File) Write() error {
+	return f.WriteTo(f.Path)
+}
+
+// WriteTo saves the document to a path
+func (f *File) WriteTo(path string) error {
+	content, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// Find returns the path of the document with an ID in an archive directory
+func Find(archiveDir, id string) (string, error) {
+	documentsDir := filepath.Join(archiveDir, "documents")
+	if _, err := os.Stat(documentsDir); err != nil {
+		return "", fmt.Errorf("no documents directory in %s", archiveDir)
+	}
+
+	var found []string
+	err := filepath.Walk(documentsDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".md") {
+			return nil
+		}
+		file, err := Read(path)
+		if err != nil {
+			// Documents that cannot be parsed cannot be matched
+			return nil
+		}
+		if file.Get("id") == id {
+			found = append(found, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to search documents: %w", err)
+	}
+
+	switch len(found) {
+	case 0:
+		return "", fmt.Errorf("document not found: %s", id)
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("document ID %s is used by %d files: %s", id, len(found), strings.Join(found, ", "))
+	}
+}
+
+// value returns the value node of a field
+func (f *File) value(key string) *yaml.Node {
+	for i := 0; i+1 < len(f.frontmatter.Content); i += 2 {
+		if f.frontmatter.Content[i].Value == key {
+			return f.frontmatter.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setNode replaces the value node of a field, or appends the field
+func (f *File) setNode(key string, node *yaml.Node) {
+	for i := 0; i+1 < len(f.frontmatter.Content); i += 2 {
+		if f.frontmatter.Content[i].Value == key {
+			f.frontmatter.Content[i+1] = node
+			return
+		}
+	}
+	f.frontmatter.Content = append(f.frontmatter.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, node)
+}
+
+// scalar builds a plain string node, quoting values YAML would read as
+// another type; dates stay unquoted
+func scalar(value string) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.ScalarNode, Value: value}
+	var decoded interface{}
+	if err := yaml.Unmarshal([]byte(value), &decoded); err != nil || strings.ContainsAny(value, "\n") {
+		node.Style = yaml.DoubleQuotedStyle
+		return node
+	}
+	switch decoded.(type) {
+	case string, time.Time:
+		if decoded == value || isDate(value) {
+			return node
+		}
+	}
+	node.Tag = "!!str"
+	node.Style = yaml.DoubleQuotedStyle
+	return node
+}
+
+// unquoteDates writes date strings of an encoded value unquoted, like
+// hand-written frontmatter
+func unquoteDates(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode && isDate(node.Value) {
+		node.Tag, node.Style = "", 0
+	}
+	for _, child := range node.Content {
+		unquoteDates(child)
+	}
+}
+
+func isDate(value string) bool {
+	_, err := time.Parse(DateLayout, value)
+	return err == nil
+}
@@ -0,0 +1,133 @@
+package docfile
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+)
+
+const testDocument = `---
+id: STR-growth
+title: Growth Strategy
+type: STR
+# Lifecycle
+status: Draft # set by promote
+version: 1.0.0
+owner: alice
+updated: 2024-01-01
+tags: [growth, "2025"]
+depends_on:
+  - MSN-mission
+---
+
+# Growth Strategy
+
+Body text.
+`
+
+func TestParseAndEdit(t *testing.T) {
+	file, err := Parse([]byte(testDocument))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if file.Get("status") != "Draft" || file.Get("updated") != "2024-01-01" {
+		t.Errorf("Unexpected fields: status %q, updated %q", file.Get("status"), file.Get("updated"))
+	}
+	if tags := file.List("tags"); len(tags) != 2 || tags[1] != "2025" {
+		t.Errorf("Unexpected tags: %v", tags)
+	}
+	if deps := file.List("depends_on"); len(deps) != 1 || deps[0] != "MSN-mission" {
+		t.Errorf("Unexpected depends_on: %v", deps)
+	}
+
+	file.Set("status", "Review")
+	file.Touch(time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC))
+	file.Set("priority", "1")
+	file.Append("reviewers", "bob")
+	file.Append("depends_on", "VSN-vision")
+	file.Append("changelog", bspec.ChangelogEntry{Version: "1.0.0", Date: "2025-03-04", Author: "alice", Changes: "Initial"})
+	file.Delete("type")
+
+	content, err := file.Bytes()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	output := string(content)
+	for _, expected := range []string{
+		"# Lifecycle\nstatus: Review # set by promote\n",
+		"updated: 2025-03-04\n",
+		"tags: [growth, \"2025\"]\n",
+		"depends_on:\n  - MSN-mission\n  - VSN-vision\n",
+		"priority: \"1\"\n",
+		"reviewers: [bob]\n",
+		"changelog:\n  - version: 1.0.0\n    date: 2025-03-04\n",
+		"---\n\n# Growth Strategy\n\nBody text.\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %q in output:\n%s", expected, output)
+		}
+	}
+	if strings.Contains(output, "type: STR") {
+		t.Error("Expected type to be deleted")
+	}
+
+	var doc bspec.BaseBSpecDocument
+	if err := file.Decode(&doc); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if doc.Status != bspec.DocumentStatusReview || len(doc.Reviewers) != 1 || !doc.HasChangelogEntry("1.0.0") {
+		t.Errorf("Unexpected decoded document: %+v", doc)
+	}
+}
+
+func TestDecodeTypedDocument(t *testing.T) {
+	file, err := Parse([]byte("---\nid: RSK-churn\ntitle: Churn\ntype: RSK\nowner: alice\nlikelihood: high\nimpact: medium\nrelated: [MIT-retention]\n---\n"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var doc bspec.RSKDocument
+	if err := file.Decode(&doc); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if doc.ID != "RSK-churn" || len(doc.Related) != 1 {
+		t.Errorf("Expected the base fields to be decoded, got %+v", doc.BaseBSpecDocument)
+	}
+	if doc.Score() != 12 {
+		t.Errorf("Expected score 12, got %d", doc.Score())
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, content := range []string{"# No frontmatter\n", "---\nid: x\n", "---\n- a\n---\n"} {
+		if _, err := Parse([]byte(content)); err == nil {
+			t.Errorf("Expected error for %q", content)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	dir := t.TempDir()
+	docsDir := filepath.Join(dir, "documents", "01-strategic")
+	os.MkdirAll(docsDir, 0755)
+	os.WriteFile(filepath.Join(docsDir, "STR-growth.md"), []byte(testDocument), 0644)
+	os.WriteFile(filepath.Join(docsDir, "broken.md"), []byte("no frontmatter"), 0644)
+
+	path, err := Find(dir, "STR-growth")
+	if err != nil || path != filepath.Join(docsDir, "STR-growth.md") {
+		t.Errorf("Expected STR-growth path, got %q, %v", path, err)
+	}
+	if _, err := Find(dir, "STR-missing"); err == nil || !strings.Contains(err.Error(), "document not found") {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+
+	os.WriteFile(filepath.Join(docsDir, "STR-copy.md"), []byte(testDocument), 0644)
+	if _, err := Find(dir, "STR-growth"); err == nil || !strings.Contains(err.Error(), "used by 2 files") {
+		t.Errorf("Expected duplicate ID error, got %v", err)
+	}
+}
@@ -0,0 +1,465 @@
+package export
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"html"
+	"html/template"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/graph"
+)
+
+//go:embed templates/*.html static/*
+var siteFS embed.FS
+
+// HTMLOptions controls the static HTML site export
+type HTMLOptions struct {
+	Title string // Site title; defaults to the manifest name
+}
+
+// HTMLResult summarizes a static HTML site export
+type HTMLResult struct {
+	OutputDir string `json:"output_dir"`
+	Pages     int    `json:"pages"`
+	Assets    int    `json:"assets"`
+}
+
+// siteDocument is a document as presented on the site
+type siteDocument struct {
+	ID     string
+	Title  string
+	Type   string
+	Status string
+	Owner  string
+	Domain string
+	Href   string
+	Emoji  string // Emoji of the domain
+}
+
+// siteDomain is a group of documents on the index page
+type siteDomain struct {
+	Name      string
+	Emoji     string
+	Documents []siteDocument
+}
+
+// siteField is a frontmatter field shown in a document's metadata card
+type siteField struct {
+	Key   string
+	Value string
+}
+
+// siteLink is a hyperlinked reference to another document
+type siteLink struct {
+	ID      string
+	Title   string
+	Href    string
+	Missing bool
+}
+
+// siteRelation groups references of one relationship type
+type siteRelation struct {
+	Type  string
+	Links []siteLink
+}
+
+// searchEntry is an entry in the client-side search index
+type searchEntry struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Type   string `json:"type"`
+	Domain string `json:"domain"`
+	Status string `json:"status"`
+	Owner  string `json:"owner"`
+	Href   string `json:"href"`
+	Text   string `json:"text"`
+}
+
+// pageData is passed to every site template
+type pageData struct {
+	SiteTitle   string
+	Root        string
+	Generated   string
+	Manifest    archive.Manifest
+	Domains     []siteDomain
+	StatusCount []siteField
+	Total       int
+
+	Document siteDocument
+	Metadata []siteField
+	Content  template.HTML
+	Outgoing []siteRelation
+	Incoming []siteRelation
+}
+
+var (
+	tagPattern        = regexp.MustCompile(`<[^>]+>`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+	pageNamePattern   = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+)
+
+// siteFuncs are the functions available to site templates
+var siteFuncs = template.FuncMap{
+	"lower":      strings.ToLower,
+	"slug":       Slugify,
+	"domainName": domainName,
+}
+
+// searchTextLength caps the amount of document text stored in the search index
+const searchTextLength = 4000
+
+// ExportHTML writes a self-contained static HTML site for an archive to outDir.
+// The site has an index grouped by domain, one page per document, copies of
+// the archive assets and a client-side search index.
+func ExportHTML(arch *archive.BSpecArchive, outDir string, opts HTMLOptions) (*HTMLResult, error) {
+	tmpl, err := template.New("site").Funcs(siteFuncs).ParseFS(siteFS, "templates/*.html")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse site templates: %w", err)
+	}
+
+	siteTitle := opts.Title
+	if siteTitle == "" {
+		siteTitle = arch.Manifest.Name
+	}
+	if siteTitle == "" {
+		siteTitle = "BSpec Specification"
+	}
+
+	g := graph.Build(arch, graph.Options{})
+	base := pageData{
+		SiteTitle: siteTitle,
+		Generated: time.Now().UTC().Format("2006-01-02 15:04 MST"),
+		Manifest:  arch.Manifest,
+	}
+
+	// Map document IDs and file names to their pages
+	documents := make(map[string]siteDocument)
+	aliases := make(map[string]string)
+	for _, node := range g.Nodes {
+		if node.Dangling {
+			continue
+		}
+		doc := siteDocument{
+			ID:     node.ID,
+			Title:  node.Title,
+			Type:   node.Type,
+			Status: node.Status,
+			Domain: node.Domain,
+			Href:   "documents/" + pageName(node.ID),
+			Emoji:  domainEmoji(node.Domain),
+		}
+		if source, ok := arch.Documents[node.Path]; ok {
+			doc.Owner = source.Owner
+		}
+		documents[node.ID] = doc
+		aliases[strings.TrimSuffix(path.Base(filepath.ToSlash(node.Path)), ".md")] = node.ID
+		aliases[node.ID] = node.ID
+	}
+
+	if err := os.MkdirAll(filepath.Join(outDir, "documents"), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	result := &HTMLResult{OutputDir: outDir}
+	var search []searchEntry
+
+	// Document pages
+	for _, node := range g.Nodes {
+		if node.Dangling {
+			continue
+		}
+		source := arch.Documents[node.Path]
+		doc := documents[node.ID]
+
+		renderer := &MarkdownRenderer{ResolveLink: func(target string) string {
+			return resolveSiteLink(target, "../", aliases)
+		}}
+		content := renderer.Render(source.Content)
+
+		data := base
+		data.Root = "../"
+		data.Document = doc
+		data.Metadata = metadataFields(source)
+		data.Content = template.HTML(content)
+		data.Outgoing, data.Incoming = relations(g, node.ID, documents)
+
+		if err := renderPage(tmpl, "document.html", filepath.Join(outDir, filepath.FromSlash(doc.Href)), data); err != nil {
+			return nil, err
+		}
+		result.Pages++
+
+		search = append(search, searchEntry{
+			ID:     doc.ID,
+			Title:  doc.Title,
+			Type:   doc.Type,
+			Domain: doc.Domain,
+			Status: doc.Status,
+			Owner:  doc.Owner,
+			Href:   doc.Href,
+			Text:   plainText(content, searchTextLength),
+		})
+	}
+
+	// Index page
+	data := base
+	data.Domains = domainSections(documents)
+	data.StatusCount = statusCounts(documents)
+	data.Total = len(documents)
+	if err := renderPage(tmpl, "index.html", filepath.Join(outDir, "index.html"), data); err != nil {
+		return nil, err
+	}
+	result.Pages++
+
+	// Search index is a script so that search also works when opened from disk
+	sort.Slice(search, func(i, j int) bool { return search[i].ID < search[j].ID })
+	index, err := json.Marshal(search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode search index: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(outDir, "search-index.js"), []byte("window.BSPEC_SEARCH_INDEX = "+string(index)+";\n"), 0644); err != nil {
+		return nil, fmt.Errorf("failed to write search index: %w", err)
+	}
+
+	// Static files
+	for _, name := range []string{"style.css", "search.js"} {
+		content, err := siteFS.ReadFile("static/" + name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		if err := os.WriteFile(filepath.Join(outDir, name), content, 0644); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	// Archive assets
+	for rel, content := range arch.Assets {
+		target := filepath.Join(outDir, "assets", filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create asset directory: %w", err)
+		}
+		if err := os.WriteFile(target, content, 0644); err != nil {
+			return nil, fmt.Errorf("failed to write asset %s: %w", rel, err)
+		}
+		result.Assets++
+	}
+
+	return result, nil
+}
+
+// renderPage executes a site template into a file
+func renderPage(tmpl *template.Template, name, target string, data pageData) error {
+	file, err := os.Create(target)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", target, err)
+	}
+	defer file.Close()
+
+	if err := tmpl.ExecuteTemplate(file, name, data); err != nil {
+		return fmt.Errorf("failed to render %s: %w", target, err)
+	}
+	return nil
+}
+
+// resolveSiteLink points links to archive documents and assets at their
+// location in the exported site; other links are left unchanged
+func resolveSiteLink(target, root string, aliases map[string]string) string {
+	if strings.Contains(target, "://") || strings.HasPrefix(target, "#") || strings.HasPrefix(target, "mailto:") {
+		return target
+	}
+
+	clean, fragment := target, ""
+	if i := strings.Index(clean, "#"); i >= 0 {
+		clean, fragment = clean[:i], clean[i:]
+	}
+
+	// Asset references are relative to the document, so strip leading ../ segments
+	if i := strings.Index(clean, "assets/"); i >= 0 && strings.Trim(clean[:i], "./") == "" {
+		return root + clean[i:] + fragment
+	}
+
+	if strings.HasSuffix(clean, ".md") {
+		if id, ok := aliases[strings.TrimSuffix(path.Base(clean), ".md")]; ok {
+			return root + "documents/" + pageName(id) + fragment
+		}
+	}
+
+	return target
+}
+
+// metadataFields returns the frontmatter of a document for its metadata card
+func metadataFields(doc archive.BSpecDocument) []siteField {
+	var fields []siteField
+	add := func(key, value string) {
+		if value != "" {
+			fields = append(fields, siteField{Key: key, Value: value})
+		}
+	}
+	add("id", doc.ID)
+	add("type", doc.Type)
+	add("status", doc.Status)
+	add("version", doc.Version)
+	add("owner", doc.Owner)
+	add("domain", doc.Domain)
+	add("created", doc.Created)
+	add("updated", doc.Updated)
+
+	keys := make([]string, 0, len(doc.Metadata))
+	for key := range doc.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		add(key, formatValue(doc.Metadata[key]))
+	}
+	return fields
+}
+
+// formatValue renders a frontmatter value as text
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, formatValue(item))
+		}
+		return strings.Join(items, ", ")
+	case map[string]interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// relations returns the outgoing and incoming references of a document
+func relations(g *graph.Graph, id string, documents map[string]siteDocument) (outgoing, incoming []siteRelation) {
+	link := func(target string) siteLink {
+		if doc, ok := documents[target]; ok {
+			return siteLink{ID: doc.ID, Title: doc.Title, Href: "../" + doc.Href}
+		}
+		return siteLink{ID: target, Missing: true}
+	}
+
+	for _, field := range archive.RelationshipFields {
+		var out, in []siteLink
+		for _, edge := range g.Edges {
+			if edge.Type != field {
+				continue
+			}
+			if edge.From == id {
+				out = append(out, link(edge.To))
+			}
+			if edge.To == id {
+				in = append(in, link(edge.From))
+			}
+		}
+		if len(out) > 0 {
+			outgoing = append(outgoing, siteRelation{Type: field, Links: out})
+		}
+		if len(in) > 0 {
+			incoming = append(incoming, siteRelation{Type: field, Links: in})
+		}
+	}
+	return outgoing, incoming
+}
+
+// domainSections groups documents by domain in archive folder order
+func domainSections(documents map[string]siteDocument) []siteDomain {
+	byDomain := make(map[string][]siteDocument)
+	for _, doc := range documents {
+		byDomain[doc.Domain] = append(byDomain[doc.Domain], doc)
+	}
+
+	var sections []siteDomain
+	for _, info := range bspec.Domains() {
+		if docs := byDomain[string(info.Domain)]; len(docs) > 0 {
+			sections = append(sections, siteDomain{Name: info.DisplayName, Emoji: info.Emoji, Documents: sortDocuments(docs)})
+			delete(byDomain, string(info.Domain))
+		}
+	}
+
+	var other []siteDocument
+	for _, docs := range byDomain {
+		other = append(other, docs...)
+	}
+	if len(other) > 0 {
+		sections = append(sections, siteDomain{Name: "Unclassified", Documents: sortDocuments(other)})
+	}
+	return sections
+}
+
+// statusCounts counts documents by status
+func statusCounts(documents map[string]siteDocument) []siteField {
+	counts := make(map[string]int)
+	for _, doc := range documents {
+		status := doc.Status
+		if status == "" {
+			status = "Unknown"
+		}
+		counts[status]++
+	}
+
+	var fields []siteField
+	for status, count := range counts {
+		fields = append(fields, siteField{Key: status, Value: fmt.Sprint(count)})
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Key < fields[j].Key })
+	return fields
+}
+
+// domainEmoji returns the emoji of a domain
+func domainEmoji(domain string) string {
+	info, _ := bspec.LookupDomain(bspec.BusinessDomain(domain))
+	return info.Emoji
+}
+
+// domainName returns the display name of a domain
+func domainName(domain string) string {
+	if info, ok := bspec.LookupDomain(bspec.BusinessDomain(domain)); ok {
+		return info.DisplayName
+	}
+	return "Unclassified"
+}
+
+func sortDocuments(docs []siteDocument) []siteDocument {
+	sort.Slice(docs, func(i, j int) bool {
+		if docs[i].Type != docs[j].Type {
+			return docs[i].Type < docs[j].Type
+		}
+		return docs[i].ID < docs[j].ID
+	})
+	return docs
+}
+
+// pageName returns the file name of a document page
+func pageName(id string) string {
+	return pageNamePattern.ReplaceAllString(id, "-") + ".html"
+}
+
+// plainText strips tags from rendered HTML for the search index
+func plainText(rendered string, limit int) string {
+	text := html.UnescapeString(tagPattern.ReplaceAllString(rendered, " "))
+	text = strings.TrimSpace(whitespacePattern.ReplaceAllString(text, " "))
+	if runes := []rune(text); len(runes) > limit {
+		text = string(runes[:limit])
+	}
+	return text
+}
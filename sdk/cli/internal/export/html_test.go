@@ -0,0 +1,113 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+func testArchive() *archive.BSpecArchive {
+	return &archive.BSpecArchive{
+		Manifest: archive.Manifest{Name: "Acme Spec", ConformanceLevel: "silver"},
+		Documents: map[string]archive.BSpecDocument{
+			"01-strategic/MSN-mission.md": {
+				ID: "MSN-mission", Type: "MSN", Title: "Mission", Status: "Accepted", Owner: "ceo",
+				Content: "# Mission\n\nSee the [strategy](STR-growth.md).\n\n![Map](../../assets/diagrams/map.svg)",
+			},
+			"01-strategic/STR-growth.md": {
+				ID: "STR-growth", Type: "STR", Title: "Growth <Strategy>", Status: "Draft",
+				Metadata: map[string]interface{}{
+					"depends_on": []interface{}{"MSN-mission", "VSN-missing"},
+					"priority":   "High",
+				},
+				Content: "# Growth\n\nGrow revenue through partnerships.",
+			},
+		},
+		Assets: map[string][]byte{
+			"diagrams/map.svg": []byte("<svg></svg>"),
+		},
+	}
+}
+
+func TestExportHTML(t *testing.T) {
+	outDir := t.TempDir()
+	result, err := ExportHTML(testArchive(), outDir, HTMLOptions{})
+	if err != nil {
+		t.Fatalf("ExportHTML failed: %v", err)
+	}
+	if result.Pages != 3 || result.Assets != 1 {
+		t.Errorf("Expected 3 pages and 1 asset, got %+v", result)
+	}
+
+	for _, name := range []string{"index.html", "style.css", "search.js", "search-index.js", "assets/diagrams/map.svg"} {
+		if _, err := os.Stat(filepath.Join(outDir, name)); err != nil {
+			t.Errorf("Expected %s to exist: %v", name, err)
+		}
+	}
+
+	index := readFile(t, filepath.Join(outDir, "index.html"))
+	for _, expected := range []string{"Acme Spec", "level-silver", "🎯 Strategic Foundation", `href="documents/MSN-mission.html"`} {
+		if !strings.Contains(index, expected) {
+			t.Errorf("Expected index to contain %q", expected)
+		}
+	}
+
+	mission := readFile(t, filepath.Join(outDir, "documents", "MSN-mission.html"))
+	for _, expected := range []string{
+		`<a href="../documents/STR-growth.html">strategy</a>`,
+		`<img src="../assets/diagrams/map.svg" alt="Map">`,
+		"Referenced by",
+		`<a href="../documents/STR-growth.html">STR-growth</a>`,
+	} {
+		if !strings.Contains(mission, expected) {
+			t.Errorf("Expected mission page to contain %q", expected)
+		}
+	}
+
+	strategy := readFile(t, filepath.Join(outDir, "documents", "STR-growth.html"))
+	for _, expected := range []string{
+		"Growth &lt;Strategy&gt;",
+		"<dt>priority</dt><dd>High</dd>",
+		`<a href="../documents/MSN-mission.html">MSN-mission</a>`,
+		`<span class="missing" title="Not in this archive">VSN-missing</span>`,
+	} {
+		if !strings.Contains(strategy, expected) {
+			t.Errorf("Expected strategy page to contain %q", expected)
+		}
+	}
+
+	search := readFile(t, filepath.Join(outDir, "search-index.js"))
+	if !strings.Contains(search, "window.BSPEC_SEARCH_INDEX") || !strings.Contains(search, "partnerships") {
+		t.Errorf("Expected search index with document text, got %s", search)
+	}
+}
+
+func TestResolveSiteLink(t *testing.T) {
+	aliases := map[string]string{"STR-growth": "STR-growth", "STR-growth-v1.0.0": "STR-growth"}
+
+	tests := map[string]string{
+		"STR-growth-v1.0.0.md":          "../documents/STR-growth.html",
+		"../01-strategic/STR-growth.md": "../documents/STR-growth.html",
+		"STR-growth.md#risks":           "../documents/STR-growth.html#risks",
+		"../../assets/img/logo.png":     "../assets/img/logo.png",
+		"https://example.com/a.md":      "https://example.com/a.md",
+		"unknown.md":                    "unknown.md",
+	}
+	for target, expected := range tests {
+		if got := resolveSiteLink(target, "../", aliases); got != expected {
+			t.Errorf("resolveSiteLink(%q) = %q, expected %q", target, got, expected)
+		}
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", path, err)
+	}
+	return string(data)
+}
@@ -0,0 +1,380 @@
+package export
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// MarkdownRenderer converts markdown to HTML. It supports the subset of
+// markdown used in BSpec documents: ATX headings, paragraphs, ordered,
+// unordered and task lists, fenced code blocks, block quotes, tables,
+// horizontal rules, and inline emphasis, code, links and images.
+type MarkdownRenderer struct {
+	// ResolveLink rewrites link and image targets, e.g. to point
+	// document links at exported pages. Targets are left as-is when nil.
+	ResolveLink func(target string) string
+
+	headingIDs map[string]int
+}
+
+var (
+	headingPattern    = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	fencePattern      = regexp.MustCompile("^\\s*(```|~~~)\\s*([\\w+-]*)")
+	rulePattern       = regexp.MustCompile(`^\s{0,3}([-*_])(\s*[-*_]){2,}\s*$`)
+	listItemPattern   = regexp.MustCompile(`^(\s*)([-*+]|\d+[.)])\s+(.*)$`)
+	tableSepPattern   = regexp.MustCompile(`^\s*\|?\s*:?-{3,}:?\s*(\|\s*:?-{3,}:?\s*)*\|?\s*$`)
+	taskPattern       = regexp.MustCompile(`^\[([ xX])\]\s+`)
+	codeSpanPattern   = regexp.MustCompile("`([^`]+)`")
+	imagePattern      = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)(?:\s+&#34;([^&]*)&#34;)?\)`)
+	linkPattern       = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)(?:\s+&#34;[^&]*&#34;)?\)`)
+	strongPattern     = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	emphasisPattern   = regexp.MustCompile(`\*([^*\s][^*]*)\*`)
+	underscorePattern = regexp.MustCompile(`(^|[^\w])_([^_\s][^_]*)_([^\w]|$)`)
+	strikePattern     = regexp.MustCompile(`~~([^~]+)~~`)
+	autolinkPattern   = regexp.MustCompile(`&lt;(https?://[^&\s]+)&gt;`)
+	slugPattern       = regexp.MustCompile(`[^a-z0-9]+`)
+	placeholderToken  = "\x00%d\x00"
+)
+
+// MarkdownToHTML converts markdown to HTML with the default renderer
+func MarkdownToHTML(markdown string) string {
+	return (&MarkdownRenderer{}).Render(markdown)
+}
+
+// Render converts markdown to HTML
+func (r *MarkdownRenderer) Render(markdown string) string {
+	r.headingIDs = make(map[string]int)
+	lines := strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n")
+
+	var b strings.Builder
+	r.renderBlocks(&b, lines)
+	return b.String()
+}
+
+// renderBlocks renders a sequence of block-level lines
+func (r *MarkdownRenderer) renderBlocks(b *strings.Builder, lines []string) {
+	var paragraph []string
+	flush := func() {
+		if len(paragraph) > 0 {
+			fmt.Fprintf(b, "<p>%s</p>\n", r.inline(strings.Join(paragraph, "\n")))
+			paragraph = nil
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			flush()
+
+		case fencePattern.MatchString(line):
+			flush()
+			match := fencePattern.FindStringSubmatch(line)
+			var code []string
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), match[1]); i++ {
+				code = append(code, lines[i])
+			}
+			class := ""
+			if match[2] != "" {
+				class = fmt.Sprintf(` class="language-%s"`, html.EscapeString(match[2]))
+			}
+			fmt.Fprintf(b, "<pre><code%s>%s</code></pre>\n", class, html.EscapeString(strings.Join(code, "\n")))
+
+		case headingPattern.MatchString(trimmed):
+			flush()
+			match := headingPattern.FindStringSubmatch(trimmed)
+			level := len(match[1])
+			fmt.Fprintf(b, "<h%d id=\"%s\">%s</h%d>\n", level, r.headingID(match[2]), r.inline(match[2]), level)
+
+		case rulePattern.MatchString(line):
+			flush()
+			b.WriteString("<hr>\n")
+
+		case strings.HasPrefix(trimmed, ">"):
+			flush()
+			var quote []string
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">"); i++ {
+				text := strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")
+				quote = append(quote, strings.TrimPrefix(text, " "))
+			}
+			i--
+			b.WriteString("<blockquote>\n")
+			r.renderBlocks(b, quote)
+			b.WriteString("</blockquote>\n")
+
+		case strings.HasPrefix(trimmed, "|") && i+1 < len(lines) && tableSepPattern.MatchString(lines[i+1]):
+			flush()
+			var rows []string
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), "|"); i++ {
+				rows = append(rows, lines[i])
+			}
+			i--
+			r.renderTable(b, rows)
+
+		case listItemPattern.MatchString(line) && len(paragraph) == 0:
+			ordered := isOrderedMarker(listItemPattern.FindStringSubmatch(line)[2])
+			end := i + 1
+			for end < len(lines) {
+				next := lines[end]
+				// A different kind of marker at the same indentation starts a new list
+				if match := listItemPattern.FindStringSubmatch(next); match != nil &&
+					indentOf(next) == indentOf(line) && isOrderedMarker(match[2]) != ordered {
+					break
+				}
+				if strings.TrimSpace(next) == "" {
+					// Blank lines continue the list only when it resumes
+					if end+1 < len(lines) && (listItemPattern.MatchString(lines[end+1]) || indentOf(lines[end+1]) > indentOf(line)) {
+						end++
+						continue
+					}
+					break
+				}
+				if !listItemPattern.MatchString(next) && indentOf(next) <= indentOf(line) {
+					break
+				}
+				end++
+			}
+			r.renderList(b, lines[i:end])
+			i = end - 1
+
+		default:
+			paragraph = append(paragraph, trimmed)
+		}
+	}
+	flush()
+}
+
+// renderList renders a list block; items indented deeper than the first
+// item become nested content of the preceding item
+func (r *MarkdownRenderer) renderList(b *strings.Builder, lines []string) {
+	baseIndent := indentOf(lines[0])
+	ordered := isOrderedMarker(listItemPattern.FindStringSubmatch(lines[0])[2])
+
+	tag := "ul"
+	if ordered {
+		tag = "ol"
+	}
+	fmt.Fprintf(b, "<%s>\n", tag)
+
+	for i := 0; i < len(lines); i++ {
+		match := listItemPattern.FindStringSubmatch(lines[i])
+		if match == nil || indentOf(lines[i]) != baseIndent {
+			continue
+		}
+
+		// Collect the lines that belong to this item
+		var nested []string
+		j := i + 1
+		for ; j < len(lines); j++ {
+			if indentOf(lines[j]) <= baseIndent && listItemPattern.MatchString(lines[j]) {
+				break
+			}
+			nested = append(nested, lines[j])
+		}
+
+		text := match[3]
+		checkbox := ""
+		if task := taskPattern.FindStringSubmatch(text); task != nil {
+			checked := ""
+			if task[1] != " " {
+				checked = " checked"
+			}
+			checkbox = fmt.Sprintf(`<input type="checkbox" disabled%s> `, checked)
+			text = text[len(task[0]):]
+		}
+
+		fmt.Fprintf(b, "<li>%s%s", checkbox, r.inline(text))
+		if content := dedent(nested); strings.TrimSpace(strings.Join(content, "")) != "" {
+			b.WriteString("\n")
+			r.renderBlocks(b, content)
+		}
+		b.WriteString("</li>\n")
+		i = j - 1
+	}
+
+	fmt.Fprintf(b, "</%s>\n", tag)
+}
+
+// renderTable renders a pipe table; the second row is the header separator
+func (r *MarkdownRenderer) renderTable(b *strings.Builder, rows []string) {
+	aligns := tableCells(rows[1])
+	for i, cell := range aligns {
+		switch {
+		case strings.HasPrefix(cell, ":") && strings.HasSuffix(cell, ":"):
+			aligns[i] = ` style="text-align:center"`
+		case strings.HasSuffix(cell, ":"):
+			aligns[i] = ` style="text-align:right"`
+		default:
+			aligns[i] = ""
+		}
+	}
+	align := func(i int) string {
+		if i < len(aligns) {
+			return aligns[i]
+		}
+		return ""
+	}
+
+	b.WriteString("<table>\n<thead>\n<tr>")
+	for i, cell := range tableCells(rows[0]) {
+		fmt.Fprintf(b, "<th%s>%s</th>", align(i), r.inline(cell))
+	}
+	b.WriteString("</tr>\n</thead>\n<tbody>\n")
+	for _, row := range rows[2:] {
+		b.WriteString("<tr>")
+		for i, cell := range tableCells(row) {
+			fmt.Fprintf(b, "<td%s>%s</td>", align(i), r.inline(cell))
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("</tbody>\n</table>\n")
+}
+
+// inline renders inline markdown within a block
+func (r *MarkdownRenderer) inline(text string) string {
+	text = html.EscapeString(text)
+
+	// Code spans, links and images are replaced by placeholders so that
+	// emphasis is not applied inside code or URLs
+	var protected []string
+	protect := func(rendered string) string {
+		protected = append(protected, rendered)
+		return fmt.Sprintf(placeholderToken, len(protected)-1)
+	}
+
+	text = codeSpanPattern.ReplaceAllStringFunc(text, func(match string) string {
+		return protect("<code>" + codeSpanPattern.FindStringSubmatch(match)[1] + "</code>")
+	})
+	text = imagePattern.ReplaceAllStringFunc(text, func(match string) string {
+		parts := imagePattern.FindStringSubmatch(match)
+		title := ""
+		if parts[3] != "" {
+			title = fmt.Sprintf(` title="%s"`, parts[3])
+		}
+		if !safeTarget(parts[2]) {
+			return protect(parts[1])
+		}
+		return protect(fmt.Sprintf(`<img src="%s" alt="%s"%s>`, r.resolve(parts[2]), parts[1], title))
+	})
+	text = linkPattern.ReplaceAllStringFunc(text, func(match string) string {
+		parts := linkPattern.FindStringSubmatch(match)
+		if !safeTarget(parts[2]) {
+			return protect(emphasis(parts[1]))
+		}
+		return protect(fmt.Sprintf(`<a href="%s">%s</a>`, r.resolve(parts[2]), emphasis(parts[1])))
+	})
+	text = autolinkPattern.ReplaceAllStringFunc(text, func(match string) string {
+		url := autolinkPattern.FindStringSubmatch(match)[1]
+		return protect(fmt.Sprintf(`<a href="%s">%s</a>`, url, url))
+	})
+
+	text = emphasis(text)
+	text = strings.ReplaceAll(text, "\n", " ")
+
+	// Placeholders may be nested, e.g. code inside link text
+	for i := len(protected) - 1; i >= 0; i-- {
+		text = strings.Replace(text, fmt.Sprintf(placeholderToken, i), protected[i], 1)
+	}
+	return text
+}
+
+// emphasis renders strong, emphasized and struck-through text
+func emphasis(text string) string {
+	text = strongPattern.ReplaceAllString(text, "<strong>$1$2</strong>")
+	text = emphasisPattern.ReplaceAllString(text, "<em>$1</em>")
+	text = underscorePattern.ReplaceAllString(text, "$1<em>$2</em>$3")
+	return strikePattern.ReplaceAllString(text, "<del>$1</del>")
+}
+
+// safeTarget reports whether an escaped link target is relative or uses
+// the http, https or mailto scheme. Browsers ignore tabs, newlines and
+// leading control characters in URLs, so they are ignored here too.
+func safeTarget(target string) bool {
+	target = strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, html.UnescapeString(target))
+	colon := strings.Index(target, ":")
+	if colon < 0 || strings.ContainsAny(target[:colon], "/?#") {
+		return true
+	}
+	switch strings.ToLower(target[:colon]) {
+	case "http", "https", "mailto":
+		return true
+	}
+	return false
+}
+
+// resolve rewrites an escaped link target through ResolveLink
+func (r *MarkdownRenderer) resolve(target string) string {
+	if r.ResolveLink == nil {
+		return target
+	}
+	return html.EscapeString(r.ResolveLink(html.UnescapeString(target)))
+}
+
+// headingID returns a unique anchor for a heading
+func (r *MarkdownRenderer) headingID(text string) string {
+	id := Slugify(text)
+	if id == "" {
+		id = "section"
+	}
+	r.headingIDs[id]++
+	if n := r.headingIDs[id]; n > 1 {
+		id = fmt.Sprintf("%s-%d", id, n-1)
+	}
+	return id
+}
+
+// Slugify converts text to a lowercase, dash-separated identifier
+func Slugify(text string) string {
+	return strings.Trim(slugPattern.ReplaceAllString(strings.ToLower(text), "-"), "-")
+}
+
+func tableCells(row string) []string {
+	row = strings.TrimSpace(row)
+	row = strings.TrimPrefix(row, "|")
+	row = strings.TrimSuffix(row, "|")
+	cells := strings.Split(row, "|")
+	for i, cell := range cells {
+		cells[i] = strings.TrimSpace(cell)
+	}
+	return cells
+}
+
+func isOrderedMarker(marker string) bool {
+	return marker != "-" && marker != "*" && marker != "+"
+}
+
+func indentOf(line string) int {
+	return len(strings.ReplaceAll(line, "\t", "    ")) - len(strings.TrimLeft(strings.ReplaceAll(line, "\t", "    "), " "))
+}
+
+// dedent removes the common leading indentation of lines
+func dedent(lines []string) []string {
+	min := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if indent := indentOf(line); min < 0 || indent < min {
+			min = indent
+		}
+	}
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		expanded := strings.ReplaceAll(line, "\t", "    ")
+		if len(expanded) >= min && min > 0 {
+			out[i] = expanded[min:]
+		} else {
+			out[i] = strings.TrimLeft(expanded, " ")
+		}
+	}
+	return out
+}
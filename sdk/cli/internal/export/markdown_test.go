@@ -0,0 +1,95 @@
+package export
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkdownToHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected []string
+	}{
+		{
+			name:     "headings get unique anchors",
+			markdown: "# Overview\n\n## Risks\n\n## Risks",
+			expected: []string{`<h1 id="overview">Overview</h1>`, `<h2 id="risks">Risks</h2>`, `<h2 id="risks-1">Risks</h2>`},
+		},
+		{
+			name:     "inline formatting",
+			markdown: "Some **bold**, *italic*, ~~old~~ and `risk_score` text with a [link](https://example.com).",
+			expected: []string{"<strong>bold</strong>", "<em>italic</em>", "<del>old</del>", "<code>risk_score</code>", `<a href="https://example.com">link</a>`},
+		},
+		{
+			name:     "html is escaped",
+			markdown: "Use <script>alert(1)</script> & friends",
+			expected: []string{"&lt;script&gt;alert(1)&lt;/script&gt; &amp; friends"},
+		},
+		{
+			name:     "nested and task lists",
+			markdown: "- [ ] Bronze item\n- [x] Done item\n  - nested\n\n1. first\n2. second",
+			expected: []string{
+				`<li><input type="checkbox" disabled> Bronze item</li>`,
+				`<li><input type="checkbox" disabled checked> Done item`,
+				"<ul>\n<li>nested</li>\n</ul>",
+				"<ol>\n<li>first</li>\n<li>second</li>\n</ol>",
+			},
+		},
+		{
+			name:     "tables",
+			markdown: "| Risk | Score |\n|------|------:|\n| Churn | 12 |",
+			expected: []string{"<th>Risk</th>", `<th style="text-align:right">Score</th>`, `<td style="text-align:right">12</td>`},
+		},
+		{
+			name:     "code blocks and quotes",
+			markdown: "```yaml\nid: <x>\n```\n\n> quoted *text*",
+			expected: []string{`<pre><code class="language-yaml">id: &lt;x&gt;</code></pre>`, "<blockquote>\n<p>quoted <em>text</em></p>\n</blockquote>"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MarkdownToHTML(tt.markdown)
+			for _, expected := range tt.expected {
+				if !strings.Contains(result, expected) {
+					t.Errorf("Expected output to contain %q, got:\n%s", expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestMarkdownRendererResolveLink(t *testing.T) {
+	renderer := &MarkdownRenderer{ResolveLink: func(target string) string {
+		return "resolved/" + target
+	}}
+
+	result := renderer.Render("See [the_strategy](STR-growth.md) and ![diagram](assets/a_b.svg)")
+	for _, expected := range []string{
+		`<a href="resolved/STR-growth.md">the_strategy</a>`,
+		`<img src="resolved/assets/a_b.svg" alt="diagram">`,
+	} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, result)
+		}
+	}
+}
+
+func TestMarkdownRendererUnsafeLinks(t *testing.T) {
+	result := MarkdownToHTML("[x](javascript:alert(1)) [y](JavaScript:void) [z](data:text/html,hi) ![i](vbscript:x) " +
+		"[web](https://example.com) [mail](mailto:a@example.com) [doc](STR-growth.md#a:b)")
+
+	if strings.Contains(strings.ToLower(result), "script:") || strings.Contains(result, "data:") {
+		t.Errorf("Expected unsafe targets to be dropped, got:\n%s", result)
+	}
+	for _, expected := range []string{
+		`<a href="https://example.com">web</a>`,
+		`<a href="mailto:a@example.com">mail</a>`,
+		`<a href="STR-growth.md#a:b">doc</a>`,
+	} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, result)
+		}
+	}
+}
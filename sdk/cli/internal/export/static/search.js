@@ -0,0 +1,107 @@
+(function () {
+  "use strict";
+
+  var index = window.BSPEC_SEARCH_INDEX || [];
+  var root = document.body.getAttribute("data-root") || "";
+  var input = document.getElementById("search");
+  var results = document.getElementById("search-results");
+  var maxResults = 20;
+
+  function score(entry, terms) {
+    var id = entry.id.toLowerCase();
+    var title = entry.title.toLowerCase();
+    var meta = [entry.type, entry.domain, entry.status, entry.owner].join(" ").toLowerCase();
+    var text = entry.text.toLowerCase();
+    var total = 0;
+
+    for (var i = 0; i < terms.length; i++) {
+      var term = terms[i];
+      if (id.indexOf(term) >= 0) {
+        total += 10;
+      } else if (title.indexOf(term) >= 0) {
+        total += 5;
+      } else if (meta.indexOf(term) >= 0) {
+        total += 3;
+      } else if (text.indexOf(term) >= 0) {
+        total += 1;
+      } else {
+        return 0;
+      }
+    }
+    return total;
+  }
+
+  function snippet(text, term) {
+    var position = text.toLowerCase().indexOf(term);
+    if (position < 0) {
+      return "";
+    }
+    var start = Math.max(0, position - 40);
+    return (start > 0 ? "…" : "") + text.substr(start, 120) + "…";
+  }
+
+  function render(query) {
+    var terms = query.toLowerCase().split(/\s+/).filter(Boolean);
+    results.innerHTML = "";
+    if (terms.length === 0) {
+      results.hidden = true;
+      return;
+    }
+
+    var matches = [];
+    for (var i = 0; i < index.length; i++) {
+      var s = score(index[i], terms);
+      if (s > 0) {
+        matches.push({ entry: index[i], score: s });
+      }
+    }
+    matches.sort(function (a, b) { return b.score - a.score; });
+
+    if (matches.length === 0) {
+      var empty = document.createElement("li");
+      empty.className = "empty";
+      empty.textContent = "No matching documents";
+      results.appendChild(empty);
+    }
+
+    matches.slice(0, maxResults).forEach(function (match) {
+      var item = document.createElement("li");
+      var link = document.createElement("a");
+      link.href = root + match.entry.href;
+
+      var title = document.createElement("div");
+      title.textContent = match.entry.title || match.entry.id;
+      var id = document.createElement("div");
+      id.className = "result-id";
+      id.textContent = [match.entry.id, match.entry.status, snippet(match.entry.text, terms[0])].filter(Boolean).join(" · ");
+
+      link.appendChild(title);
+      link.appendChild(id);
+      item.appendChild(link);
+      results.appendChild(item);
+    });
+    results.hidden = false;
+  }
+
+  if (!input || !results) {
+    return;
+  }
+
+  input.addEventListener("input", function () { render(input.value); });
+  input.addEventListener("keydown", function (event) {
+    if (event.key === "Escape") {
+      input.value = "";
+      render("");
+    } else if (event.key === "Enter") {
+      var first = results.querySelector("a");
+      if (first) {
+        window.location.href = first.href;
+      }
+    }
+  });
+  document.addEventListener("click", function (event) {
+    if (!results.contains(event.target) && event.target !== input) {
+      results.hidden = true;
+    }
+  });
+})();
@@ -0,0 +1,438 @@
+package gaps
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/conformance"
+	"github.com/a3tai/bspec/cli/internal/graph"
+)
+
+// ReportPath is the location of the gap analysis inside an archive
+const ReportPath = "computed/analysis/gap-analysis.json"
+
+// Suggestion priorities
+const (
+	PriorityHigh   = "high"
+	PriorityMedium = "medium"
+	PriorityLow    = "low"
+)
+
+// Score weights of a suggestion
+const (
+	scoreHighLevel   = 300 // Required for the target or next conformance level
+	scoreMediumLevel = 200 // Required for the level after that
+	scoreLowLevel    = 100 // Required for a higher level
+	scoreDependent   = 10  // Per existing document type that depends on the suggestion
+	scoreEnabler     = 5   // Per existing document type that enables the suggestion
+)
+
+// Options controls the gap analysis
+type Options struct {
+	Level   bspec.ConformanceLevel // Target level; defaults to the manifest conformance level
+	Profile bspec.IndustryProfile  // Industry profile; defaults to the manifest industry profile
+	Catalog *bspec.BSpec           // Document type catalog; defaults to bspec.DefaultCatalog()
+}
+
+// Report is the gap analysis of an archive
+type Report struct {
+	TargetLevel  string        `json:"target_level"`
+	CurrentLevel string        `json:"current_level"`
+	Suggestions  []Suggestion  `json:"suggestions"`
+	MissingLinks []MissingLink `json:"missing_links"`
+}
+
+// Suggestion is a document type worth writing next
+type Suggestion struct {
+	DocumentType string   `json:"document_type"`
+	Name         string   `json:"name"`
+	Domain       string   `json:"domain"`
+	Priority     string   `json:"priority"`
+	Score        int      `json:"score"`
+	Level        string   `json:"level,omitempty"`       // Lowest conformance level that requires the type
+	Reasons      []string `json:"reasons"`               // Why the type is suggested
+	After        []string `json:"after,omitempty"`       // Suggested types to write first
+	ExpectedBy   []string `json:"expected_by,omitempty"` // Existing types that depend on the type
+}
+
+// MissingLink is a relationship the catalog expects but a document lacks
+type MissingLink struct {
+	Document     string   `json:"document"`
+	DocumentType string   `json:"document_type"`
+	Relationship string   `json:"relationship"` // depends_on or enables
+	ExpectedType string   `json:"expected_type"`
+	Candidates   []string `json:"candidates,omitempty"` // Existing documents of the expected type
+	Message      string   `json:"message"`
+}
+
+// Analyze suggests the document types to write next, grouped by the
+// conformance level that requires them and in dependency order within each
+// group, and lists the relationships the catalog expects between existing
+// documents but that are missing
+func Analyze(arch *archive.BSpecArchive, opts Options) (*Report, error) {
+	catalog := opts.Catalog
+	if catalog == nil {
+		catalog = bspec.DefaultCatalog()
+	}
+
+	assessment, err := conformance.Evaluate(arch, conformance.Options{Level: opts.Level, Profile: opts.Profile})
+	if err != nil {
+		return nil, err
+	}
+	profile := bspec.IndustryProfile(assessment.IndustryProfile)
+
+	byType := documentsByType(arch)
+
+	report := &Report{
+		TargetLevel:  assessment.TargetLevel,
+		CurrentLevel: assessment.CurrentLevel,
+		Suggestions:  suggestions(catalog, byType, profile, assessment),
+		MissingLinks: missingLinks(arch, catalog, byType),
+	}
+	return report, nil
+}
+
+// suggestions ranks the missing document types
+func suggestions(catalog *bspec.BSpec, byType map[string][]string, profile bspec.IndustryProfile, assessment *conformance.Report) []Suggestion {
+	// Levels up to the target, or the next unachieved one, have the most impact
+	focus := conformance.LevelRank(assessment.TargetLevel)
+	if next := conformance.LevelRank(assessment.CurrentLevel) + 1; next > focus {
+		focus = next
+	}
+
+	candidates := make(map[string]*Suggestion)
+	candidate := func(code string) *Suggestion {
+		if suggestion, ok := candidates[code]; ok {
+			return suggestion
+		}
+		suggestion := &Suggestion{DocumentType: code}
+		info := catalog.GetDocumentType(code)
+		if info == nil {
+			info = bspec.LegacyDocumentType(code)
+		}
+		if info != nil {
+			suggestion.Name = info.Name
+			suggestion.Domain = info.Domain
+		}
+		candidates[code] = suggestion
+		return suggestion
+	}
+
+	// Types required by a conformance level
+	for _, requirements := range conformance.Levels() {
+		for _, required := range conformance.Requirements(requirements.Level, profile) {
+			count := 0
+			for _, code := range required.Types {
+				count += len(byType[code])
+			}
+			if count >= required.Count {
+				continue
+			}
+			code := required.Type()
+			level, _ := conformance.RequiredLevel(code, profile)
+			suggestion := candidate(code)
+			if suggestion.Level != "" {
+				continue
+			}
+			suggestion.Level = string(level)
+			switch rank := conformance.LevelRank(string(level)); {
+			case rank <= focus:
+				suggestion.Score += scoreHighLevel
+			case rank == focus+1:
+				suggestion.Score += scoreMediumLevel
+			default:
+				suggestion.Score += scoreLowLevel
+			}
+			reason := fmt.Sprintf("required for %s conformance", level)
+			if required.Count > 1 {
+				reason = fmt.Sprintf("%d required for %s conformance, %d found", required.Count, level, count)
+			}
+			suggestion.Reasons = append(suggestion.Reasons, reason)
+		}
+	}
+
+	// Types the existing documents depend on or enable
+	present := sortedKeys(byType)
+	for _, code := range present {
+		for _, dependency := range catalog.GetDependencies(code) {
+			if len(byType[dependency]) > 0 || catalog.GetDocumentType(dependency) == nil {
+				continue
+			}
+			suggestion := candidate(dependency)
+			suggestion.Score += scoreDependent
+			suggestion.ExpectedBy = append(suggestion.ExpectedBy, code)
+		}
+		for _, enablement := range catalog.GetEnablements(code) {
+			if len(byType[enablement]) > 0 || catalog.GetDocumentType(enablement) == nil {
+				continue
+			}
+			suggestion := candidate(enablement)
+			suggestion.Score += scoreEnabler
+			suggestion.Reasons = append(suggestion.Reasons, "enabled by "+code)
+		}
+	}
+	for _, suggestion := range candidates {
+		if len(suggestion.ExpectedBy) > 0 {
+			suggestion.Reasons = append([]string{"dependency of " + strings.Join(suggestion.ExpectedBy, ", ")}, suggestion.Reasons...)
+		}
+	}
+
+	// Types required up to the target level come first, then those of the
+	// following levels, then the types no level requires
+	tier := func(code string) int {
+		level := candidates[code].Level
+		switch rank := conformance.LevelRank(level); {
+		case level == "":
+			return 3
+		case rank <= focus:
+			return 0
+		case rank == focus+1:
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	// Write dependencies first: only dependencies of the same or an earlier
+	// tier are ordered before a type, and they inherit its score
+	for code, suggestion := range candidates {
+		for _, dependency := range catalog.GetDependencies(code) {
+			if _, ok := candidates[dependency]; ok && tier(dependency) <= tier(code) {
+				suggestion.After = append(suggestion.After, dependency)
+			}
+		}
+		sort.Strings(suggestion.After)
+	}
+	for range candidates {
+		changed := false
+		for _, suggestion := range candidates {
+			for _, dependency := range suggestion.After {
+				if suggestion.Score > candidates[dependency].Score {
+					candidates[dependency].Score = suggestion.Score
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	codes := make([]string, 0, len(candidates))
+	for code := range candidates {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		a, b := codes[i], codes[j]
+		if tier(a) != tier(b) {
+			return tier(a) < tier(b)
+		}
+		if candidates[a].Score != candidates[b].Score {
+			return candidates[a].Score > candidates[b].Score
+		}
+		return a < b
+	})
+	var order []string
+	for start := 0; start < len(codes); {
+		end := start + 1
+		for end < len(codes) && tier(codes[end]) == tier(codes[start]) {
+			end++
+		}
+		order = append(order, dependencyOrder(candidates, codes[start:end])...)
+		start = end
+	}
+
+	for _, code := range order {
+		for _, dependency := range candidates[code].After {
+			candidates[dependency].Reasons = append(candidates[dependency].Reasons, "needed before "+code)
+		}
+	}
+
+	result := make([]Suggestion, 0, len(order))
+	for _, code := range order {
+		suggestion := candidates[code]
+		switch tier(code) {
+		case 0:
+			suggestion.Priority = PriorityHigh
+		case 1:
+			suggestion.Priority = PriorityMedium
+		default:
+			suggestion.Priority = PriorityLow
+		}
+		result = append(result, *suggestion)
+	}
+	return result
+}
+
+// dependencyOrder orders the codes so that each comes after the codes it is
+// written after, otherwise keeping their order; the After of codes outside
+// the list are written already. A dependency cycle is broken at its first
+// code, which drops the codes of the cycle from its After.
+func dependencyOrder(candidates map[string]*Suggestion, codes []string) []string {
+	remaining := append([]string{}, codes...)
+	pending := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		pending[code] = true
+	}
+
+	ready := func(code string) bool {
+		for _, dependency := range candidates[code].After {
+			if pending[dependency] {
+				return false
+			}
+		}
+		return true
+	}
+
+	order := make([]string, 0, len(codes))
+	for len(remaining) > 0 {
+		next := 0
+		for next < len(remaining) && !ready(remaining[next]) {
+			next++
+		}
+		if next == len(remaining) {
+			next = 0
+			suggestion := candidates[remaining[next]]
+			after := suggestion.After[:0]
+			for _, dependency := range suggestion.After {
+				if !pending[dependency] {
+					after = append(after, dependency)
+				}
+			}
+			suggestion.After = after
+		}
+		code := remaining[next]
+		pending[code] = false
+		order = append(order, code)
+		remaining = append(remaining[:next], remaining[next+1:]...)
+	}
+	return order
+}
+
+// missingLinks finds documents without relationships to the types the catalog
+// or the SDK validation expects
+func missingLinks(arch *archive.BSpecArchive, catalog *bspec.BSpec, byType map[string][]string) []MissingLink {
+	g := graph.Build(arch, graph.Options{})
+
+	linked := make(map[string]map[string]bool)
+	link := func(id, docType string) {
+		if linked[id] == nil {
+			linked[id] = make(map[string]bool)
+		}
+		linked[id][strings.ToUpper(docType)] = true
+	}
+	for _, edge := range g.Edges {
+		from, _ := g.Node(edge.From)
+		to, _ := g.Node(edge.To)
+		link(edge.From, to.Type)
+		link(edge.To, from.Type)
+	}
+
+	docs := make(map[string]archive.BSpecDocument, len(arch.Documents))
+	for _, doc := range arch.Documents {
+		docs[doc.ID] = doc
+	}
+
+	result := []MissingLink{}
+	for _, docType := range sortedKeys(byType) {
+		for _, id := range byType[docType] {
+			// References the SDK validation expects, such as RSK to MIT
+			for _, expected := range bspec.ExpectedReferences(bspec.DocumentType(docType)) {
+				if referencesType(docs[id], expected) {
+					continue
+				}
+				expectedType := string(expected.Type)
+				missing := MissingLink{
+					Document:     id,
+					DocumentType: docType,
+					Relationship: expected.Key,
+					ExpectedType: expectedType,
+					Candidates:   byType[expectedType],
+				}
+				if len(missing.Candidates) > 0 {
+					missing.Message = fmt.Sprintf("%s does not reference a %s document in %s (candidates: %s)", id, expectedType, expected.Key, strings.Join(missing.Candidates, ", "))
+				} else {
+					missing.Message = fmt.Sprintf("%s should reference a %s document in %s, but the archive has no %s document", id, expectedType, expected.Key, expectedType)
+				}
+				result = append(result, missing)
+			}
+
+			for _, expected := range catalog.GetDependencies(docType) {
+				if linked[id][expected] {
+					continue
+				}
+				missing := MissingLink{
+					Document:     id,
+					DocumentType: docType,
+					Relationship: "depends_on",
+					ExpectedType: expected,
+					Candidates:   byType[expected],
+				}
+				if len(missing.Candidates) > 0 {
+					missing.Message = fmt.Sprintf("%s has no link to a %s document (candidates: %s)", id, expected, strings.Join(missing.Candidates, ", "))
+				} else {
+					missing.Message = fmt.Sprintf("%s typically depends on %s, but the archive has no %s document", id, expected, expected)
+				}
+				result = append(result, missing)
+			}
+
+			// Enabled documents may not be written yet; only report unlinked ones
+			for _, expected := range catalog.GetEnablements(docType) {
+				if linked[id][expected] || len(byType[expected]) == 0 {
+					continue
+				}
+				result = append(result, MissingLink{
+					Document:     id,
+					DocumentType: docType,
+					Relationship: "enables",
+					ExpectedType: expected,
+					Candidates:   byType[expected],
+					Message:      fmt.Sprintf("%s typically enables %s, but is not linked to %s", id, expected, strings.Join(byType[expected], ", ")),
+				})
+			}
+		}
+	}
+	return result
+}
+
+// referencesType reports whether a document lists a document of the expected
+// type under the expected key, by ID prefix as the SDK validation checks it
+func referencesType(doc archive.BSpecDocument, expected bspec.ExpectedReference) bool {
+	for _, ref := range doc.References(expected.Key) {
+		if strings.HasPrefix(ref, string(expected.Type)+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+// documentsByType maps each document type to the IDs of its non-deprecated documents
+func documentsByType(arch *archive.BSpecArchive) map[string][]string {
+	g := graph.Build(arch, graph.Options{})
+
+	byType := make(map[string][]string)
+	for _, node := range g.Nodes {
+		if node.Dangling || strings.EqualFold(node.Status, string(bspec.DocumentStatusDeprecated)) {
+			continue
+		}
+		docType := strings.ToUpper(node.Type)
+		byType[docType] = append(byType[docType], node.ID)
+	}
+	for _, ids := range byType {
+		sort.Strings(ids)
+	}
+	return byType
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,234 @@
+package gaps
+
+import (
+	"strings"
+	"testing"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+// testCatalog is a small catalog: VSN depends on MSN and VAL, STR depends on
+// VSN and MKT, MSN enables VSN and STR
+func testCatalog() *bspec.BSpec {
+	return &bspec.BSpec{
+		DocumentTypes: []bspec.DocumentTypeInfo{
+			{Code: "MSN", Name: "Mission", Domain: "Strategic Foundation", Enablements: []string{"VSN", "STR"}},
+			{Code: "VAL", Name: "Values", Domain: "Strategic Foundation"},
+			{Code: "VSN", Name: "Vision", Domain: "Strategic Foundation", Dependencies: []string{"MSN", "VAL"}},
+			{Code: "MKT", Name: "Market", Domain: "Market & Environment"},
+			{Code: "STR", Name: "Strategy", Domain: "Strategic Foundation", Dependencies: []string{"VSN", "MKT"}},
+		},
+	}
+}
+
+func testArchive(docs ...archive.BSpecDocument) *archive.BSpecArchive {
+	arch := &archive.BSpecArchive{
+		Manifest:  archive.Manifest{ConformanceLevel: "bronze", IndustryProfile: "software-saas"},
+		Documents: make(map[string]archive.BSpecDocument),
+	}
+	for _, doc := range docs {
+		arch.Documents[doc.ID+".md"] = doc
+	}
+	return arch
+}
+
+func findSuggestion(report *Report, code string) (int, *Suggestion) {
+	for i := range report.Suggestions {
+		if report.Suggestions[i].DocumentType == code {
+			return i, &report.Suggestions[i]
+		}
+	}
+	return -1, nil
+}
+
+func TestAnalyzeSuggestions(t *testing.T) {
+	arch := testArchive(
+		archive.BSpecDocument{ID: "MSN-001", Type: "MSN", Status: "Draft"},
+		archive.BSpecDocument{ID: "VSN-001", Type: "VSN", Status: "Draft", Metadata: map[string]interface{}{"depends_on": []interface{}{"MSN-001"}}},
+	)
+
+	report, err := Analyze(arch, Options{Catalog: testCatalog()})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if report.TargetLevel != "bronze" || report.CurrentLevel != "none" {
+		t.Errorf("Unexpected levels: target %s, current %s", report.TargetLevel, report.CurrentLevel)
+	}
+
+	if _, msn := findSuggestion(report, "MSN"); msn != nil {
+		t.Error("Expected existing MSN not to be suggested")
+	}
+
+	valIndex, val := findSuggestion(report, "VAL")
+	if val == nil {
+		t.Fatal("Expected VAL to be suggested")
+	}
+	if val.Priority != PriorityHigh || val.Level != "bronze" {
+		t.Errorf("Expected high priority bronze VAL, got %+v", val)
+	}
+	if len(val.ExpectedBy) != 1 || val.ExpectedBy[0] != "VSN" {
+		t.Errorf("Expected VAL to be expected by VSN, got %v", val.ExpectedBy)
+	}
+	if !strings.Contains(strings.Join(val.Reasons, ";"), "dependency of VSN") {
+		t.Errorf("Expected dependency reason, got %v", val.Reasons)
+	}
+
+	// STR depends on MKT, so MKT is written first and inherits STR's score
+	strIndex, str := findSuggestion(report, "STR")
+	mktIndex, mkt := findSuggestion(report, "MKT")
+	if str == nil || mkt == nil {
+		t.Fatal("Expected STR and MKT to be suggested")
+	}
+	if mktIndex > strIndex {
+		t.Errorf("Expected MKT (%d) before STR (%d)", mktIndex, strIndex)
+	}
+	if mkt.Score < str.Score || mkt.Priority != str.Priority {
+		t.Errorf("Expected MKT to inherit STR's score, got %d < %d", mkt.Score, str.Score)
+	}
+	if len(str.After) != 1 || str.After[0] != "MKT" {
+		t.Errorf("Expected STR after MKT, got %v", str.After)
+	}
+	if !strings.Contains(strings.Join(mkt.Reasons, ";"), "needed before STR") {
+		t.Errorf("Expected ordering reason, got %v", mkt.Reasons)
+	}
+	if !strings.Contains(strings.Join(str.Reasons, ";"), "enabled by MSN") {
+		t.Errorf("Expected enablement reason, got %v", str.Reasons)
+	}
+
+	// VAL is required for bronze and expected by VSN, so it outranks gold-only types
+	if valIndex > 2 {
+		t.Errorf("Expected VAL near the top, got position %d", valIndex)
+	}
+	for _, suggestion := range report.Suggestions[valIndex+1:] {
+		if suggestion.Score > val.Score {
+			t.Errorf("Expected suggestions sorted by score, %s (%d) after VAL (%d)", suggestion.DocumentType, suggestion.Score, val.Score)
+		}
+	}
+}
+
+func TestAnalyzeMissingLinks(t *testing.T) {
+	arch := testArchive(
+		archive.BSpecDocument{ID: "MSN-001", Type: "MSN", Status: "Draft"},
+		archive.BSpecDocument{ID: "VSN-001", Type: "VSN", Status: "Draft"},
+		archive.BSpecDocument{ID: "STR-old", Type: "STR", Status: "Deprecated"},
+	)
+
+	report, err := Analyze(arch, Options{Catalog: testCatalog()})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var messages []string
+	for _, missing := range report.MissingLinks {
+		messages = append(messages, missing.Document+" "+missing.Relationship+" "+missing.ExpectedType)
+	}
+	got := strings.Join(messages, ",")
+	expected := "MSN-001 enables VSN,VSN-001 depends_on MSN,VSN-001 depends_on VAL"
+	if got != expected {
+		t.Errorf("Expected missing links %q, got %q", expected, got)
+	}
+
+	// Deprecated documents neither count as present nor expect links
+	if _, str := findSuggestion(report, "STR"); str == nil {
+		t.Error("Expected deprecated STR to be suggested again")
+	}
+
+	for _, missing := range report.MissingLinks {
+		if missing.ExpectedType == "MSN" && (len(missing.Candidates) != 1 || !strings.Contains(missing.Message, "candidates: MSN-001")) {
+			t.Errorf("Expected MSN-001 as candidate, got %+v", missing)
+		}
+		if missing.ExpectedType == "VAL" && len(missing.Candidates) != 0 {
+			t.Errorf("Expected no VAL candidates, got %v", missing.Candidates)
+		}
+	}
+}
+
+func TestAnalyzeDefaultCatalog(t *testing.T) {
+	arch := testArchive(archive.BSpecDocument{ID: "RSK-001", Type: "RSK", Status: "Draft"})
+
+	report, err := Analyze(arch, Options{Level: bspec.ConformanceLevelSilver})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if report.TargetLevel != "silver" {
+		t.Errorf("Expected silver target, got %s", report.TargetLevel)
+	}
+
+	// RSK typically depends on STR
+	if _, str := findSuggestion(report, "STR"); str == nil || !strings.Contains(strings.Join(str.ExpectedBy, ","), "RSK") {
+		t.Errorf("Expected STR to be expected by RSK, got %+v", str)
+	}
+	found := false
+	for _, missing := range report.MissingLinks {
+		if missing.Document == "RSK-001" && missing.ExpectedType == "STR" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected RSK-001 to miss a link to STR")
+	}
+
+	if _, err := Analyze(arch, Options{Level: "platinum"}); err == nil {
+		t.Error("Expected error for unknown level")
+	}
+}
+
+func TestAnalyzeExpectedReferences(t *testing.T) {
+	arch := testArchive(
+		archive.BSpecDocument{ID: "RSK-churn", Type: "RSK", Status: "Draft"},
+		archive.BSpecDocument{ID: "RSK-outage", Type: "RSK", Status: "Draft", Metadata: map[string]interface{}{"related": []interface{}{"MIT-failover"}}},
+		archive.BSpecDocument{ID: "MIT-failover", Type: "MIT", Status: "Draft"},
+	)
+
+	report, err := Analyze(arch, Options{Catalog: testCatalog()})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var messages []string
+	for _, missing := range report.MissingLinks {
+		messages = append(messages, missing.Document+" "+missing.Relationship+" "+missing.ExpectedType)
+	}
+	// The MIT's link to RSK-outage does not count as its own reference
+	expected := "MIT-failover related RSK,RSK-churn related MIT"
+	if got := strings.Join(messages, ","); got != expected {
+		t.Errorf("Expected missing links %q, got %q", expected, got)
+	}
+	for _, missing := range report.MissingLinks {
+		if missing.Document == "RSK-churn" && !strings.Contains(missing.Message, "candidates: MIT-failover") {
+			t.Errorf("Expected MIT-failover as candidate, got %q", missing.Message)
+		}
+	}
+}
+
+func TestAnalyzeOrder(t *testing.T) {
+	report, err := Analyze(testArchive(), Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Bronze types come first, and every type comes after the types it is
+	// written after
+	written := make(map[string]bool)
+	bronze := true
+	for _, suggestion := range report.Suggestions {
+		if suggestion.Level != "bronze" {
+			bronze = false
+		} else if !bronze {
+			t.Errorf("Expected bronze %s before the types of higher levels", suggestion.DocumentType)
+		}
+		for _, dependency := range suggestion.After {
+			if !written[dependency] {
+				t.Errorf("Expected %s after %s, but it comes first", suggestion.DocumentType, dependency)
+			}
+		}
+		written[suggestion.DocumentType] = true
+	}
+
+	// MIT is required by the README rather than spec/v1
+	if _, mit := findSuggestion(report, "MIT"); mit == nil || mit.Name == "" || mit.Priority != PriorityHigh {
+		t.Errorf("Expected a named high priority MIT, got %+v", mit)
+	}
+}
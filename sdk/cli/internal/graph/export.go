@@ -0,0 +1,340 @@
+package graph
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Supported export formats
+const (
+	FormatDOT     = "dot"
+	FormatMermaid = "mermaid"
+	FormatGraphML = "graphml"
+	FormatJSON    = "json"
+)
+
+// Formats lists the supported export formats
+var Formats = []string{FormatDOT, FormatMermaid, FormatGraphML, FormatJSON, FormatSVG}
+
+// statusColors maps document status to node fill colors
+var statusColors = map[string]string{
+	"draft":      "#e0e0e0",
+	"review":     "#fff3b0",
+	"approved":   "#c8e6c9",
+	"accepted":   "#c8e6c9",
+	"active":     "#b3e5fc",
+	"deprecated": "#ffcdd2",
+}
+
+const (
+	defaultColor  = "#ffffff"
+	danglingColor = "#d32f2f"
+)
+
+var identifierPattern = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// StatusColor returns the fill color used for a document status
+func StatusColor(status string) string {
+	if color, ok := statusColors[strings.ToLower(status)]; ok {
+		return color
+	}
+	return defaultColor
+}
+
+// Write writes the graph in the given format
+func Write(w io.Writer, g *Graph, format string) error {
+	switch strings.ToLower(format) {
+	case FormatDOT:
+		return WriteDOT(w, g)
+	case FormatMermaid:
+		return WriteMermaid(w, g)
+	case FormatGraphML:
+		return WriteGraphML(w, g)
+	case FormatJSON:
+		return WriteJSON(w, g)
+	case FormatSVG:
+		return WriteSVG(w, g)
+	default:
+		return fmt.Errorf("unsupported graph format: %s (supported: %s)", format, strings.Join(Formats, ", "))
+	}
+}
+
+// WriteDOT writes the graph in Graphviz DOT format
+func WriteDOT(w io.Writer, g *Graph) error {
+	var b strings.Builder
+	b.WriteString("digraph bspec {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+	b.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n\n")
+
+	for _, node := range g.Nodes {
+		if node.Dangling {
+			fmt.Fprintf(&b, "  %s [label=%s, fillcolor=\"%s\", color=\"%s\", style=\"rounded,dashed\"];\n",
+				dotQuote(node.ID), dotQuote(node.ID+"\n(missing)"), defaultColor, danglingColor)
+			continue
+		}
+		fmt.Fprintf(&b, "  %s [label=%s, fillcolor=\"%s\"];\n",
+			dotQuote(node.ID), dotQuote(nodeLabel(node)), StatusColor(node.Status))
+	}
+
+	if len(g.Edges) > 0 {
+		b.WriteString("\n")
+	}
+	for _, edge := range g.Edges {
+		attrs := fmt.Sprintf("label=%s", dotQuote(edge.Type))
+		if edge.Dangling {
+			attrs += fmt.Sprintf(", style=dashed, color=\"%s\"", danglingColor)
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", dotQuote(edge.From), dotQuote(edge.To), attrs)
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteMermaid writes the graph as a Mermaid flowchart
+func WriteMermaid(w io.Writer, g *Graph) error {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	ids := make(map[string]string, len(g.Nodes))
+	for i, node := range g.Nodes {
+		ids[node.ID] = mermaidID(node.ID, i)
+		label := nodeLabel(node)
+		if node.Dangling {
+			label = node.ID + "\n(missing)"
+		}
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", ids[node.ID], mermaidEscape(label))
+	}
+
+	var danglingLinks []int
+	for i, edge := range g.Edges {
+		arrow := "-->"
+		if edge.Dangling {
+			arrow = "-.->"
+			danglingLinks = append(danglingLinks, i)
+		}
+		fmt.Fprintf(&b, "  %s %s|%s| %s\n", ids[edge.From], arrow, edge.Type, ids[edge.To])
+	}
+
+	// Color nodes by status
+	classes := make(map[string][]string)
+	var classOrder []string
+	for _, node := range g.Nodes {
+		class := "dangling"
+		if !node.Dangling {
+			class = "status_" + identifierPattern.ReplaceAllString(strings.ToLower(node.Status), "_")
+		}
+		if _, ok := classes[class]; !ok {
+			classOrder = append(classOrder, class)
+		}
+		classes[class] = append(classes[class], ids[node.ID])
+	}
+	for _, class := range classOrder {
+		if class == "dangling" {
+			fmt.Fprintf(&b, "  classDef dangling fill:%s,stroke:%s,stroke-dasharray:5 5\n", defaultColor, danglingColor)
+		} else {
+			status := strings.TrimPrefix(class, "status_")
+			fmt.Fprintf(&b, "  classDef %s fill:%s,stroke:#555555\n", class, StatusColor(status))
+		}
+		fmt.Fprintf(&b, "  class %s %s\n", strings.Join(classes[class], ","), class)
+	}
+	for _, i := range danglingLinks {
+		fmt.Fprintf(&b, "  linkStyle %d stroke:%s\n", i, danglingColor)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// graphML mirrors the GraphML document structure
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	ID     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// WriteGraphML writes the graph in GraphML format
+func WriteGraphML(w io.Writer, g *Graph) error {
+	doc := graphML{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "type", For: "node", AttrName: "type", AttrType: "string"},
+			{ID: "title", For: "node", AttrName: "title", AttrType: "string"},
+			{ID: "status", For: "node", AttrName: "status", AttrType: "string"},
+			{ID: "domain", For: "node", AttrName: "domain", AttrType: "string"},
+			{ID: "color", For: "node", AttrName: "color", AttrType: "string"},
+			{ID: "dangling", For: "all", AttrName: "dangling", AttrType: "boolean"},
+			{ID: "relationship", For: "edge", AttrName: "relationship", AttrType: "string"},
+			{ID: "strength", For: "edge", AttrName: "strength", AttrType: "string"},
+		},
+		Graph: graphMLGraph{ID: "bspec", EdgeDefault: "directed"},
+	}
+
+	for _, node := range g.Nodes {
+		color := StatusColor(node.Status)
+		if node.Dangling {
+			color = danglingColor
+		}
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			ID: node.ID,
+			Data: []graphMLData{
+				{Key: "type", Value: node.Type},
+				{Key: "title", Value: node.Title},
+				{Key: "status", Value: node.Status},
+				{Key: "domain", Value: node.Domain},
+				{Key: "color", Value: color},
+				{Key: "dangling", Value: fmt.Sprint(node.Dangling)},
+			},
+		})
+	}
+
+	for i, edge := range g.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			ID:     fmt.Sprintf("e%d", i),
+			Source: edge.From,
+			Target: edge.To,
+			Data: []graphMLData{
+				{Key: "relationship", Value: edge.Type},
+				{Key: "strength", Value: edge.Strength},
+				{Key: "dangling", Value: fmt.Sprint(edge.Dangling)},
+			},
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode GraphML: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// Document is the dependency graph JSON format described in the .bspec format
+// specification (computed/relationships/dependency-graph.json)
+type Document struct {
+	GraphVersion string   `json:"graph_version"`
+	Generated    string   `json:"generated"`
+	Nodes        []Node   `json:"nodes"`
+	Edges        []Edge   `json:"edges"`
+	Analysis     Analysis `json:"analysis"`
+}
+
+// Analysis summarizes the structure of the graph
+type Analysis struct {
+	TotalNodes         int        `json:"total_nodes"`
+	TotalEdges         int        `json:"total_edges"`
+	CyclesDetected     int        `json:"cycles_detected"`
+	Cycles             [][]string `json:"cycles,omitempty"`
+	OrphanedDocuments  []string   `json:"orphaned_documents"`
+	DanglingReferences []Edge     `json:"dangling_references,omitempty"`
+	CriticalPath       []string   `json:"critical_path"`
+}
+
+// ToDocument converts the graph to the dependency graph JSON format
+func (g *Graph) ToDocument() Document {
+	nodes := g.Nodes
+	if nodes == nil {
+		nodes = []Node{}
+	}
+	edges := g.Edges
+	if edges == nil {
+		edges = []Edge{}
+	}
+	cycles := g.Cycles()
+
+	return Document{
+		GraphVersion: "1.0.0",
+		Generated:    time.Now().UTC().Format(time.RFC3339),
+		Nodes:        nodes,
+		Edges:        edges,
+		Analysis: Analysis{
+			TotalNodes:         len(nodes),
+			TotalEdges:         len(edges),
+			CyclesDetected:     len(cycles),
+			Cycles:             cycles,
+			OrphanedDocuments:  g.Orphans(),
+			DanglingReferences: g.DanglingEdges(),
+			CriticalPath:       g.CriticalPath(),
+		},
+	}
+}
+
+// WriteJSON writes the graph in the dependency graph JSON format
+func WriteJSON(w io.Writer, g *Graph) error {
+	data, err := json.MarshalIndent(g.ToDocument(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode graph: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// nodeLabel returns the display label of a document node
+func nodeLabel(node Node) string {
+	if node.Title == "" {
+		return node.ID
+	}
+	return node.ID + "\n" + node.Title
+}
+
+// dotQuote quotes a string for use as a DOT identifier or label
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// mermaidID returns a Mermaid-safe node identifier
+func mermaidID(id string, i int) string {
+	return fmt.Sprintf("n%d_%s", i, identifierPattern.ReplaceAllString(id, "_"))
+}
+
+// mermaidEscape escapes a label for use inside a quoted Mermaid node
+func mermaidEscape(s string) string {
+	s = strings.ReplaceAll(s, `"`, "#quot;")
+	return strings.ReplaceAll(s, "\n", "<br/>")
+}
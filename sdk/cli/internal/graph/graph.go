@@ -0,0 +1,380 @@
+package graph
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+// Node represents a document in the relationship graph
+type Node struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   string `json:"status"`
+	Domain   string `json:"domain"`
+	Path     string `json:"path,omitempty"`
+	Dangling bool   `json:"dangling,omitempty"`
+}
+
+// Edge represents a typed relationship between two documents
+type Edge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Type     string `json:"type"`
+	Strength string `json:"strength"`
+	Dangling bool   `json:"dangling,omitempty"`
+}
+
+// Graph is the relationship graph of an archive
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+
+	index map[string]int
+}
+
+// Options controls which documents and relationships are included in a graph
+type Options struct {
+	Domains   []string // Only include documents in these domains
+	Types     []string // Only include documents of these types
+	EdgeTypes []string // Only include relationships of these types
+}
+
+// edgeStrengths maps relationship fields to the strength reported in the JSON graph format
+var edgeStrengths = map[string]string{
+	"depends_on":     "critical",
+	"conflicts_with": "critical",
+	"enables":        "strong",
+	"parent":         "strong",
+	"supersedes":     "strong",
+	"risks":          "moderate",
+	"metrics":        "moderate",
+	"related":        "weak",
+}
+
+// Build constructs the relationship graph of an archive. References that do
+// not resolve to a document in the archive are kept as dangling nodes.
+func Build(arch *archive.BSpecArchive, opts Options) *Graph {
+	g := &Graph{index: make(map[string]int)}
+
+	// Resolve references by document ID and by file name
+	aliases := make(map[string]string)
+	paths := make([]string, 0, len(arch.Documents))
+	for path := range arch.Documents {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		doc := arch.Documents[path]
+		id := documentID(path, doc)
+		aliases[id] = id
+		aliases[strings.TrimSuffix(filepath.Base(path), ".md")] = id
+
+		node := Node{
+			ID:     id,
+			Type:   doc.Type,
+			Title:  doc.Title,
+			Status: doc.Status,
+			Domain: documentDomain(path, doc),
+			Path:   path,
+		}
+		if !matches(opts.Domains, node.Domain) || !matches(opts.Types, node.Type) {
+			continue
+		}
+		g.addNode(node)
+	}
+
+	for _, path := range paths {
+		doc := arch.Documents[path]
+		from := documentID(path, doc)
+		if _, ok := g.index[from]; !ok {
+			continue
+		}
+
+		for _, field := range archive.RelationshipFields {
+			if !matches(opts.EdgeTypes, field) {
+				continue
+			}
+			for _, ref := range doc.References(field) {
+				// Wildcard references such as "STR-*" are template placeholders
+				if strings.Contains(ref, "*") {
+					continue
+				}
+
+				edge := Edge{From: from, Type: field, Strength: edgeStrengths[field]}
+				if id, ok := aliases[ref]; ok {
+					// Skip edges to documents excluded by the filters
+					if _, included := g.index[id]; !included {
+						continue
+					}
+					edge.To = id
+				} else {
+					edge.To = ref
+					edge.Dangling = true
+					if _, ok := g.index[ref]; !ok {
+						g.addNode(Node{ID: ref, Type: typeFromID(ref), Dangling: true})
+					}
+				}
+				g.Edges = append(g.Edges, edge)
+			}
+		}
+	}
+
+	return g
+}
+
+// Node returns the node with the given ID
+func (g *Graph) Node(id string) (Node, bool) {
+	i, ok := g.index[id]
+	if !ok {
+		return Node{}, false
+	}
+	return g.Nodes[i], true
+}
+
+// DanglingEdges returns the edges whose target is not a document in the archive
+func (g *Graph) DanglingEdges() []Edge {
+	var edges []Edge
+	for _, edge := range g.Edges {
+		if edge.Dangling {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
+// Orphans returns the IDs of documents that have no relationships at all
+func (g *Graph) Orphans() []string {
+	connected := make(map[string]bool)
+	for _, edge := range g.Edges {
+		connected[edge.From] = true
+		connected[edge.To] = true
+	}
+
+	orphans := []string{}
+	for _, node := range g.Nodes {
+		if !node.Dangling && !connected[node.ID] {
+			orphans = append(orphans, node.ID)
+		}
+	}
+	return orphans
+}
+
+// Cycles returns the dependency cycles in the graph. Each cycle lists the
+// document IDs in order, starting and ending with the same document.
+func (g *Graph) Cycles() [][]string {
+	adjacency := g.dependencies()
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int)
+	var stack []string
+	var cycles [][]string
+
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = visiting
+		stack = append(stack, id)
+
+		for _, next := range adjacency[id] {
+			switch state[next] {
+			case unvisited:
+				visit(next)
+			case visiting:
+				// Found a back edge; extract the cycle from the stack
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i] == next {
+						cycle := append([]string{}, stack[i:]...)
+						cycles = append(cycles, append(cycle, next))
+						break
+					}
+				}
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[id] = done
+	}
+
+	for _, node := range g.Nodes {
+		if state[node.ID] == unvisited {
+			visit(node.ID)
+		}
+	}
+
+	return cycles
+}
+
+// CriticalPath returns the longest chain of dependencies, starting from the
+// most foundational document. Documents that are part of a cycle are ignored.
+func (g *Graph) CriticalPath() []string {
+	adjacency := g.dependencies()
+
+	inCycle := make(map[string]bool)
+	for _, cycle := range g.Cycles() {
+		for _, id := range cycle {
+			inCycle[id] = true
+		}
+	}
+
+	longest := make(map[string][]string)
+	var visit func(id string) []string
+	visit = func(id string) []string {
+		if path, ok := longest[id]; ok {
+			return path
+		}
+		longest[id] = []string{id}
+
+		var best []string
+		for _, next := range adjacency[id] {
+			if inCycle[next] {
+				continue
+			}
+			if path := visit(next); len(path) > len(best) {
+				best = path
+			}
+		}
+
+		// Dependencies come first on the path
+		path := append(append([]string{}, best...), id)
+		longest[id] = path
+		return path
+	}
+
+	var critical []string
+	for _, node := range g.Nodes {
+		if node.Dangling || inCycle[node.ID] {
+			continue
+		}
+		if path := visit(node.ID); len(path) > len(critical) {
+			critical = path
+		}
+	}
+	if len(critical) < 2 {
+		return []string{}
+	}
+	return critical
+}
+
+// DomainMap collapses the graph into one node per domain. Relationships
+// between documents become relationships between their domains; each
+// relationship type is kept once per pair of domains.
+func (g *Graph) DomainMap() *Graph {
+	domains := &Graph{index: make(map[string]int)}
+	counts := make(map[string]int)
+
+	domainOf := make(map[string]string, len(g.Nodes))
+	for _, node := range g.Nodes {
+		id := node.Domain
+		if node.Dangling {
+			id = "missing"
+		} else if id == "" {
+			id = "unclassified"
+		}
+		domainOf[node.ID] = id
+		counts[id]++
+
+		if _, ok := domains.index[id]; !ok {
+			domainNode := Node{ID: id, Domain: node.Domain, Dangling: node.Dangling}
+			if info, ok := bspec.LookupDomain(bspec.BusinessDomain(id)); ok {
+				domainNode.Title = info.DisplayName
+			}
+			domains.addNode(domainNode)
+		}
+	}
+	for i, node := range domains.Nodes {
+		domains.Nodes[i].Type = "domain"
+		if node.Title == "" {
+			domains.Nodes[i].Title = node.ID
+		}
+		domains.Nodes[i].Title = fmt.Sprintf("%s (%d)", domains.Nodes[i].Title, counts[node.ID])
+	}
+
+	seen := make(map[Edge]bool)
+	for _, edge := range g.Edges {
+		from, to := domainOf[edge.From], domainOf[edge.To]
+		if from == to {
+			continue
+		}
+		domainEdge := Edge{From: from, To: to, Type: edge.Type, Strength: edge.Strength, Dangling: edge.Dangling}
+		if !seen[domainEdge] {
+			seen[domainEdge] = true
+			domains.Edges = append(domains.Edges, domainEdge)
+		}
+	}
+
+	return domains
+}
+
+// dependencies returns the depends_on adjacency list between known documents
+func (g *Graph) dependencies() map[string][]string {
+	adjacency := make(map[string][]string)
+	for _, edge := range g.Edges {
+		if edge.Type == "depends_on" && !edge.Dangling {
+			adjacency[edge.From] = append(adjacency[edge.From], edge.To)
+		}
+	}
+	return adjacency
+}
+
+func (g *Graph) addNode(node Node) {
+	g.index[node.ID] = len(g.Nodes)
+	g.Nodes = append(g.Nodes, node)
+}
+
+// documentID returns the document ID, falling back to its file name
+func documentID(path string, doc archive.BSpecDocument) string {
+	if doc.ID != "" {
+		return doc.ID
+	}
+	return strings.TrimSuffix(filepath.Base(path), ".md")
+}
+
+// documentDomain returns the document domain, falling back to its domain
+// folder and then to the domain of its document type
+func documentDomain(path string, doc archive.BSpecDocument) string {
+	if doc.Domain != "" {
+		if domain, ok := bspec.ParseBusinessDomain(doc.Domain); ok {
+			return string(domain)
+		}
+		return strings.ToLower(doc.Domain)
+	}
+	if domain, ok := bspec.ParseBusinessDomain(filepath.Base(filepath.Dir(path))); ok {
+		return string(domain)
+	}
+	if domain, ok := bspec.DomainForType(bspec.DocumentType(strings.ToUpper(doc.Type))); ok {
+		return string(domain)
+	}
+	return ""
+}
+
+// typeFromID returns the type prefix of a document ID such as "RSK-churn"
+func typeFromID(id string) string {
+	if i := strings.Index(id, "-"); i > 0 {
+		return id[:i]
+	}
+	return ""
+}
+
+// matches reports whether value is in the filter list; an empty filter matches everything
+func matches(filter []string, value string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, f := range filter {
+		if strings.EqualFold(f, value) {
+			return true
+		}
+	}
+	return false
+}
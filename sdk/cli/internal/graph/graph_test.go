@@ -0,0 +1,238 @@
+package graph
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+func testArchive() *archive.BSpecArchive {
+	return &archive.BSpecArchive{
+		Documents: map[string]archive.BSpecDocument{
+			"01-strategic/MSN-mission.md": {ID: "MSN-mission", Type: "MSN", Title: "Mission", Status: "Accepted"},
+			"01-strategic/STR-growth.md": {ID: "STR-growth", Type: "STR", Title: "Growth", Status: "Draft",
+				Metadata: map[string]interface{}{
+					"depends_on": []interface{}{"MSN-mission"},
+					"enables":    []interface{}{"PRD-platform", "CAM-launch"},
+				}},
+			"04-product/PRD-platform.md": {ID: "PRD-platform", Type: "PRD", Title: "Platform", Status: "Review", Domain: "product",
+				Metadata: map[string]interface{}{
+					"depends_on": []interface{}{"STR-growth", "STR-*"},
+				}},
+			"09-risk/RSK-churn.md": {ID: "RSK-churn", Type: "RSK", Title: "Churn", Status: "Draft"},
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	g := Build(testArchive(), Options{})
+
+	if len(g.Nodes) != 5 {
+		t.Fatalf("Expected 5 nodes (4 documents, 1 dangling), got %d", len(g.Nodes))
+	}
+	if len(g.Edges) != 4 {
+		t.Fatalf("Expected 4 edges, got %d: %+v", len(g.Edges), g.Edges)
+	}
+
+	node, ok := g.Node("CAM-launch")
+	if !ok || !node.Dangling || node.Type != "CAM" {
+		t.Errorf("Expected dangling CAM-launch node, got %+v", node)
+	}
+	if dangling := g.DanglingEdges(); len(dangling) != 1 || dangling[0].From != "STR-growth" {
+		t.Errorf("Expected one dangling edge from STR-growth, got %+v", dangling)
+	}
+
+	node, _ = g.Node("STR-growth")
+	if node.Domain != "strategic" {
+		t.Errorf("Expected domain derived from folder, got %q", node.Domain)
+	}
+
+	if orphans := g.Orphans(); len(orphans) != 1 || orphans[0] != "RSK-churn" {
+		t.Errorf("Expected RSK-churn to be orphaned, got %v", orphans)
+	}
+
+	path := g.CriticalPath()
+	expected := []string{"MSN-mission", "STR-growth", "PRD-platform"}
+	if strings.Join(path, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected critical path %v, got %v", expected, path)
+	}
+}
+
+func TestBuildFilters(t *testing.T) {
+	g := Build(testArchive(), Options{Domains: []string{"strategic"}})
+	if len(g.Nodes) != 3 {
+		t.Errorf("Expected 2 strategic documents and 1 dangling node, got %+v", g.Nodes)
+	}
+	for _, edge := range g.Edges {
+		if edge.To == "PRD-platform" {
+			t.Error("Expected edges to filtered-out documents to be dropped")
+		}
+	}
+
+	g = Build(testArchive(), Options{EdgeTypes: []string{"depends_on"}})
+	for _, edge := range g.Edges {
+		if edge.Type != "depends_on" {
+			t.Errorf("Expected only depends_on edges, got %s", edge.Type)
+		}
+	}
+
+	g = Build(testArchive(), Options{Types: []string{"rsk"}})
+	if len(g.Nodes) != 1 || g.Nodes[0].ID != "RSK-churn" {
+		t.Errorf("Expected only RSK-churn, got %+v", g.Nodes)
+	}
+}
+
+func TestCycles(t *testing.T) {
+	arch := &archive.BSpecArchive{
+		Documents: map[string]archive.BSpecDocument{
+			"A.md": {ID: "A", Metadata: map[string]interface{}{"depends_on": []interface{}{"B"}}},
+			"B.md": {ID: "B", Metadata: map[string]interface{}{"depends_on": []interface{}{"C"}}},
+			"C.md": {ID: "C", Metadata: map[string]interface{}{"depends_on": []interface{}{"A"}}},
+		},
+	}
+
+	cycles := Build(arch, Options{}).Cycles()
+	if len(cycles) != 1 {
+		t.Fatalf("Expected 1 cycle, got %v", cycles)
+	}
+	if strings.Join(cycles[0], "->") != "A->B->C->A" {
+		t.Errorf("Expected cycle A->B->C->A, got %v", cycles[0])
+	}
+}
+
+func TestWriteFormats(t *testing.T) {
+	g := Build(testArchive(), Options{})
+
+	var dot strings.Builder
+	if err := WriteDOT(&dot, g); err != nil {
+		t.Fatalf("WriteDOT failed: %v", err)
+	}
+	for _, expected := range []string{
+		"digraph bspec {",
+		`"STR-growth" -> "MSN-mission" [label="depends_on"];`,
+		`fillcolor="` + StatusColor("Accepted") + `"`,
+		"style=dashed",
+	} {
+		if !strings.Contains(dot.String(), expected) {
+			t.Errorf("Expected DOT output to contain %q, got:\n%s", expected, dot.String())
+		}
+	}
+
+	var mermaid strings.Builder
+	if err := WriteMermaid(&mermaid, g); err != nil {
+		t.Fatalf("WriteMermaid failed: %v", err)
+	}
+	for _, expected := range []string{"flowchart LR", "-->|depends_on|", "-.->|enables|", "classDef dangling", "classDef status_draft"} {
+		if !strings.Contains(mermaid.String(), expected) {
+			t.Errorf("Expected Mermaid output to contain %q, got:\n%s", expected, mermaid.String())
+		}
+	}
+
+	var graphml strings.Builder
+	if err := WriteGraphML(&graphml, g); err != nil {
+		t.Fatalf("WriteGraphML failed: %v", err)
+	}
+	var decoded graphML
+	if err := xml.Unmarshal([]byte(graphml.String()), &decoded); err != nil {
+		t.Fatalf("Expected valid GraphML, got error: %v", err)
+	}
+	if len(decoded.Graph.Nodes) != 5 || len(decoded.Graph.Edges) != 4 {
+		t.Errorf("Expected 5 nodes and 4 edges in GraphML, got %d and %d", len(decoded.Graph.Nodes), len(decoded.Graph.Edges))
+	}
+
+	var jsonOut strings.Builder
+	if err := Write(&jsonOut, g, "json"); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	var doc Document
+	if err := json.Unmarshal([]byte(jsonOut.String()), &doc); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+	if doc.GraphVersion != "1.0.0" || doc.Analysis.TotalNodes != 5 || doc.Analysis.TotalEdges != 4 {
+		t.Errorf("Unexpected graph document: %+v", doc)
+	}
+
+	if err := Write(&jsonOut, g, "png"); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+}
+
+func TestDomainMap(t *testing.T) {
+	domains := Build(testArchive(), Options{}).DomainMap()
+
+	node, ok := domains.Node("strategic")
+	if !ok {
+		t.Fatalf("Expected strategic domain node, got %+v", domains.Nodes)
+	}
+	if node.Title != "Strategic Foundation (2)" {
+		t.Errorf("Expected title with document count, got %q", node.Title)
+	}
+	if _, ok := domains.Node("missing"); !ok {
+		t.Error("Expected missing documents to be grouped")
+	}
+
+	found := false
+	for _, edge := range domains.Edges {
+		if edge.From == edge.To {
+			t.Errorf("Expected relationships within a domain to be dropped, got %+v", edge)
+		}
+		if edge.From == "product" && edge.To == "strategic" && edge.Type == "depends_on" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected product to depend on strategic, got %+v", domains.Edges)
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	var svg strings.Builder
+	if err := Write(&svg, Build(testArchive(), Options{}), FormatSVG); err != nil {
+		t.Fatalf("WriteSVG failed: %v", err)
+	}
+
+	var decoded struct {
+		XMLName xml.Name `xml:"svg"`
+	}
+	if err := xml.Unmarshal([]byte(svg.String()), &decoded); err != nil {
+		t.Fatalf("Expected well-formed SVG, got error: %v", err)
+	}
+
+	out := svg.String()
+	for _, expected := range []string{
+		"🎯 Strategic Foundation",
+		"📦 Product &amp; Service",
+		"⚠️ Risk &amp; Governance",
+		"Missing",
+		"🎯 MSN-mission",
+		`marker-end="url(#arrow-depends_on)"`,
+		`stroke-dasharray="5 3"`,
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("Expected SVG to contain %q", expected)
+		}
+	}
+
+	// Columns follow domain order: strategic before product before risk
+	strategic := strings.Index(out, "Strategic Foundation")
+	product := strings.Index(out, "Product &amp; Service")
+	risk := strings.Index(out, "Risk &amp; Governance")
+	if !(strategic < product && product < risk) {
+		t.Error("Expected columns in domain order")
+	}
+
+	// Nodes carry the emoji of their own domain, not of their type's default
+	arch := &archive.BSpecArchive{Documents: map[string]archive.BSpecDocument{
+		"07-technology/RSK-lockin.md": {ID: "RSK-lockin", Type: "RSK", Domain: "technology"},
+	}}
+	svg.Reset()
+	if err := Write(&svg, Build(arch, Options{}), FormatSVG); err != nil {
+		t.Fatalf("WriteSVG failed: %v", err)
+	}
+	if !strings.Contains(svg.String(), "🔧 RSK-lockin") {
+		t.Errorf("Expected the technology emoji on RSK-lockin, got:\n%s", svg.String())
+	}
+}
@@ -0,0 +1,320 @@
+package graph
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"sort"
+	"strings"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+// FormatSVG renders the graph as a laid-out SVG image without external tools
+const FormatSVG = "svg"
+
+// SVG layout dimensions
+const (
+	svgMargin       = 24
+	svgHeaderHeight = 44
+	svgColumnWidth  = 240
+	svgNodeWidth    = 190
+	svgNodeHeight   = 46
+	svgNodeGap      = 18
+	svgLegendHeight = 40
+	svgTitleLength  = 26
+)
+
+// edgeStyle describes how a relationship type is drawn
+type edgeStyle struct {
+	Color string
+	Dash  string
+}
+
+// edgeStyles maps relationship types to stroke styles
+var edgeStyles = map[string]edgeStyle{
+	"depends_on":     {Color: "#37474f"},
+	"enables":        {Color: "#2e7d32"},
+	"conflicts_with": {Color: "#c62828", Dash: "6 3"},
+	"related":        {Color: "#9e9e9e", Dash: "2 3"},
+	"parent":         {Color: "#1565c0"},
+	"supersedes":     {Color: "#6a1b9a", Dash: "8 3 2 3"},
+	"risks":          {Color: "#ef6c00"},
+	"metrics":        {Color: "#00838f", Dash: "4 2"},
+}
+
+// svgColumn is a layer of the layout holding the nodes of one domain
+type svgColumn struct {
+	Label string
+	Nodes []Node
+}
+
+// svgPoint is the position of a laid-out node
+type svgPoint struct {
+	Column int
+	X, Y   int
+}
+
+// WriteSVG writes the graph as an SVG image. Nodes are laid out in one column
+// per domain, in archive folder order (01-strategic through 11-learning),
+// followed by unclassified documents and references to missing documents.
+func WriteSVG(w io.Writer, g *Graph) error {
+	columns := svgColumns(g)
+
+	positions := make(map[string]svgPoint, len(g.Nodes))
+	rows := 0
+	for c, column := range columns {
+		for r, node := range column.Nodes {
+			positions[node.ID] = svgPoint{
+				Column: c,
+				X:      svgMargin + c*svgColumnWidth,
+				Y:      svgMargin + svgHeaderHeight + r*(svgNodeHeight+svgNodeGap),
+			}
+		}
+		if len(column.Nodes) > rows {
+			rows = len(column.Nodes)
+		}
+	}
+
+	edgeTypes := usedEdgeTypes(g)
+
+	width := 2*svgMargin + len(columns)*svgColumnWidth - (svgColumnWidth - svgNodeWidth)
+	if legend := 2*svgMargin + len(edgeTypes)*130; legend > width {
+		width = legend
+	}
+	if width < 2*svgMargin+svgNodeWidth {
+		width = 2*svgMargin + svgNodeWidth
+	}
+	height := 2*svgMargin + svgHeaderHeight + rows*(svgNodeHeight+svgNodeGap) + svgLegendHeight
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="Helvetica, Arial, sans-serif">`+"\n",
+		width, height, width, height)
+
+	// Arrow markers, one per relationship type
+	b.WriteString("  <defs>\n")
+	for _, edgeType := range edgeTypes {
+		fmt.Fprintf(&b, `    <marker id="arrow-%s" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="7" markerHeight="7" orient="auto-start-reverse"><path d="M0,0 L10,5 L0,10 z" fill="%s"/></marker>`+"\n",
+			edgeType, edgeStyleFor(edgeType).Color)
+	}
+	b.WriteString("  </defs>\n")
+	fmt.Fprintf(&b, `  <rect width="%d" height="%d" fill="#ffffff"/>`+"\n", width, height)
+
+	// Column headers
+	for c, column := range columns {
+		x := svgMargin + c*svgColumnWidth + svgNodeWidth/2
+		fmt.Fprintf(&b, `  <text x="%d" y="%d" text-anchor="middle" font-size="14" font-weight="bold" fill="#263238">%s</text>`+"\n",
+			x, svgMargin+18, html.EscapeString(column.Label))
+	}
+
+	// Edges are drawn below the nodes
+	b.WriteString(`  <g fill="none" stroke-width="1.5">` + "\n")
+	for _, edge := range g.Edges {
+		from, ok := positions[edge.From]
+		if !ok {
+			continue
+		}
+		to, ok := positions[edge.To]
+		if !ok {
+			continue
+		}
+		style := edgeStyleFor(edge.Type)
+		dash := ""
+		if style.Dash != "" {
+			dash = fmt.Sprintf(` stroke-dasharray="%s"`, style.Dash)
+		}
+		fmt.Fprintf(&b, `    <path d="%s" stroke="%s"%s marker-end="url(#arrow-%s)"><title>%s</title></path>`+"\n",
+			svgEdgePath(from, to), style.Color, dash, edge.Type,
+			html.EscapeString(fmt.Sprintf("%s %s %s", edge.From, edge.Type, edge.To)))
+	}
+	b.WriteString("  </g>\n")
+
+	// Nodes
+	for _, column := range columns {
+		for _, node := range column.Nodes {
+			p := positions[node.ID]
+			fill, stroke, dash := StatusColor(node.Status), "#546e7a", ""
+			if node.Dangling {
+				fill, stroke, dash = defaultColor, danglingColor, ` stroke-dasharray="5 3"`
+			}
+
+			title := node.Title
+			if node.Dangling {
+				title = "missing"
+			}
+
+			fmt.Fprintf(&b, `  <g><title>%s</title>`+"\n", html.EscapeString(nodeTooltip(node)))
+			fmt.Fprintf(&b, `    <rect x="%d" y="%d" width="%d" height="%d" rx="6" fill="%s" stroke="%s"%s/>`+"\n",
+				p.X, p.Y, svgNodeWidth, svgNodeHeight, fill, stroke, dash)
+			fmt.Fprintf(&b, `    <text x="%d" y="%d" font-size="12" font-weight="bold" fill="#212121">%s</text>`+"\n",
+				p.X+8, p.Y+18, html.EscapeString(strings.TrimSpace(nodeEmoji(node)+" "+node.ID)))
+			if title != "" {
+				fmt.Fprintf(&b, `    <text x="%d" y="%d" font-size="11" fill="#455a64">%s</text>`+"\n",
+					p.X+8, p.Y+35, html.EscapeString(truncateLabel(title, svgTitleLength)))
+			}
+			b.WriteString("  </g>\n")
+		}
+	}
+
+	// Legend of relationship types
+	legendY := height - svgMargin - 10
+	for i, edgeType := range edgeTypes {
+		style := edgeStyleFor(edgeType)
+		x := svgMargin + i*130
+		dash := ""
+		if style.Dash != "" {
+			dash = fmt.Sprintf(` stroke-dasharray="%s"`, style.Dash)
+		}
+		fmt.Fprintf(&b, `  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="%s" stroke-width="2"%s/>`+"\n",
+			x, legendY, x+28, legendY, style.Color, dash)
+		fmt.Fprintf(&b, `  <text x="%d" y="%d" font-size="11" fill="#37474f">%s</text>`+"\n",
+			x+34, legendY+4, edgeType)
+	}
+
+	b.WriteString("</svg>\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// svgColumns groups the nodes into columns by domain order
+func svgColumns(g *Graph) []svgColumn {
+	byDomain := make(map[string][]Node)
+	var unclassified, missing []Node
+	for _, node := range g.Nodes {
+		switch {
+		case node.Dangling:
+			missing = append(missing, node)
+		case node.Type == "domain":
+			// Domain map nodes are laid out one per column like documents
+			byDomain[node.ID] = append(byDomain[node.ID], node)
+		default:
+			if _, ok := bspec.LookupDomain(bspec.BusinessDomain(node.Domain)); ok {
+				byDomain[node.Domain] = append(byDomain[node.Domain], node)
+			} else {
+				unclassified = append(unclassified, node)
+			}
+		}
+	}
+
+	var columns []svgColumn
+	for _, info := range bspec.Domains() {
+		nodes := byDomain[string(info.Domain)]
+		if len(nodes) == 0 {
+			continue
+		}
+		sortNodes(nodes)
+		columns = append(columns, svgColumn{Label: info.Emoji + " " + info.DisplayName, Nodes: nodes})
+	}
+	if nodes := append(byDomain["unclassified"], unclassified...); len(nodes) > 0 {
+		sortNodes(nodes)
+		columns = append(columns, svgColumn{Label: "Unclassified", Nodes: nodes})
+	}
+	if len(missing) > 0 {
+		sortNodes(missing)
+		columns = append(columns, svgColumn{Label: "Missing", Nodes: missing})
+	}
+	return columns
+}
+
+// svgEdgePath returns a curved path between two laid-out nodes
+func svgEdgePath(from, to svgPoint) string {
+	fromY := from.Y + svgNodeHeight/2
+	toY := to.Y + svgNodeHeight/2
+
+	switch {
+	case to.Column > from.Column:
+		x1, x2 := from.X+svgNodeWidth, to.X
+		mid := (x1 + x2) / 2
+		return fmt.Sprintf("M%d,%d C%d,%d %d,%d %d,%d", x1, fromY, mid, fromY, mid, toY, x2, toY)
+	case to.Column < from.Column:
+		x1, x2 := from.X, to.X+svgNodeWidth
+		mid := (x1 + x2) / 2
+		return fmt.Sprintf("M%d,%d C%d,%d %d,%d %d,%d", x1, fromY, mid, fromY, mid, toY, x2, toY)
+	default:
+		// Same column: loop out to the right of the column
+		x := from.X + svgNodeWidth
+		bulge := x + 30 + abs(toY-fromY)/6
+		return fmt.Sprintf("M%d,%d C%d,%d %d,%d %d,%d", x, fromY, bulge, fromY, bulge, toY, x, toY)
+	}
+}
+
+// usedEdgeTypes returns the relationship types present in the graph in a stable order
+func usedEdgeTypes(g *Graph) []string {
+	seen := make(map[string]bool)
+	for _, edge := range g.Edges {
+		seen[edge.Type] = true
+	}
+	var types []string
+	for _, field := range archive.RelationshipFields {
+		if seen[field] {
+			types = append(types, field)
+			delete(seen, field)
+		}
+	}
+	var other []string
+	for edgeType := range seen {
+		other = append(other, edgeType)
+	}
+	sort.Strings(other)
+	return append(types, other...)
+}
+
+func edgeStyleFor(edgeType string) edgeStyle {
+	if style, ok := edgeStyles[edgeType]; ok {
+		return style
+	}
+	return edgeStyle{Color: "#616161"}
+}
+
+// nodeEmoji returns the emoji of the node's domain, shown in front of its
+// label. The specification defines emoji per domain, not per document type.
+func nodeEmoji(node Node) string {
+	domain := node.Domain
+	if node.Type == "domain" {
+		domain = node.ID
+	}
+	info, _ := bspec.LookupDomain(bspec.BusinessDomain(domain))
+	return info.Emoji
+}
+
+// nodeTooltip returns the hover text of a node
+func nodeTooltip(node Node) string {
+	if node.Dangling {
+		return node.ID + " (missing from archive)"
+	}
+	parts := []string{node.ID}
+	if node.Title != "" {
+		parts = append(parts, node.Title)
+	}
+	if node.Status != "" {
+		parts = append(parts, "Status: "+node.Status)
+	}
+	return strings.Join(parts, "\n")
+}
+
+func sortNodes(nodes []Node) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].Type != nodes[j].Type {
+			return nodes[i].Type < nodes[j].Type
+		}
+		return nodes[i].ID < nodes[j].ID
+	})
+}
+
+func truncateLabel(s string, length int) string {
+	runes := []rune(s)
+	if len(runes) <= length {
+		return s
+	}
+	return string(runes[:length-1]) + "…"
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,225 @@
+package integrity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/graph"
+)
+
+// Severity levels of integrity issues
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Issue codes reported by the checker
+const (
+	CodeDanglingReference       = "dangling_reference"
+	CodeCircularDependency      = "circular_dependency"
+	CodeAcceptedConflict        = "accepted_conflict"
+	CodeSupersededNotDeprecated = "superseded_not_deprecated"
+	CodeMissingBackReference    = "missing_back_reference"
+)
+
+// backReferences pairs the document types that must reference each other:
+// a risk and the mitigation that addresses it
+var backReferences = map[string]string{"RSK": "MIT", "MIT": "RSK"}
+
+// Issue is a relationship problem found in an archive
+type Issue struct {
+	Code     string `json:"code"`
+	Severity string `json:"severity"`
+	Document string `json:"document"`
+	Path     string `json:"path,omitempty"` // Document file, relative to documents/
+	Field    string `json:"field,omitempty"`
+	Target   string `json:"target,omitempty"`
+	Line     int    `json:"line,omitempty"` // Line of the document file
+	Message  string `json:"message"`
+	Fix      string `json:"fix,omitempty"` // Suggested fix
+}
+
+// Report is the result of checking an archive
+type Report struct {
+	Documents             int        `json:"documents"`
+	Relationships         int        `json:"relationships"`
+	RelationshipIntegrity bool       `json:"relationship_integrity"`
+	CircularDependencies  bool       `json:"circular_dependencies"`
+	Cycles                [][]string `json:"cycles"`
+	Issues                []Issue    `json:"issues"`
+}
+
+// Check finds references that resolve to no document, dependency cycles,
+// conflicting documents that are both Accepted and superseded documents that
+// are not Deprecated.
+func Check(arch *archive.BSpecArchive) *Report {
+	g := graph.Build(arch, graph.Options{})
+
+	report := &Report{
+		Relationships: len(g.Edges),
+		Cycles:        g.Cycles(),
+		Issues:        []Issue{},
+	}
+	for _, node := range g.Nodes {
+		if !node.Dangling {
+			report.Documents++
+		}
+	}
+	if report.Cycles == nil {
+		report.Cycles = [][]string{}
+	}
+
+	for _, edge := range g.DanglingEdges() {
+		report.Issues = append(report.Issues, Issue{
+			Code:     CodeDanglingReference,
+			Severity: SeverityError,
+			Document: edge.From,
+			Field:    edge.Type,
+			Target:   edge.To,
+			Message:  fmt.Sprintf("%s references %s in %s, but no such document exists", edge.From, edge.To, edge.Type),
+		})
+	}
+
+	for _, cycle := range report.Cycles {
+		report.Issues = append(report.Issues, Issue{
+			Code:     CodeCircularDependency,
+			Severity: SeverityError,
+			Document: cycle[0],
+			Field:    "depends_on",
+			Message:  "circular dependency: " + strings.Join(cycle, " -> "),
+		})
+	}
+
+	references := make(map[string]bool)
+	for _, edge := range g.Edges {
+		references[edge.From+"\x00"+edge.To] = true
+	}
+
+	conflicts := make(map[string]bool)
+	backReported := make(map[string]bool)
+	for _, edge := range g.Edges {
+		if edge.Dangling {
+			continue
+		}
+		from, _ := g.Node(edge.From)
+		to, _ := g.Node(edge.To)
+
+		key := edge.To + "\x00" + edge.From
+		if backReferences[from.Type] == to.Type && !references[key] && !backReported[key] {
+			backReported[key] = true
+			report.Issues = append(report.Issues, Issue{
+				Code:     CodeMissingBackReference,
+				Severity: SeverityWarning,
+				Document: edge.To,
+				Field:    "related",
+				Target:   edge.From,
+				Message:  fmt.Sprintf("%s references %s in %s, but %s does not reference %s", edge.From, edge.To, edge.Type, edge.To, edge.From),
+				Fix:      fmt.Sprintf("add %s to related", edge.From),
+			})
+		}
+
+		switch edge.Type {
+		case "conflicts_with":
+			// Report each pair once, whichever side declares the conflict
+			pair := []string{edge.From, edge.To}
+			sort.Strings(pair)
+			key := pair[0] + "\x00" + pair[1]
+			if conflicts[key] || !isStatus(from, "Accepted") || !isStatus(to, "Accepted") {
+				continue
+			}
+			conflicts[key] = true
+			report.Issues = append(report.Issues, Issue{
+				Code:     CodeAcceptedConflict,
+				Severity: SeverityWarning,
+				Document: edge.From,
+				Field:    edge.Type,
+				Target:   edge.To,
+				Message:  fmt.Sprintf("%s and %s conflict with each other but are both Accepted", edge.From, edge.To),
+			})
+		case "supersedes":
+			if isStatus(to, "Deprecated") {
+				continue
+			}
+			report.Issues = append(report.Issues, Issue{
+				Code:     CodeSupersededNotDeprecated,
+				Severity: SeverityWarning,
+				Document: edge.To,
+				Field:    edge.Type,
+				Target:   edge.From,
+				Message:  fmt.Sprintf("%s is superseded by %s but its status is %s, not Deprecated", edge.To, edge.From, statusOrUnset(to)),
+			})
+		}
+	}
+
+	report.RelationshipIntegrity = len(report.Filter(CodeDanglingReference)) == 0
+	report.CircularDependencies = len(report.Cycles) > 0
+
+	return report
+}
+
+// Errors returns the issues with error severity
+func (r *Report) Errors() []Issue {
+	return r.bySeverity(SeverityError)
+}
+
+// Warnings returns the issues with warning severity
+func (r *Report) Warnings() []Issue {
+	return r.bySeverity(SeverityWarning)
+}
+
+// Filter returns the issues with the given code
+func (r *Report) Filter(code string) []Issue {
+	var issues []Issue
+	for _, issue := range r.Issues {
+		if issue.Code == code {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// Validation returns the manifest validation fields computed from the report.
+// Fields the checker does not compute are copied from existing, if set.
+func (r *Report) Validation(existing *archive.Validation) *archive.Validation {
+	validation := &archive.Validation{}
+	if existing != nil {
+		validation.SchemaCompliance = existing.SchemaCompliance
+		validation.AssetReferences = existing.AssetReferences
+	}
+	validation.RelationshipIntegrity = r.RelationshipIntegrity
+	validation.CircularDependencies = r.CircularDependencies
+	validation.Warnings = messages(r.Warnings())
+	validation.Errors = messages(r.Errors())
+	return validation
+}
+
+func (r *Report) bySeverity(severity string) []Issue {
+	var issues []Issue
+	for _, issue := range r.Issues {
+		if issue.Severity == severity {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+func messages(issues []Issue) []string {
+	result := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		result = append(result, issue.Message)
+	}
+	return result
+}
+
+func isStatus(node graph.Node, status string) bool {
+	return strings.EqualFold(node.Status, status)
+}
+
+func statusOrUnset(node graph.Node) string {
+	if node.Status == "" {
+		return "unset"
+	}
+	return node.Status
+}
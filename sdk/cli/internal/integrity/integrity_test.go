@@ -0,0 +1,121 @@
+package integrity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+func testArchive() *archive.BSpecArchive {
+	return &archive.BSpecArchive{
+		Documents: map[string]archive.BSpecDocument{
+			"01-strategic/STR-a.md": {ID: "STR-a", Type: "STR", Status: "Accepted",
+				Metadata: map[string]interface{}{
+					"depends_on":     []interface{}{"STR-b"},
+					"conflicts_with": []interface{}{"STR-b"},
+					"metrics":        []interface{}{"MET-missing"},
+				}},
+			"01-strategic/STR-b.md": {ID: "STR-b", Type: "STR", Status: "Accepted",
+				Metadata: map[string]interface{}{
+					"depends_on":     []interface{}{"STR-a"},
+					"conflicts_with": []interface{}{"STR-a"},
+				}},
+			"01-strategic/STR-c.md": {ID: "STR-c", Type: "STR", Status: "Draft",
+				Metadata: map[string]interface{}{
+					"supersedes": []interface{}{"STR-old", "STR-retired"},
+				}},
+			"01-strategic/STR-old.md":     {ID: "STR-old", Type: "STR", Status: "Accepted"},
+			"01-strategic/STR-retired.md": {ID: "STR-retired", Type: "STR", Status: "Deprecated"},
+		},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	report := Check(testArchive())
+
+	if report.Documents != 5 {
+		t.Errorf("Expected 5 documents, got %d", report.Documents)
+	}
+	if report.RelationshipIntegrity {
+		t.Error("Expected relationship integrity to fail")
+	}
+	if !report.CircularDependencies || len(report.Cycles) != 1 {
+		t.Errorf("Expected one dependency cycle, got %v", report.Cycles)
+	}
+
+	dangling := report.Filter(CodeDanglingReference)
+	if len(dangling) != 1 || dangling[0].Target != "MET-missing" || dangling[0].Field != "metrics" {
+		t.Errorf("Unexpected dangling references: %+v", dangling)
+	}
+
+	conflicts := report.Filter(CodeAcceptedConflict)
+	if len(conflicts) != 1 {
+		t.Errorf("Expected the accepted conflict to be reported once, got %+v", conflicts)
+	}
+
+	superseded := report.Filter(CodeSupersededNotDeprecated)
+	if len(superseded) != 1 || superseded[0].Document != "STR-old" {
+		t.Errorf("Expected only STR-old to be reported as superseded, got %+v", superseded)
+	}
+
+	if len(report.Errors()) != 2 || len(report.Warnings()) != 2 {
+		t.Errorf("Expected 2 errors and 2 warnings, got %d and %d", len(report.Errors()), len(report.Warnings()))
+	}
+}
+
+func TestCheckClean(t *testing.T) {
+	arch := &archive.BSpecArchive{
+		Documents: map[string]archive.BSpecDocument{
+			"01-strategic/MSN-mission.md": {ID: "MSN-mission", Type: "MSN", Status: "Accepted"},
+			"01-strategic/STR-growth.md": {ID: "STR-growth", Type: "STR", Status: "Draft",
+				Metadata: map[string]interface{}{"depends_on": []interface{}{"MSN-mission"}}},
+		},
+	}
+
+	report := Check(arch)
+	if !report.RelationshipIntegrity || report.CircularDependencies || len(report.Issues) != 0 {
+		t.Errorf("Expected a clean report, got %+v", report)
+	}
+}
+
+func TestValidation(t *testing.T) {
+	report := Check(testArchive())
+	validation := report.Validation(&archive.Validation{SchemaCompliance: true, RelationshipIntegrity: true})
+
+	if !validation.SchemaCompliance {
+		t.Error("Expected schema_compliance to be preserved")
+	}
+	if validation.RelationshipIntegrity || !validation.CircularDependencies {
+		t.Errorf("Expected computed fields, got %+v", validation)
+	}
+	if len(validation.Errors) != 2 || !strings.Contains(validation.Errors[0], "MET-missing") {
+		t.Errorf("Unexpected errors: %v", validation.Errors)
+	}
+}
+
+func TestCheckBackReferences(t *testing.T) {
+	arch := &archive.BSpecArchive{
+		Documents: map[string]archive.BSpecDocument{
+			"09-risk/RSK-churn.md": {ID: "RSK-churn", Type: "RSK"},
+			"09-risk/MIT-retention.md": {ID: "MIT-retention", Type: "MIT",
+				Metadata: map[string]interface{}{"risks": []interface{}{"RSK-churn"}, "related": []interface{}{"RSK-churn"}}},
+			"09-risk/RSK-fraud.md": {ID: "RSK-fraud", Type: "RSK",
+				Metadata: map[string]interface{}{"related": []interface{}{"MIT-review"}}},
+			"09-risk/MIT-review.md": {ID: "MIT-review", Type: "MIT",
+				Metadata: map[string]interface{}{"risks": []interface{}{"RSK-fraud"}}},
+		},
+	}
+
+	issues := Check(arch).Filter(CodeMissingBackReference)
+	if len(issues) != 1 {
+		t.Fatalf("Expected one missing back-reference, got %+v", issues)
+	}
+	issue := issues[0]
+	if issue.Document != "RSK-churn" || issue.Target != "MIT-retention" || issue.Field != "related" || issue.Severity != SeverityWarning {
+		t.Errorf("Unexpected issue: %+v", issue)
+	}
+	if issue.Fix != "add MIT-retention to related" {
+		t.Errorf("Expected a fix suggestion, got %q", issue.Fix)
+	}
+}
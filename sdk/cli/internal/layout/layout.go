@@ -0,0 +1,378 @@
+package layout
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/rename"
+)
+
+// DocumentsDir is the folder of the documents inside an archive
+const DocumentsDir = "documents"
+
+// Issue codes
+const (
+	CodeWrongFolder   = "wrong_folder"
+	CodeWrongFileName = "wrong_file_name"
+	CodeUnplaceable   = "unplaceable"
+)
+
+var versionPattern = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+
+// Issue is a document file that does not follow the standard layout
+type Issue struct {
+	Code     string `json:"code"`
+	Path     string `json:"path"`               // Relative to the archive directory
+	Expected string `json:"expected,omitempty"` // Where the document belongs
+	Document string `json:"document,omitempty"`
+	Message  string `json:"message"`
+}
+
+// Plan moves the documents of an archive directory into the standard layout
+type Plan struct {
+	Dir     string
+	Changes []rename.Change // Moved documents and documents whose links are rewritten
+	Skipped []Issue         // Documents that cannot be moved
+}
+
+// Folders returns the standard document folders relative to the archive
+// directory, from documents/01-strategic to documents/11-learning
+func Folders() []string {
+	var folders []string
+	seen := make(map[string]bool)
+	for _, domain := range bspec.Domains() {
+		if domain.Folder != "" && !seen[domain.Folder] {
+			seen[domain.Folder] = true
+			folders = append(folders, path.Join(DocumentsDir, domain.Folder))
+		}
+	}
+	return folders
+}
+
+// ExpectedPath returns where a document belongs relative to the archive
+// directory: documents/{NN-domain}/{TYPE}-{kebab-name}-v{version}.md
+func ExpectedPath(doc archive.BSpecDocument) (string, error) {
+	docType := strings.ToUpper(strings.TrimSpace(doc.Type))
+	if docType == "" {
+		return "", fmt.Errorf("document has no type")
+	}
+	if doc.ID == "" {
+		return "", fmt.Errorf("document has no id")
+	}
+	if !versionPattern.MatchString(doc.Version) {
+		return "", fmt.Errorf("version %q is not a semantic version", doc.Version)
+	}
+
+	base := &bspec.BaseBSpecDocument{Type: bspec.DocumentType(docType)}
+	if domain, ok := bspec.ParseBusinessDomain(doc.Domain); ok {
+		base.Domain = &domain
+	}
+	if base.GetDomain() == "" {
+		return "", fmt.Errorf("unknown document type %s", docType)
+	}
+	info, ok := bspec.LookupDomain(base.GetDomain())
+	if !ok {
+		return "", fmt.Errorf("unknown domain %q", base.GetDomain())
+	}
+	if info.Folder == "" {
+		return "", fmt.Errorf("the .bspec format defines no folder for %s documents", info.DisplayName)
+	}
+
+	// The kebab name is the ID without its type prefix
+	name := doc.ID
+	if len(name) > len(docType) && strings.EqualFold(name[:len(docType)+1], docType+"-") {
+		name = name[len(docType)+1:]
+	}
+	id := bspec.DocumentID(bspec.DocumentType(docType), name)
+	return path.Join(DocumentsDir, info.Folder, bspec.DocumentFileName(id, doc.Version)), nil
+}
+
+// Check compares the document paths of an archive with the standard layout
+func Check(arch *archive.BSpecArchive) []Issue {
+	keys := make([]string, 0, len(arch.Documents))
+	for key := range arch.Documents {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var issues []Issue
+	for _, key := range keys {
+		doc := arch.Documents[key]
+		issues = append(issues, checkDocument(path.Join(DocumentsDir, key), doc)...)
+	}
+	return issues
+}
+
+// checkDocument compares the path of a document with where it belongs
+func checkDocument(relPath string, doc archive.BSpecDocument) []Issue {
+	expected, err := ExpectedPath(doc)
+	if err != nil {
+		return []Issue{{
+			Code:     CodeUnplaceable,
+			Path:     relPath,
+			Document: doc.ID,
+			Message:  fmt.Sprintf("%s cannot be placed: %v", relPath, err),
+		}}
+	}
+
+	var issues []Issue
+	if path.Dir(relPath) != path.Dir(expected) {
+		issues = append(issues, Issue{
+			Code:     CodeWrongFolder,
+			Path:     relPath,
+			Expected: expected,
+			Document: doc.ID,
+			Message:  fmt.Sprintf("%s belongs in %s/", relPath, path.Dir(expected)),
+		})
+	}
+	if path.Base(relPath) != path.Base(expected) {
+		issues = append(issues, Issue{
+			Code:     CodeWrongFileName,
+			Path:     relPath,
+			Expected: expected,
+			Document: doc.ID,
+			Message:  fmt.Sprintf("%s should be named %s", relPath, path.Base(expected)),
+		})
+	}
+	return issues
+}
+
+// NewPlan computes the moves that organize an archive directory, and the
+// relative markdown links to rewrite so that links to assets and other
+// documents keep working. Documents that cannot be placed, or whose target
+// is taken, are skipped.
+func NewPlan(dir string) (*Plan, error) {
+	files, err := readFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{Dir: dir}
+	existing := make(map[string]bool, len(files))
+	for _, file := range files {
+		existing[file.path] = true
+	}
+
+	// Decide the moves
+	moves := make(map[string]string)
+	taken := make(map[string]string)
+	for _, file := range files {
+		if file.doc == nil {
+			plan.Skipped = append(plan.Skipped, Issue{
+				Code:    CodeUnplaceable,
+				Path:    file.path,
+				Message: fmt.Sprintf("%s cannot be placed: %v", file.path, file.err),
+			})
+			continue
+		}
+		issues := checkDocument(file.path, *file.doc)
+		if len(issues) == 0 {
+			continue
+		}
+		if issues[0].Code == CodeUnplaceable {
+			plan.Skipped = append(plan.Skipped, issues[0])
+			continue
+		}
+
+		target := issues[0].Expected
+		skip := ""
+		switch {
+		case existing[target]:
+			skip = fmt.Sprintf("%s cannot be moved: %s already exists", file.path, target)
+		case taken[target] != "":
+			skip = fmt.Sprintf("%s cannot be moved: %s is also the target of %s", file.path, target, taken[target])
+		}
+		if skip != "" {
+			plan.Skipped = append(plan.Skipped, Issue{Code: issues[0].Code, Path: file.path, Expected: target, Document: file.doc.ID, Message: skip})
+			continue
+		}
+		moves[file.path] = target
+		taken[target] = file.path
+	}
+
+	plan.Changes = relinkFiles(files, moves)
+	return plan, nil
+}
+
+// MovePlan computes the moves of documents of an archive directory, by path
+// relative to it, and the relative markdown links to rewrite so that links to
+// assets and other documents keep working
+func MovePlan(dir string, moves map[string]string) (*Plan, error) {
+	files, err := readFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool, len(files))
+	for _, file := range files {
+		existing[file.path] = true
+	}
+	for from, to := range moves {
+		if !existing[from] {
+			return nil, fmt.Errorf("document not found: %s", from)
+		}
+		if existing[to] {
+			return nil, fmt.Errorf("cannot move %s: %s already exists", from, to)
+		}
+	}
+	return &Plan{Dir: dir, Changes: relinkFiles(files, moves)}, nil
+}
+
+// relinkFiles computes the change of each moved file and of each file whose
+// links to moved files are rewritten
+func relinkFiles(files []file, moves map[string]string) []rename.Change {
+	var changes []rename.Change
+	for _, file := range files {
+		newPath := file.path
+		if target, ok := moves[file.path]; ok {
+			newPath = target
+		}
+
+		lines := strings.Split(string(file.content), "\n")
+		before := append([]string{}, lines...)
+		rename.RewriteLinks(lines[bodyStart(lines):], func(target string) string {
+			return relink(target, file.path, newPath, moves)
+		})
+
+		change := rename.Change{Path: file.path, NewPath: newPath, Before: file.content, After: file.content}
+		for i := range lines {
+			if lines[i] != before[i] {
+				change.Lines = append(change.Lines, rename.LineChange{Line: i + 1, Before: before[i], After: lines[i]})
+			}
+		}
+		if len(change.Lines) == 0 && !change.Renamed() {
+			continue
+		}
+		if len(change.Lines) > 0 {
+			change.After = []byte(strings.Join(lines, "\n"))
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// Moves returns the number of documents the plan moves or renames
+func (p *Plan) Moves() int {
+	count := 0
+	for _, change := range p.Changes {
+		if change.Renamed() {
+			count++
+		}
+	}
+	return count
+}
+
+// Diff renders the plan as a unified-style diff of the moves and changed lines
+func (p *Plan) Diff() string {
+	return (&rename.Plan{Dir: p.Dir, Changes: p.Changes}).Diff()
+}
+
+// Apply creates the target folders and writes the changes
+func (p *Plan) Apply() error {
+	for _, change := range p.Changes {
+		folder := filepath.Join(p.Dir, filepath.FromSlash(path.Dir(change.NewPath)))
+		if err := os.MkdirAll(folder, 0755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+	}
+	return (&rename.Plan{Dir: p.Dir, Changes: p.Changes}).Apply()
+}
+
+// file is a markdown file of the documents folder
+type file struct {
+	path    string // Relative to the archive directory
+	content []byte
+	doc     *archive.BSpecDocument // nil if the document cannot be parsed
+	err     error
+}
+
+// readFiles reads the markdown files of an archive directory, sorted by path
+func readFiles(dir string) ([]file, error) {
+	documentsDir := filepath.Join(dir, DocumentsDir)
+	if _, err := os.Stat(documentsDir); err != nil {
+		return nil, fmt.Errorf("no documents directory in %s", dir)
+	}
+
+	var files []file
+	err := filepath.Walk(documentsDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(filePath, ".md") {
+			return nil
+		}
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to read document %s: %w", filePath, err)
+		}
+		relPath, _ := filepath.Rel(dir, filePath)
+		f := file{path: filepath.ToSlash(relPath), content: content}
+		f.doc, f.err = archive.ParseDocument(content)
+		files = append(files, f)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read documents: %w", err)
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].path < files[j].path })
+	return files, nil
+}
+
+// bodyStart returns the index of the first line after the frontmatter
+func bodyStart(lines []string) int {
+	if len(lines) == 0 || lines[0] != "---" {
+		return 0
+	}
+	for i := 1; i < len(lines); i++ {
+		if lines[i] == "---" {
+			return i + 1
+		}
+	}
+	return len(lines)
+}
+
+// Relink rewrites the relative markdown links in the body of a document
+// moved from oldPath to newPath, following the moves of the documents it
+// links to. Paths are relative to the archive directory.
+func Relink(content []byte, oldPath, newPath string, moves map[string]string) []byte {
+	lines := strings.Split(string(content), "\n")
+	rename.RewriteLinks(lines[bodyStart(lines):], func(target string) string {
+		return relink(target, oldPath, newPath, moves)
+	})
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// relink rewrites a relative link target of a document moved from oldPath to
+// newPath, following the moves of the documents it links to
+func relink(target, oldPath, newPath string, moves map[string]string) string {
+	location, anchor := target, ""
+	if i := strings.Index(target, "#"); i >= 0 {
+		location, anchor = target[:i], target[i:]
+	}
+	if location == "" || strings.HasPrefix(location, "/") || strings.Contains(location, ":") {
+		return target
+	}
+
+	resolved := path.Join(path.Dir(oldPath), location)
+	moved, ok := moves[resolved]
+	if !ok {
+		if oldPath == newPath {
+			return target
+		}
+		moved = resolved
+	}
+	rel, err := filepath.Rel(filepath.FromSlash(path.Dir(newPath)), filepath.FromSlash(moved))
+	if err != nil {
+		return target
+	}
+	return filepath.ToSlash(rel) + anchor
+}
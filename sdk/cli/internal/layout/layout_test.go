@@ -0,0 +1,117 @@
+package layout
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+func TestExpectedPath(t *testing.T) {
+	tests := []struct {
+		doc      archive.BSpecDocument
+		expected string
+		err      string
+	}{
+		{archive.BSpecDocument{ID: "STR-growth", Type: "STR", Version: "1.2.0"}, "documents/01-strategic/STR-growth-v1.2.0.md", ""},
+		{archive.BSpecDocument{ID: "msn-Mission_001", Type: "msn", Version: "1.0.0"}, "documents/01-strategic/MSN-mission-001-v1.0.0.md", ""},
+		{archive.BSpecDocument{ID: "RSK-lockin", Type: "RSK", Version: "1.0.0", Domain: "Technology & Data"}, "documents/07-technology/RSK-lockin-v1.0.0.md", ""},
+		{archive.BSpecDocument{ID: "BRD-voice", Type: "BRD", Version: "1.0.0"}, "", "no folder for Brand & Marketing documents"},
+		{archive.BSpecDocument{ID: "RSK-lockin", Type: "RSK", Version: "draft"}, "", "not a semantic version"},
+		{archive.BSpecDocument{ID: "RSK-lockin", Version: "1.0.0"}, "", "no type"},
+	}
+	for _, tt := range tests {
+		got, err := ExpectedPath(tt.doc)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("%s: expected error containing %q, got %v", tt.doc.ID, tt.err, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.expected {
+			t.Errorf("%s: expected %s, got %s (%v)", tt.doc.ID, tt.expected, got, err)
+		}
+	}
+}
+
+func TestFolders(t *testing.T) {
+	folders := Folders()
+	if len(folders) != 11 || folders[0] != "documents/01-strategic" || folders[10] != "documents/11-learning" {
+		t.Errorf("Expected the 11 folders of the format, got %v", folders)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	arch := &archive.BSpecArchive{Documents: map[string]archive.BSpecDocument{
+		"01-strategic/STR-growth-v1.0.0.md": {ID: "STR-growth", Type: "STR", Version: "1.0.0"},
+		"risks/RSK-lockin.md":               {ID: "RSK-lockin", Type: "RSK", Version: "1.0.0"},
+		"notes.md":                          {ID: "NOTE-1", Version: "1.0.0"},
+	}}
+	issues := Check(arch)
+	codes := make(map[string]int)
+	for _, issue := range issues {
+		codes[issue.Code]++
+	}
+	if len(issues) != 3 || codes[CodeWrongFolder] != 1 || codes[CodeWrongFileName] != 1 || codes[CodeUnplaceable] != 1 {
+		t.Errorf("Unexpected issues: %+v", issues)
+	}
+}
+
+func writeFile(t *testing.T, dir, relPath, content string) {
+	t.Helper()
+	path := filepath.Join(dir, filepath.FromSlash(relPath))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPlan(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "documents/misc/strategy.md", "---\nid: STR-growth\ntitle: Growth\ntype: STR\nversion: 1.2.0\n---\n# Growth\n\n![chart](../../assets/chart.png)\nSee [lock-in](../RSK-lockin.md#impact) and [site](https://example.com).\n\n```\n[example](../x.md)\n```\n")
+	writeFile(t, dir, "documents/RSK-lockin.md", "---\nid: RSK-lockin\ntitle: Lock-in\ntype: RSK\nversion: 1.0.0\n---\n# Lock-in\n\n[strategy](misc/strategy.md)\n\n[logo]: ../assets/logo.png\n")
+	writeFile(t, dir, "documents/01-strategic/STR-growth-v1.2.0.md", "---\nid: STR-other\ntitle: Other\ntype: STR\nversion: 1.0.0\n---\n")
+	writeFile(t, dir, "documents/README.md", "Not a document\n")
+
+	plan, err := NewPlan(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// STR-growth's target is taken by STR-other and README.md has no frontmatter
+	if len(plan.Skipped) != 2 {
+		t.Errorf("Expected 2 skipped documents, got %+v", plan.Skipped)
+	}
+	if plan.Moves() != 2 {
+		t.Fatalf("Expected 2 moves, got %d:\n%s", plan.Moves(), plan.Diff())
+	}
+
+	if err := plan.Apply(); err != nil {
+		t.Fatalf("Failed to apply plan: %v", err)
+	}
+	risk, err := os.ReadFile(filepath.Join(dir, "documents", "09-risk", "RSK-lockin-v1.0.0.md"))
+	if err != nil {
+		t.Fatalf("Expected moved risk document: %v", err)
+	}
+	for _, expected := range []string{"[strategy](../misc/strategy.md)", "[logo]: ../../assets/logo.png"} {
+		if !strings.Contains(string(risk), expected) {
+			t.Errorf("Expected %q in:\n%s", expected, risk)
+		}
+	}
+	other, err := os.ReadFile(filepath.Join(dir, "documents", "01-strategic", "STR-other-v1.0.0.md"))
+	if err != nil {
+		t.Fatalf("Expected renamed document: %v", err)
+	}
+	if string(other) != "---\nid: STR-other\ntitle: Other\ntype: STR\nversion: 1.0.0\n---\n" {
+		t.Errorf("Expected unchanged content, got:\n%s", other)
+	}
+	strategy, _ := os.ReadFile(filepath.Join(dir, "documents", "misc", "strategy.md"))
+	for _, expected := range []string{"(../09-risk/RSK-lockin-v1.0.0.md#impact)", "(../../assets/chart.png)", "(https://example.com)", "[example](../x.md)"} {
+		if !strings.Contains(string(strategy), expected) {
+			t.Errorf("Expected %q in:\n%s", expected, strategy)
+		}
+	}
+}
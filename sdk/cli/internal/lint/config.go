@@ -0,0 +1,185 @@
+package lint
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFile is the name of the project configuration file
+const ConfigFile = ".bspec.yaml"
+
+// Severities of a rule in the configuration. SeverityOff disables the rule.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+	SeverityOff     = "off"
+)
+
+// Config is the lint section of a project configuration:
+//
+//	lint:
+//	  rules:
+//	    missing_related: off
+//	  custom:
+//	    - id: owner-email
+//	      assert: owner matches "^[^@ ]+@[^@ ]+$"
+//	      message: owner must be an email address
+//	  overrides:
+//	    - paths: ["09-risk/**"]
+//	      rules:
+//	        owner-email: warning
+type Config struct {
+	Rules     map[string]string `yaml:"rules"`     // Severity per issue code
+	Custom    []CustomRule      `yaml:"custom"`    // Declarative rules
+	Overrides []Override        `yaml:"overrides"` // Severities per path; later overrides win
+
+	rules []Rule
+}
+
+// CustomRule is a declarative rule: documents that match When must satisfy
+// Assert. Both are expressions over frontmatter fields.
+type CustomRule struct {
+	ID       string `yaml:"id"`
+	Severity string `yaml:"severity"` // Default: error
+	When     string `yaml:"when"`     // Default: every document
+	Assert   string `yaml:"assert"`
+	Field    string `yaml:"field"` // Reported field
+	Message  string `yaml:"message"`
+	Fix      string `yaml:"fix"`
+
+	// Set is applied by --fix: frontmatter fields and their values, e.g.
+	// {visibility: internal}
+	Set map[string]interface{} `yaml:"set"`
+}
+
+// Override sets the severity of rules for documents matching one of the
+// paths. Paths are relative to documents/ and support * and a trailing /**.
+type Override struct {
+	Paths []string          `yaml:"paths"`
+	Rules map[string]string `yaml:"rules"`
+}
+
+// LoadConfig reads the lint section of a project configuration file. A
+// missing file is an empty configuration.
+func LoadConfig(filename string) (*Config, error) {
+	data, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", filename, err)
+	}
+	config, err := ParseConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", filename, err)
+	}
+	return config, nil
+}
+
+// ParseConfig parses the lint section of a project configuration and
+// compiles its custom rules
+func ParseConfig(data []byte) (*Config, error) {
+	var file struct {
+		Lint Config `yaml:"lint"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse configuration: %w", err)
+	}
+	config := &file.Lint
+
+	for code, severity := range config.Rules {
+		if !validSeverity(severity) {
+			return nil, fmt.Errorf("rules.%s: unknown severity %q", code, severity)
+		}
+	}
+	for i, override := range config.Overrides {
+		if len(override.Paths) == 0 {
+			return nil, fmt.Errorf("overrides[%d]: paths is required", i)
+		}
+		for _, pattern := range override.Paths {
+			if _, err := path.Match(trimPattern(pattern), ""); err != nil {
+				return nil, fmt.Errorf("overrides[%d]: invalid path %q", i, pattern)
+			}
+		}
+		for code, severity := range override.Rules {
+			if !validSeverity(severity) {
+				return nil, fmt.Errorf("overrides[%d].rules.%s: unknown severity %q", i, code, severity)
+			}
+		}
+	}
+
+	seen := make(map[string]bool)
+	for i, custom := range config.Custom {
+		if custom.ID == "" {
+			return nil, fmt.Errorf("custom[%d]: id is required", i)
+		}
+		if seen[custom.ID] {
+			return nil, fmt.Errorf("custom[%d]: duplicate id %s", i, custom.ID)
+		}
+		seen[custom.ID] = true
+		rule, err := compileRule(custom)
+		if err != nil {
+			return nil, fmt.Errorf("custom rule %s: %w", custom.ID, err)
+		}
+		config.rules = append(config.rules, rule)
+	}
+	return config, nil
+}
+
+// Severity returns the configured severity of an issue code for a document
+// path, or the default severity if the configuration does not set one
+func (c *Config) Severity(code, docPath, defaultSeverity string) string {
+	severity := defaultSeverity
+	if s, ok := c.Rules[code]; ok {
+		severity = s
+	}
+	docPath = filepath.ToSlash(docPath)
+	for _, override := range c.Overrides {
+		if s, ok := override.Rules[code]; ok && override.matches(docPath) {
+			severity = s
+		}
+	}
+	return severity
+}
+
+// matches returns true if a document path matches one of the override paths
+func (o Override) matches(docPath string) bool {
+	if docPath == "" {
+		return false
+	}
+	for _, pattern := range o.Paths {
+		pattern = trimPattern(pattern)
+		if pattern == "**" {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(pattern, "/**"); ok {
+			if strings.HasPrefix(docPath, prefix+"/") {
+				return true
+			}
+			continue
+		}
+		if matched, _ := path.Match(pattern, docPath); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// trimPattern makes a path pattern relative to documents/
+func trimPattern(pattern string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(filepath.ToSlash(pattern), "./"), "documents/")
+}
+
+func validSeverity(severity string) bool {
+	switch severity {
+	case SeverityError, SeverityWarning, SeverityInfo, SeverityOff:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around a change
+const diffContext = 2
+
+// diffOp is a line of an edit script: ' ' kept, '-' removed or '+' added
+type diffOp struct {
+	kind byte
+	text string
+}
+
+// Diff renders the changes between two versions of a file as a unified diff
+func Diff(oldPath, newPath string, before, after []byte) string {
+	if string(before) == string(after) {
+		return ""
+	}
+	ops := diffLines(strings.Split(string(before), "\n"), strings.Split(string(after), "\n"))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- a/%s\n+++ b/%s\n", oldPath, newPath)
+	oldLine, newLine := 1, 1
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			oldLine++
+			newLine++
+			i++
+			continue
+		}
+
+		// Extend the hunk while changes are within twice the context
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for end < len(ops) {
+			if ops[end].kind != ' ' {
+				end++
+				continue
+			}
+			next := end
+			for next < len(ops) && ops[next].kind == ' ' && next-end < 2*diffContext {
+				next++
+			}
+			if next < len(ops) && ops[next].kind != ' ' {
+				end = next
+				continue
+			}
+			break
+		}
+		stop := end + diffContext
+		if stop > len(ops) {
+			stop = len(ops)
+		}
+
+		hunkOld, hunkNew := oldLine-(i-start), newLine-(i-start)
+		var oldCount, newCount int
+		var body strings.Builder
+		for _, op := range ops[start:stop] {
+			body.WriteByte(op.kind)
+			body.WriteString(op.text)
+			body.WriteByte('\n')
+			if op.kind != '+' {
+				oldCount++
+			}
+			if op.kind != '-' {
+				newCount++
+			}
+		}
+		fmt.Fprintf(&b, "@@ -%d,%d +%d,%d @@\n", hunkOld, oldCount, hunkNew, newCount)
+		b.WriteString(body.String())
+
+		for _, op := range ops[i:stop] {
+			if op.kind != '+' {
+				oldLine++
+			}
+			if op.kind != '-' {
+				newLine++
+			}
+		}
+		i = stop
+	}
+	return b.String()
+}
+
+// diffLines computes a shortest edit script with the longest common
+// subsequence of the lines
+func diffLines(before, after []string) []diffOp {
+	// lcs[i][j] is the length of the LCS of before[i:] and after[j:]
+	lcs := make([][]int, len(before)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(after)+1)
+	}
+	for i := len(before) - 1; i >= 0; i-- {
+		for j := len(after) - 1; j >= 0; j-- {
+			if before[i] == after[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(before) || j < len(after) {
+		switch {
+		case i < len(before) && j < len(after) && before[i] == after[j]:
+			ops = append(ops, diffOp{' ', before[i]})
+			i++
+			j++
+		case j < len(after) && (i == len(before) || lcs[i][j+1] > lcs[i+1][j]):
+			ops = append(ops, diffOp{'+', after[j]})
+			j++
+		default:
+			ops = append(ops, diffOp{'-', before[i]})
+			i++
+		}
+	}
+	return ops
+}
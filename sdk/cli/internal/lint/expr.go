@@ -0,0 +1,511 @@
+package lint
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+)
+
+// Expr is a compiled expression over the frontmatter fields of a document.
+//
+// Expressions support field names (dotted for nested fields, e.g.
+// demographics.role), string, number, boolean, null and list literals, the
+// comparison operators ==, !=, <, <=, >, >=, in and matches (a regular
+// expression), the logical operators &&, || and !, parentheses and the
+// functions empty(x), exists(x), len(x) and section(name). section returns
+// the text of the body section with that heading or anchor, "" if it has
+// only whitespace and comments, or null if the body has no such section.
+type Expr struct {
+	source string
+	root   node
+}
+
+// BodyField is the key of the document body in the fields an expression is
+// evaluated against. "$" is not an identifier character, so expressions only
+// read it through section().
+const BodyField = "$body"
+
+// node is an element of an expression tree
+type node interface {
+	eval(fields map[string]interface{}) interface{}
+}
+
+// ParseExpr compiles an expression
+func ParseExpr(source string) (*Expr, error) {
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", source, err)
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err == nil && p.peek().kind != tokenEOF {
+		err = fmt.Errorf("unexpected %q", p.peek().text)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", source, err)
+	}
+	return &Expr{source: source, root: root}, nil
+}
+
+// Eval evaluates the expression against frontmatter fields. Missing fields
+// are null; comparisons between values of different kinds are false.
+func (e *Expr) Eval(fields map[string]interface{}) bool {
+	return truthy(e.root.eval(fields))
+}
+
+// String returns the source of the expression
+func (e *Expr) String() string {
+	return e.source
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// operators are matched longest first
+var operators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", "[", "]", ","}
+
+func tokenize(source string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(source); {
+		c := rune(source[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"' || c == '\'':
+			end := i + 1
+			var text strings.Builder
+			for ; end < len(source) && rune(source[end]) != c; end++ {
+				if source[end] == '\\' && end+1 < len(source) {
+					end++
+				}
+				text.WriteByte(source[end])
+			}
+			if end >= len(source) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			tokens = append(tokens, token{tokenString, text.String()})
+			i = end + 1
+		case unicode.IsDigit(c) || (c == '-' && i+1 < len(source) && unicode.IsDigit(rune(source[i+1]))):
+			end := i + 1
+			for end < len(source) && (unicode.IsDigit(rune(source[end])) || source[end] == '.') {
+				end++
+			}
+			tokens = append(tokens, token{tokenNumber, source[i:end]})
+			i = end
+		case unicode.IsLetter(c) || c == '_':
+			end := i + 1
+			for end < len(source) && isIdentChar(rune(source[end])) {
+				end++
+			}
+			tokens = append(tokens, token{tokenIdent, source[i:end]})
+			i = end
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(source[i:], op) {
+					tokens = append(tokens, token{tokenOperator, op})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q", c)
+			}
+		}
+	}
+	return append(tokens, token{kind: tokenEOF}), nil
+}
+
+func isIdentChar(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' || c == '-' || c == '.'
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// accept consumes the next token if it is the operator or keyword
+func (p *parser) accept(text string) bool {
+	if t := p.peek(); (t.kind == tokenOperator || t.kind == tokenIdent) && t.text == text {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	if !p.accept(text) {
+		return fmt.Errorf("expected %q", text)
+	}
+	return nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	for err == nil && p.accept("||") {
+		var right node
+		if right, err = p.parseAnd(); err == nil {
+			left = logicalNode{op: "||", left: left, right: right}
+		}
+	}
+	return left, err
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	for err == nil && p.accept("&&") {
+		var right node
+		if right, err = p.parseNot(); err == nil {
+			left = logicalNode{op: "&&", left: left, right: right}
+		}
+	}
+	return left, err
+}
+
+func (p *parser) parseNot() (node, error) {
+	if p.accept("!") {
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">", "in", "matches"} {
+		if !p.accept(op) {
+			continue
+		}
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		if op != "matches" {
+			return compareNode{op: op, left: left, right: right}, nil
+		}
+		literal, ok := right.(literalNode)
+		pattern, isString := literal.value.(string)
+		if !ok || !isString {
+			return nil, fmt.Errorf("matches requires a string pattern")
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+		return matchNode{value: left, pattern: re}, nil
+	}
+	return left, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenString:
+		return literalNode{t.text}, nil
+	case tokenNumber:
+		value, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", t.text)
+		}
+		return literalNode{value}, nil
+	case tokenIdent:
+		switch t.text {
+		case "true":
+			return literalNode{true}, nil
+		case "false":
+			return literalNode{false}, nil
+		case "null":
+			return literalNode{nil}, nil
+		}
+		if p.accept("(") {
+			return p.parseCall(t.text)
+		}
+		return fieldNode{strings.Split(t.text, ".")}, nil
+	case tokenOperator:
+		switch t.text {
+		case "(":
+			inner, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			return inner, p.expect(")")
+		case "[":
+			var items listNode
+			for !p.accept("]") {
+				if len(items) > 0 {
+					if err := p.expect(","); err != nil {
+						return nil, err
+					}
+				}
+				item, err := p.parsePrimary()
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+			return items, nil
+		}
+	case tokenEOF:
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return nil, fmt.Errorf("unexpected %q", t.text)
+}
+
+func (p *parser) parseCall(name string) (node, error) {
+	switch name {
+	case "empty", "exists", "len", "section":
+	default:
+		return nil, fmt.Errorf("unknown function %s", name)
+	}
+	arg, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	return callNode{name: name, arg: arg}, p.expect(")")
+}
+
+type literalNode struct{ value interface{} }
+
+func (n literalNode) eval(map[string]interface{}) interface{} { return n.value }
+
+type fieldNode struct{ path []string }
+
+func (n fieldNode) eval(fields map[string]interface{}) interface{} {
+	var value interface{} = fields
+	for _, key := range n.path {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
+
+type listNode []node
+
+func (n listNode) eval(fields map[string]interface{}) interface{} {
+	values := make([]interface{}, len(n))
+	for i, item := range n {
+		values[i] = item.eval(fields)
+	}
+	return values
+}
+
+type logicalNode struct {
+	op          string
+	left, right node
+}
+
+func (n logicalNode) eval(fields map[string]interface{}) interface{} {
+	if n.op == "&&" {
+		return truthy(n.left.eval(fields)) && truthy(n.right.eval(fields))
+	}
+	return truthy(n.left.eval(fields)) || truthy(n.right.eval(fields))
+}
+
+type notNode struct{ operand node }
+
+func (n notNode) eval(fields map[string]interface{}) interface{} {
+	return !truthy(n.operand.eval(fields))
+}
+
+type compareNode struct {
+	op          string
+	left, right node
+}
+
+func (n compareNode) eval(fields map[string]interface{}) interface{} {
+	left, right := n.left.eval(fields), n.right.eval(fields)
+	switch n.op {
+	case "==":
+		return equal(left, right)
+	case "!=":
+		return !equal(left, right)
+	case "in":
+		switch r := right.(type) {
+		case []interface{}:
+			for _, item := range r {
+				if equal(left, item) {
+					return true
+				}
+			}
+		case string:
+			s, ok := left.(string)
+			return ok && strings.Contains(r, s)
+		}
+		return false
+	}
+
+	if l, ok := number(left); ok {
+		if r, ok := number(right); ok {
+			return ordered(n.op, compareFloat(l, r))
+		}
+		return false
+	}
+	l, lok := left.(string)
+	r, rok := right.(string)
+	return lok && rok && ordered(n.op, strings.Compare(l, r))
+}
+
+type matchNode struct {
+	value   node
+	pattern *regexp.Regexp
+}
+
+func (n matchNode) eval(fields map[string]interface{}) interface{} {
+	switch v := n.value.eval(fields).(type) {
+	case nil, []interface{}, map[string]interface{}:
+		return false
+	default:
+		return n.pattern.MatchString(fmt.Sprint(v))
+	}
+}
+
+type callNode struct {
+	name string
+	arg  node
+}
+
+func (n callNode) eval(fields map[string]interface{}) interface{} {
+	value := n.arg.eval(fields)
+	switch n.name {
+	case "exists":
+		return value != nil
+	case "len":
+		return float64(length(value))
+	case "section":
+		return sectionText(fields, value)
+	default:
+		return length(value) == 0
+	}
+}
+
+// sectionText returns the text of a section of the body in the fields, "" if
+// it is empty, or nil if there is no such section
+func sectionText(fields map[string]interface{}, name interface{}) interface{} {
+	body, ok := fields[BodyField].(string)
+	heading, isString := name.(string)
+	if !ok || !isString {
+		return nil
+	}
+	section := bspec.ParseBody(body).Find(heading)
+	if section == nil {
+		return nil
+	}
+	if section.Empty() {
+		return ""
+	}
+	return section.Content()
+}
+
+// truthy converts a value to a boolean: null, false, 0, "" and empty lists
+// are false
+func truthy(value interface{}) bool {
+	if b, ok := value.(bool); ok {
+		return b
+	}
+	if f, ok := number(value); ok {
+		return f != 0
+	}
+	return length(value) > 0
+}
+
+// length returns the number of items of a list or map, or characters of a
+// string; null is empty and any other value has length 1
+func length(value interface{}) int {
+	switch v := value.(type) {
+	case nil:
+		return 0
+	case string:
+		return len(strings.TrimSpace(v))
+	case []interface{}:
+		return len(v)
+	case map[string]interface{}:
+		return len(v)
+	default:
+		return 1
+	}
+}
+
+func number(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+func equal(left, right interface{}) bool {
+	if l, ok := number(left); ok {
+		r, ok := number(right)
+		return ok && l == r
+	}
+	return reflect.DeepEqual(left, right)
+}
+
+func compareFloat(l, r float64) int {
+	switch {
+	case l < r:
+		return -1
+	case l > r:
+		return 1
+	}
+	return 0
+}
+
+func ordered(op string, cmp int) bool {
+	switch op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
@@ -0,0 +1,73 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExprEval(t *testing.T) {
+	fields := map[string]interface{}{
+		"priority":     "critical",
+		"visibility":   "public",
+		"owner":        "alice@example.com",
+		"metrics":      []interface{}{"MET-nps"},
+		"related":      []interface{}{},
+		"score":        4,
+		"demographics": map[string]interface{}{"role": "Operations lead"},
+		BodyField:      "# Churn\n\n## Impact\n\nLost revenue.\n\n## Owners\n<!-- TBD -->\n",
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`priority == "critical"`, true},
+		{`priority != 'critical'`, false},
+		{`!empty(metrics) && empty(related)`, true},
+		{`empty(missing) || exists(missing)`, true},
+		{`exists(missing)`, false},
+		{`missing == null`, true},
+		{`score >= 4 && score < 5.5`, true},
+		{`len(metrics) == 1`, true},
+		{`"MET-nps" in metrics`, true},
+		{`visibility in ["internal", "confidential"]`, false},
+		{`owner matches "^[^@ ]+@[^@ ]+$"`, true},
+		{`missing matches ".*"`, false},
+		{`demographics.role == "Operations lead"`, true},
+		{`!(priority == "critical" && visibility == "public")`, false},
+		{`score > "3"`, false},
+		{`priority`, true},
+		{`exists(section("Impact")) && !empty(section("impact"))`, true},
+		{`section("Impact") matches "revenue"`, true},
+		{`exists(section("owners")) && empty(section("Owners"))`, true},
+		{`exists(section("Timeline"))`, false},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		if err != nil {
+			t.Errorf("ParseExpr(%s) failed: %v", tt.expr, err)
+			continue
+		}
+		if got := expr.Eval(fields); got != tt.want {
+			t.Errorf("%s = %t, want %t", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestParseExprErrors(t *testing.T) {
+	for expr, want := range map[string]string{
+		`priority ==`:             "unexpected end",
+		`(priority == "critical"`: `expected ")"`,
+		`owner matches name`:      "string pattern",
+		`owner matches "["`:       "invalid pattern",
+		`upper(owner)`:            "unknown function",
+		`owner == "alice`:         "unterminated string",
+		`owner = "alice"`:         "unexpected character",
+		`owner owner`:             `unexpected "owner"`,
+		`$body == ""`:             "unexpected character",
+	} {
+		if _, err := ParseExpr(expr); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("ParseExpr(%s): expected error containing %q, got %v", expr, want, err)
+		}
+	}
+}
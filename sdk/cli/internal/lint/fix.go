@@ -0,0 +1,415 @@
+package lint
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/docfile"
+	"github.com/a3tai/bspec/cli/internal/integrity"
+	"github.com/a3tai/bspec/cli/internal/layout"
+	"github.com/a3tai/bspec/cli/internal/rename"
+)
+
+// FixFunc fixes an issue by editing the documents of a workspace. It returns
+// false if the issue cannot be fixed automatically.
+type FixFunc func(ws *Workspace, issue integrity.Issue) bool
+
+// Fixer is a rule that can fix the issues it reports
+type Fixer interface {
+	Rule
+	Fix(ws *Workspace, issue integrity.Issue) bool
+}
+
+// looseVersionPattern matches versions that can be completed to a semantic
+// version, e.g. "1", "v1.2" or "1.2.0"
+var looseVersionPattern = regexp.MustCompile(`^[vV]?(\d+)(?:\.(\d+))?(?:\.(\d+))?$`)
+
+// fixes are the built-in fixes by issue code
+var fixes = map[string]FixFunc{
+	bspec.CodeRequiredField:            fixDate,
+	bspec.CodeInvalidID:                fixID,
+	bspec.CodeInvalidVersion:           fixVersion,
+	layout.CodeWrongFolder:             fixFolder,
+	CodeUnsortedList:                   fixSortedList,
+	integrity.CodeMissingBackReference: fixBackReference,
+}
+
+// Workspace holds the documents of an archive directory while fixes edit
+// them. Paths are relative to documents/.
+type Workspace struct {
+	dir     string
+	now     time.Time
+	content map[string][]byte
+	files   map[string]*docfile.File
+	ids     map[string]string // Document ID to path
+	edited  map[string]bool
+	moves   map[string]string // Path to new path
+	renames []idRename
+}
+
+// idRename is an ID change that the references to the document follow
+type idRename struct {
+	path  string
+	oldID string
+	newID string
+}
+
+// newWorkspace reads the documents of an archive directory
+func newWorkspace(dir string, now time.Time) (*Workspace, error) {
+	ws := &Workspace{
+		dir:     dir,
+		now:     now,
+		content: make(map[string][]byte),
+		files:   make(map[string]*docfile.File),
+		ids:     make(map[string]string),
+		edited:  make(map[string]bool),
+		moves:   make(map[string]string),
+	}
+	documentsDir := filepath.Join(dir, layout.DocumentsDir)
+	err := filepath.Walk(documentsDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(filePath, ".md") {
+			return nil
+		}
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to read document %s: %w", filePath, err)
+		}
+		relPath, _ := filepath.Rel(documentsDir, filePath)
+		relPath = filepath.ToSlash(relPath)
+		ws.content[relPath] = content
+		if file, err := docfile.Parse(content); err == nil {
+			ws.files[relPath] = file
+			if id := file.Get("id"); id != "" && ws.ids[id] == "" {
+				ws.ids[id] = relPath
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read documents: %w", err)
+	}
+	return ws, nil
+}
+
+// Edit returns the document at a path for editing, or nil if it cannot be
+// parsed
+func (w *Workspace) Edit(docPath string) *docfile.File {
+	file := w.files[docPath]
+	if file != nil {
+		w.edited[docPath] = true
+	}
+	return file
+}
+
+// EditID returns the document with an ID for editing, or nil if there is none
+func (w *Workspace) EditID(id string) *docfile.File {
+	if docPath, ok := w.ids[id]; ok {
+		return w.Edit(docPath)
+	}
+	return nil
+}
+
+// Move moves a document to a new path
+func (w *Workspace) Move(docPath, newPath string) bool {
+	if _, exists := w.content[newPath]; exists {
+		return false
+	}
+	for _, taken := range w.moves {
+		if taken == newPath {
+			return false
+		}
+	}
+	w.moves[docPath] = newPath
+	return true
+}
+
+// Now returns the date fixes use for dates
+func (w *Workspace) Now() time.Time {
+	return w.now
+}
+
+// FixPlan is the set of edits that fix the issues of an archive directory
+type FixPlan struct {
+	Dir     string
+	Changes []rename.Change // Paths relative to the archive directory
+	Fixed   []integrity.Issue
+}
+
+// Fix computes the edits that fix the issues of an archive directory with
+// the built-in fixes and the fixes of the rules that declare one. Issues
+// without a fix are left alone.
+func (l *Linter) Fix(dir string, issues []integrity.Issue, now time.Time) (*FixPlan, error) {
+	ws, err := newWorkspace(dir, now)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &FixPlan{Dir: dir}
+	for _, issue := range issues {
+		fix := fixes[issue.Code]
+		if fixer, ok := l.fixers[issue.Code]; ok {
+			fix = fixer.Fix
+		}
+		if fix != nil && fix(ws, issue) {
+			plan.Fixed = append(plan.Fixed, issue)
+		}
+	}
+
+	plan.Fixed = append(plan.Fixed, l.recheckFolders(ws)...)
+
+	// Contents and current paths, relative to the archive directory
+	contents := make(map[string][]byte, len(ws.content))
+	current := make(map[string]string, len(ws.content))
+	for docPath, content := range ws.content {
+		archivePath := path.Join(layout.DocumentsDir, docPath)
+		if ws.edited[docPath] {
+			if content, err = render(ws.files[docPath], content); err != nil {
+				return nil, fmt.Errorf("failed to render %s: %w", docPath, err)
+			}
+		}
+		contents[archivePath] = content
+		current[archivePath] = archivePath
+	}
+
+	// Renamed IDs follow the rename plan: references, links and file names
+	for _, r := range ws.renames {
+		origin := path.Join(layout.DocumentsDir, r.path)
+		changes, err := rename.Rewrite(contents, current[origin], r.oldID, r.newID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rename %s: %w", r.oldID, err)
+		}
+		for _, change := range changes {
+			if _, exists := contents[change.NewPath]; change.Renamed() && exists {
+				change.NewPath = change.Path
+			}
+			delete(contents, change.Path)
+			contents[change.NewPath] = change.After
+			if change.Renamed() {
+				current[origin] = change.NewPath
+			}
+		}
+	}
+
+	moves := make(map[string]string, len(ws.moves))
+	for from, to := range ws.moves {
+		from = current[path.Join(layout.DocumentsDir, from)]
+		moves[from] = path.Join(layout.DocumentsDir, path.Dir(to), path.Base(from))
+	}
+
+	docPaths := make([]string, 0, len(ws.content))
+	for docPath := range ws.content {
+		docPaths = append(docPaths, docPath)
+	}
+	sort.Strings(docPaths)
+	for _, docPath := range docPaths {
+		oldPath := path.Join(layout.DocumentsDir, docPath)
+		before := ws.content[docPath]
+		currentPath := current[oldPath]
+		after := contents[currentPath]
+
+		newPath := currentPath
+		if moved, ok := moves[currentPath]; ok {
+			newPath = moved
+		}
+		if len(moves) > 0 {
+			after = layout.Relink(after, currentPath, newPath, moves)
+		}
+		if newPath == oldPath && bytes.Equal(before, after) {
+			continue
+		}
+		plan.Changes = append(plan.Changes, rename.Change{Path: oldPath, NewPath: newPath, Before: before, After: after})
+	}
+	return plan, nil
+}
+
+// recheckFolders runs the folder check again on the documents that the fixes
+// edited, since it skips documents whose ID or version is invalid, and moves
+// the documents it reports
+func (l *Linter) recheckFolders(ws *Workspace) []integrity.Issue {
+	docPaths := make([]string, 0, len(ws.edited))
+	for docPath := range ws.edited {
+		if _, moved := ws.moves[docPath]; !moved {
+			docPaths = append(docPaths, docPath)
+		}
+	}
+	sort.Strings(docPaths)
+
+	var fixed []integrity.Issue
+	for _, docPath := range docPaths {
+		content, err := render(ws.files[docPath], ws.content[docPath])
+		if err != nil {
+			continue
+		}
+		parsed, err := archive.ParseDocument(content)
+		if err != nil {
+			continue
+		}
+		doc := Document{BSpecDocument: *parsed, Path: docPath}
+		if codes, all := doc.Suppressed(); all || codes[layout.CodeWrongFolder] {
+			continue
+		}
+		for _, issue := range checkFolder(doc) {
+			result := newIssue(doc, issue)
+			result.Severity = l.config.Severity(result.Code, docPath, result.Severity)
+			if result.Severity != SeverityOff && fixFolder(ws, result) {
+				fixed = append(fixed, result)
+			}
+		}
+	}
+	return fixed
+}
+
+// render renders an edited document, or returns the original content if the
+// edits changed nothing
+func render(file *docfile.File, original []byte) ([]byte, error) {
+	content, err := file.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	unchanged, err := docfile.Parse(original)
+	if err != nil {
+		return content, nil
+	}
+	if rendered, err := unchanged.Bytes(); err == nil && bytes.Equal(rendered, content) {
+		return original, nil
+	}
+	return content, nil
+}
+
+// Diff renders the plan as a unified diff of the changed files
+func (p *FixPlan) Diff() string {
+	var b strings.Builder
+	for _, change := range p.Changes {
+		if change.Renamed() {
+			fmt.Fprintf(&b, "rename %s => %s\n", change.Path, change.NewPath)
+		}
+		b.WriteString(Diff(change.Path, change.NewPath, change.Before, change.After))
+	}
+	return b.String()
+}
+
+// Apply writes the changes
+func (p *FixPlan) Apply() error {
+	return (&layout.Plan{Dir: p.Dir, Changes: p.Changes}).Apply()
+}
+
+// fixDate sets a missing created date to the updated date or today, and a
+// missing updated date to today
+func fixDate(ws *Workspace, issue integrity.Issue) bool {
+	if issue.Field != "created" && issue.Field != "updated" {
+		return false
+	}
+	file := ws.Edit(issue.Path)
+	if file == nil || file.Get(issue.Field) != "" {
+		return false
+	}
+	date := ws.Now().Format(docfile.DateLayout)
+	if updated := file.Get("updated"); issue.Field == "created" && updated != "" {
+		date = updated
+	}
+	file.Set(issue.Field, date)
+	return true
+}
+
+// fixID adds the type prefix to an ID. The references to it, the links to
+// it and its file name are updated when the plan is computed.
+func fixID(ws *Workspace, issue integrity.Issue) bool {
+	file := ws.Edit(issue.Path)
+	if file == nil {
+		return false
+	}
+	oldID := file.Get("id")
+	docType := bspec.DocumentType(strings.ToUpper(file.Get("type")))
+	newID := bspec.DocumentID(docType, strings.TrimPrefix(oldID, string(docType)+"-"))
+	if newID == oldID || bspec.ValidateDocumentID(newID, docType) != nil {
+		return false
+	}
+	if _, taken := ws.ids[newID]; taken {
+		return false
+	}
+
+	file.Set("id", newID)
+	ws.ids[newID] = issue.Path
+	ws.renames = append(ws.renames, idRename{path: issue.Path, oldID: oldID, newID: newID})
+	return true
+}
+
+// fixVersion completes a version to a semantic version, e.g. "1.2" to "1.2.0"
+func fixVersion(ws *Workspace, issue integrity.Issue) bool {
+	file := ws.Edit(issue.Path)
+	if file == nil {
+		return false
+	}
+	match := looseVersionPattern.FindStringSubmatch(file.Get("version"))
+	if match == nil {
+		return false
+	}
+	parts := match[1:]
+	for i := range parts {
+		if parts[i] == "" {
+			parts[i] = "0"
+		}
+	}
+	file.Set("version", strings.Join(parts, "."))
+	return true
+}
+
+// fixFolder moves a document into the folder of its domain, keeping its
+// file name
+func fixFolder(ws *Workspace, issue integrity.Issue) bool {
+	file := ws.files[issue.Path]
+	if file == nil {
+		return false
+	}
+	doc := archive.BSpecDocument{ID: file.Get("id"), Type: file.Get("type"), Version: file.Get("version"), Domain: file.Get("domain")}
+	expected, err := layout.ExpectedPath(doc)
+	if err != nil {
+		return false
+	}
+	folder := strings.TrimPrefix(path.Dir(expected), layout.DocumentsDir+"/")
+	return ws.Move(issue.Path, path.Join(folder, path.Base(issue.Path)))
+}
+
+// fixSortedList sorts a relationship list, keeping its style
+func fixSortedList(ws *Workspace, issue integrity.Issue) bool {
+	file := ws.Edit(issue.Path)
+	if file == nil {
+		return false
+	}
+	refs := file.List(issue.Field)
+	if len(refs) < 2 {
+		return false
+	}
+	sort.Strings(refs)
+	file.SetList(issue.Field, refs)
+	return true
+}
+
+// fixBackReference adds the referencing document to the related list
+func fixBackReference(ws *Workspace, issue integrity.Issue) bool {
+	file := ws.Edit(issue.Path)
+	if file == nil || issue.Target == "" {
+		return false
+	}
+	related := file.List(issue.Field)
+	for _, ref := range related {
+		if ref == issue.Target {
+			return false
+		}
+	}
+	file.SetList(issue.Field, append(related, issue.Target))
+	return true
+}
@@ -0,0 +1,248 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/integrity"
+	"github.com/a3tai/bspec/cli/internal/layout"
+)
+
+func writeFixture(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(target, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func readArchive(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	files := make(map[string]string)
+	filepath.Walk(filepath.Join(dir, "documents"), func(filePath string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			content, _ := os.ReadFile(filePath)
+			relPath, _ := filepath.Rel(dir, filePath)
+			files[filepath.ToSlash(relPath)] = string(content)
+		}
+		return err
+	})
+	return files
+}
+
+func TestFix(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, map[string]string{
+		"documents/01-strategic/STR-growth.md": "---\n" +
+			"id: STR-growth\ntitle: Growth\ntype: STR\nstatus: Draft\n" +
+			"version: \"1.2\" # bumped by hand\n" +
+			"owner: alice\n" +
+			"# Dates come from the planning sheet\n" +
+			"updated: 2025-02-01\n" +
+			"success_criteria: [Revenue doubles]\n" +
+			"depends_on: [MSN-acme, MIS-b]\n" +
+			"---\n\n# Growth\n",
+		"documents/01-strategic/RSK-churn.md": "---\n" +
+			"id: churn\ntitle: Churn\ntype: RSK\nstatus: Draft\nversion: 1.0.0\nowner: cro\n" +
+			"created: 2025-01-01\nupdated: 2025-01-01\n" +
+			"---\n\nSee [the mitigation](../09-risk/MIT-retention.md).\n",
+		"documents/09-risk/MIT-retention.md": "---\n" +
+			"id: MIT-retention\ntitle: Retention\ntype: MIT\nstatus: Draft\nversion: 1.0.0\nowner: cro\n" +
+			"created: 2025-01-01\nupdated: 2025-01-01\nrisks: [churn]\n" +
+			"---\n\nMitigates [churn](../01-strategic/RSK-churn.md).\n",
+	})
+
+	config, err := ParseConfig([]byte("lint:\n  custom:\n    - id: team-owner\n      when: type == \"MIT\"\n      assert: team == \"retention\"\n      set: {team: retention}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	linter := New(config)
+	arch := loadArchive(t, dir)
+	issues := linter.Run(arch, integrity.Check(arch).Issues)
+
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	plan, err := linter.Fix(dir, issues, now)
+	if err != nil {
+		t.Fatalf("Fix failed: %v", err)
+	}
+	diff := plan.Diff()
+	if err := plan.Apply(); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	files := readArchive(t, dir)
+
+	growth := files["documents/01-strategic/STR-growth.md"]
+	for _, expected := range []string{
+		`version: "1.2.0" # bumped by hand`,
+		"# Dates come from the planning sheet\nupdated: 2025-02-01",
+		"created: 2025-02-01",
+		"depends_on: [MIS-b, MSN-acme]",
+		"success_criteria: [Revenue doubles]",
+	} {
+		if !strings.Contains(growth, expected) {
+			t.Errorf("Expected %q in STR-growth:\n%s", expected, growth)
+		}
+	}
+
+	// The risk gets its type prefix, moves to its domain folder and
+	// references its mitigation
+	risk, ok := files["documents/09-risk/RSK-churn.md"]
+	if !ok {
+		t.Fatalf("Expected RSK-churn to move to 09-risk, got %v", files)
+	}
+	if !strings.Contains(risk, "id: RSK-churn") || !strings.Contains(risk, "related: [MIT-retention]") {
+		t.Errorf("Expected the id and back-reference fixes, got:\n%s", risk)
+	}
+	if !strings.Contains(risk, "[the mitigation](MIT-retention.md)") {
+		t.Errorf("Expected the link to be rewritten, got:\n%s", risk)
+	}
+
+	mitigation := files["documents/09-risk/MIT-retention.md"]
+	if !strings.Contains(mitigation, "risks: [RSK-churn]") || !strings.Contains(mitigation, "team: retention") {
+		t.Errorf("Expected the reference and custom rule fixes, got:\n%s", mitigation)
+	}
+	if !strings.Contains(mitigation, "[churn](RSK-churn.md)") {
+		t.Errorf("Expected the link to the moved risk to be rewritten, got:\n%s", mitigation)
+	}
+
+	for _, expected := range []string{
+		"rename documents/01-strategic/RSK-churn.md => documents/09-risk/RSK-churn.md",
+		"--- a/documents/01-strategic/STR-growth.md\n+++ b/documents/01-strategic/STR-growth.md",
+		"-version: \"1.2\" # bumped by hand\n+version: \"1.2.0\" # bumped by hand",
+	} {
+		if !strings.Contains(diff, expected) {
+			t.Errorf("Expected %q in diff:\n%s", expected, diff)
+		}
+	}
+
+	// Everything fixable is fixed
+	arch = loadArchive(t, dir)
+	for _, issue := range linter.Run(arch, integrity.Check(arch).Issues) {
+		if _, fixable := fixes[issue.Code]; fixable && issue.Code != "required_field" {
+			t.Errorf("Expected %s to be fixed: %s", issue.Code, issue.Message)
+		}
+	}
+}
+
+func TestFixSinglePass(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, map[string]string{
+		// The folder is only checked once the version is valid
+		"documents/01-strategic/churn.md": "---\n" +
+			"id: churn\ntitle: Churn\ntype: RSK\nstatus: Draft\nversion: \"1\"\nowner: cro\n" +
+			"created: 2025-01-01\nupdated: 2025-01-01\nrelated: [MIT-retention]\n" +
+			"---\n\nSee [the mitigation](../09-risk/MIT-retention.md).\n",
+		"documents/09-risk/MIT-retention.md": "---\n" +
+			"id: MIT-retention\ntitle: Retention\ntype: MIT\nstatus: Draft\nversion: 1.0.0\nowner: cro\n" +
+			"created: 2025-01-01\nupdated: 2025-01-01\nrisks: [churn]\n" +
+			"---\n\nMitigates [churn](../01-strategic/churn.md).\n",
+	})
+
+	linter := New(nil)
+	arch := loadArchive(t, dir)
+	issues := linter.Run(arch, nil)
+	for _, issue := range issues {
+		if issue.Code == layout.CodeWrongFolder {
+			t.Fatalf("Expected no folder issue before the version is fixed, got %s", issue.Message)
+		}
+	}
+
+	plan, err := linter.Fix(dir, issues, time.Now())
+	if err != nil {
+		t.Fatalf("Fix failed: %v", err)
+	}
+	if err := plan.Apply(); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	files := readArchive(t, dir)
+
+	// The risk is renamed after its new ID and moved in the same pass
+	risk, ok := files["documents/09-risk/RSK-churn.md"]
+	if !ok {
+		t.Fatalf("Expected churn.md to become 09-risk/RSK-churn.md, got %v", files)
+	}
+	if !strings.Contains(risk, "id: RSK-churn") || !strings.Contains(risk, `version: "1.0.0"`) {
+		t.Errorf("Expected the id and version fixes, got:\n%s", risk)
+	}
+	if !strings.Contains(risk, "[the mitigation](MIT-retention.md)") {
+		t.Errorf("Expected the link to be rewritten, got:\n%s", risk)
+	}
+
+	mitigation := files["documents/09-risk/MIT-retention.md"]
+	if !strings.Contains(mitigation, "risks: [RSK-churn]") || !strings.Contains(mitigation, "[churn](RSK-churn.md)") {
+		t.Errorf("Expected the reference and link to follow the rename, got:\n%s", mitigation)
+	}
+
+	found := false
+	for _, issue := range plan.Fixed {
+		found = found || issue.Code == layout.CodeWrongFolder
+	}
+	if !found {
+		t.Errorf("Expected the folder issue to be reported as fixed, got %+v", plan.Fixed)
+	}
+
+	arch = loadArchive(t, dir)
+	for _, issue := range linter.Run(arch, nil) {
+		if _, fixable := fixes[issue.Code]; fixable {
+			t.Errorf("Expected %s to be fixed: %s", issue.Code, issue.Message)
+		}
+	}
+}
+
+func TestFixLeavesUnfixableIssues(t *testing.T) {
+	dir := t.TempDir()
+	content := "---\nid: STR-growth\ntitle: Growth\ntype: STR\nversion: latest\n---\n\n# Growth\n"
+	writeFixture(t, dir, map[string]string{"documents/STR-growth.md": content})
+
+	linter := New(nil)
+	arch := loadArchive(t, dir)
+	plan, err := linter.Fix(dir, linter.Run(arch, nil), time.Now())
+	if err != nil {
+		t.Fatalf("Fix failed: %v", err)
+	}
+	for _, issue := range plan.Fixed {
+		if issue.Field == "owner" || issue.Field == "version" || issue.Code == layout.CodeWrongFolder {
+			t.Errorf("Expected %s %s to be left alone", issue.Code, issue.Field)
+		}
+	}
+	if len(plan.Changes) != 1 || !strings.Contains(string(plan.Changes[0].After), "version: latest\n") {
+		t.Errorf("Expected only the dates to change, got %+v", plan.Changes)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\n"
+	after := "a\nB\nc\nd\ne\nf\ng\nh\nI\nj\nk\n"
+	diff := Diff("x.md", "x.md", []byte(before), []byte(after))
+	expected := "--- a/x.md\n+++ b/x.md\n" +
+		"@@ -1,4 +1,4 @@\n a\n-b\n+B\n c\n d\n" +
+		"@@ -7,5 +7,6 @@\n g\n h\n-i\n+I\n j\n+k\n \n"
+	if diff != expected {
+		t.Errorf("Unexpected diff:\n%s\nwant:\n%s", diff, expected)
+	}
+	if Diff("x.md", "x.md", []byte(before), []byte(before)) != "" {
+		t.Error("Expected no diff for equal content")
+	}
+}
+
+func loadArchive(t *testing.T, dir string) *archive.BSpecArchive {
+	t.Helper()
+	arch := &archive.BSpecArchive{Documents: make(map[string]archive.BSpecDocument)}
+	for name, content := range readArchive(t, dir) {
+		doc, err := archive.ParseDocument([]byte(content))
+		if err != nil {
+			t.Fatalf("Failed to parse %s: %v", name, err)
+		}
+		arch.Documents[strings.TrimPrefix(name, "documents/")] = *doc
+	}
+	return arch
+}
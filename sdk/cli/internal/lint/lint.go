@@ -0,0 +1,196 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/integrity"
+)
+
+// Rule checks a single document. The severity of the returned issues is the
+// default severity; the project configuration can change it per path.
+type Rule interface {
+	Check(doc Document) bspec.ValidationIssues
+}
+
+// RuleFunc adapts a function to the Rule interface
+type RuleFunc func(doc Document) bspec.ValidationIssues
+
+// Check calls f(doc)
+func (f RuleFunc) Check(doc Document) bspec.ValidationIssues {
+	return f(doc)
+}
+
+// Document is an archive document checked by a rule
+type Document struct {
+	archive.BSpecDocument
+	Path string // Relative to documents/
+}
+
+// Fields returns the frontmatter of a document as a map, including the core
+// fields that are set
+func (d Document) Fields() map[string]interface{} {
+	fields := make(map[string]interface{}, len(d.Metadata)+9)
+	for key, value := range d.Metadata {
+		fields[key] = value
+	}
+	for key, value := range map[string]string{
+		"id":      d.ID,
+		"title":   d.Title,
+		"type":    d.Type,
+		"status":  d.Status,
+		"version": d.Version,
+		"owner":   d.Owner,
+		"created": d.Created,
+		"updated": d.Updated,
+		"domain":  d.Domain,
+	} {
+		if value != "" {
+			fields[key] = value
+		}
+	}
+	return fields
+}
+
+// suppressPattern matches inline suppression comments such as
+// <!-- bspec-disable missing_related, owner-email -->
+var suppressPattern = regexp.MustCompile(`<!--\s*bspec-disable\b([^>]*?)-->`)
+
+// Suppressed returns the codes disabled by inline comments in the document
+// body, and whether a comment without codes disables every rule
+func (d Document) Suppressed() (map[string]bool, bool) {
+	codes := make(map[string]bool)
+	all := false
+	for _, match := range suppressPattern.FindAllStringSubmatch(d.Content, -1) {
+		fields := strings.FieldsFunc(match[1], func(r rune) bool { return r == ',' || r == ' ' || r == '\t' || r == '\n' })
+		if len(fields) == 0 {
+			all = true
+		}
+		for _, code := range fields {
+			codes[code] = true
+		}
+	}
+	return codes, all
+}
+
+// Linter runs the built-in rules, the custom rules of a project
+// configuration and any additional rules, or the writing-standards rules
+type Linter struct {
+	config *Config
+	rules  []Rule
+	fixers map[string]Fixer // Rules that fix the issues they report, by code
+}
+
+// New creates a linter. A nil configuration runs the built-in rules with
+// their default severities.
+func New(config *Config, rules ...Rule) *Linter {
+	if config == nil {
+		config = &Config{}
+	}
+	all := append(Builtin(), config.rules...)
+	return &Linter{config: config, rules: append(all, rules...), fixers: make(map[string]Fixer)}
+}
+
+// NewProse creates a linter that checks the body of archive documents
+// against the writing standards
+func NewProse(config *Config) *Linter {
+	return newRulesLinter(config, ProseRules())
+}
+
+// NewSpecProse creates a linter that checks specification files against the
+// writing standards
+func NewSpecProse(config *Config) *Linter {
+	return newRulesLinter(config, SpecProseRules())
+}
+
+// newRulesLinter creates a linter that runs only the given rules
+func newRulesLinter(config *Config, rules []Rule) *Linter {
+	if config == nil {
+		config = &Config{}
+	}
+	return &Linter{config: config, rules: rules, fixers: make(map[string]Fixer)}
+}
+
+// Run checks every document of an archive, adds the issues to the given
+// issues, such as those of an integrity report, and applies the
+// configuration to all of them: severities are overridden per code and per
+// path, and issues that are turned off or suppressed inline are dropped.
+func (l *Linter) Run(arch *archive.BSpecArchive, issues []integrity.Issue) []integrity.Issue {
+	paths := make([]string, 0, len(arch.Documents))
+	for path := range arch.Documents {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	docs := make(map[string]Document, len(paths))
+	pathsByID := make(map[string]string, len(paths))
+	for _, path := range paths {
+		doc := Document{BSpecDocument: arch.Documents[path], Path: path}
+		docs[path] = doc
+		if _, exists := pathsByID[doc.ID]; doc.ID != "" && !exists {
+			pathsByID[doc.ID] = path
+		}
+		for _, rule := range l.rules {
+			for _, issue := range rule.Check(doc) {
+				if fixer, ok := rule.(Fixer); ok {
+					l.fixers[issue.Code] = fixer
+				}
+				issues = append(issues, newIssue(doc, issue))
+			}
+		}
+	}
+
+	result := []integrity.Issue{}
+	for _, issue := range issues {
+		if issue.Path == "" {
+			issue.Path = pathsByID[issue.Document]
+		}
+		if doc, ok := docs[issue.Path]; ok {
+			codes, all := doc.Suppressed()
+			if all || codes[issue.Code] {
+				continue
+			}
+		}
+		issue.Severity = l.config.Severity(issue.Code, issue.Path, issue.Severity)
+		if issue.Severity != SeverityOff {
+			result = append(result, issue)
+		}
+	}
+	return result
+}
+
+// newIssue converts an issue reported by a rule. Lines of the body become
+// lines of the file, and the message starts with the document and line.
+func newIssue(doc Document, issue bspec.ValidationIssue) integrity.Issue {
+	result := integrity.Issue{
+		Code:     issue.Code,
+		Severity: string(issue.Severity),
+		Document: doc.ID,
+		Path:     doc.Path,
+		Field:    issue.Field,
+		Message:  fmt.Sprintf("%s: %s", documentName(doc), issue.Message),
+		Fix:      issue.Fix,
+	}
+	if issue.Line > 0 {
+		bodyLine := doc.BodyLine
+		if bodyLine == 0 {
+			bodyLine = 1
+		}
+		result.Line = bodyLine + issue.Line - 1
+		result.Message = fmt.Sprintf("%s:%d: %s", documentName(doc), result.Line, issue.Message)
+	}
+	return result
+}
+
+// documentName identifies a document in issue messages
+func documentName(doc Document) string {
+	if doc.ID != "" {
+		return doc.ID
+	}
+	return doc.Path
+}
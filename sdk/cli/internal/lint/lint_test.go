@@ -0,0 +1,187 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/integrity"
+)
+
+const testConfig = `
+lint:
+  rules:
+    missing_related: off
+  custom:
+    - id: critical-needs-metrics
+      when: priority == "critical"
+      assert: "!empty(metrics)"
+      field: metrics
+      message: critical documents must list metrics
+    - id: confidential-not-public
+      when: classification == "confidential"
+      assert: visibility != "public"
+      field: visibility
+      message: confidential documents must not be public
+      fix: set visibility to internal
+    - id: owner-email
+      assert: owner matches "^[^@ ]+@[^@ ]+\\.[a-z]+$"
+      field: owner
+      message: owner must be an email address
+  overrides:
+    - paths: ["documents/09-risk/**"]
+      rules:
+        owner-email: warning
+        dangling_reference: info
+`
+
+func testDocument(id, docType, owner string, metadata map[string]interface{}) archive.BSpecDocument {
+	return archive.BSpecDocument{
+		ID: id, Title: id, Type: docType, Status: "Draft", Version: "1.0.0",
+		Owner: owner, Created: "2025-01-01", Updated: "2025-01-01", Metadata: metadata,
+	}
+}
+
+func testArchive() *archive.BSpecArchive {
+	risk := testDocument("RSK-churn", "RSK", "cro", map[string]interface{}{"related": []interface{}{"MIT-missing"}})
+	suppressed := testDocument("STR-legacy", "STR", "strategy team", map[string]interface{}{"priority": "critical"})
+	suppressed.Content = "# Legacy\n\n<!-- bspec-disable owner-email, critical-needs-metrics -->\n"
+	return &archive.BSpecArchive{Documents: map[string]archive.BSpecDocument{
+		"01-strategic/STR-growth.md": testDocument("STR-growth", "STR", "alice@example.com", map[string]interface{}{
+			"priority": "critical", "classification": "confidential", "visibility": "public",
+		}),
+		"01-strategic/STR-legacy.md": suppressed,
+		"09-risk/RSK-churn.md":       risk,
+	}}
+}
+
+func issueCodes(issues []integrity.Issue) map[string]integrity.Issue {
+	codes := make(map[string]integrity.Issue)
+	for _, issue := range issues {
+		codes[issue.Document+" "+issue.Code] = issue
+	}
+	return codes
+}
+
+func TestRun(t *testing.T) {
+	config, err := ParseConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatalf("ParseConfig failed: %v", err)
+	}
+	arch := testArchive()
+	dangling := integrity.Issue{Code: integrity.CodeDanglingReference, Severity: integrity.SeverityError, Document: "RSK-churn", Target: "MIT-missing"}
+
+	issues := issueCodes(New(config).Run(arch, []integrity.Issue{dangling}))
+
+	metrics, ok := issues["STR-growth critical-needs-metrics"]
+	if !ok || metrics.Severity != SeverityError || metrics.Path != "01-strategic/STR-growth.md" || metrics.Field != "metrics" {
+		t.Errorf("Expected critical-needs-metrics error, got %+v", metrics)
+	}
+	if metrics.Message != "STR-growth: critical documents must list metrics" {
+		t.Errorf("Unexpected message %q", metrics.Message)
+	}
+	if public := issues["STR-growth confidential-not-public"]; public.Fix != "set visibility to internal" {
+		t.Errorf("Expected confidential-not-public with fix, got %+v", public)
+	}
+	if _, ok := issues["STR-growth owner-email"]; ok {
+		t.Error("Expected an email owner to pass")
+	}
+
+	// Re-graded for the risk folder
+	if owner := issues["RSK-churn owner-email"]; owner.Severity != SeverityWarning {
+		t.Errorf("Expected owner-email warning for RSK-churn, got %+v", owner)
+	}
+	if ref := issues["RSK-churn dangling_reference"]; ref.Severity != SeverityInfo || ref.Path != "09-risk/RSK-churn.md" {
+		t.Errorf("Expected dangling_reference info with path, got %+v", ref)
+	}
+	// Built-in rule turned off
+	for key := range issues {
+		if strings.HasSuffix(key, bspec.CodeMissingRelated) {
+			t.Errorf("Expected missing_related to be off, got %s", key)
+		}
+	}
+
+	// Suppressed inline
+	if _, ok := issues["STR-legacy owner-email"]; ok {
+		t.Error("Expected owner-email to be suppressed for STR-legacy")
+	}
+	if _, ok := issues["STR-legacy critical-needs-metrics"]; ok {
+		t.Error("Expected critical-needs-metrics to be suppressed for STR-legacy")
+	}
+}
+
+func TestRunBuiltinAndExtraRules(t *testing.T) {
+	arch := testArchive()
+	doc := arch.Documents["01-strategic/STR-growth.md"]
+	doc.Owner = ""
+	arch.Documents["01-strategic/STR-growth.md"] = doc
+	risk := arch.Documents["09-risk/RSK-churn.md"]
+	risk.Metadata = nil
+	arch.Documents["09-risk/RSK-churn.md"] = risk
+
+	extra := RuleFunc(func(doc Document) bspec.ValidationIssues {
+		if doc.Status == "Draft" {
+			return bspec.ValidationIssues{{Code: "no-drafts", Severity: bspec.SeverityWarning, Message: "document is a draft"}}
+		}
+		return nil
+	})
+	issues := issueCodes(New(nil, extra).Run(arch, nil))
+
+	if owner := issues["STR-growth "+bspec.CodeRequiredField]; owner.Field != "owner" || owner.Severity != SeverityError {
+		t.Errorf("Expected the SDK required owner error, got %+v", owner)
+	}
+	if _, ok := issues["RSK-churn "+bspec.CodeMissingMitigationReference]; !ok {
+		t.Error("Expected the RSK rule of the SDK to run")
+	}
+	if drafts := issues["STR-legacy no-drafts"]; drafts.Severity != SeverityWarning {
+		t.Errorf("Expected the extra rule to run, got %+v", drafts)
+	}
+}
+
+func TestSeverityOverrides(t *testing.T) {
+	config, err := ParseConfig([]byte(`
+lint:
+  custom:
+    - id: risk-owner
+      severity: off
+      assert: exists(owner)
+  overrides:
+    - paths: ["09-risk/*.md", "09-risk/archive/**"]
+      rules:
+        risk-owner: error
+    - paths: ["09-risk/RSK-old.md"]
+      rules:
+        risk-owner: off
+`))
+	if err != nil {
+		t.Fatalf("ParseConfig failed: %v", err)
+	}
+	for docPath, want := range map[string]string{
+		"01-strategic/STR-growth.md": SeverityOff,
+		"09-risk/RSK-churn.md":       SeverityError,
+		"09-risk/archive/2024/x.md":  SeverityError,
+		"09-risk/RSK-old.md":         SeverityOff,
+	} {
+		if got := config.Severity("risk-owner", docPath, SeverityOff); got != want {
+			t.Errorf("Severity for %s = %s, want %s", docPath, got, want)
+		}
+	}
+}
+
+func TestParseConfigErrors(t *testing.T) {
+	for config, want := range map[string]string{
+		"lint:\n  rules:\n    missing_related: fatal\n":                               "unknown severity",
+		"lint:\n  custom:\n    - assert: exists(owner)\n":                             "id is required",
+		"lint:\n  custom:\n    - id: a\n":                                             "assert is required",
+		"lint:\n  custom:\n    - id: a\n      assert: owner ==\n":                     "custom rule a",
+		"lint:\n  custom:\n    - {id: a, assert: x}\n    - {id: a, assert: x}\n":      "duplicate id",
+		"lint:\n  overrides:\n    - rules:\n        a: off\n":                         "paths is required",
+		"lint:\n  overrides:\n    - paths: ['[']\n      rules:\n        a: warning\n": "invalid path",
+	} {
+		if _, err := ParseConfig([]byte(config)); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error containing %q for %q, got %v", want, config, err)
+		}
+	}
+}
@@ -0,0 +1,479 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+)
+
+// Writing-standards issue codes
+const (
+	CodeMissingSection   = bspec.CodeMissingSection
+	CodeEmptySection     = bspec.CodeEmptySection
+	CodeSectionOrder     = "section_order"
+	CodeHeaderFormat     = "header_format"
+	CodeInformalPhrase   = "informal_phrase"
+	CodeNormativeKeyword = "normative_keyword"
+	CodeLongSentence     = "long_sentence"
+	CodePlaceholderText  = "placeholder_text"
+)
+
+// requirementKeywords are the normative keywords whose capitalization is
+// checked. The lowercase forms of MAY, RECOMMENDED and OPTIONAL are ordinary
+// English (RFC 8174), while must, shall and should state a requirement in
+// any case.
+var requirementKeywords = []string{"MUST", "SHALL", "SHOULD"}
+
+// abbreviations end with a period but do not end a sentence
+var abbreviations = map[string]bool{
+	"e.g.": true, "i.e.": true, "etc.": true, "vs.": true, "approx.": true,
+	"inc.": true, "ltd.": true, "dr.": true, "mr.": true, "mrs.": true, "ms.": true, "no.": true,
+}
+
+var (
+	fencePattern     = regexp.MustCompile("^(```|~~~)")
+	headingPattern   = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	itemPattern      = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s+(?:\[[ xX]\]\s+)?`)
+	fieldLinePattern = regexp.MustCompile(`^\*\*([^*]+):\*\*`)
+	commentPattern   = regexp.MustCompile(`<!--.*?-->`)
+	codeSpanPattern  = regexp.MustCompile("`[^`]*`")
+	linkPattern      = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+	urlPattern       = regexp.MustCompile(`https?://\S+`)
+	quotedSpan       = regexp.MustCompile(`"[^"]*"|“[^”]*”`)
+	numberingPattern = regexp.MustCompile(`^\d+(\.\d+)*\.?\s+`)
+	titleField       = regexp.MustCompile(`\\\{[^}]*\\\}`)
+)
+
+// proseLine is a line of a document body outside code blocks, comments and
+// tables
+type proseLine struct {
+	number  int    // Line of the body, starting at 1
+	text    string // Without list markers, code spans and link targets
+	heading int    // Heading level, 0 for text
+	start   bool   // Starts a paragraph: a list item or a field line
+}
+
+// scanBody returns the prose lines of a markdown body. Blank lines are kept
+// with an empty text, as they end paragraphs.
+func scanBody(content string) []proseLine {
+	var (
+		lines     []proseLine
+		fence     string
+		inComment bool
+	)
+	for i, line := range strings.Split(content, "\n") {
+		number := i + 1
+		trimmed := strings.TrimSpace(line)
+
+		if match := fencePattern.FindString(trimmed); match != "" {
+			switch fence {
+			case "":
+				fence = match
+			case match:
+				fence = ""
+			}
+			lines = append(lines, proseLine{number: number})
+			continue
+		}
+		if fence != "" {
+			continue
+		}
+
+		if inComment {
+			end := strings.Index(trimmed, "-->")
+			if end < 0 {
+				continue
+			}
+			inComment = false
+			trimmed = strings.TrimSpace(trimmed[end+3:])
+		}
+		trimmed = commentPattern.ReplaceAllString(trimmed, "")
+		if start := strings.Index(trimmed, "<!--"); start >= 0 {
+			inComment = true
+			trimmed = strings.TrimSpace(trimmed[:start])
+		}
+
+		switch {
+		case strings.HasPrefix(trimmed, "|"):
+			lines = append(lines, proseLine{number: number})
+		case headingPattern.MatchString(trimmed):
+			match := headingPattern.FindStringSubmatch(trimmed)
+			lines = append(lines, proseLine{number: number, text: cleanText(match[2]), heading: len(match[1])})
+		default:
+			item := itemPattern.FindString(trimmed)
+			text := strings.TrimSpace(strings.TrimPrefix(trimmed[len(item):], ">"))
+			lines = append(lines, proseLine{
+				number: number,
+				text:   cleanText(text),
+				start:  item != "" || fieldLinePattern.MatchString(text),
+			})
+		}
+	}
+	return lines
+}
+
+// cleanText drops code spans, link targets and URLs
+func cleanText(text string) string {
+	text = codeSpanPattern.ReplaceAllString(text, "")
+	text = linkPattern.ReplaceAllString(text, "$1")
+	return strings.TrimSpace(urlPattern.ReplaceAllString(text, ""))
+}
+
+// sentence is a sentence of a paragraph
+type sentence struct {
+	line  int // Line of the body where the sentence starts
+	words int
+}
+
+// sentences splits the paragraphs of prose lines into sentences. Headings,
+// blank lines, list items and field lines start new paragraphs, and a
+// paragraph ends a sentence even without a period.
+func sentences(lines []proseLine) []sentence {
+	var (
+		result  []sentence
+		current sentence
+	)
+	flush := func() {
+		if current.words > 0 {
+			result = append(result, current)
+		}
+		current = sentence{}
+	}
+	for _, line := range lines {
+		if line.heading > 0 || line.text == "" || line.start {
+			flush()
+		}
+		if line.heading > 0 {
+			continue
+		}
+		for _, word := range strings.Fields(line.text) {
+			if !strings.ContainsFunc(word, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) {
+				continue
+			}
+			if current.words == 0 {
+				current.line = line.number
+			}
+			current.words++
+			if endsSentence(word) {
+				flush()
+			}
+		}
+	}
+	flush()
+	return result
+}
+
+// endsSentence reports whether a word ends a sentence
+func endsSentence(word string) bool {
+	word = strings.TrimRight(word, `"')]*_”’`)
+	if abbreviations[strings.ToLower(word)] {
+		return false
+	}
+	return strings.HasSuffix(word, ".") || strings.HasSuffix(word, "!") || strings.HasSuffix(word, "?")
+}
+
+// normalizeHeading makes headings comparable: case, numbering, a trailing
+// colon and "&" do not matter
+func normalizeHeading(title string) string {
+	title = numberingPattern.ReplaceAllString(strings.TrimSpace(title), "")
+	title = strings.ReplaceAll(strings.TrimSuffix(title, ":"), "&", "and")
+	return strings.ToLower(strings.Join(strings.Fields(title), " "))
+}
+
+// vocabularyRule is a compiled vocabulary rule of the writing standards
+type vocabularyRule struct {
+	bspec.VocabularyRule
+	pattern *regexp.Regexp
+}
+
+// prose holds the compiled writing standards
+type prose struct {
+	standards    bspec.WritingStandards
+	catalog      *bspec.BSpec
+	vocabulary   []vocabularyRule
+	keywords     *regexp.Regexp
+	placeholders *regexp.Regexp
+	title        *regexp.Regexp
+}
+
+func newProse() *prose {
+	standards := bspec.DefaultWritingStandards()
+	p := &prose{standards: standards, catalog: bspec.DefaultCatalog()}
+
+	for _, rule := range standards.Vocabulary {
+		words := strings.Fields(regexp.QuoteMeta(rule.Avoid))
+		p.vocabulary = append(p.vocabulary, vocabularyRule{
+			VocabularyRule: rule,
+			pattern:        regexp.MustCompile(`(?i)\b` + strings.Join(words, `\s+`) + `\b`),
+		})
+	}
+
+	var keywords []string
+	for _, keyword := range requirementKeywords {
+		for _, known := range standards.NormativeKeywords {
+			if keyword == known {
+				keywords = append(keywords, keyword)
+			}
+		}
+	}
+	p.keywords = regexp.MustCompile(`(?i)\b(?:` + strings.Join(keywords, "|") + `)(?:\s+not)?\b`)
+
+	placeholders := make([]string, len(standards.Placeholders))
+	for i, placeholder := range standards.Placeholders {
+		placeholders[i] = strings.Join(strings.Fields(regexp.QuoteMeta(placeholder)), `\s+`)
+	}
+	p.placeholders = regexp.MustCompile(`(?i)\b(?:` + strings.Join(placeholders, "|") + `)\b`)
+
+	// "{CODE}" is a document type code; other placeholders are any text
+	title := regexp.QuoteMeta(standards.Title)
+	title = strings.ReplaceAll(title, `\{CODE\}`, `[A-Z]{3}`)
+	p.title = regexp.MustCompile(`^` + titleField.ReplaceAllString(title, `.+`) + `$`)
+	return p
+}
+
+// ProseRules returns the writing-standards rules for archive documents: the
+// sections of the content template of the document's type, vocabulary,
+// normative keywords, sentence length and placeholder text
+func ProseRules() []Rule {
+	p := newProse()
+	return []Rule{RuleFunc(p.checkTemplateSections), RuleFunc(p.checkText)}
+}
+
+// SpecProseRules returns the writing-standards rules for specification
+// files: the header and the sections required by the standards, and the
+// same text checks as for documents
+func SpecProseRules() []Rule {
+	p := newProse()
+	return []Rule{RuleFunc(p.checkHeader), RuleFunc(p.checkSpecSections), RuleFunc(p.checkText)}
+}
+
+// checkTemplateSections checks that a document has the "##" sections of the
+// content template of its type, in order
+func (p *prose) checkTemplateSections(doc Document) bspec.ValidationIssues {
+	info := p.catalog.GetDocumentType(doc.Type)
+	if info == nil {
+		return nil
+	}
+	var expected []string
+	for _, section := range info.Sections {
+		if section.Level == 2 {
+			expected = append(expected, section.Title)
+		}
+	}
+	return checkSections(doc.Content, expected, len(expected))
+}
+
+// checkSpecSections checks that a specification file has the required
+// sections of the writing standards, and that they and the optional
+// sections are in order
+func (p *prose) checkSpecSections(doc Document) bspec.ValidationIssues {
+	expected := append(append([]string{}, p.standards.Sections...), p.standards.OptionalSections...)
+	return checkSections(doc.Content, expected, len(p.standards.Sections))
+}
+
+// checkSections reports the missing sections among the first required
+// expected sections, the expected sections without text, and the "##"
+// headings that come before an expected section listed earlier. Other
+// headings are ignored.
+func checkSections(body string, expected []string, required int) bspec.ValidationIssues {
+	if len(expected) == 0 {
+		return nil
+	}
+	index := make(map[string]int, len(expected))
+	for i, title := range expected {
+		index[normalizeHeading(title)] = i
+	}
+
+	var issues bspec.ValidationIssues
+	found := make(map[int]bool)
+	last := -1
+	for _, section := range bspec.ParseBody(body).All() {
+		if section.Level != 2 {
+			continue
+		}
+		i, ok := index[normalizeHeading(section.Heading)]
+		if !ok {
+			continue
+		}
+		found[i] = true
+		if section.Empty() {
+			issues = append(issues, bspec.ValidationIssue{
+				Code:     CodeEmptySection,
+				Severity: bspec.SeverityWarning,
+				Line:     section.Line,
+				Message:  fmt.Sprintf("section %q is empty", expected[i]),
+				Fix:      fmt.Sprintf("write the %q section or remove it", expected[i]),
+			})
+		}
+		if i < last {
+			issues = append(issues, bspec.ValidationIssue{
+				Code:     CodeSectionOrder,
+				Severity: bspec.SeverityWarning,
+				Line:     section.Line,
+				Message:  fmt.Sprintf("section %q comes after %q", expected[i], expected[last]),
+				Fix:      fmt.Sprintf("move %q before %q", expected[i], expected[last]),
+			})
+			continue
+		}
+		last = i
+	}
+	for i, title := range expected[:required] {
+		if !found[i] {
+			issues = append(issues, bspec.ValidationIssue{
+				Code:     CodeMissingSection,
+				Severity: bspec.SeverityWarning,
+				Message:  fmt.Sprintf("missing section %q", title),
+				Fix:      fmt.Sprintf("add a %q section", "## "+title),
+			})
+		}
+	}
+	return issues
+}
+
+// checkHeader checks the title and the header fields of a specification
+// file
+func (p *prose) checkHeader(doc Document) bspec.ValidationIssues {
+	var (
+		issues bspec.ValidationIssues
+		title  *proseLine
+		fields = make(map[string]int)
+		order  []string
+	)
+	lines := scanBody(doc.Content)
+	for i := range lines {
+		line := lines[i]
+		if line.heading == 1 && title == nil {
+			title = &lines[i]
+			continue
+		}
+		if line.heading > 1 {
+			break
+		}
+		if match := fieldLinePattern.FindStringSubmatch(line.text); match != nil && title != nil {
+			fields[match[1]] = line.number
+			order = append(order, match[1])
+		}
+	}
+
+	if title == nil || !p.title.MatchString("# "+title.text) {
+		issue := bspec.ValidationIssue{
+			Code:     CodeHeaderFormat,
+			Severity: bspec.SeverityWarning,
+			Message:  "title does not follow " + p.standards.Title,
+			Fix:      "start the file with " + p.standards.Title,
+		}
+		if title != nil {
+			issue.Line = title.number
+		}
+		return append(issues, issue)
+	}
+
+	index := make(map[string]int, len(p.standards.HeaderFields))
+	for i, field := range p.standards.HeaderFields {
+		index[field] = i
+	}
+	last := -1
+	for _, field := range order {
+		i, ok := index[field]
+		if !ok {
+			continue
+		}
+		if i < last {
+			previous := p.standards.HeaderFields[last]
+			issues = append(issues, bspec.ValidationIssue{
+				Code:     CodeHeaderFormat,
+				Severity: bspec.SeverityWarning,
+				Line:     fields[field],
+				Message:  fmt.Sprintf("header field %q comes after %q", field, previous),
+				Fix:      fmt.Sprintf("move %q before %q", field, previous),
+			})
+			continue
+		}
+		last = i
+	}
+	for _, field := range p.standards.HeaderFields {
+		if _, ok := fields[field]; !ok {
+			issues = append(issues, bspec.ValidationIssue{
+				Code:     CodeHeaderFormat,
+				Severity: bspec.SeverityWarning,
+				Line:     title.number,
+				Message:  fmt.Sprintf("missing header field %q", field),
+				Fix:      fmt.Sprintf("add **%s:** after the title", field),
+			})
+		}
+	}
+	return issues
+}
+
+// checkText checks the vocabulary, normative keywords, placeholders and
+// sentence length of a body. Quoted text is not checked.
+func (p *prose) checkText(doc Document) bspec.ValidationIssues {
+	var issues bspec.ValidationIssues
+	lines := scanBody(doc.Content)
+	for _, line := range lines {
+		text := quotedSpan.ReplaceAllString(line.text, "")
+		for _, rule := range p.vocabulary {
+			if rule.pattern.MatchString(text) {
+				issues = append(issues, bspec.ValidationIssue{
+					Code:     CodeInformalPhrase,
+					Severity: bspec.SeverityWarning,
+					Line:     line.number,
+					Message:  fmt.Sprintf("informal phrase %q", rule.Avoid),
+					Fix:      "use " + quotedList(rule.Use),
+				})
+			}
+		}
+		if line.heading == 0 {
+			for _, keyword := range p.keywords.FindAllString(text, -1) {
+				keyword = strings.Join(strings.Fields(keyword), " ")
+				if keyword == strings.ToUpper(keyword) {
+					continue
+				}
+				issues = append(issues, bspec.ValidationIssue{
+					Code:     CodeNormativeKeyword,
+					Severity: bspec.SeverityWarning,
+					Line:     line.number,
+					Message:  fmt.Sprintf("normative keyword %q is not capitalized", keyword),
+					Fix:      fmt.Sprintf("write %s, or rephrase without a requirement", strings.ToUpper(keyword)),
+				})
+			}
+		}
+		if placeholder := p.placeholders.FindString(text); placeholder != "" {
+			issues = append(issues, bspec.ValidationIssue{
+				Code:     CodePlaceholderText,
+				Severity: bspec.SeverityWarning,
+				Line:     line.number,
+				Message:  fmt.Sprintf("placeholder text %q", placeholder),
+				Fix:      "complete the content",
+			})
+		}
+	}
+
+	for _, s := range sentences(lines) {
+		if s.words > p.standards.MaxSentenceWords {
+			issues = append(issues, bspec.ValidationIssue{
+				Code:     CodeLongSentence,
+				Severity: bspec.SeverityInfo,
+				Line:     s.line,
+				Message:  fmt.Sprintf("sentence has %d words; the writing standards aim for %d-%d", s.words, p.standards.MinSentenceWords, p.standards.MaxSentenceWords),
+				Fix:      "split the sentence",
+			})
+		}
+	}
+	return issues
+}
+
+// quotedList renders "a", "b" or "c"
+func quotedList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	if len(quoted) < 2 {
+		return strings.Join(quoted, "")
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
+}
@@ -0,0 +1,130 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+const proseBody = `# Growth
+
+## Strategic Framework
+
+We need to figure out which markets to enter first.
+The team should review the plan, and partners MUST sign off.
+Teams may choose any tool. "Make sure" is fine in quotes.
+Owners: TBD
+
+## Overview
+
+| help | get |
+|------|-----|
+
+` + "```" + `
+we should get this done
+` + "```" + `
+
+<!-- Coming soon -->
+- **Budget**: see [the pricing page](https://example.com/help) for e.g. costs.
+This sentence keeps going with many words because it describes every market,
+every channel, every partner, every supplier and every risk of the plan in a
+single long breath.
+`
+
+func proseIssues(linter *Linter, docs map[string]archive.BSpecDocument) map[string][]string {
+	found := make(map[string][]string)
+	for _, issue := range linter.Run(&archive.BSpecArchive{Documents: docs}, nil) {
+		found[issue.Code] = append(found[issue.Code], issue.Message)
+	}
+	return found
+}
+
+func TestProseRules(t *testing.T) {
+	doc := testDocument("STR-growth", "STR", "alice", nil)
+	doc.Content = proseBody
+	doc.BodyLine = 10 // Lines are reported in the file
+
+	found := proseIssues(NewProse(nil), map[string]archive.BSpecDocument{"01-strategic/STR-growth.md": doc})
+
+	for code, want := range map[string]string{
+		CodeInformalPhrase:   `STR-growth:14: informal phrase "figure out"`,
+		CodeNormativeKeyword: `STR-growth:15: normative keyword "should" is not capitalized`,
+		CodePlaceholderText:  `STR-growth:17: placeholder text "TBD"`,
+		CodeLongSentence:     "STR-growth:30: sentence has 29 words; the writing standards aim for 15-25",
+		CodeSectionOrder:     `STR-growth:19: section "Overview" comes after "Strategic Framework"`,
+	} {
+		if len(found[code]) != 1 || found[code][0] != want {
+			t.Errorf("Expected %s %q, got %v", code, want, found[code])
+		}
+	}
+	if len(found[CodeEmptySection]) != 0 {
+		t.Errorf("Expected the sections with text not to be empty, got %v", found[CodeEmptySection])
+	}
+	for _, missing := range found[CodeMissingSection] {
+		if strings.Contains(missing, "Overview") || strings.Contains(missing, "Strategic Framework") {
+			t.Errorf("Expected %s to be found", missing)
+		}
+	}
+	if !strings.Contains(strings.Join(found[CodeMissingSection], "\n"), `missing section "Strategic Choices"`) {
+		t.Errorf("Expected the other template sections to be missing, got %v", found[CodeMissingSection])
+	}
+}
+
+func TestProseRulesFileLines(t *testing.T) {
+	content := "---\nid: STR-growth\ntitle: Growth\ntype: STR\n---\n# Growth\n\nWe need to figure out the plan.\n"
+	doc, err := archive.ParseDocument([]byte(content))
+	if err != nil {
+		t.Fatalf("Failed to parse document: %v", err)
+	}
+
+	found := proseIssues(NewProse(nil), map[string]archive.BSpecDocument{"01-strategic/STR-growth.md": *doc})
+
+	// "figure out" is on line 8 of the file
+	want := `STR-growth:8: informal phrase "figure out"`
+	if len(found[CodeInformalPhrase]) != 1 || found[CodeInformalPhrase][0] != want {
+		t.Errorf("Expected %q, got %v", want, found[CodeInformalPhrase])
+	}
+}
+
+func TestSpecProseRules(t *testing.T) {
+	spec := archive.BSpecDocument{Type: "RSK", BodyLine: 1, Content: `# RSK: Risks Document Type Specification
+
+**Document Type Code:** RSK
+**Document Type Name:** Risks
+**Domain:** Risk & Governance
+**Status:** Draft
+**Version:** 1.0.0
+
+## Purpose and Scope
+
+## Abstract
+
+## Document Metadata Schema
+`}
+	untitled := archive.BSpecDocument{Type: "MIT", BodyLine: 1, Content: "## Abstract\n"}
+
+	found := proseIssues(NewSpecProse(nil), map[string]archive.BSpecDocument{
+		"risk-governance/RSK-spec.md": spec,
+		"risk-governance/MIT-spec.md": untitled,
+	})
+
+	want := []string{
+		`risk-governance/MIT-spec.md: title does not follow # {CODE}: {Full Name} Specification`,
+		`risk-governance/RSK-spec.md:7: header field "Version" comes after "Status"`,
+		`risk-governance/RSK-spec.md:1: missing header field "Last Updated"`,
+	}
+	if strings.Join(found[CodeHeaderFormat], "\n") != strings.Join(want, "\n") {
+		t.Errorf("Unexpected header issues:\n%s", strings.Join(found[CodeHeaderFormat], "\n"))
+	}
+	if order := found[CodeSectionOrder]; len(order) != 1 || order[0] != `risk-governance/RSK-spec.md:11: section "Abstract" comes after "Purpose and Scope"` {
+		t.Errorf("Unexpected order issues: %v", order)
+	}
+	if empty := found[CodeEmptySection]; len(empty) != 4 || empty[1] != `risk-governance/RSK-spec.md:9: section "Purpose and Scope" is empty` {
+		t.Errorf("Unexpected empty section issues: %v", empty)
+	}
+	missing := strings.Join(found[CodeMissingSection], "\n")
+	if !strings.Contains(missing, `RSK-spec.md: missing section "Quality Standards"`) || strings.Contains(missing, "Industry Variations") {
+		t.Errorf("Expected the required sections only to be missing, got:\n%s", missing)
+	}
+}
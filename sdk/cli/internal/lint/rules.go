@@ -0,0 +1,176 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/docfile"
+	"github.com/a3tai/bspec/cli/internal/integrity"
+	"github.com/a3tai/bspec/cli/internal/layout"
+)
+
+// CodeUnsortedList is reported for relationship lists that are not sorted
+const CodeUnsortedList = "unsorted_list"
+
+// Builtin returns the rules that always run: the document validation of the
+// SDK for the document's type, the domain folder of the document and the
+// order of its relationship lists
+func Builtin() []Rule {
+	return []Rule{RuleFunc(validateDocument), RuleFunc(checkFolder), RuleFunc(checkSortedLists)}
+}
+
+// validateDocument decodes the frontmatter into the SDK type of the
+// document and validates it
+func validateDocument(doc Document) bspec.ValidationIssues {
+	var typed bspec.Document = &bspec.BaseBSpecDocument{}
+	if defaults, err := bspec.NewTypedDocument(bspec.DocumentType(doc.Type), "", "", ""); err == nil {
+		// Start from the zero value so that defaults do not hide missing fields
+		typed = reflect.New(reflect.TypeOf(defaults).Elem()).Interface().(bspec.Document)
+	}
+
+	data, err := json.Marshal(doc.Fields())
+	if err == nil {
+		err = json.Unmarshal(data, typed)
+	}
+	if err != nil {
+		return bspec.ValidationIssues{{
+			Code:     bspec.CodeInvalidValue,
+			Severity: bspec.SeverityError,
+			Message:  fmt.Sprintf("frontmatter does not match the %s fields: %v", doc.Type, err),
+		}}
+	}
+	return typed.Validate()
+}
+
+// customRule is a compiled declarative rule
+type customRule struct {
+	CustomRule
+	when   *Expr
+	assert *Expr
+}
+
+func compileRule(custom CustomRule) (*customRule, error) {
+	if custom.Assert == "" {
+		return nil, fmt.Errorf("assert is required")
+	}
+	if custom.Severity == "" {
+		custom.Severity = SeverityError
+	}
+	if !validSeverity(custom.Severity) {
+		return nil, fmt.Errorf("unknown severity %q", custom.Severity)
+	}
+	if custom.Message == "" {
+		custom.Message = "does not satisfy " + custom.Assert
+	}
+	for key, value := range custom.Set {
+		switch value.(type) {
+		case string, int, float64, bool, []interface{}:
+		default:
+			return nil, fmt.Errorf("set.%s must be a scalar or a list", key)
+		}
+	}
+
+	rule := &customRule{CustomRule: custom}
+	var err error
+	if custom.When != "" {
+		if rule.when, err = ParseExpr(custom.When); err != nil {
+			return nil, err
+		}
+	}
+	if rule.assert, err = ParseExpr(custom.Assert); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// Check reports the document if it matches the condition but not the
+// assertion
+func (r *customRule) Check(doc Document) bspec.ValidationIssues {
+	fields := doc.Fields()
+	fields[BodyField] = doc.Content
+	if (r.when != nil && !r.when.Eval(fields)) || r.assert.Eval(fields) {
+		return nil
+	}
+	return bspec.ValidationIssues{{
+		Code:     r.ID,
+		Severity: bspec.Severity(r.Severity),
+		Field:    r.Field,
+		Message:  r.Message,
+		Fix:      r.CustomRule.Fix,
+	}}
+}
+
+// Fix sets the fields of the rule's set section
+func (r *customRule) Fix(ws *Workspace, issue integrity.Issue) bool {
+	file := ws.Edit(issue.Path)
+	if file == nil || len(r.Set) == 0 {
+		return false
+	}
+	keys := make([]string, 0, len(r.Set))
+	for key := range r.Set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		setField(file, key, r.Set[key])
+	}
+	return true
+}
+
+// setField sets a frontmatter field to a scalar or a list
+func setField(file *docfile.File, key string, value interface{}) {
+	items, ok := value.([]interface{})
+	if !ok {
+		file.Set(key, fmt.Sprint(value))
+		return
+	}
+	values := make([]string, len(items))
+	for i, item := range items {
+		values[i] = fmt.Sprint(item)
+	}
+	file.SetList(key, values)
+}
+
+// checkFolder reports documents outside the folder of their domain.
+// Documents that cannot be placed are reported by the document validation.
+func checkFolder(doc Document) bspec.ValidationIssues {
+	expected, err := layout.ExpectedPath(doc.BSpecDocument)
+	if err != nil {
+		return nil
+	}
+	folder := path.Dir(expected)
+	if path.Dir(path.Join(layout.DocumentsDir, filepath.ToSlash(doc.Path))) == folder {
+		return nil
+	}
+	return bspec.ValidationIssues{{
+		Code:     layout.CodeWrongFolder,
+		Severity: bspec.SeverityWarning,
+		Message:  fmt.Sprintf("document belongs in %s/", folder),
+		Fix:      fmt.Sprintf("move the file to %s/", folder),
+	}}
+}
+
+// checkSortedLists reports relationship lists that are not in alphabetical
+// order
+func checkSortedLists(doc Document) bspec.ValidationIssues {
+	var issues bspec.ValidationIssues
+	for _, field := range archive.RelationshipFields {
+		if refs := doc.References(field); !sort.StringsAreSorted(refs) {
+			issues = append(issues, bspec.ValidationIssue{
+				Code:     CodeUnsortedList,
+				Severity: bspec.SeverityInfo,
+				Field:    field,
+				Message:  fmt.Sprintf("%s is not sorted", field),
+				Fix:      fmt.Sprintf("sort %s", field),
+			})
+		}
+	}
+	return issues
+}
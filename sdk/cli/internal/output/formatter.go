@@ -3,14 +3,18 @@ package output
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"sort"
 	"strings"
+	"text/template"
 	"time"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/conformance"
 	"github.com/a3tai/bspec/cli/internal/query"
+	"github.com/a3tai/bspec/cli/internal/review"
 )
 
 // OutputFormat represents the supported output formats
@@ -20,12 +24,14 @@ const (
 	FormatJSON     OutputFormat = "json"
 	FormatYAML     OutputFormat = "yaml"
 	FormatMarkdown OutputFormat = "markdown"
+	FormatNDJSON   OutputFormat = "ndjson"
 )
 
 // Formatter handles formatting output in different formats
 type Formatter struct {
-	format OutputFormat
-	pretty bool
+	format   OutputFormat
+	pretty   bool
+	template *template.Template
 }
 
 // NewFormatter creates a new formatter
@@ -39,6 +45,8 @@ func NewFormatter(format string, pretty bool) (*Formatter, error) {
 		outputFormat = FormatYAML
 	case "markdown", "md":
 		outputFormat = FormatMarkdown
+	case "ndjson", "jsonl":
+		outputFormat = FormatNDJSON
 	default:
 		return nil, fmt.Errorf("unsupported output format: %s", format)
 	}
@@ -49,8 +57,29 @@ func NewFormatter(format string, pretty bool) (*Formatter, error) {
 	}, nil
 }
 
+// Format returns the output format of the formatter
+func (f *Formatter) Format() OutputFormat {
+	return f.format
+}
+
+// Streaming reports whether output should be written incrementally, one
+// document at a time, instead of being rendered as a single document
+func (f *Formatter) Streaming() bool {
+	return f.format == FormatNDJSON && f.template == nil
+}
+
+// SetTemplate makes the formatter render all output through a Go template,
+// taking precedence over the configured output format
+func (f *Formatter) SetTemplate(tmpl *template.Template) {
+	f.template = tmpl
+}
+
 // FormatQueryResult formats a query result
 func (f *Formatter) FormatQueryResult(result *query.QueryResult) (string, error) {
+	if f.template != nil {
+		return f.executeTemplate(result)
+	}
+
 	switch f.format {
 	case FormatJSON:
 		return f.formatQueryResultJSON(result)
@@ -58,6 +87,8 @@ func (f *Formatter) FormatQueryResult(result *query.QueryResult) (string, error)
 		return f.formatQueryResultYAML(result)
 	case FormatMarkdown:
 		return f.formatQueryResultMarkdown(result)
+	case FormatNDJSON:
+		return f.formatQueryResultNDJSON(result)
 	default:
 		return "", fmt.Errorf("unsupported format: %s", f.format)
 	}
@@ -65,6 +96,10 @@ func (f *Formatter) FormatQueryResult(result *query.QueryResult) (string, error)
 
 // FormatDocument formats a single document
 func (f *Formatter) FormatDocument(doc archive.BSpecDocument) (string, error) {
+	if f.template != nil {
+		return f.executeTemplate(doc)
+	}
+
 	switch f.format {
 	case FormatJSON:
 		return f.formatDocumentJSON(doc)
@@ -72,6 +107,8 @@ func (f *Formatter) FormatDocument(doc archive.BSpecDocument) (string, error) {
 		return f.formatDocumentYAML(doc)
 	case FormatMarkdown:
 		return f.formatDocumentMarkdown(doc)
+	case FormatNDJSON:
+		return f.formatLineJSON(doc)
 	default:
 		return "", fmt.Errorf("unsupported format: %s", f.format)
 	}
@@ -79,6 +116,10 @@ func (f *Formatter) FormatDocument(doc archive.BSpecDocument) (string, error) {
 
 // FormatArchiveInfo formats archive information
 func (f *Formatter) FormatArchiveInfo(arch *archive.BSpecArchive) (string, error) {
+	if f.template != nil {
+		return f.executeTemplate(arch)
+	}
+
 	switch f.format {
 	case FormatJSON:
 		return f.formatArchiveInfoJSON(arch)
@@ -86,6 +127,8 @@ func (f *Formatter) FormatArchiveInfo(arch *archive.BSpecArchive) (string, error
 		return f.formatArchiveInfoYAML(arch)
 	case FormatMarkdown:
 		return f.formatArchiveInfoMarkdown(arch)
+	case FormatNDJSON:
+		return f.formatLineJSON(archiveInfo(arch))
 	default:
 		return "", fmt.Errorf("unsupported format: %s", f.format)
 	}
@@ -93,6 +136,10 @@ func (f *Formatter) FormatArchiveInfo(arch *archive.BSpecArchive) (string, error
 
 // FormatStats formats statistics
 func (f *Formatter) FormatStats(stats map[string]interface{}) (string, error) {
+	if f.template != nil {
+		return f.executeTemplate(stats)
+	}
+
 	switch f.format {
 	case FormatJSON:
 		return f.formatStatsJSON(stats)
@@ -100,6 +147,30 @@ func (f *Formatter) FormatStats(stats map[string]interface{}) (string, error) {
 		return f.formatStatsYAML(stats)
 	case FormatMarkdown:
 		return f.formatStatsMarkdown(stats)
+	case FormatNDJSON:
+		return f.formatLineJSON(stats)
+	default:
+		return "", fmt.Errorf("unsupported format: %s", f.format)
+	}
+}
+
+// FormatReviewReport formats the documents due for review; the markdown
+// format is a digest grouped by owner
+func (f *Formatter) FormatReviewReport(report *review.Report) (string, error) {
+	if f.template != nil {
+		return f.executeTemplate(report)
+	}
+
+	switch f.format {
+	case FormatJSON:
+		return f.formatReviewReportJSON(report)
+	case FormatYAML:
+		data, err := yaml.Marshal(report)
+		return string(data), err
+	case FormatMarkdown:
+		return f.formatReviewReportMarkdown(report)
+	case FormatNDJSON:
+		return f.formatLineJSON(report)
 	default:
 		return "", fmt.Errorf("unsupported format: %s", f.format)
 	}
@@ -149,6 +220,49 @@ func (f *Formatter) formatStatsJSON(stats map[string]interface{}) (string, error
 	return string(data), err
 }
 
+func (f *Formatter) formatReviewReportJSON(report *review.Report) (string, error) {
+	if f.pretty {
+		data, err := json.MarshalIndent(report, "", "  ")
+		return string(data), err
+	}
+	data, err := json.Marshal(report)
+	return string(data), err
+}
+
+// NDJSON formatters
+
+// WriteDocumentNDJSON writes a document as a single line of JSON
+func (f *Formatter) WriteDocumentNDJSON(w io.Writer, doc archive.BSpecDocument) error {
+	return json.NewEncoder(w).Encode(doc)
+}
+
+func (f *Formatter) formatQueryResultNDJSON(result *query.QueryResult) (string, error) {
+	var sb strings.Builder
+	for _, doc := range result.Documents {
+		if err := f.WriteDocumentNDJSON(&sb, doc); err != nil {
+			return "", err
+		}
+	}
+	return sb.String(), nil
+}
+
+func (f *Formatter) formatLineJSON(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
+
+func archiveInfo(arch *archive.BSpecArchive) map[string]interface{} {
+	return map[string]interface{}{
+		"manifest":       arch.Manifest,
+		"document_count": len(arch.Documents),
+		"asset_count":    len(arch.Assets),
+		"computed_count": len(arch.Computed),
+	}
+}
+
 // YAML formatters
 func (f *Formatter) formatQueryResultYAML(result *query.QueryResult) (string, error) {
 	data, err := yaml.Marshal(result)
@@ -356,7 +470,48 @@ func (f *Formatter) formatStatsMarkdown(stats map[string]interface{}) (string, e
 		for _, owner := range owners {
 			sb.WriteString(fmt.Sprintf("- %s\n", owner))
 		}
+		sb.WriteString("\n")
+	}
+
+	// Checklist completion
+	if checklists, ok := stats["checklists"].(map[string]*conformance.ChecklistTotals); ok {
+		sb.WriteString("## Checklist Completion\n\n")
+		found := false
+		for _, name := range []string{"bronze", "silver", "gold", conformance.ValidationChecklist} {
+			totals, ok := checklists[name]
+			if !ok || totals.Documents == 0 {
+				continue
+			}
+			found = true
+			sb.WriteString(fmt.Sprintf("- **%s:** %d/%d items checked, %d of %d documents complete\n",
+				name, totals.Checked, totals.Total, totals.Complete, totals.Documents))
+		}
+		if !found {
+			sb.WriteString("No checklists found\n")
+		}
 	}
 
 	return sb.String(), nil
-}
\ No newline at end of file
+}
+
+func (f *Formatter) formatReviewReportMarkdown(report *review.Report) (string, error) {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("# Review digest — %s\n\n", report.AsOf))
+	if report.Total == 0 {
+		sb.WriteString("No documents are due for review.\n")
+		return sb.String(), nil
+	}
+	sb.WriteString(fmt.Sprintf("%d items are due for review", report.Total))
+	if report.Within > 0 {
+		sb.WriteString(fmt.Sprintf(" or become due within %d days", report.Within))
+	}
+	sb.WriteString(".\n")
+	for _, group := range report.Owners {
+		sb.WriteString(fmt.Sprintf("\n## %s\n\n", group.Owner))
+		for _, item := range group.Items {
+			sb.WriteString(fmt.Sprintf("- **%s** %s (%s): %s — `%s`\n", item.Document, item.Title, item.Status, item.Description(), item.Path))
+		}
+	}
+	return sb.String(), nil
+}
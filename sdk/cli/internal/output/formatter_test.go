@@ -1,7 +1,13 @@
 package output
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/query"
+	"github.com/a3tai/bspec/cli/internal/review"
 )
 
 func TestFormatterConstants(t *testing.T) {
@@ -48,4 +54,70 @@ func TestOutputFormatString(t *testing.T) {
 	if format != FormatMarkdown {
 		t.Errorf("Expected format to equal FormatMarkdown")
 	}
-}
\ No newline at end of file
+}
+
+func TestNDJSONFormat(t *testing.T) {
+	formatter, err := NewFormatter("ndjson", true)
+	if err != nil {
+		t.Fatalf("Failed to create formatter: %v", err)
+	}
+	if !formatter.Streaming() {
+		t.Error("Expected NDJSON formatter to stream")
+	}
+
+	result, err := formatter.FormatQueryResult(&query.QueryResult{
+		Documents: []archive.BSpecDocument{{ID: "MSN-a"}, {ID: "VSN-b"}},
+		Total:     2,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(result, "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected one line per document, got %d: %q", len(lines), result)
+	}
+	var doc archive.BSpecDocument
+	if err := json.Unmarshal([]byte(lines[1]), &doc); err != nil {
+		t.Fatalf("Expected each line to be valid JSON: %v", err)
+	}
+	if doc.ID != "VSN-b" {
+		t.Errorf("Expected second line to be VSN-b, got %s", doc.ID)
+	}
+
+	// A template disables streaming
+	tmpl, _ := ParseTemplate("t", "{{.Total}}")
+	formatter.SetTemplate(tmpl)
+	if formatter.Streaming() {
+		t.Error("Expected template to take precedence over NDJSON streaming")
+	}
+}
+
+func TestFormatReviewReport(t *testing.T) {
+	report := &review.Report{
+		AsOf:   "2025-01-10",
+		Within: 7,
+		Total:  1,
+		Owners: []review.OwnerGroup{{Owner: "alice", Items: []review.Item{
+			{Document: "MSN-001", Title: "Mission", Status: "Accepted", Path: "01-strategic/MSN-001.md", Due: "2023-04-10",
+				Reason: review.ReasonReviewOverdue, ReviewCycle: "quarterly", Updated: "2023-01-10", DaysOverdue: 641},
+		}}},
+	}
+
+	formatter, _ := NewFormatter("markdown", false)
+	digest, err := formatter.FormatReviewReport(report)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, expected := range []string{"# Review digest — 2025-01-10", "or become due within 7 days", "## alice", "- **MSN-001** Mission (Accepted): review overdue by 641 days"} {
+		if !strings.Contains(digest, expected) {
+			t.Errorf("Expected %q in digest:\n%s", expected, digest)
+		}
+	}
+
+	formatter, _ = NewFormatter("json", false)
+	data, err := formatter.FormatReviewReport(report)
+	if err != nil || !strings.Contains(data, `"as_of":"2025-01-10"`) {
+		t.Errorf("Expected the report as JSON, got %s (%v)", data, err)
+	}
+}
@@ -0,0 +1,269 @@
+package output
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+//go:embed templates/*.tmpl
+var builtinTemplates embed.FS
+
+// DocumentGroup is a set of documents sharing the same value for a field
+type DocumentGroup struct {
+	Key       string                  `json:"key"`
+	Documents []archive.BSpecDocument `json:"documents"`
+}
+
+// BuiltinTemplates returns the names of the templates shipped with the CLI
+func BuiltinTemplates() []string {
+	entries, err := builtinTemplates.ReadDir("templates")
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".tmpl"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadTemplate loads a template from a file path or, if no such file exists,
+// from the built-in template with the given name
+func LoadTemplate(nameOrPath string) (*template.Template, error) {
+	if _, err := os.Stat(nameOrPath); err == nil {
+		content, err := os.ReadFile(nameOrPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template %s: %w", nameOrPath, err)
+		}
+		return ParseTemplate(filepath.Base(nameOrPath), string(content))
+	}
+
+	content, err := builtinTemplates.ReadFile("templates/" + nameOrPath + ".tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("template not found: %s (built-in templates: %s)", nameOrPath, strings.Join(BuiltinTemplates(), ", "))
+	}
+	return ParseTemplate(nameOrPath, string(content))
+}
+
+// ParseTemplate parses template text with the output helper functions available
+func ParseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(TemplateFuncs()).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+// TemplateFuncs returns the helper functions available to output templates
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"formatDate":     formatDate,
+		"now":            time.Now,
+		"daysSince":      daysSince,
+		"markdownToText": markdownToText,
+		"join":           join,
+		"groupBy":        groupBy,
+		"upper":          strings.ToUpper,
+		"lower":          strings.ToLower,
+		"truncate":       truncate,
+		"default":        defaultValue,
+	}
+}
+
+// executeTemplate renders data with the formatter's template
+func (f *Formatter) executeTemplate(data interface{}) (string, error) {
+	var buf bytes.Buffer
+	if err := f.template.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to execute template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// parseDate accepts a time.Time or a YYYY-MM-DD / RFC3339 string
+func parseDate(value interface{}) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case *time.Time:
+		if v != nil {
+			return *v, true
+		}
+	case string:
+		for _, layout := range []string{"2006-01-02", time.RFC3339} {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// formatDate formats a date using a Go time layout; unparseable values are returned unchanged
+func formatDate(layout string, value interface{}) string {
+	t, ok := parseDate(value)
+	if !ok {
+		return fmt.Sprint(value)
+	}
+	return t.Format(layout)
+}
+
+// daysSince returns the number of whole days between a date and now, or -1 if the date is invalid
+func daysSince(value interface{}) int {
+	t, ok := parseDate(value)
+	if !ok {
+		return -1
+	}
+	return int(time.Since(t).Hours() / 24)
+}
+
+var (
+	mdHeadingPattern = regexp.MustCompile(`(?m)^#{1,6}\s+`)
+	mdListPattern    = regexp.MustCompile(`(?m)^(\s*)([-*+]|\d+\.)\s+(\[[ xX]\]\s+)?`)
+	mdImagePattern   = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	mdLinkPattern    = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+	mdStrongPattern  = regexp.MustCompile(`(\*\*|__)(\S(?:.*?\S)?)(\*\*|__)`)
+	mdItalicPattern  = regexp.MustCompile(`(^|[\s(])[*_](\S(?:[^*_]*?\S)?)[*_]([\s).,;:!?]|$)`)
+	mdStrikePattern  = regexp.MustCompile(`~~(.+?)~~`)
+	mdCodePattern    = regexp.MustCompile("`([^`]*)`")
+	mdFencePattern   = regexp.MustCompile("(?m)^```.*$\n?")
+	mdQuotePattern   = regexp.MustCompile(`(?m)^>\s?`)
+	mdBlankPattern   = regexp.MustCompile(`\n{3,}`)
+)
+
+// markdownToText strips markdown syntax, leaving readable plain text
+func markdownToText(markdown string) string {
+	text := mdFencePattern.ReplaceAllString(markdown, "")
+	text = mdHeadingPattern.ReplaceAllString(text, "")
+	text = mdQuotePattern.ReplaceAllString(text, "")
+	text = mdListPattern.ReplaceAllString(text, "$1- ")
+	text = mdImagePattern.ReplaceAllString(text, "$1")
+	text = mdLinkPattern.ReplaceAllString(text, "$1")
+	text = mdStrongPattern.ReplaceAllString(text, "$2")
+	text = mdItalicPattern.ReplaceAllString(text, "$1$2$3")
+	text = mdStrikePattern.ReplaceAllString(text, "$1")
+	text = mdCodePattern.ReplaceAllString(text, "$1")
+	text = mdBlankPattern.ReplaceAllString(text, "\n\n")
+	return strings.TrimSpace(text)
+}
+
+// join joins a list of values with a separator
+func join(sep string, items interface{}) string {
+	if items == nil {
+		return ""
+	}
+	if s, ok := items.([]string); ok {
+		return strings.Join(s, sep)
+	}
+
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return fmt.Sprint(items)
+	}
+
+	parts := make([]string, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		parts = append(parts, fmt.Sprint(v.Index(i).Interface()))
+	}
+	return strings.Join(parts, sep)
+}
+
+// groupBy groups documents by a field name, returning groups sorted by key.
+// Known fields are read from the document; any other field is read from its metadata.
+func groupBy(field string, docs interface{}) ([]DocumentGroup, error) {
+	var list []archive.BSpecDocument
+	switch d := docs.(type) {
+	case []archive.BSpecDocument:
+		list = d
+	case map[string]archive.BSpecDocument:
+		keys := make([]string, 0, len(d))
+		for key := range d {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			list = append(list, d[key])
+		}
+	default:
+		return nil, fmt.Errorf("groupBy: unsupported value of type %T", docs)
+	}
+
+	index := make(map[string]int)
+	var groups []DocumentGroup
+	for _, doc := range list {
+		key := documentField(doc, field)
+		i, exists := index[key]
+		if !exists {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, DocumentGroup{Key: key})
+		}
+		groups[i].Documents = append(groups[i].Documents, doc)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].Key < groups[j].Key
+	})
+	return groups, nil
+}
+
+// documentField returns the string value of a document field by its frontmatter name
+func documentField(doc archive.BSpecDocument, field string) string {
+	switch strings.ToLower(field) {
+	case "id":
+		return doc.ID
+	case "title":
+		return doc.Title
+	case "type":
+		return doc.Type
+	case "status":
+		return doc.Status
+	case "version":
+		return doc.Version
+	case "owner":
+		return doc.Owner
+	case "created":
+		return doc.Created
+	case "updated":
+		return doc.Updated
+	case "domain":
+		return doc.Domain
+	}
+
+	if value, ok := doc.Metadata[field]; ok && value != nil {
+		return fmt.Sprint(value)
+	}
+	return ""
+}
+
+// truncate shortens a string to at most n runes, appending an ellipsis when cut
+func truncate(n int, s string) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "..."
+}
+
+// defaultValue returns fallback when value is empty
+func defaultValue(fallback string, value interface{}) interface{} {
+	if value == nil {
+		return fallback
+	}
+	if s, ok := value.(string); ok && s == "" {
+		return fallback
+	}
+	return value
+}
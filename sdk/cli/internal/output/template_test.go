@@ -0,0 +1,170 @@
+package output
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/query"
+)
+
+func testQueryResult() *query.QueryResult {
+	docs := []archive.BSpecDocument{
+		{ID: "MSN-mission", Title: "Mission", Type: "MSN", Status: "Accepted", Owner: "alice", Updated: "2025-01-15"},
+		{ID: "VSN-vision", Title: "Vision", Type: "VSN", Status: "Draft", Owner: "bob", Updated: "2025-02-01"},
+		{ID: "STR-strategy", Title: "Strategy", Type: "STR", Status: "Draft", Owner: "alice", Metadata: map[string]interface{}{"priority": "high"}},
+	}
+	return &query.QueryResult{Documents: docs, Total: len(docs)}
+}
+
+func TestTemplateStringRendering(t *testing.T) {
+	formatter, err := NewFormatter("json", true)
+	if err != nil {
+		t.Fatalf("Failed to create formatter: %v", err)
+	}
+
+	tmpl, err := ParseTemplate("inline", `{{range .Documents}}{{.ID}}|{{end}}total={{.Total}}`)
+	if err != nil {
+		t.Fatalf("Failed to parse template: %v", err)
+	}
+	formatter.SetTemplate(tmpl)
+
+	result, err := formatter.FormatQueryResult(testQueryResult())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "MSN-mission|VSN-vision|STR-strategy|total=3"
+	if result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTemplateFuncs(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{
+			name:     "formatDate",
+			template: `{{formatDate "Jan 2, 2006" "2025-01-15"}}`,
+			expected: "Jan 15, 2025",
+		},
+		{
+			name:     "join",
+			template: `{{join ", " .Tags}}`,
+			expected: "a, b, c",
+		},
+		{
+			name:     "markdownToText",
+			template: `{{markdownToText .Markdown}}`,
+			expected: "Title\n\nSome bold text with a link and risk_category.",
+		},
+		{
+			name:     "truncate",
+			template: `{{truncate 4 "abcdefgh"}}`,
+			expected: "abcd...",
+		},
+		{
+			name:     "default",
+			template: `{{default "none" ""}}`,
+			expected: "none",
+		},
+	}
+
+	data := map[string]interface{}{
+		"Tags":     []string{"a", "b", "c"},
+		"Markdown": "# Title\n\nSome **bold** text with a [link](http://example.com) and `risk_category`.",
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseTemplate(tt.name, tt.template)
+			if err != nil {
+				t.Fatalf("Failed to parse template: %v", err)
+			}
+
+			var sb strings.Builder
+			if err := tmpl.Execute(&sb, data); err != nil {
+				t.Fatalf("Failed to execute template: %v", err)
+			}
+			if sb.String() != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, sb.String())
+			}
+		})
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	result := testQueryResult()
+
+	groups, err := groupBy("owner", result.Documents)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 groups, got %d", len(groups))
+	}
+	if groups[0].Key != "alice" || len(groups[0].Documents) != 2 {
+		t.Errorf("Expected alice to own 2 documents, got %s with %d", groups[0].Key, len(groups[0].Documents))
+	}
+
+	groups, err = groupBy("priority", result.Documents)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if groups[len(groups)-1].Key != "high" {
+		t.Errorf("Expected metadata field to be used as group key, got %q", groups[len(groups)-1].Key)
+	}
+
+	if _, err := groupBy("owner", "not documents"); err == nil {
+		t.Error("Expected error for unsupported value")
+	}
+}
+
+func TestLoadTemplate(t *testing.T) {
+	// Built-in templates are available by name
+	for _, name := range BuiltinTemplates() {
+		if _, err := LoadTemplate(name); err != nil {
+			t.Errorf("Failed to load built-in template %s: %v", name, err)
+		}
+	}
+
+	tmpl, err := LoadTemplate("weekly-status")
+	if err != nil {
+		t.Fatalf("Failed to load weekly-status: %v", err)
+	}
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, testQueryResult()); err != nil {
+		t.Fatalf("Failed to execute weekly-status: %v", err)
+	}
+	for _, expected := range []string{"# Weekly Status", "## Accepted (1)", "## Draft (2)", "MSN-mission"} {
+		if !strings.Contains(sb.String(), expected) {
+			t.Errorf("Expected weekly-status output to contain %q, got:\n%s", expected, sb.String())
+		}
+	}
+
+	// Template files take precedence over built-in names
+	path := filepath.Join(t.TempDir(), "custom.tmpl")
+	if err := os.WriteFile(path, []byte("{{len .Documents}} docs"), 0644); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+	tmpl, err = LoadTemplate(path)
+	if err != nil {
+		t.Fatalf("Failed to load template file: %v", err)
+	}
+	sb.Reset()
+	if err := tmpl.Execute(&sb, testQueryResult()); err != nil {
+		t.Fatalf("Failed to execute template file: %v", err)
+	}
+	if sb.String() != "3 docs" {
+		t.Errorf("Expected '3 docs', got %q", sb.String())
+	}
+
+	if _, err := LoadTemplate("no-such-template"); err == nil {
+		t.Error("Expected error for unknown template")
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"regexp"
 	"strings"
 
+	bspec "github.com/bspec-foundation/bspec-go"
+
 	"github.com/a3tai/bspec/cli/internal/archive"
 )
 
@@ -28,6 +30,7 @@ type Query struct {
 	Owner     string            `json:"owner,omitempty"`      // Filter by owner
 	Tags      []string          `json:"tags,omitempty"`       // Filter by tags
 	Search    string            `json:"search,omitempty"`     // Text search in content
+	Section   string            `json:"section,omitempty"`    // Scope search and content to a section, by heading or anchor
 	Fields    []string          `json:"fields,omitempty"`     // Select specific fields
 	Limit     int               `json:"limit,omitempty"`      // Limit results
 	SortBy    string            `json:"sort_by,omitempty"`    // Sort field
@@ -49,7 +52,7 @@ func (qe *QueryEngine) Execute(q Query) (*QueryResult, error) {
 	// Apply filters
 	for _, doc := range qe.archive.Documents {
 		if qe.matchesQuery(doc, q) {
-			results = append(results, doc)
+			results = append(results, scopeSection(doc, q.Section))
 		}
 	}
 
@@ -75,6 +78,47 @@ func (qe *QueryEngine) Execute(q Query) (*QueryResult, error) {
 	}, nil
 }
 
+// Stream executes a query and passes each matching document to fn as soon as
+// it matches, without collecting the full result. Field selection and the
+// limit are applied per document. Sorting needs the full result set, so
+// sorted queries are executed first and then replayed to fn.
+// Stream returns the number of documents passed to fn.
+func (qe *QueryEngine) Stream(q Query, fn func(archive.BSpecDocument) error) (int, error) {
+	if q.SortBy != "" {
+		result, err := qe.Execute(q)
+		if err != nil {
+			return 0, err
+		}
+		for i, doc := range result.Documents {
+			if err := fn(doc); err != nil {
+				return i, err
+			}
+		}
+		return result.Total, nil
+	}
+
+	count := 0
+	for _, doc := range qe.archive.Documents {
+		if q.Limit > 0 && count >= q.Limit {
+			break
+		}
+		if !qe.matchesQuery(doc, q) {
+			continue
+		}
+
+		doc = scopeSection(doc, q.Section)
+		if len(q.Fields) > 0 {
+			doc = qe.selectFields([]archive.BSpecDocument{doc}, q.Fields)[0]
+		}
+		if err := fn(doc); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
 // matchesQuery checks if a document matches the query criteria
 func (qe *QueryEngine) matchesQuery(doc archive.BSpecDocument, q Query) bool {
 	// Type filter
@@ -97,11 +141,20 @@ func (qe *QueryEngine) matchesQuery(doc archive.BSpecDocument, q Query) bool {
 		return false
 	}
 
+	// Section filter
+	searchIn := doc.Title + " " + doc.Content
+	if q.Section != "" {
+		section := bspec.ParseBody(doc.Content).Find(q.Section)
+		if section == nil {
+			return false
+		}
+		searchIn = section.String()
+	}
+
 	// Text search filter
 	if q.Search != "" {
 		searchTerm := strings.ToLower(q.Search)
-		searchIn := strings.ToLower(doc.Title + " " + doc.Content)
-		if !strings.Contains(searchIn, searchTerm) {
+		if !strings.Contains(strings.ToLower(searchIn), searchTerm) {
 			return false
 		}
 	}
@@ -130,6 +183,18 @@ func (qe *QueryEngine) matchesQuery(doc archive.BSpecDocument, q Query) bool {
 	return true
 }
 
+// scopeSection replaces the content of a document with the markdown of a
+// section, from its heading to the next heading of the same or a higher level
+func scopeSection(doc archive.BSpecDocument, name string) archive.BSpecDocument {
+	if name == "" {
+		return doc
+	}
+	if section := bspec.ParseBody(doc.Content).Find(name); section != nil {
+		doc.Content = section.String()
+	}
+	return doc
+}
+
 // sortDocuments sorts documents by the specified field
 func (qe *QueryEngine) sortDocuments(docs []archive.BSpecDocument, sortBy, order string) []archive.BSpecDocument {
 	// Simple sorting implementation
@@ -91,4 +91,69 @@ func TestEmptyQuery(t *testing.T) {
 	if len(query.Tags) != 0 {
 		t.Errorf("Expected no tags, got %d", len(query.Tags))
 	}
-}
\ No newline at end of file
+}
+
+func TestStream(t *testing.T) {
+	testArchive := &archive.BSpecArchive{
+		Documents: map[string]archive.BSpecDocument{
+			"a.md": {ID: "RSK-a", Title: "Risk A", Type: "RSK", Owner: "alice"},
+			"b.md": {ID: "RSK-b", Title: "Risk B", Type: "RSK", Owner: "bob"},
+			"c.md": {ID: "MIT-c", Title: "Mitigation C", Type: "MIT", Owner: "alice"},
+		},
+	}
+	engine := NewQueryEngine(testArchive)
+
+	var streamed []archive.BSpecDocument
+	total, err := engine.Stream(Query{Type: "RSK", Fields: []string{"id"}}, func(doc archive.BSpecDocument) error {
+		streamed = append(streamed, doc)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if total != 2 || len(streamed) != 2 {
+		t.Fatalf("Expected 2 streamed documents, got total=%d streamed=%d", total, len(streamed))
+	}
+	for _, doc := range streamed {
+		if doc.ID == "" || doc.Title != "" {
+			t.Errorf("Expected only the id field to be selected, got %+v", doc)
+		}
+	}
+
+	total, err = engine.Stream(Query{Limit: 1}, func(doc archive.BSpecDocument) error {
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if total != 1 {
+		t.Errorf("Expected limit to stop the stream after 1 document, got %d", total)
+	}
+}
+
+func TestSectionQuery(t *testing.T) {
+	testArchive := &archive.BSpecArchive{
+		Documents: map[string]archive.BSpecDocument{
+			"a.md": {ID: "RSK-a", Type: "RSK", Content: "# Risk A\n\n## Impact\n\nVendor lock-in.\n\n## Mitigation\n\nDual vendors.\n"},
+			"b.md": {ID: "RSK-b", Type: "RSK", Content: "# Risk B\n\n## Impact\n\nChurn.\n\n## Mitigation\n\nSwitch vendor.\n"},
+			"c.md": {ID: "RSK-c", Type: "RSK", Content: "# Risk C\n\nVendor outage.\n"},
+		},
+	}
+	engine := NewQueryEngine(testArchive)
+
+	result, err := engine.Execute(Query{Section: "impact", Search: "vendor"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Total != 1 || result.Documents[0].ID != "RSK-a" {
+		t.Fatalf("Expected only RSK-a to mention a vendor in its impact, got %+v", result.Documents)
+	}
+	if content := result.Documents[0].Content; content != "## Impact\n\nVendor lock-in.\n\n" {
+		t.Errorf("Expected the content to be scoped to the section, got %q", content)
+	}
+
+	result, _ = engine.Execute(Query{Section: "Mitigation", Fields: []string{"id", "content"}})
+	if result.Total != 2 {
+		t.Errorf("Expected the documents without the section to be left out, got %d", result.Total)
+	}
+}
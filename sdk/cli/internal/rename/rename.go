@@ -0,0 +1,428 @@
+package rename
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+	"gopkg.in/yaml.v3"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+var (
+	// fileSuffixPattern matches what follows the ID in a document file name
+	fileSuffixPattern = regexp.MustCompile(`^(-v\d+\.\d+\.\d+)?\.md$`)
+	inlineLinkPattern = regexp.MustCompile(`\]\(([^)\s]+)`)
+	refLinkPattern    = regexp.MustCompile(`^\s{0,3}\[[^\]]+\]:\s*(\S+)`)
+)
+
+// Plan is the set of edits that renames a document ID
+type Plan struct {
+	Dir     string
+	OldID   string
+	NewID   string
+	Changes []Change
+}
+
+// Change is the edit of one document file
+type Change struct {
+	Path    string // Relative to the archive directory
+	NewPath string // Differs from Path when the file is renamed
+	Before  []byte
+	After   []byte
+	Lines   []LineChange
+}
+
+// LineChange is a changed line of a file
+type LineChange struct {
+	Line   int
+	Before string
+	After  string
+}
+
+// Renamed returns true if the change moves the file
+func (c Change) Renamed() bool {
+	return c.NewPath != c.Path
+}
+
+// document is a parsed document file
+type document struct {
+	path        string // Relative to the archive directory
+	content     []byte
+	lines       []string
+	frontmatter *yaml.Node
+	bodyStart   int // Index of the first line after the closing ---
+}
+
+// edit replaces Old with New at a column of a line
+type edit struct {
+	line   int // Index into lines
+	column int // Byte offset where the search for Old starts
+}
+
+// NewPlan computes the edits that rename a document ID in an archive
+// directory: the document's id, every relationship field that references it,
+// markdown links to it and its file name.
+func NewPlan(dir, oldID, newID string) (*Plan, error) {
+	if oldID == newID {
+		return nil, fmt.Errorf("the new ID is the same as the old ID")
+	}
+
+	docs, err := readDocuments(dir, oldID)
+	if err != nil {
+		return nil, err
+	}
+
+	var target *document
+	for _, doc := range docs {
+		switch scalarValue(doc.frontmatter, "id") {
+		case oldID:
+			if target != nil {
+				return nil, fmt.Errorf("document ID %s is used by both %s and %s", oldID, target.path, doc.path)
+			}
+			target = doc
+		case newID:
+			return nil, fmt.Errorf("document ID %s already exists in %s", newID, doc.path)
+		}
+	}
+	if target == nil {
+		return nil, fmt.Errorf("document not found: %s", oldID)
+	}
+	docType := bspec.DocumentType(scalarValue(target.frontmatter, "type"))
+	if err := bspec.ValidateDocumentID(newID, docType); err != nil {
+		return nil, fmt.Errorf("invalid new ID %s: %w", newID, err)
+	}
+
+	plan := &Plan{Dir: dir, OldID: oldID, NewID: newID, Changes: changes(docs, target, oldID, newID)}
+
+	for _, change := range plan.Changes {
+		if change.Renamed() {
+			if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(change.NewPath))); err == nil {
+				return nil, fmt.Errorf("cannot rename %s: %s already exists", change.Path, change.NewPath)
+			}
+		}
+	}
+	return plan, nil
+}
+
+// Rewrite computes the edits that rename the ID of the document at target
+// in a set of document contents, by path relative to the archive directory.
+// The target's id is only rewritten if it still is oldID, so callers that
+// already changed it get the references, links and file name updated.
+func Rewrite(files map[string][]byte, target, oldID, newID string) ([]Change, error) {
+	var docs []*document
+	var targetDoc *document
+	for relPath, content := range files {
+		doc, err := parseDocument(relPath, content)
+		if err != nil {
+			continue
+		}
+		if relPath == target {
+			targetDoc = doc
+		}
+		docs = append(docs, doc)
+	}
+	if targetDoc == nil {
+		return nil, fmt.Errorf("document not found: %s", target)
+	}
+	sort.Slice(docs, func(i, j int) bool { return docs[i].path < docs[j].path })
+	return changes(docs, targetDoc, oldID, newID), nil
+}
+
+// changes computes the edit of each document that renames the target's ID
+func changes(docs []*document, target *document, oldID, newID string) []Change {
+	// The file is renamed when its name starts with the ID
+	oldBase := path.Base(target.path)
+	newBase := oldBase
+	if suffix := strings.TrimPrefix(oldBase, oldID); suffix != oldBase && fileSuffixPattern.MatchString(suffix) {
+		newBase = newID + suffix
+	}
+
+	var result []Change
+	for _, doc := range docs {
+		var edits []edit
+		if doc == target {
+			edits = append(edits, nodeEdits(valueNode(doc.frontmatter, "id"), oldID)...)
+		}
+		for _, field := range archive.RelationshipFields {
+			edits = append(edits, nodeEdits(valueNode(doc.frontmatter, field), oldID)...)
+		}
+
+		lines := append([]string{}, doc.lines...)
+		applyEdits(lines, edits, oldID, newID)
+		rewriteLinks(lines[doc.bodyStart:], oldID, newID, oldBase, newBase)
+
+		change := Change{Path: doc.path, NewPath: doc.path, Before: doc.content}
+		if doc == target {
+			change.NewPath = path.Join(path.Dir(doc.path), newBase)
+		}
+		for i := range lines {
+			if lines[i] != doc.lines[i] {
+				change.Lines = append(change.Lines, LineChange{Line: i + 1, Before: doc.lines[i], After: lines[i]})
+			}
+		}
+		if len(change.Lines) == 0 && !change.Renamed() {
+			continue
+		}
+		change.After = []byte(strings.Join(lines, "\n"))
+		result = append(result, change)
+	}
+	return result
+}
+
+// Diff renders the plan as a unified-style diff of the changed lines
+func (p *Plan) Diff() string {
+	var b strings.Builder
+	for _, change := range p.Changes {
+		if change.Renamed() {
+			fmt.Fprintf(&b, "rename %s => %s\n", change.Path, change.NewPath)
+		}
+		if len(change.Lines) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "--- a/%s\n+++ b/%s\n", change.Path, change.NewPath)
+		for _, line := range change.Lines {
+			fmt.Fprintf(&b, "@@ -%d +%d @@\n-%s\n+%s\n", line.Line, line.Line, line.Before, line.After)
+		}
+	}
+	return b.String()
+}
+
+// Apply writes the changes. New contents are written to temporary files
+// first and then moved into place, so a failure leaves the archive unchanged
+// where possible.
+func (p *Plan) Apply() error {
+	temps := make([]string, len(p.Changes))
+	cleanup := func() {
+		for _, temp := range temps {
+			if temp != "" {
+				os.Remove(temp)
+			}
+		}
+	}
+
+	for i, change := range p.Changes {
+		target := filepath.Join(p.Dir, filepath.FromSlash(change.NewPath))
+		temp, err := os.CreateTemp(filepath.Dir(target), ".bspec-mv-*")
+		if err != nil {
+			cleanup()
+			return fmt.Errorf("failed to create temporary file: %w", err)
+		}
+		temps[i] = temp.Name()
+		if _, err := temp.Write(change.After); err != nil {
+			temp.Close()
+			cleanup()
+			return fmt.Errorf("failed to write %s: %w", change.NewPath, err)
+		}
+		if err := temp.Close(); err != nil {
+			cleanup()
+			return fmt.Errorf("failed to write %s: %w", change.NewPath, err)
+		}
+	}
+
+	for i, change := range p.Changes {
+		target := filepath.Join(p.Dir, filepath.FromSlash(change.NewPath))
+		if err := os.Rename(temps[i], target); err != nil {
+			p.rollback(i)
+			cleanup()
+			return fmt.Errorf("failed to update %s: %w", change.NewPath, err)
+		}
+		temps[i] = ""
+		if change.Renamed() {
+			if err := os.Remove(filepath.Join(p.Dir, filepath.FromSlash(change.Path))); err != nil {
+				p.rollback(i + 1)
+				cleanup()
+				return fmt.Errorf("failed to remove %s: %w", change.Path, err)
+			}
+		}
+	}
+	return nil
+}
+
+// rollback restores the first n applied changes
+func (p *Plan) rollback(n int) {
+	for _, change := range p.Changes[:n] {
+		os.WriteFile(filepath.Join(p.Dir, filepath.FromSlash(change.Path)), change.Before, 0644)
+		if change.Renamed() {
+			os.Remove(filepath.Join(p.Dir, filepath.FromSlash(change.NewPath)))
+		}
+	}
+}
+
+// readDocuments parses the documents of an archive directory, sorted by path.
+// Documents that cannot be parsed are skipped unless they mention the ID.
+func readDocuments(dir, id string) ([]*document, error) {
+	documentsDir := filepath.Join(dir, "documents")
+	if _, err := os.Stat(documentsDir); err != nil {
+		return nil, fmt.Errorf("no documents directory in %s", dir)
+	}
+
+	var docs []*document
+	err := filepath.Walk(documentsDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(filePath, ".md") {
+			return nil
+		}
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to read document %s: %w", filePath, err)
+		}
+		relPath, _ := filepath.Rel(dir, filePath)
+		doc, err := parseDocument(filepath.ToSlash(relPath), content)
+		if err != nil {
+			if strings.Contains(string(content), id) {
+				return err
+			}
+			return nil
+		}
+		docs = append(docs, doc)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read documents: %w", err)
+	}
+
+	sort.Slice(docs, func(i, j int) bool { return docs[i].path < docs[j].path })
+	return docs, nil
+}
+
+// parseDocument locates the frontmatter and body of a document
+func parseDocument(relPath string, content []byte) (*document, error) {
+	doc := &document{path: relPath, content: content, lines: strings.Split(string(content), "\n")}
+	if len(doc.lines) == 0 || doc.lines[0] != "---" {
+		return nil, fmt.Errorf("%s: document missing YAML frontmatter", relPath)
+	}
+	end := -1
+	for i := 1; i < len(doc.lines); i++ {
+		if doc.lines[i] == "---" {
+			end = i
+			break
+		}
+	}
+	if end == -1 {
+		return nil, fmt.Errorf("%s: invalid frontmatter format", relPath)
+	}
+	doc.bodyStart = end + 1
+
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(strings.Join(doc.lines[1:end], "\n")), &node); err != nil {
+		return nil, fmt.Errorf("%s: invalid YAML frontmatter: %w", relPath, err)
+	}
+	doc.frontmatter = &yaml.Node{Kind: yaml.MappingNode}
+	if len(node.Content) > 0 && node.Content[0].Kind == yaml.MappingNode {
+		doc.frontmatter = node.Content[0]
+	}
+	return doc, nil
+}
+
+// valueNode returns the value node of a frontmatter field
+func valueNode(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func scalarValue(mapping *yaml.Node, key string) string {
+	if node := valueNode(mapping, key); node != nil && node.Kind == yaml.ScalarNode {
+		return node.Value
+	}
+	return ""
+}
+
+// nodeEdits finds the scalars of a field that equal an ID. Node lines are
+// relative to the frontmatter, which starts on the second line of the file.
+func nodeEdits(node *yaml.Node, id string) []edit {
+	if node == nil {
+		return nil
+	}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if node.Value == id {
+			return []edit{{line: node.Line, column: node.Column - 1}}
+		}
+	case yaml.SequenceNode:
+		var edits []edit
+		for _, item := range node.Content {
+			edits = append(edits, nodeEdits(item, id)...)
+		}
+		return edits
+	}
+	return nil
+}
+
+// applyEdits replaces the ID at each edit position, right to left so that
+// columns on the same line stay valid
+func applyEdits(lines []string, edits []edit, oldID, newID string) {
+	sort.Slice(edits, func(i, j int) bool {
+		if edits[i].line != edits[j].line {
+			return edits[i].line < edits[j].line
+		}
+		return edits[i].column > edits[j].column
+	})
+	for _, e := range edits {
+		if e.line < 0 || e.line >= len(lines) || e.column > len(lines[e.line]) {
+			continue
+		}
+		line := lines[e.line]
+		if index := strings.Index(line[e.column:], oldID); index >= 0 {
+			start := e.column + index
+			lines[e.line] = line[:start] + newID + line[start+len(oldID):]
+		}
+	}
+}
+
+// rewriteLinks updates markdown link targets that point to the renamed file
+// or to the ID, outside fenced code blocks
+func rewriteLinks(lines []string, oldID, newID, oldBase, newBase string) {
+	rewrite := func(target string) string {
+		location, anchor := target, ""
+		if i := strings.Index(target, "#"); i >= 0 {
+			location, anchor = target[:i], target[i:]
+		}
+		if location == "" || strings.Contains(location, "://") {
+			return target
+		}
+		dir, base := path.Split(location)
+		switch base {
+		case oldBase:
+			return dir + newBase + anchor
+		case oldID:
+			return dir + newID + anchor
+		}
+		return target
+	}
+
+	RewriteLinks(lines, rewrite)
+}
+
+// RewriteLinks replaces the targets of inline and reference markdown links
+// outside fenced code blocks
+func RewriteLinks(lines []string, rewrite func(target string) string) {
+	inFence := false
+	for i, line := range lines {
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		lines[i] = inlineLinkPattern.ReplaceAllStringFunc(line, func(match string) string {
+			return "](" + rewrite(match[2:])
+		})
+		if match := refLinkPattern.FindStringSubmatchIndex(lines[i]); match != nil {
+			lines[i] = lines[i][:match[2]] + rewrite(lines[i][match[2]:match[3]]) + lines[i][match[3]:]
+		}
+	}
+}
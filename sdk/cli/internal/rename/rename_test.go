@@ -0,0 +1,169 @@
+package rename
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const growthDocument = "---\nid: STR-growth\ntitle: Growth\ntype: STR\nstatus: Draft\n---\n\n# Growth\n"
+
+const productDocument = `---
+id: PRD-platform
+title: Platform
+type: PRD
+status: Draft
+depends_on: [MSN-mission, STR-growth]
+related:
+  - "STR-growth"
+  - STR-growth-plan
+parent: STR-growth # owning strategy
+---
+
+See [growth](../01-strategic/STR-growth-v1.0.0.md#goals) and [by id](STR-growth).
+[ref]: ../01-strategic/STR-growth-v1.0.0.md
+
+` + "```" + `
+[example](STR-growth-v1.0.0.md)
+` + "```" + `
+
+STR-growth in prose is not a link.
+`
+
+func testArchive(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for path, content := range map[string]string{
+		"documents/01-strategic/STR-growth-v1.0.0.md": growthDocument,
+		"documents/04-product/PRD-platform.md":        productDocument,
+		"documents/04-product/README.md":              "No frontmatter",
+	} {
+		full := filepath.Join(dir, filepath.FromSlash(path))
+		os.MkdirAll(filepath.Dir(full), 0755)
+		os.WriteFile(full, []byte(content), 0644)
+	}
+	return dir
+}
+
+func TestNewPlan(t *testing.T) {
+	dir := testArchive(t)
+
+	plan, err := NewPlan(dir, "STR-growth", "STR-scale")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(plan.Changes) != 2 {
+		t.Fatalf("Expected 2 changed files, got %d", len(plan.Changes))
+	}
+
+	strategy := plan.Changes[0]
+	if strategy.Path != "documents/01-strategic/STR-growth-v1.0.0.md" || strategy.NewPath != "documents/01-strategic/STR-scale-v1.0.0.md" {
+		t.Errorf("Unexpected rename: %s => %s", strategy.Path, strategy.NewPath)
+	}
+
+	product := string(plan.Changes[1].After)
+	for _, expected := range []string{
+		"depends_on: [MSN-mission, STR-scale]\n",
+		"  - \"STR-scale\"\n  - STR-growth-plan\n",
+		"parent: STR-scale # owning strategy\n",
+		"[growth](../01-strategic/STR-scale-v1.0.0.md#goals) and [by id](STR-scale)",
+		"[ref]: ../01-strategic/STR-scale-v1.0.0.md\n",
+		"[example](STR-growth-v1.0.0.md)",
+		"STR-growth in prose is not a link.",
+	} {
+		if !strings.Contains(product, expected) {
+			t.Errorf("Expected %q in:\n%s", expected, product)
+		}
+	}
+
+	diff := plan.Diff()
+	for _, expected := range []string{
+		"rename documents/01-strategic/STR-growth-v1.0.0.md => documents/01-strategic/STR-scale-v1.0.0.md",
+		"@@ -2 +2 @@\n-id: STR-growth\n+id: STR-scale\n",
+		"--- a/documents/04-product/PRD-platform.md\n+++ b/documents/04-product/PRD-platform.md\n",
+	} {
+		if !strings.Contains(diff, expected) {
+			t.Errorf("Expected %q in diff:\n%s", expected, diff)
+		}
+	}
+
+	// Planning does not touch the files
+	if _, err := os.Stat(filepath.Join(dir, "documents", "01-strategic", "STR-growth-v1.0.0.md")); err != nil {
+		t.Errorf("Expected original file to remain: %v", err)
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	// The target's id is already changed, e.g. by a fix
+	files := map[string][]byte{
+		"documents/01-strategic/STR-growth-v1.0.0.md": []byte(strings.Replace(growthDocument, "id: STR-growth", "id: STR-scale", 1)),
+		"documents/04-product/PRD-platform.md":        []byte(productDocument),
+		"documents/04-product/README.md":              []byte("No frontmatter"),
+	}
+
+	changes, err := Rewrite(files, "documents/01-strategic/STR-growth-v1.0.0.md", "STR-growth", "STR-scale")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("Expected 2 changed files, got %d", len(changes))
+	}
+	if changes[0].NewPath != "documents/01-strategic/STR-scale-v1.0.0.md" || len(changes[0].Lines) != 0 {
+		t.Errorf("Expected only the strategy file to be renamed, got %+v", changes[0])
+	}
+	product := string(changes[1].After)
+	if !strings.Contains(product, "depends_on: [MSN-mission, STR-scale]") || !strings.Contains(product, "[growth](../01-strategic/STR-scale-v1.0.0.md#goals)") {
+		t.Errorf("Expected the references and links to be rewritten, got:\n%s", product)
+	}
+
+	if _, err := Rewrite(files, "documents/missing.md", "STR-growth", "STR-scale"); err == nil {
+		t.Error("Expected an error for a missing target")
+	}
+}
+
+func TestApply(t *testing.T) {
+	dir := testArchive(t)
+
+	plan, err := NewPlan(dir, "STR-growth", "STR-scale")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := plan.Apply(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "documents", "01-strategic", "STR-growth-v1.0.0.md")); !os.IsNotExist(err) {
+		t.Error("Expected old file to be removed")
+	}
+	content, err := os.ReadFile(filepath.Join(dir, "documents", "01-strategic", "STR-scale-v1.0.0.md"))
+	if err != nil || !strings.Contains(string(content), "id: STR-scale\n") {
+		t.Errorf("Expected renamed document, got %q, %v", content, err)
+	}
+	entries, _ := os.ReadDir(filepath.Join(dir, "documents", "04-product"))
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".bspec-mv-") {
+			t.Errorf("Expected temporary files to be removed, found %s", entry.Name())
+		}
+	}
+}
+
+func TestNewPlanErrors(t *testing.T) {
+	dir := testArchive(t)
+
+	tests := []struct {
+		oldID, newID, message string
+	}{
+		{"STR-growth", "STR-growth", "same as the old ID"},
+		{"STR-missing", "STR-new", "document not found: STR-missing"},
+		{"STR-growth", "PRD-growth", "id must start with document type 'STR-'"},
+		{"STR-growth", "STR-", "id must start with document type 'STR-'"},
+		{"STR-growth", "STR-a/b", "path separators"},
+		{"STR-growth", "PRD-platform", "already exists"},
+	}
+	for _, tt := range tests {
+		if _, err := NewPlan(dir, tt.oldID, tt.newID); err == nil || !strings.Contains(err.Error(), tt.message) {
+			t.Errorf("NewPlan(%s, %s): expected error containing %q, got %v", tt.oldID, tt.newID, tt.message, err)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package review
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+// icsEscaper escapes TEXT values as required by RFC 5545
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// WriteICS writes an iCalendar feed with an all-day event on the expiry date
+// of every non-deprecated document
+func WriteICS(w io.Writer, arch *archive.BSpecArchive, generated time.Time) error {
+	type event struct {
+		date time.Time
+		doc  archive.BSpecDocument
+	}
+	var events []event
+	for _, doc := range arch.Documents {
+		if strings.EqualFold(doc.Status, string(bspec.DocumentStatusDeprecated)) {
+			continue
+		}
+		date, err := parseDate(metadataString(doc, "expires"))
+		if err != nil {
+			continue
+		}
+		events = append(events, event{date: date, doc: doc})
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].date.Equal(events[j].date) {
+			return events[i].date.Before(events[j].date)
+		}
+		return events[i].doc.ID < events[j].doc.ID
+	})
+
+	name := arch.Manifest.Name
+	if name == "" {
+		name = "BSpec"
+	}
+	stamp := generated.UTC().Format("20060102T150405Z")
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//BSpec//bspec review-due//EN",
+		"CALSCALE:GREGORIAN",
+		"X-WR-CALNAME:" + icsEscaper.Replace(name+" document expiry"),
+	}
+	for _, e := range events {
+		title := e.doc.Title
+		if title == "" {
+			title = e.doc.ID
+		}
+		description := fmt.Sprintf("%s (%s) expires. Owner: %s. Status: %s.", e.doc.ID, e.doc.Type, ownerOf(e.doc), e.doc.Status)
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+e.doc.ID+"-expires@bspec",
+			"DTSTAMP:"+stamp,
+			"DTSTART;VALUE=DATE:"+e.date.Format("20060102"),
+			"DTEND;VALUE=DATE:"+e.date.AddDate(0, 0, 1).Format("20060102"),
+			"SUMMARY:"+icsEscaper.Replace("Expires: "+title),
+			"DESCRIPTION:"+icsEscaper.Replace(description),
+			"END:VEVENT",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := io.WriteString(w, fold(line)+"\r\n"); err != nil {
+			return fmt.Errorf("failed to write calendar: %w", err)
+		}
+	}
+	return nil
+}
+
+// fold splits content lines longer than 75 octets, as required by RFC 5545,
+// without breaking UTF-8 sequences
+func fold(line string) string {
+	const limit = 75
+	if len(line) <= limit {
+		return line
+	}
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
+
+func ownerOf(doc archive.BSpecDocument) string {
+	if doc.Owner == "" {
+		return Unowned
+	}
+	return doc.Owner
+}
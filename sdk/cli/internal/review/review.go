@@ -0,0 +1,232 @@
+package review
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+// DateLayout is the date format of document frontmatter and --as-of
+const DateLayout = "2006-01-02"
+
+// Unowned groups documents without an owner
+const Unowned = "(unowned)"
+
+// Reasons a document is due
+const (
+	ReasonReviewOverdue = "review_overdue"
+	ReasonReviewDue     = "review_due"
+	ReasonExpired       = "expired"
+	ReasonExpiring      = "expiring"
+)
+
+// cycles maps review_cycle values to their length in months and days
+var cycles = map[string]struct{ months, days int }{
+	"weekly":        {0, 7},
+	"sprint":        {0, 14},
+	"sprint-based":  {0, 14},
+	"biweekly":      {0, 14},
+	"monthly":       {1, 0},
+	"quarterly":     {3, 0},
+	"semi-annually": {6, 0},
+	"semi-annual":   {6, 0},
+	"annually":      {12, 0},
+	"annual":        {12, 0},
+	"yearly":        {12, 0},
+}
+
+// unscheduled lists review_cycle values without a fixed interval
+var unscheduled = map[string]bool{
+	"as-needed":         true,
+	"campaign-specific": true,
+	"experiment-cycle":  true,
+}
+
+// Options controls which documents are due
+type Options struct {
+	AsOf   time.Time // Reference date; defaults to today
+	Within int       // Also include documents due within this many days
+}
+
+// Report lists the due documents of an archive grouped by owner
+type Report struct {
+	AsOf      string       `json:"as_of"`
+	Within    int          `json:"within_days"`
+	Total     int          `json:"total"`
+	Owners    []OwnerGroup `json:"owners"`
+	Unchecked []string     `json:"unchecked,omitempty"` // Documents with a review cycle or expiry that could not be evaluated
+}
+
+// OwnerGroup is the due documents of one owner
+type OwnerGroup struct {
+	Owner string `json:"owner"`
+	Items []Item `json:"items"`
+}
+
+// Item is a document that is past, or close to, its review date or expiry
+type Item struct {
+	Document    string `json:"document"`
+	Title       string `json:"title"`
+	Type        string `json:"type"`
+	Status      string `json:"status"`
+	Path        string `json:"path"`
+	Owner       string `json:"owner"`
+	Updated     string `json:"updated,omitempty"`
+	ReviewCycle string `json:"review_cycle,omitempty"`
+	Due         string `json:"due"`
+	Reason      string `json:"reason"`
+	DaysOverdue int    `json:"days_overdue"` // Negative when the date is still ahead
+}
+
+// Description describes why an item is listed
+func (i Item) Description() string {
+	switch i.Reason {
+	case ReasonReviewOverdue:
+		return fmt.Sprintf("review overdue by %d days (%s, updated %s)", i.DaysOverdue, i.ReviewCycle, i.Updated)
+	case ReasonReviewDue:
+		return fmt.Sprintf("review due in %d days (%s)", -i.DaysOverdue, i.ReviewCycle)
+	case ReasonExpired:
+		return fmt.Sprintf("expired %d days ago", i.DaysOverdue)
+	default:
+		return fmt.Sprintf("expires in %d days", -i.DaysOverdue)
+	}
+}
+
+// Due finds the non-deprecated documents whose review cycle has elapsed since
+// their last update or whose expiry date has passed, grouped by owner
+func Due(arch *archive.BSpecArchive, opts Options) *Report {
+	asOf := opts.AsOf
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+	asOf = day(asOf)
+	horizon := asOf.AddDate(0, 0, opts.Within)
+
+	report := &Report{AsOf: asOf.Format(DateLayout), Within: opts.Within, Owners: []OwnerGroup{}}
+	byOwner := make(map[string][]Item)
+
+	for path, doc := range arch.Documents {
+		if strings.EqualFold(doc.Status, string(bspec.DocumentStatusDeprecated)) {
+			continue
+		}
+
+		base := Item{
+			Document:    doc.ID,
+			Title:       doc.Title,
+			Type:        doc.Type,
+			Status:      doc.Status,
+			Path:        path,
+			Owner:       ownerOf(doc),
+			Updated:     doc.Updated,
+			ReviewCycle: metadataString(doc, "review_cycle"),
+		}
+
+		var items []Item
+		checked := true
+		if base.ReviewCycle != "" && !unscheduled[strings.ToLower(base.ReviewCycle)] {
+			if next, ok := NextReview(doc); !ok {
+				checked = false
+			} else if !next.After(horizon) {
+				items = append(items, due(base, next, asOf, ReasonReviewOverdue, ReasonReviewDue))
+			}
+		}
+		if expires := metadataString(doc, "expires"); expires != "" {
+			if date, err := parseDate(expires); err != nil {
+				checked = false
+			} else if !date.After(horizon) {
+				items = append(items, due(base, date, asOf, ReasonExpired, ReasonExpiring))
+			}
+		}
+
+		if !checked {
+			report.Unchecked = append(report.Unchecked, doc.ID)
+		}
+		byOwner[base.Owner] = append(byOwner[base.Owner], items...)
+		report.Total += len(items)
+	}
+
+	for owner, items := range byOwner {
+		if len(items) == 0 {
+			continue
+		}
+		sort.Slice(items, func(i, j int) bool {
+			if items[i].DaysOverdue != items[j].DaysOverdue {
+				return items[i].DaysOverdue > items[j].DaysOverdue
+			}
+			if items[i].Document != items[j].Document {
+				return items[i].Document < items[j].Document
+			}
+			return items[i].Reason < items[j].Reason
+		})
+		report.Owners = append(report.Owners, OwnerGroup{Owner: owner, Items: items})
+	}
+	sort.Slice(report.Owners, func(i, j int) bool {
+		a, b := report.Owners[i].Owner, report.Owners[j].Owner
+		if (a == Unowned) != (b == Unowned) {
+			return b == Unowned
+		}
+		return a < b
+	})
+	sort.Strings(report.Unchecked)
+	return report
+}
+
+// NextReview returns the date a document is next due for review: its last
+// update, or creation, plus its review cycle. It reports false when the cycle
+// is not a fixed interval or the document has no valid date.
+func NextReview(doc archive.BSpecDocument) (time.Time, bool) {
+	cycle, ok := cycles[strings.ToLower(metadataString(doc, "review_cycle"))]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	last := doc.Updated
+	if last == "" {
+		last = doc.Created
+	}
+	date, err := parseDate(last)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return date.AddDate(0, cycle.months, cycle.days), true
+}
+
+// due builds an item for a date that has passed, or is coming up
+func due(base Item, date, asOf time.Time, passed, upcoming string) Item {
+	item := base
+	item.Due = date.Format(DateLayout)
+	item.DaysOverdue = int(asOf.Sub(date).Hours() / 24)
+	item.Reason = passed
+	if date.After(asOf) {
+		item.Reason = upcoming
+	}
+	return item
+}
+
+// parseDate parses a frontmatter date, accepting full timestamps
+func parseDate(value string) (time.Time, error) {
+	if len(value) >= len(DateLayout) {
+		if date, err := time.Parse(DateLayout, value[:len(DateLayout)]); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date: %s", value)
+}
+
+// day truncates a time to midnight UTC of its calendar date
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func metadataString(doc archive.BSpecDocument, key string) string {
+	value, ok := doc.Metadata[key]
+	if !ok || value == nil {
+		return ""
+	}
+	return strings.TrimSpace(fmt.Sprint(value))
+}
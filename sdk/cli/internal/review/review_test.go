@@ -0,0 +1,131 @@
+package review
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+)
+
+func testArchive() *archive.BSpecArchive {
+	return &archive.BSpecArchive{
+		Manifest: archive.Manifest{Name: "Acme"},
+		Documents: map[string]archive.BSpecDocument{
+			"01-strategic/MSN-001.md": {ID: "MSN-001", Title: "Mission", Type: "MSN", Status: "Accepted", Owner: "alice", Updated: "2023-01-10",
+				Metadata: map[string]interface{}{"review_cycle": "quarterly", "expires": "2024-06-01"}},
+			"01-strategic/VSN-001.md": {ID: "VSN-001", Title: "Vision", Type: "VSN", Status: "Draft", Updated: "2024-12-20",
+				Metadata: map[string]interface{}{"review_cycle": "monthly"}},
+			"01-strategic/STR-001.md": {ID: "STR-001", Title: "Strategy", Type: "STR", Status: "Review", Owner: "bob", Created: "2024-11-01",
+				Metadata: map[string]interface{}{"review_cycle": "Annually"}},
+			"01-strategic/STR-old.md": {ID: "STR-old", Title: "Old", Type: "STR", Status: "Deprecated", Owner: "bob", Updated: "2020-01-01",
+				Metadata: map[string]interface{}{"review_cycle": "monthly", "expires": "2021-01-01"}},
+			"09-risk/RSK-001.md": {ID: "RSK-001", Title: "Risks", Type: "RSK", Status: "Draft", Owner: "bob", Updated: "2024-01-01",
+				Metadata: map[string]interface{}{"review_cycle": "{frequency}", "expires": "2025-01-15"}},
+			"09-risk/RSK-002.md": {ID: "RSK-002", Title: "Ad hoc", Type: "RSK", Status: "Draft", Owner: "bob", Updated: "2020-01-01",
+				Metadata: map[string]interface{}{"review_cycle": "as-needed"}},
+		},
+	}
+}
+
+func asOf() time.Time {
+	return time.Date(2025, 1, 10, 15, 30, 0, 0, time.UTC)
+}
+
+func TestDue(t *testing.T) {
+	report := Due(testArchive(), Options{AsOf: asOf()})
+
+	if report.AsOf != "2025-01-10" {
+		t.Errorf("Expected as_of 2025-01-10, got %s", report.AsOf)
+	}
+	if report.Total != 2 || len(report.Owners) != 1 || report.Owners[0].Owner != "alice" {
+		t.Fatalf("Expected two items for alice, got %+v", report.Owners)
+	}
+
+	items := report.Owners[0].Items
+	if items[0].Reason != ReasonReviewOverdue || items[0].Due != "2023-04-10" || items[0].DaysOverdue != 641 {
+		t.Errorf("Unexpected review item: %+v", items[0])
+	}
+	if items[1].Reason != ReasonExpired || items[1].Due != "2024-06-01" || items[1].Path != "01-strategic/MSN-001.md" {
+		t.Errorf("Unexpected expiry item: %+v", items[1])
+	}
+
+	if len(report.Unchecked) != 1 || report.Unchecked[0] != "RSK-001" {
+		t.Errorf("Expected RSK-001 to be unchecked, got %v", report.Unchecked)
+	}
+}
+
+func TestDueWithin(t *testing.T) {
+	report := Due(testArchive(), Options{AsOf: asOf(), Within: 14})
+
+	if report.Total != 4 {
+		t.Fatalf("Expected four items, got %d: %+v", report.Total, report.Owners)
+	}
+
+	var owners []string
+	for _, group := range report.Owners {
+		owners = append(owners, group.Owner)
+	}
+	if strings.Join(owners, ",") != "alice,bob,"+Unowned {
+		t.Errorf("Expected owners sorted with unowned last, got %v", owners)
+	}
+
+	bob := report.Owners[1].Items
+	if len(bob) != 1 || bob[0].Document != "RSK-001" || bob[0].Reason != ReasonExpiring || bob[0].DaysOverdue != -5 {
+		t.Errorf("Unexpected items for bob: %+v", bob)
+	}
+	unowned := report.Owners[2].Items
+	if len(unowned) != 1 || unowned[0].Reason != ReasonReviewDue || unowned[0].Due != "2025-01-20" {
+		t.Errorf("Unexpected unowned items: %+v", unowned)
+	}
+}
+
+func TestNextReview(t *testing.T) {
+	doc := archive.BSpecDocument{Created: "2024-11-01T09:00:00Z", Metadata: map[string]interface{}{"review_cycle": "semi-annually"}}
+	next, ok := NextReview(doc)
+	if !ok || next.Format(DateLayout) != "2025-05-01" {
+		t.Errorf("Expected 2025-05-01 from created date, got %v %v", next, ok)
+	}
+
+	doc.Metadata["review_cycle"] = "sometimes"
+	if _, ok := NextReview(doc); ok {
+		t.Error("Expected unknown cycle not to have a review date")
+	}
+}
+
+func TestWriteICS(t *testing.T) {
+	arch := testArchive()
+	arch.Documents["01-strategic/VSN-001.md"] = archive.BSpecDocument{ID: "VSN-001", Title: "Vision, long; " + strings.Repeat("x", 80), Type: "VSN",
+		Status: "Draft", Metadata: map[string]interface{}{"expires": "2025-03-01"}}
+
+	var buf bytes.Buffer
+	if err := WriteICS(&buf, arch, asOf()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	output := buf.String()
+
+	for _, expected := range []string{
+		"BEGIN:VCALENDAR\r\nVERSION:2.0\r\n",
+		"X-WR-CALNAME:Acme document expiry\r\n",
+		"UID:MSN-001-expires@bspec\r\nDTSTAMP:20250110T153000Z\r\nDTSTART;VALUE=DATE:20240601\r\nDTEND;VALUE=DATE:20240602\r\n",
+		`SUMMARY:Expires: Vision\, long\; xxx`,
+		"DESCRIPTION:MSN-001 (MSN) expires. Owner: alice. Status: Accepted.\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %q in calendar:\n%s", expected, output)
+		}
+	}
+	if strings.Contains(output, "STR-old") {
+		t.Error("Expected deprecated documents to be skipped")
+	}
+	if strings.Index(output, "MSN-001") > strings.Index(output, "RSK-001") {
+		t.Error("Expected events sorted by date")
+	}
+	for _, line := range strings.Split(output, "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("Expected folded lines, got %d octets: %s", len(line), line)
+		}
+	}
+}
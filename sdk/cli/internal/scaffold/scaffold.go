@@ -0,0 +1,362 @@
+package scaffold
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	bspec "github.com/bspec-foundation/bspec-go"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/conformance"
+	"github.com/a3tai/bspec/cli/internal/docfile"
+)
+
+// Options describes the document to create
+type Options struct {
+	Type    string       // Document type code, e.g. "RSK"
+	Title   string       // Document title
+	Owner   string       // Document owner
+	ID      string       // Document ID; generated from the title when empty
+	Link    bool         // Pre-populate depends_on and the references the type's validation expects with existing documents
+	Catalog *bspec.BSpec // Document type catalog; defaults to bspec.DefaultCatalog()
+}
+
+// ProjectOptions describes the placeholder documents of a new project
+type ProjectOptions struct {
+	Level   bspec.ConformanceLevel // Conformance level whose document types are required
+	Profile bspec.IndustryProfile  // Industry profile whose document types are required
+	Owner   string                 // Owner of the placeholders
+	Catalog *bspec.BSpec           // Document type catalog; defaults to bspec.DefaultCatalog()
+}
+
+// Document is a new document ready to be written
+type Document struct {
+	ID         string
+	Type       string
+	Title      string
+	Path       string // Relative to the archive directory, e.g. "documents/09-risk/RSK-vendor-lock-in-v1.0.0.md"
+	Content    []byte
+	Links      []string            // Documents added to depends_on
+	References map[string][]string // Documents added to the lists the type's validation expects, by key, e.g. related
+	Unlinked   []string            // Typical dependency and expected types without a document in the archive
+}
+
+// Placeholder is a document a conformance level requires
+type Placeholder struct {
+	*Document
+	Level bspec.ConformanceLevel // Lowest level that requires the document type
+}
+
+// New builds a document of a type from the spec catalog: a compliant ID and
+// file name in the folder of the type's domain, the SDK defaults, and the
+// section skeleton of the type's content template
+func New(arch *archive.BSpecArchive, opts Options) (*Document, error) {
+	catalog := opts.Catalog
+	if catalog == nil {
+		catalog = bspec.DefaultCatalog()
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(opts.Type))
+	info := catalog.GetDocumentType(code)
+	if info == nil {
+		return nil, fmt.Errorf("unknown document type: %s", opts.Type)
+	}
+	title := strings.TrimSpace(opts.Title)
+	if title == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+	if strings.TrimSpace(opts.Owner) == "" {
+		return nil, fmt.Errorf("owner is required")
+	}
+
+	ids := make(map[string]bool)
+	for _, doc := range arch.Documents {
+		ids[doc.ID] = true
+	}
+	id := opts.ID
+	if id == "" {
+		base := bspec.DocumentID(bspec.DocumentType(code), title)
+		id = base
+		for i := 2; ids[id]; i++ {
+			id = fmt.Sprintf("%s-%d", base, i)
+		}
+	} else if ids[id] {
+		return nil, fmt.Errorf("document ID %s already exists", id)
+	}
+	if err := bspec.ValidateDocumentID(id, bspec.DocumentType(code)); err != nil {
+		return nil, fmt.Errorf("invalid ID %s: %w", id, err)
+	}
+
+	var (
+		links, unlinked []string
+		references      map[string][]string
+	)
+	if opts.Link {
+		byType := documentsByType(arch)
+		links, unlinked = dependencies(byType, info.Dependencies)
+		var missing []string
+		references, missing = expectedReferences(byType, code)
+		unlinked = append(unlinked, missing...)
+	}
+	result, err := build(info, id, title, opts.Owner, links, references)
+	if err != nil {
+		return nil, err
+	}
+	result.Unlinked = unlinked
+	return result, nil
+}
+
+// Project builds the placeholder documents a conformance level requires for
+// an industry profile, as many of each type as the level counts, by level and
+// in dependency order. Each placeholder depends on the placeholders of its
+// type's typical dependencies and references those its validation expects,
+// such as an RSK its MIT. The documents that the fields the validation of a
+// type requires reference, such as a MET for the metrics of a REV, are
+// created too. Required types that are not in the catalog are returned as
+// missing.
+func Project(opts ProjectOptions) (placeholders []Placeholder, missing []string, err error) {
+	catalog := opts.Catalog
+	if catalog == nil {
+		catalog = bspec.DefaultCatalog()
+	}
+	if _, ok := conformance.LookupLevel(opts.Level); !ok {
+		return nil, nil, fmt.Errorf("unknown conformance level: %s", opts.Level)
+	}
+	if _, ok := conformance.ProfileDocuments(opts.Profile); !ok {
+		return nil, nil, fmt.Errorf("unknown industry profile: %s", opts.Profile)
+	}
+	if strings.TrimSpace(opts.Owner) == "" {
+		return nil, nil, fmt.Errorf("owner is required")
+	}
+
+	var types []*bspec.DocumentTypeInfo
+	titles := make(map[string]string)
+	ids := make(map[string][]string) // Placeholder IDs by type
+	for _, required := range conformance.Requirements(opts.Level, opts.Profile) {
+		code := required.Type()
+		info := catalog.GetDocumentType(code)
+		if info == nil {
+			missing = append(missing, code)
+			continue
+		}
+		types = append(types, info)
+		for i := 1; i <= required.Count; i++ {
+			title := info.Name
+			if i > 1 {
+				title = fmt.Sprintf("%s %d", info.Name, i)
+			}
+			id := bspec.DocumentID(bspec.DocumentType(code), title)
+			titles[id] = title
+			ids[code] = append(ids[code], id)
+		}
+	}
+
+	// The documents the required fields reference, such as a MET for the
+	// metrics of a REV, are created at the level of the referencing type
+	levels := make(map[string]bspec.ConformanceLevel)
+	for _, info := range types {
+		for _, reference := range referenceTypes(info.Code) {
+			code := string(reference.Type)
+			if len(ids[code]) > 0 {
+				continue
+			}
+			if refInfo := catalog.GetDocumentType(code); refInfo != nil {
+				types = append(types, refInfo)
+				id := bspec.DocumentID(reference.Type, refInfo.Name)
+				titles[id] = refInfo.Name
+				ids[code] = []string{id}
+				levels[code], _ = conformance.RequiredLevel(info.Code, opts.Profile)
+			}
+		}
+	}
+
+	for _, info := range dependencyOrder(types, opts.Profile) {
+		links, unlinked := dependencies(ids, info.Dependencies)
+		references, _ := expectedReferences(ids, info.Code)
+		level, ok := levels[info.Code]
+		if !ok {
+			level, _ = conformance.RequiredLevel(info.Code, opts.Profile)
+		}
+		for _, id := range ids[info.Code] {
+			doc, err := build(info, id, titles[id], opts.Owner, links, references)
+			if err != nil {
+				return nil, nil, err
+			}
+			doc.Unlinked = unlinked
+			placeholders = append(placeholders, Placeholder{Document: doc, Level: level})
+		}
+	}
+	return placeholders, missing, nil
+}
+
+// dependencyOrder sorts document types by the level that requires them, with
+// the dependencies of a type at the same or a lower level before it
+func dependencyOrder(types []*bspec.DocumentTypeInfo, profile bspec.IndustryProfile) []*bspec.DocumentTypeInfo {
+	byCode := make(map[string]*bspec.DocumentTypeInfo, len(types))
+	rank := make(map[string]int, len(types))
+	for _, info := range types {
+		byCode[info.Code] = info
+		level, _ := conformance.RequiredLevel(info.Code, profile)
+		rank[info.Code] = conformance.LevelRank(string(level))
+	}
+
+	visited := make(map[string]bool)
+	var order []*bspec.DocumentTypeInfo
+	var visit func(info *bspec.DocumentTypeInfo)
+	visit = func(info *bspec.DocumentTypeInfo) {
+		if visited[info.Code] {
+			return
+		}
+		visited[info.Code] = true
+		for _, dependency := range info.Dependencies {
+			if dep, ok := byCode[dependency]; ok && rank[dependency] <= rank[info.Code] {
+				visit(dep)
+			}
+		}
+		order = append(order, info)
+	}
+	for _, info := range types {
+		visit(info)
+	}
+	return order
+}
+
+// requiredPlaceholders are the values of the fields that the validation of a
+// type requires, for the author to replace
+var requiredPlaceholders = map[string]string{
+	"success_criteria": "Replace with a measurable success criterion",
+}
+
+// requiredReferenceTypes are the document types that the fields the
+// validation of a type requires reference, such as the MET documents in the
+// metrics of a REV
+var requiredReferenceTypes = map[string]string{
+	"metrics": "MET",
+}
+
+// requiredFields returns the fields the validation of a type requires
+func requiredFields(docType string) []string {
+	typed, err := bspec.NewTypedDocument(bspec.DocumentType(docType), docType+"-placeholder", "Placeholder", "owner")
+	if err != nil {
+		return nil
+	}
+	var fields []string
+	for _, issue := range typed.Validate() {
+		if issue.Severity == bspec.SeverityError && issue.Field != "" {
+			fields = append(fields, issue.Field)
+		}
+	}
+	return fields
+}
+
+// build renders a document with the SDK defaults and the section skeleton of
+// its type, linked to its dependencies and the references its validation
+// expects. Fields its validation requires get placeholders.
+func build(info *bspec.DocumentTypeInfo, id, title, owner string, links []string, references map[string][]string) (*Document, error) {
+	doc := bspec.NewDocument(bspec.DocumentType(info.Code), id, title, owner)
+	doc.DependsOn = append(doc.DependsOn, links...)
+
+	folder := ""
+	if doc.Domain != nil {
+		if domain, ok := bspec.LookupDomain(*doc.Domain); ok {
+			folder = domain.Folder
+		}
+	}
+	result := &Document{
+		ID:         id,
+		Type:       info.Code,
+		Title:      title,
+		Path:       path.Join("documents", folder, bspec.DocumentFileName(id, doc.Version)),
+		Links:      links,
+		References: references,
+	}
+
+	file, err := docfile.New(doc, "\n"+info.Skeleton(title))
+	if err != nil {
+		return nil, err
+	}
+	for key, ids := range references {
+		file.SetList(key, ids)
+	}
+	for _, field := range requiredFields(info.Code) {
+		if placeholder, ok := requiredPlaceholders[field]; ok {
+			file.SetList(field, []string{placeholder})
+		}
+	}
+	// Keep the relationships to fill in, but not the other empty lists
+	file.Delete("content")
+	for _, key := range file.Keys() {
+		if key != "depends_on" && key != "enables" && file.Has(key) && file.List(key) == nil && file.Get(key) == "" {
+			file.Delete(key)
+		}
+	}
+	if result.Content, err = file.Bytes(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// documentsByType maps each document type to the IDs of its non-deprecated documents
+func documentsByType(arch *archive.BSpecArchive) map[string][]string {
+	byType := make(map[string][]string)
+	for _, doc := range arch.Documents {
+		if strings.EqualFold(doc.Status, string(bspec.DocumentStatusDeprecated)) {
+			continue
+		}
+		docType := strings.ToUpper(doc.Type)
+		byType[docType] = append(byType[docType], doc.ID)
+	}
+	for _, ids := range byType {
+		sort.Strings(ids)
+	}
+	return byType
+}
+
+// dependencies returns the documents of the dependency types, sorted, and the
+// types that have none
+func dependencies(byType map[string][]string, types []string) (links, missing []string) {
+	for _, docType := range types {
+		ids := byType[docType]
+		if len(ids) == 0 {
+			missing = append(missing, docType)
+			continue
+		}
+		links = append(links, ids...)
+	}
+	sort.Strings(links)
+	return links, missing
+}
+
+// expectedReferences returns the documents the validation of a type expects
+// it to reference, such as the MIT documents of an RSK, by frontmatter key
+// with each list sorted, and the expected types that have none
+func expectedReferences(byType map[string][]string, docType string) (references map[string][]string, missing []string) {
+	for _, expected := range referenceTypes(docType) {
+		ids := byType[string(expected.Type)]
+		if len(ids) == 0 {
+			missing = append(missing, string(expected.Type))
+			continue
+		}
+		if references == nil {
+			references = make(map[string][]string)
+		}
+		references[expected.Key] = append(references[expected.Key], ids...)
+		sort.Strings(references[expected.Key])
+	}
+	return references, missing
+}
+
+// referenceTypes returns the references the validation of a type expects,
+// followed by those of the fields it requires, such as the MET documents in
+// the metrics of a REV
+func referenceTypes(docType string) []bspec.ExpectedReference {
+	references := bspec.ExpectedReferences(bspec.DocumentType(docType))
+	for _, field := range requiredFields(docType) {
+		if refType, ok := requiredReferenceTypes[field]; ok {
+			references = append(references, bspec.ExpectedReference{Key: field, Type: bspec.DocumentType(refType)})
+		}
+	}
+	return references
+}
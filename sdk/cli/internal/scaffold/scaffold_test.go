@@ -0,0 +1,171 @@
+package scaffold
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/a3tai/bspec/cli/internal/archive"
+	"github.com/a3tai/bspec/cli/internal/docfile"
+)
+
+func testArchive() *archive.BSpecArchive {
+	return &archive.BSpecArchive{
+		Documents: map[string]archive.BSpecDocument{
+			"01-strategic/STR-growth.md":    {ID: "STR-growth", Type: "STR", Status: "Accepted"},
+			"01-strategic/STR-legacy.md":    {ID: "STR-legacy", Type: "STR", Status: "Deprecated"},
+			"09-risk/RSK-vendor-lock-in.md": {ID: "RSK-vendor-lock-in", Type: "RSK", Status: "Draft"},
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	doc, err := New(testArchive(), Options{Type: "rsk", Title: "Vendor lock-in", Owner: "alice", Link: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if doc.ID != "RSK-vendor-lock-in-2" {
+		t.Errorf("Expected a unique ID, got %s", doc.ID)
+	}
+	if doc.Path != "documents/09-risk/RSK-vendor-lock-in-2-v1.0.0.md" {
+		t.Errorf("Expected the risk folder, got %s", doc.Path)
+	}
+	if len(doc.Links) != 1 || doc.Links[0] != "STR-growth" {
+		t.Errorf("Expected a link to STR-growth only, got %v", doc.Links)
+	}
+	if len(doc.Unlinked) == 0 {
+		t.Error("Expected dependency types without documents")
+	}
+
+	file, err := docfile.Parse(doc.Content)
+	if err != nil {
+		t.Fatalf("Failed to parse document: %v", err)
+	}
+	for key, expected := range map[string]string{"id": doc.ID, "type": "RSK", "status": "Draft", "version": "1.0.0", "owner": "alice", "domain": "risk"} {
+		if got := file.Get(key); got != expected {
+			t.Errorf("Expected %s %q, got %q", key, expected, got)
+		}
+	}
+	if file.Has("content") || file.Has("tags") {
+		t.Error("Expected no content or empty list fields")
+	}
+	if deps := file.List("depends_on"); len(deps) != 1 || deps[0] != "STR-growth" {
+		t.Errorf("Expected depends_on [STR-growth], got %v", deps)
+	}
+	if !strings.HasPrefix(file.Body(), "\n# Vendor lock-in\n") || !strings.Contains(file.Body(), "\n## Risk Identification\n") {
+		t.Errorf("Expected the RSK section skeleton, got:\n%s", file.Body())
+	}
+}
+
+func TestNewExpectedReferences(t *testing.T) {
+	arch := testArchive()
+	doc, err := New(arch, Options{Type: "RSK", Title: "Churn", Owner: "alice", Link: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if doc.References != nil || !strings.Contains(strings.Join(doc.Unlinked, ","), "MIT") {
+		t.Errorf("Expected MIT to be unlinked without MIT documents, got %v and %v", doc.References, doc.Unlinked)
+	}
+
+	arch.Documents["09-risk/MIT-failover.md"] = archive.BSpecDocument{ID: "MIT-failover", Type: "MIT", Status: "Draft"}
+	doc, err = New(arch, Options{Type: "RSK", Title: "Churn", Owner: "alice", Link: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	file, err := docfile.Parse(doc.Content)
+	if err != nil {
+		t.Fatalf("Failed to parse document: %v", err)
+	}
+	if related := file.List("related"); len(related) != 1 || related[0] != "MIT-failover" {
+		t.Errorf("Expected related [MIT-failover], got %v", related)
+	}
+	if strings.Contains(strings.Join(doc.Unlinked, ","), "MIT") {
+		t.Errorf("Expected MIT to be linked, got unlinked %v", doc.Unlinked)
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	arch := testArchive()
+	tests := []struct {
+		opts     Options
+		expected string
+	}{
+		{Options{Type: "XYZ", Title: "T", Owner: "a"}, "unknown document type"},
+		{Options{Type: "RSK", Title: " ", Owner: "a"}, "title is required"},
+		{Options{Type: "RSK", Title: "T"}, "owner is required"},
+		{Options{Type: "RSK", Title: "T", Owner: "a", ID: "RSK-vendor-lock-in"}, "already exists"},
+		{Options{Type: "RSK", Title: "T", Owner: "a", ID: "STR-x"}, "invalid ID"},
+	}
+	for _, tt := range tests {
+		if _, err := New(arch, tt.opts); err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("%+v: expected error containing %q, got %v", tt.opts, tt.expected, err)
+		}
+	}
+}
+
+func TestProject(t *testing.T) {
+	placeholders, missing, err := Project(ProjectOptions{Level: "silver", Profile: "nonprofit", Owner: "alice"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(missing) != 0 {
+		t.Errorf("Expected every required type in the catalog or legacy, got %v missing", missing)
+	}
+
+	position := make(map[string]int)
+	counts := make(map[string]int)
+	for i, placeholder := range placeholders {
+		if _, ok := position[placeholder.Type]; !ok {
+			position[placeholder.Type] = i
+		}
+		counts[placeholder.Type]++
+	}
+	if counts["PER"] != 2 || counts["RSK"] != 2 || counts["MIT"] != 1 {
+		t.Errorf("Expected 2 PER, 2 RSK and 1 MIT placeholders, got %v", counts)
+	}
+	for _, code := range []string{"MSN", "RSK", "MIT", "MKT", "GOV", "PUR"} {
+		if _, ok := position[code]; !ok {
+			t.Errorf("Expected a %s placeholder", code)
+		}
+	}
+	if _, ok := position["ORG"]; ok {
+		t.Error("Expected no gold level placeholders")
+	}
+	if position["VAL"] > position["VSN"] || position["MSN"] > position["STR"] {
+		t.Error("Expected dependencies before the types that depend on them")
+	}
+	if placeholders[0].Level != "bronze" || placeholders[len(placeholders)-1].Level != "silver" {
+		t.Error("Expected placeholders ordered by level")
+	}
+
+	vision := placeholders[position["VSN"]]
+	if vision.Path != "documents/01-strategic/VSN-vision-statement-v1.0.0.md" {
+		t.Errorf("Unexpected path %s", vision.Path)
+	}
+	file, err := docfile.Parse(vision.Content)
+	if err != nil {
+		t.Fatalf("Failed to parse placeholder: %v", err)
+	}
+	deps := file.List("depends_on")
+	if len(deps) != 2 || deps[0] != "MSN-mission-statement" || deps[1] != "VAL-organizational-values" {
+		t.Errorf("Expected links to the MSN and VAL placeholders, got %v", deps)
+	}
+
+	// Risks and mitigations reference each other as their validation expects
+	mitigation, err := docfile.Parse(placeholders[position["MIT"]].Content)
+	if err != nil {
+		t.Fatalf("Failed to parse placeholder: %v", err)
+	}
+	if related := mitigation.List("related"); len(related) != 2 || related[0] != "RSK-risks" || related[1] != "RSK-risks-2" {
+		t.Errorf("Expected the MIT placeholder to reference both RSK placeholders, got %v", related)
+	}
+	if placeholders[position["RSK"]+1].ID != "RSK-risks-2" || placeholders[position["RSK"]+1].Title != "Risks 2" {
+		t.Errorf("Expected a second RSK placeholder, got %+v", placeholders[position["RSK"]+1].Document)
+	}
+
+	if _, _, err := Project(ProjectOptions{Level: "platinum", Profile: "nonprofit", Owner: "alice"}); err == nil || !strings.Contains(err.Error(), "unknown conformance level") {
+		t.Errorf("Expected unknown level error, got %v", err)
+	}
+	if _, _, err := Project(ProjectOptions{Level: "bronze", Profile: "retail", Owner: "alice"}); err == nil || !strings.Contains(err.Error(), "unknown industry profile") {
+		t.Errorf("Expected unknown profile error, got %v", err)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"os"
 	"time"
 
+	bspec "github.com/bspec-foundation/bspec-go"
+
 	"github.com/a3tai/bspec/cli/internal/archive"
 )
 
@@ -185,8 +187,7 @@ func (c *SDKConverter) validateBasicMetadata(metadata map[string]interface{}) er
 		if !ok {
 			return fmt.Errorf("status field must be a string")
 		}
-		validStatuses := []string{"Draft", "Review", "Accepted", "Deprecated"}
-		if !c.isStringInSlice(status, validStatuses) {
+		if !bspec.DocumentStatus(status).IsValid() {
 			return fmt.Errorf("invalid status value: %s", status)
 		}
 	}
@@ -234,16 +235,6 @@ func (c *SDKConverter) isValueInEnum(value interface{}, enumValues []interface{}
 	return false
 }
 
-// isStringInSlice checks if a string exists in a slice
-func (c *SDKConverter) isStringInSlice(str string, slice []string) bool {
-	for _, s := range slice {
-		if str == s {
-			return true
-		}
-	}
-	return false
-}
-
 // SearchDocumentTypes searches document types by query
 func (c *SDKConverter) SearchDocumentTypes(query string) ([]map[string]interface{}, error) {
 	docTypes, err := c.GetDocumentTypes()
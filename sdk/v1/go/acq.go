@@ -1,60 +0,0 @@
-// Package bspec provides ACQ document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.618043
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// ACQDocument represents a Acquisitions (ACQ) document
-// Domain: growth
-//
-// M&A strategy and integration planning
-type ACQDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewACQDocument creates a new ACQ document with defaults
-func NewACQDocument(id, title, owner string) *ACQDocument {
-	doc := &ACQDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeACQ,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainGrowth
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the ACQ document and returns any validation errors
-func (d *ACQDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeACQ {
-		errors = append(errors, "document type must be ACQ")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
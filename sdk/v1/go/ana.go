@@ -1,60 +0,0 @@
-// Package bspec provides ANA document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.615677
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// ANADocument represents a Analytics (ANA) document
-// Domain: technology
-//
-// Data analytics, business intelligence, and insights
-type ANADocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewANADocument creates a new ANA document with defaults
-func NewANADocument(id, title, owner string) *ANADocument {
-	doc := &ANADocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeANA,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainTechnology
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the ANA document and returns any validation errors
-func (d *ANADocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeANA {
-		errors = append(errors, "document type must be ANA")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides ARC document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.614997
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// ARCDocument represents a Architecture (ARC) document
-// Domain: technology
-//
-// High-level system design and component relationships
-type ARCDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewARCDocument creates a new ARC document with defaults
-func NewARCDocument(id, title, owner string) *ARCDocument {
-	doc := &ARCDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeARC,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainTechnology
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the ARC document and returns any validation errors
-func (d *ARCDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeARC {
-		errors = append(errors, "document type must be ARC")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
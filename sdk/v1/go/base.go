@@ -1,10 +1,3 @@
-// Package bspec provides Go types and utilities for BSpec documents
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.601773
-// Generator: go-generator v1.0.0
-
 package bspec
 
 import (
@@ -38,16 +31,18 @@ const (
 type BusinessDomain string
 
 const (
-BusinessDomainStrategic BusinessDomain = "strategic"
-BusinessDomainMarket BusinessDomain = "market"
-BusinessDomainCustomer BusinessDomain = "customer"
-BusinessDomainProduct BusinessDomain = "product"
-BusinessDomainBusinessModel BusinessDomain = "business-model"
-BusinessDomainOperations BusinessDomain = "operations"
-BusinessDomainTechnology BusinessDomain = "technology"
-BusinessDomainFinancial BusinessDomain = "financial"
-BusinessDomainRisk BusinessDomain = "risk"
-BusinessDomainGrowth BusinessDomain = "growth"
+	BusinessDomainStrategic     BusinessDomain = "strategic"
+	BusinessDomainMarket        BusinessDomain = "market"
+	BusinessDomainCustomer      BusinessDomain = "customer"
+	BusinessDomainProduct       BusinessDomain = "product"
+	BusinessDomainBusinessModel BusinessDomain = "business-model"
+	BusinessDomainOperations    BusinessDomain = "operations"
+	BusinessDomainTechnology    BusinessDomain = "technology"
+	BusinessDomainFinancial     BusinessDomain = "financial"
+	BusinessDomainRisk          BusinessDomain = "risk"
+	BusinessDomainGrowth        BusinessDomain = "growth"
+	BusinessDomainLearning      BusinessDomain = "learning"
+	BusinessDomainBrand         BusinessDomain = "brand"
 )
 
 // OrganizationalScope represents the organizational scope
@@ -108,130 +103,36 @@ const (
 	ClassificationConfidential Classification = "confidential"
 )
 
-// DocumentType represents the BSpec document type codes
+// DocumentType represents the BSpec document type codes; the codes are
+// generated from the specification in types_gen.go
 type DocumentType string
 
-const (
-DocumentTypeMSN DocumentType = "MSN"
-DocumentTypeVSN DocumentType = "VSN"
-DocumentTypeVAL DocumentType = "VAL"
-DocumentTypeSTR DocumentType = "STR"
-DocumentTypeOBJ DocumentType = "OBJ"
-DocumentTypeMOT DocumentType = "MOT"
-DocumentTypePUR DocumentType = "PUR"
-DocumentTypeTHY DocumentType = "THY"
-DocumentTypeMKT DocumentType = "MKT"
-DocumentTypeSEG DocumentType = "SEG"
-DocumentTypeCMP DocumentType = "CMP"
-DocumentTypePOS DocumentType = "POS"
-DocumentTypeTRN DocumentType = "TRN"
-DocumentTypeECO DocumentType = "ECO"
-DocumentTypeOPP DocumentType = "OPP"
-DocumentTypeTHR DocumentType = "THR"
-DocumentTypeREG DocumentType = "REG"
-DocumentTypeMAC DocumentType = "MAC"
-DocumentTypePER DocumentType = "PER"
-DocumentTypeJTB DocumentType = "JTB"
-DocumentTypeCJM DocumentType = "CJM"
-DocumentTypeUSE DocumentType = "USE"
-DocumentTypeSTO DocumentType = "STO"
-DocumentTypePAI DocumentType = "PAI"
-DocumentTypeGAI DocumentType = "GAI"
-DocumentTypeEMP DocumentType = "EMP"
-DocumentTypeFEE DocumentType = "FEE"
-DocumentTypeINT DocumentType = "INT"
-DocumentTypeSUR DocumentType = "SUR"
-DocumentTypeBEH DocumentType = "BEH"
-DocumentTypePRD DocumentType = "PRD"
-DocumentTypeSVC DocumentType = "SVC"
-DocumentTypeFEA DocumentType = "FEA"
-DocumentTypeROD DocumentType = "ROD"
-DocumentTypeREQ DocumentType = "REQ"
-DocumentTypeQUA DocumentType = "QUA"
-DocumentTypeUXD DocumentType = "UXD"
-DocumentTypeSUP DocumentType = "SUP"
-DocumentTypeBMC DocumentType = "BMC"
-DocumentTypeREV DocumentType = "REV"
-DocumentTypePRC DocumentType = "PRC"
-DocumentTypeCST DocumentType = "CST"
-DocumentTypeCHN DocumentType = "CHN"
-DocumentTypeREL DocumentType = "REL"
-DocumentTypeRES DocumentType = "RES"
-DocumentTypeACT DocumentType = "ACT"
-DocumentTypePRT DocumentType = "PRT"
-DocumentTypeUNT DocumentType = "UNT"
-DocumentTypeLTV DocumentType = "LTV"
-DocumentTypeCAC DocumentType = "CAC"
-DocumentTypeWFL DocumentType = "WFL"
-DocumentTypeORG DocumentType = "ORG"
-DocumentTypeROL DocumentType = "ROL"
-DocumentTypeTEA DocumentType = "TEA"
-DocumentTypeSKI DocumentType = "SKI"
-DocumentTypePOL DocumentType = "POL"
-DocumentTypeSLA DocumentType = "SLA"
-DocumentTypeVND DocumentType = "VND"
-DocumentTypeFAC DocumentType = "FAC"
-DocumentTypeTOO DocumentType = "TOO"
-DocumentTypeCAP DocumentType = "CAP"
-DocumentTypeARC DocumentType = "ARC"
-DocumentTypeSYS DocumentType = "SYS"
-DocumentTypeDAT DocumentType = "DAT"
-DocumentTypeAPI DocumentType = "API"
-DocumentTypeINF DocumentType = "INF"
-DocumentTypeSEC DocumentType = "SEC"
-DocumentTypeDEV DocumentType = "DEV"
-DocumentTypeANA DocumentType = "ANA"
-DocumentTypeFIN DocumentType = "FIN"
-DocumentTypeBUD DocumentType = "BUD"
-DocumentTypeFOR DocumentType = "FOR"
-DocumentTypeFND DocumentType = "FND"
-DocumentTypeINV DocumentType = "INV"
-DocumentTypeMET DocumentType = "MET"
-DocumentTypeREP DocumentType = "REP"
-DocumentTypeAUD DocumentType = "AUD"
-DocumentTypeTAX DocumentType = "TAX"
-DocumentTypeRSK DocumentType = "RSK"
-DocumentTypeMIT DocumentType = "MIT"
-DocumentTypeGVN DocumentType = "GVN"
-DocumentTypeCTL DocumentType = "CTL"
-DocumentTypeCRI DocumentType = "CRI"
-DocumentTypeETH DocumentType = "ETH"
-DocumentTypeSTA DocumentType = "STA"
-DocumentTypeGTM DocumentType = "GTM"
-DocumentTypeGRW DocumentType = "GRW"
-DocumentTypeSCL DocumentType = "SCL"
-DocumentTypeEXP DocumentType = "EXP"
-DocumentTypeINN DocumentType = "INN"
-DocumentTypeRND DocumentType = "RND"
-DocumentTypeACQ DocumentType = "ACQ"
-)
-
 // ConformanceLevel represents the BSpec conformance levels
 type ConformanceLevel string
 
 const (
-ConformanceLevelBronze ConformanceLevel = "bronze"
-ConformanceLevelSilver ConformanceLevel = "silver"
-ConformanceLevelGold ConformanceLevel = "gold"
+	ConformanceLevelBronze ConformanceLevel = "bronze"
+	ConformanceLevelSilver ConformanceLevel = "silver"
+	ConformanceLevelGold   ConformanceLevel = "gold"
 )
 
 // IndustryProfile represents the BSpec industry profiles
 type IndustryProfile string
 
 const (
-IndustryProfileSoftwareSaas IndustryProfile = "software-saas"
-IndustryProfilePhysicalProduct IndustryProfile = "physical-product"
-IndustryProfileServiceBusiness IndustryProfile = "service-business"
-IndustryProfileNonprofit IndustryProfile = "nonprofit"
+	IndustryProfileSoftwareSaas    IndustryProfile = "software-saas"
+	IndustryProfilePhysicalProduct IndustryProfile = "physical-product"
+	IndustryProfileServiceBusiness IndustryProfile = "service-business"
+	IndustryProfileNonprofit       IndustryProfile = "nonprofit"
 )
 
 // ChangelogEntry represents a single entry in the document version history
 type ChangelogEntry struct {
-	Version         string `json:"version" yaml:"version"`                                 // Version number
-	Date            string `json:"date" yaml:"date"`                                       // Change date (YYYY-MM-DD)
-	Author          string `json:"author" yaml:"author"`                                   // Author name
-	Changes         string `json:"changes" yaml:"changes"`                                 // Description of changes
-	BreakingChanges bool   `json:"breaking_changes" yaml:"breaking_changes"`               // Whether changes are breaking
+	Version         string `json:"version" yaml:"version"`                   // Version number
+	Date            string `json:"date" yaml:"date"`                         // Change date (YYYY-MM-DD)
+	Author          string `json:"author" yaml:"author"`                     // Author name
+	Changes         string `json:"changes" yaml:"changes"`                   // Description of changes
+	BreakingChanges bool   `json:"breaking_changes" yaml:"breaking_changes"` // Whether changes are breaking
 }
 
 // BaseBSpecDocument defines the universal YAML frontmatter schema
@@ -245,24 +146,27 @@ type BaseBSpecDocument struct {
 	Version string         `json:"version" yaml:"version"` // Semantic versioning
 
 	// === OWNERSHIP & RESPONSIBILITY ===
-	Owner        string   `json:"owner" yaml:"owner"`                               // Who owns and maintains this document
+	Owner        string   `json:"owner" yaml:"owner"`                              // Who owns and maintains this document
 	Stakeholders []string `json:"stakeholders,omitempty" yaml:"stakeholders,flow"` // Who has interest in this document
 	Reviewers    []string `json:"reviewers,omitempty" yaml:"reviewers,flow"`       // Who reviewed/approved this version
 	Contributors []string `json:"contributors,omitempty" yaml:"contributors,flow"` // Who contributed to this document
 
 	// === TEMPORAL METADATA ===
-	Created     string       `json:"created" yaml:"created"`                     // When document was first created (YYYY-MM-DD)
-	Updated     string       `json:"updated" yaml:"updated"`                     // When document was last modified (YYYY-MM-DD)
-	Expires     *string      `json:"expires,omitempty" yaml:"expires,omitempty"` // When document expires (YYYY-MM-DD)
+	Created     string       `json:"created" yaml:"created"`                               // When document was first created (YYYY-MM-DD)
+	Updated     string       `json:"updated" yaml:"updated"`                               // When document was last modified (YYYY-MM-DD)
+	Expires     *string      `json:"expires,omitempty" yaml:"expires,omitempty"`           // When document expires (YYYY-MM-DD)
 	ReviewCycle *ReviewCycle `json:"review_cycle,omitempty" yaml:"review_cycle,omitempty"` // How often to review
 
 	// === RELATIONSHIP GRAPH ===
-	Parent        *string  `json:"parent,omitempty" yaml:"parent,omitempty"`               // Parent document (hierarchical)
-	DependsOn     []string `json:"depends_on,omitempty" yaml:"depends_on,flow"`           // Dependencies (this needs those)
-	Enables       []string `json:"enables,omitempty" yaml:"enables,flow"`                 // Enablements (this makes those possible)
-	ConflictsWith []string `json:"conflicts_with,omitempty" yaml:"conflicts_with,flow"`   // Mutual exclusions
-	Related       []string `json:"related,omitempty" yaml:"related,flow"`                 // Other relevant documents
-	Supersedes    *string  `json:"supersedes,omitempty" yaml:"supersedes,omitempty"`      // What this document replaces
+	Parent        *string  `json:"parent,omitempty" yaml:"parent,omitempty"`            // Parent document (hierarchical)
+	DependsOn     []string `json:"depends_on,omitempty" yaml:"depends_on,flow"`         // Dependencies (this needs those)
+	Enables       []string `json:"enables,omitempty" yaml:"enables,flow"`               // Enablements (this makes those possible)
+	ConflictsWith []string `json:"conflicts_with,omitempty" yaml:"conflicts_with,flow"` // Mutual exclusions
+	Related       []string `json:"related,omitempty" yaml:"related,flow"`               // Other relevant documents
+	Supersedes    *string  `json:"supersedes,omitempty" yaml:"supersedes,omitempty"`    // What this document replaces
+
+	// === DEPRECATION ===
+	DeprecationReason *string `json:"deprecation_reason,omitempty" yaml:"deprecation_reason,omitempty"` // Why this document is deprecated
 
 	// === BUSINESS CONTEXT ===
 	Domain     *BusinessDomain      `json:"domain,omitempty" yaml:"domain,omitempty"`         // Business domain classification
@@ -272,11 +176,11 @@ type BaseBSpecDocument struct {
 	Visibility *Visibility          `json:"visibility,omitempty" yaml:"visibility,omitempty"` // Access level
 
 	// === VALIDATION & MEASUREMENT ===
-	Assumptions      []string `json:"assumptions,omitempty" yaml:"assumptions,flow"`           // Key assumptions
-	Constraints      []string `json:"constraints,omitempty" yaml:"constraints,flow"`           // Key constraints
-	SuccessCriteria  []string `json:"success_criteria,omitempty" yaml:"success_criteria,flow"` // Measurable success criteria
-	Risks            []string `json:"risks,omitempty" yaml:"risks,flow"`                       // Associated risk documents
-	Metrics          []string `json:"metrics,omitempty" yaml:"metrics,flow"`                   // How success is measured
+	Assumptions     []string `json:"assumptions,omitempty" yaml:"assumptions,flow"`           // Key assumptions
+	Constraints     []string `json:"constraints,omitempty" yaml:"constraints,flow"`           // Key constraints
+	SuccessCriteria []string `json:"success_criteria,omitempty" yaml:"success_criteria,flow"` // Measurable success criteria
+	Risks           []string `json:"risks,omitempty" yaml:"risks,flow"`                       // Associated risk documents
+	Metrics         []string `json:"metrics,omitempty" yaml:"metrics,flow"`                   // How success is measured
 
 	// === IMPLEMENTATION ===
 	ImplementationStatus *ImplementationStatus `json:"implementation_status,omitempty" yaml:"implementation_status,omitempty"` // Implementation progress
@@ -285,10 +189,10 @@ type BaseBSpecDocument struct {
 	ResourcesRequired    []string              `json:"resources_required,omitempty" yaml:"resources_required,flow"`            // Resource requirements
 
 	// === METADATA & DISCOVERY ===
-	Tags           []string        `json:"tags,omitempty" yaml:"tags,flow"`                       // Searchable tags
-	Industry       []string        `json:"industry,omitempty" yaml:"industry,flow"`               // Industry classifications
-	Geography      []string        `json:"geography,omitempty" yaml:"geography,flow"`             // Geographic relevance
-	Language       *string         `json:"language,omitempty" yaml:"language,omitempty"`          // Primary language
+	Tags           []string        `json:"tags,omitempty" yaml:"tags,flow"`                          // Searchable tags
+	Industry       []string        `json:"industry,omitempty" yaml:"industry,flow"`                  // Industry classifications
+	Geography      []string        `json:"geography,omitempty" yaml:"geography,flow"`                // Geographic relevance
+	Language       *string         `json:"language,omitempty" yaml:"language,omitempty"`             // Primary language
 	Classification *Classification `json:"classification,omitempty" yaml:"classification,omitempty"` // Information classification
 
 	// === CHANGE TRACKING ===
@@ -298,68 +202,79 @@ type BaseBSpecDocument struct {
 	Content string `json:"content" yaml:"content"` // Markdown content of the document
 }
 
-// Validate validates the document and returns any validation errors
-func (d *BaseBSpecDocument) Validate() []string {
-	var errors []string
+// Validate validates the document and returns the validation issues
+func (d *BaseBSpecDocument) Validate() ValidationIssues {
+	var issues ValidationIssues
 
 	// Required field validation
-	if d.ID == "" {
-		errors = append(errors, "id is required")
-	}
-	if d.Title == "" {
-		errors = append(errors, "title is required")
-	}
-	if d.Owner == "" {
-		errors = append(errors, "owner is required")
-	}
-	if d.Created == "" {
-		errors = append(errors, "created date is required")
+	required := []struct{ key, value, message, fix string }{
+		{"id", d.ID, "id is required", ""},
+		{"title", d.Title, "title is required", ""},
+		{"owner", d.Owner, "owner is required", ""},
+		{"type", string(d.Type), "type is required", ""},
+		{"created", d.Created, "created date is required", "set created to the date the document was first written"},
+		{"updated", d.Updated, "updated date is required", "set updated to the date of the last change"},
+	}
+	for _, field := range required {
+		if field.value == "" {
+			issues = append(issues, newIssue(SeverityError, CodeRequiredField, field.key, field.message).withFix(field.fix))
+		}
 	}
-	if d.Updated == "" {
-		errors = append(errors, "updated date is required")
+
+	// Type validation
+	if d.Type != "" {
+		if d.Type.IsLegacy() {
+			issue := newIssue(SeverityWarning, CodeLegacyType, "type", fmt.Sprintf("type %s is from an earlier draft of the specification", d.Type))
+			if successor, ok := d.Type.Successor(); ok {
+				issue = issue.withFix(fmt.Sprintf("change type to %s", successor))
+			}
+			issues = append(issues, issue)
+		} else if !d.Type.IsValid() {
+			issues = append(issues, newIssue(SeverityError, CodeUnknownType, "type", fmt.Sprintf("type %s is not defined by the specification", d.Type)))
+		}
 	}
 
 	// ID format validation
-	if d.ID != "" && !strings.HasPrefix(d.ID, string(d.Type)+"-") {
-		errors = append(errors, fmt.Sprintf("id must start with document type '%s-'", d.Type))
+	if d.ID != "" {
+		if err := ValidateDocumentID(d.ID, d.Type); err != nil {
+			issues = append(issues, newIssue(SeverityError, CodeInvalidID, "id", err.Error()).
+				withFix(fmt.Sprintf("rename the id to %s", DocumentID(d.Type, strings.TrimPrefix(d.ID, string(d.Type)+"-")))))
+		}
 	}
 
 	// Version format validation (semantic versioning)
 	if d.Version != "" {
 		versionRegex := regexp.MustCompile(`^\d+\.\d+\.\d+$`)
 		if !versionRegex.MatchString(d.Version) {
-			errors = append(errors, "version must follow semantic versioning (e.g., '1.0.0')")
+			issues = append(issues, newIssue(SeverityError, CodeInvalidVersion, "version", "version must follow semantic versioning (e.g., '1.0.0')").
+				withFix("use MAJOR.MINOR.PATCH, e.g. 1.0.0"))
 		}
 	}
 
 	// Date format validation (basic YYYY-MM-DD check)
-	dateFields := map[string]string{
-		"created":             d.Created,
-		"updated":             d.Updated,
-	}
-	if d.Expires != nil {
-		dateFields["expires"] = *d.Expires
-	}
-	if d.ImplementationDate != nil {
-		dateFields["implementation_date"] = *d.ImplementationDate
-	}
-	if d.CompletionDate != nil {
-		dateFields["completion_date"] = *d.CompletionDate
+	dateFields := []string{"created", "updated", "expires", "implementation_date", "completion_date"}
+	dates := map[string]*string{
+		"created":             &d.Created,
+		"updated":             &d.Updated,
+		"expires":             d.Expires,
+		"implementation_date": d.ImplementationDate,
+		"completion_date":     d.CompletionDate,
 	}
 
 	dateRegex := regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
-	for fieldName, fieldValue := range dateFields {
-		if fieldValue != "" && !dateRegex.MatchString(fieldValue) {
-			errors = append(errors, fmt.Sprintf("%s must be in YYYY-MM-DD format", fieldName))
+	for _, key := range dateFields {
+		if value := dates[key]; value != nil && *value != "" && !dateRegex.MatchString(*value) {
+			issues = append(issues, newIssue(SeverityError, CodeInvalidDate, key, fmt.Sprintf("%s must be in YYYY-MM-DD format", key)).
+				withFix(fmt.Sprintf("write %s as YYYY-MM-DD", key)))
 		}
 	}
 
-	return errors
+	return issues
 }
 
-// IsValid returns true if the document passes validation
+// IsValid returns true if validation finds no errors; warnings are allowed
 func (d *BaseBSpecDocument) IsValid() bool {
-	return len(d.Validate()) == 0
+	return !d.Validate().HasErrors()
 }
 
 // ToJSON serializes the document to JSON
@@ -372,201 +287,15 @@ func (d *BaseBSpecDocument) FromJSON(data []byte) error {
 	return json.Unmarshal(data, d)
 }
 
-// GetDomain returns the business domain, defaulting based on document type if not set
+// GetDomain returns the business domain, defaulting based on document type if
+// not set; it is empty for unknown document types
 func (d *BaseBSpecDocument) GetDomain() BusinessDomain {
 	if d.Domain != nil {
 		return *d.Domain
 	}
 
-	// Default domain mapping based on document type
-	switch d.Type {
-case DocumentTypeMSN:
-		return BusinessDomainStrategic
-case DocumentTypeVSN:
-		return BusinessDomainStrategic
-case DocumentTypeVAL:
-		return BusinessDomainStrategic
-case DocumentTypeSTR:
-		return BusinessDomainStrategic
-case DocumentTypeOBJ:
-		return BusinessDomainStrategic
-case DocumentTypeMOT:
-		return BusinessDomainStrategic
-case DocumentTypePUR:
-		return BusinessDomainStrategic
-case DocumentTypeTHY:
-		return BusinessDomainStrategic
-case DocumentTypeMKT:
-		return BusinessDomainMarket
-case DocumentTypeSEG:
-		return BusinessDomainMarket
-case DocumentTypeCMP:
-		return BusinessDomainMarket
-case DocumentTypePOS:
-		return BusinessDomainMarket
-case DocumentTypeTRN:
-		return BusinessDomainMarket
-case DocumentTypeECO:
-		return BusinessDomainMarket
-case DocumentTypeOPP:
-		return BusinessDomainMarket
-case DocumentTypeTHR:
-		return BusinessDomainMarket
-case DocumentTypeREG:
-		return BusinessDomainMarket
-case DocumentTypeMAC:
-		return BusinessDomainMarket
-case DocumentTypePER:
-		return BusinessDomainCustomer
-case DocumentTypeJTB:
-		return BusinessDomainCustomer
-case DocumentTypeCJM:
-		return BusinessDomainCustomer
-case DocumentTypeUSE:
-		return BusinessDomainCustomer
-case DocumentTypeSTO:
-		return BusinessDomainCustomer
-case DocumentTypePAI:
-		return BusinessDomainCustomer
-case DocumentTypeGAI:
-		return BusinessDomainCustomer
-case DocumentTypeEMP:
-		return BusinessDomainCustomer
-case DocumentTypeFEE:
-		return BusinessDomainCustomer
-case DocumentTypeINT:
-		return BusinessDomainCustomer
-case DocumentTypeSUR:
-		return BusinessDomainCustomer
-case DocumentTypeBEH:
-		return BusinessDomainCustomer
-case DocumentTypePRD:
-		return BusinessDomainProduct
-case DocumentTypeSVC:
-		return BusinessDomainProduct
-case DocumentTypeFEA:
-		return BusinessDomainProduct
-case DocumentTypeROD:
-		return BusinessDomainProduct
-case DocumentTypeREQ:
-		return BusinessDomainProduct
-case DocumentTypeQUA:
-		return BusinessDomainProduct
-case DocumentTypeUXD:
-		return BusinessDomainProduct
-case DocumentTypeSUP:
-		return BusinessDomainProduct
-case DocumentTypeBMC:
-		return BusinessDomainBusinessModel
-case DocumentTypeREV:
-		return BusinessDomainBusinessModel
-case DocumentTypePRC:
-		return BusinessDomainBusinessModel
-case DocumentTypeCST:
-		return BusinessDomainBusinessModel
-case DocumentTypeCHN:
-		return BusinessDomainBusinessModel
-case DocumentTypeREL:
-		return BusinessDomainBusinessModel
-case DocumentTypeRES:
-		return BusinessDomainBusinessModel
-case DocumentTypeACT:
-		return BusinessDomainBusinessModel
-case DocumentTypePRT:
-		return BusinessDomainBusinessModel
-case DocumentTypeUNT:
-		return BusinessDomainBusinessModel
-case DocumentTypeLTV:
-		return BusinessDomainBusinessModel
-case DocumentTypeCAC:
-		return BusinessDomainBusinessModel
-case DocumentTypeWFL:
-		return BusinessDomainOperations
-case DocumentTypeORG:
-		return BusinessDomainOperations
-case DocumentTypeROL:
-		return BusinessDomainOperations
-case DocumentTypeTEA:
-		return BusinessDomainOperations
-case DocumentTypeSKI:
-		return BusinessDomainOperations
-case DocumentTypePOL:
-		return BusinessDomainOperations
-case DocumentTypeSLA:
-		return BusinessDomainOperations
-case DocumentTypeVND:
-		return BusinessDomainOperations
-case DocumentTypeFAC:
-		return BusinessDomainOperations
-case DocumentTypeTOO:
-		return BusinessDomainOperations
-case DocumentTypeCAP:
-		return BusinessDomainOperations
-case DocumentTypeARC:
-		return BusinessDomainTechnology
-case DocumentTypeSYS:
-		return BusinessDomainTechnology
-case DocumentTypeDAT:
-		return BusinessDomainTechnology
-case DocumentTypeAPI:
-		return BusinessDomainTechnology
-case DocumentTypeINF:
-		return BusinessDomainTechnology
-case DocumentTypeSEC:
-		return BusinessDomainTechnology
-case DocumentTypeDEV:
-		return BusinessDomainTechnology
-case DocumentTypeANA:
-		return BusinessDomainTechnology
-case DocumentTypeFIN:
-		return BusinessDomainFinancial
-case DocumentTypeBUD:
-		return BusinessDomainFinancial
-case DocumentTypeFOR:
-		return BusinessDomainFinancial
-case DocumentTypeFND:
-		return BusinessDomainFinancial
-case DocumentTypeINV:
-		return BusinessDomainFinancial
-case DocumentTypeMET:
-		return BusinessDomainFinancial
-case DocumentTypeREP:
-		return BusinessDomainFinancial
-case DocumentTypeAUD:
-		return BusinessDomainFinancial
-case DocumentTypeTAX:
-		return BusinessDomainFinancial
-case DocumentTypeRSK:
-		return BusinessDomainRisk
-case DocumentTypeMIT:
-		return BusinessDomainRisk
-case DocumentTypeGVN:
-		return BusinessDomainRisk
-case DocumentTypeCTL:
-		return BusinessDomainRisk
-case DocumentTypeCRI:
-		return BusinessDomainRisk
-case DocumentTypeETH:
-		return BusinessDomainRisk
-case DocumentTypeSTA:
-		return BusinessDomainRisk
-case DocumentTypeGTM:
-		return BusinessDomainGrowth
-case DocumentTypeGRW:
-		return BusinessDomainGrowth
-case DocumentTypeSCL:
-		return BusinessDomainGrowth
-case DocumentTypeEXP:
-		return BusinessDomainGrowth
-case DocumentTypeINN:
-		return BusinessDomainGrowth
-case DocumentTypeRND:
-		return BusinessDomainGrowth
-case DocumentTypeACQ:
-		return BusinessDomainGrowth
-default:
-		return BusinessDomainStrategic // Default fallback
-	}
+	domain, _ := DomainForType(d.Type)
+	return domain
 }
 
 // SetDefaults sets reasonable defaults for optional fields
@@ -638,4 +367,4 @@ func (d *BaseBSpecDocument) SetDefaults() {
 	if d.Changelog == nil {
 		d.Changelog = []ChangelogEntry{}
 	}
-}
\ No newline at end of file
+}
@@ -1,60 +0,0 @@
-// Package bspec provides BEH document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.610915
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// BEHDocument represents a Behaviors (BEH) document
-// Domain: customer
-//
-// Customer usage patterns and behavioral insights
-type BEHDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewBEHDocument creates a new BEH document with defaults
-func NewBEHDocument(id, title, owner string) *BEHDocument {
-	doc := &BEHDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeBEH,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainCustomer
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the BEH document and returns any validation errors
-func (d *BEHDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeBEH {
-		errors = append(errors, "document type must be BEH")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
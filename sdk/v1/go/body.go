@@ -0,0 +1,300 @@
+package bspec
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	tableSeparatorPattern = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+	bodyLinkPattern       = regexp.MustCompile(`(!?)\[([^\]]*)\]\(([^)\s]+)(?:\s+"[^"]*")?\)`)
+	bodyCommentPattern    = regexp.MustCompile(`(?s)<!--.*?-->`)
+)
+
+// Body is a markdown document body as a tree of sections. String returns
+// the body exactly as parsed.
+type Body struct {
+	Preamble string     `json:"preamble,omitempty"` // Text before the first heading
+	Sections []*Section `json:"sections"`
+}
+
+// Section is a heading of a document body and the text under it. Headings
+// inside code blocks are part of the text.
+type Section struct {
+	Heading   string          `json:"heading"`
+	Level     int             `json:"level"`  // Heading level, 2 for "##"
+	Anchor    string          `json:"anchor"` // e.g. "risk-assessment", with a "-1" suffix for repeated headings
+	Line      int             `json:"line"`   // Line of the heading in the body
+	Text      string          `json:"text"`   // Text up to the first subsection, with line endings
+	Checklist []ChecklistItem `json:"checklist,omitempty"`
+	Tables    []Table         `json:"tables,omitempty"`
+	Links     []Link          `json:"links,omitempty"`
+	Sections  []*Section      `json:"sections,omitempty"`
+
+	raw string // Heading line as written
+}
+
+// Table is a markdown table of a section's text
+type Table struct {
+	Line   int        `json:"line"` // Line of the header row in the body
+	Header []string   `json:"header"`
+	Rows   [][]string `json:"rows"`
+}
+
+// Link is a markdown link of a section's text
+type Link struct {
+	Text string `json:"text"`
+	URL  string `json:"url"`
+	Line int    `json:"line"`
+}
+
+// bodyLine is a line of a body with its line ending
+type bodyLine struct {
+	text   string
+	number int
+	fenced bool // Inside a code block, or a fence
+}
+
+// splitBody splits a body into lines, keeping the line endings, and marks
+// the lines of code blocks
+func splitBody(body string) []bodyLine {
+	var (
+		lines []bodyLine
+		fence bool
+	)
+	for i, text := range strings.SplitAfter(body, "\n") {
+		if text == "" {
+			continue
+		}
+		toggle := checklistFencePattern.MatchString(text)
+		lines = append(lines, bodyLine{text: text, number: i + 1, fenced: fence || toggle})
+		if toggle {
+			fence = !fence
+		}
+	}
+	return lines
+}
+
+// ParseBody parses a markdown document body into its sections
+func ParseBody(body string) *Body {
+	var (
+		b       = &Body{Sections: []*Section{}}
+		stack   []*Section // Open sections, outermost first
+		text    strings.Builder
+		lines   []bodyLine // Lines of text
+		anchors = make(map[string]int)
+	)
+	flush := func() {
+		if len(stack) == 0 {
+			b.Preamble = text.String()
+		} else {
+			stack[len(stack)-1].setText(text.String(), lines)
+		}
+		text.Reset()
+		lines = nil
+	}
+
+	for _, line := range splitBody(body) {
+		match := checklistHeadingPattern.FindStringSubmatch(strings.TrimRight(line.text, "\r\n"))
+		if match == nil || line.fenced {
+			text.WriteString(line.text)
+			lines = append(lines, line)
+			continue
+		}
+		flush()
+
+		section := &Section{Heading: match[2], Level: len(match[1]), Line: line.number, raw: line.text}
+		section.Anchor = Anchor(section.Heading)
+		if n := anchors[section.Anchor]; n > 0 {
+			anchors[section.Anchor]++
+			section.Anchor = fmt.Sprintf("%s-%d", section.Anchor, n)
+		} else {
+			anchors[section.Anchor] = 1
+		}
+
+		for len(stack) > 0 && stack[len(stack)-1].Level >= section.Level {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			b.Sections = append(b.Sections, section)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.Sections = append(parent.Sections, section)
+		}
+		stack = append(stack, section)
+	}
+	flush()
+	return b
+}
+
+// setText sets the text of a section and the checklist items, tables and
+// links it contains
+func (s *Section) setText(text string, lines []bodyLine) {
+	s.Text = text
+	for _, line := range lines {
+		if line.fenced {
+			continue
+		}
+		for _, match := range bodyLinkPattern.FindAllStringSubmatch(line.text, -1) {
+			if match[1] == "" {
+				s.Links = append(s.Links, Link{Text: match[2], URL: match[3], Line: line.number})
+			}
+		}
+	}
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		if line.fenced {
+			continue
+		}
+		content := strings.TrimRight(line.text, "\r\n")
+		if match := checklistItemPattern.FindStringSubmatch(content); match != nil {
+			s.Checklist = append(s.Checklist, ChecklistItem{Text: match[2], Checked: match[1] != " ", Line: line.number})
+		}
+		if strings.HasPrefix(strings.TrimSpace(content), "|") && i+1 < len(lines) && tableSeparatorPattern.MatchString(strings.TrimRight(lines[i+1].text, "\r\n")) {
+			table := Table{Line: line.number, Header: tableCells(content), Rows: [][]string{}}
+			for i += 2; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i].text), "|"); i++ {
+				table.Rows = append(table.Rows, tableCells(strings.TrimRight(lines[i].text, "\r\n")))
+			}
+			i--
+			s.Tables = append(s.Tables, table)
+		}
+	}
+}
+
+// tableCells splits a table row into its trimmed cells
+func tableCells(row string) []string {
+	row = strings.TrimSpace(row)
+	row = strings.TrimSuffix(strings.TrimPrefix(row, "|"), "|")
+	cells := strings.Split(row, "|")
+	for i, cell := range cells {
+		cells[i] = strings.TrimSpace(cell)
+	}
+	return cells
+}
+
+// Anchor returns the anchor of a heading as GitHub generates it: lowercase,
+// without punctuation, with hyphens for spaces
+func Anchor(heading string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(heading)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_':
+			b.WriteRune(r)
+		case r == ' ':
+			b.WriteRune('-')
+		}
+	}
+	return b.String()
+}
+
+// String returns the body as markdown
+func (b *Body) String() string {
+	var sb strings.Builder
+	sb.WriteString(b.Preamble)
+	for _, section := range b.Sections {
+		section.write(&sb)
+	}
+	return sb.String()
+}
+
+// All returns the sections of the body in document order, subsections
+// after their parent
+func (b *Body) All() []*Section {
+	var all []*Section
+	var walk func(sections []*Section)
+	walk = func(sections []*Section) {
+		for _, section := range sections {
+			all = append(all, section)
+			walk(section.Sections)
+		}
+	}
+	walk(b.Sections)
+	return all
+}
+
+// Find returns the first section whose heading or anchor is name, or nil.
+// Headings are compared case-insensitively with their spacing collapsed.
+func (b *Body) Find(name string) *Section {
+	key := normalizeSectionName(name)
+	for _, section := range b.All() {
+		if section.Anchor == name || normalizeSectionName(section.Heading) == key {
+			return section
+		}
+	}
+	return nil
+}
+
+// RequireSections returns a missing_section issue for each named section
+// the body does not have, and an empty_section issue for each that has no
+// text
+func (b *Body) RequireSections(names ...string) ValidationIssues {
+	var issues ValidationIssues
+	for _, name := range names {
+		section := b.Find(name)
+		if section == nil {
+			issues = append(issues, newIssue(SeverityWarning, CodeMissingSection, "",
+				fmt.Sprintf("missing section %q", name)).withFix(fmt.Sprintf("add a %q section", "## "+name)))
+			continue
+		}
+		if section.Empty() {
+			issue := newIssue(SeverityWarning, CodeEmptySection, "", fmt.Sprintf("section %q is empty", section.Heading))
+			issue.Line = section.Line
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// normalizeSectionName lowercases a heading and collapses its spacing
+func normalizeSectionName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// String returns the section as markdown, from its heading to the next
+// heading of the same or a higher level
+func (s *Section) String() string {
+	var sb strings.Builder
+	s.write(&sb)
+	return sb.String()
+}
+
+// Content returns the section as markdown without its heading line
+func (s *Section) Content() string {
+	var sb strings.Builder
+	sb.WriteString(s.Text)
+	for _, section := range s.Sections {
+		section.write(&sb)
+	}
+	return sb.String()
+}
+
+// Empty reports whether the section and its subsections have no text other
+// than whitespace and comments
+func (s *Section) Empty() bool {
+	if strings.TrimSpace(bodyCommentPattern.ReplaceAllString(s.Text, "")) != "" {
+		return false
+	}
+	for _, section := range s.Sections {
+		if !section.Empty() {
+			return false
+		}
+	}
+	return true
+}
+
+// write writes the heading line as written, or a new one if the heading or
+// level was changed, followed by the content
+func (s *Section) write(sb *strings.Builder) {
+	match := checklistHeadingPattern.FindStringSubmatch(strings.TrimRight(s.raw, "\r\n"))
+	if match != nil && match[2] == s.Heading && len(match[1]) == s.Level {
+		sb.WriteString(s.raw)
+		if !strings.HasSuffix(s.raw, "\n") && s.Content() != "" {
+			sb.WriteString("\n")
+		}
+	} else {
+		fmt.Fprintf(sb, "%s %s\n", strings.Repeat("#", s.Level), s.Heading)
+	}
+	sb.WriteString(s.Content())
+}
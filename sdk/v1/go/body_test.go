@@ -0,0 +1,134 @@
+package bspec
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sampleBody = `Intro before any heading.
+
+# Churn Risk
+
+## Risk Assessment
+
+Likelihood is **high**; see [the model](https://example.com/model) and ![chart](chart.png).
+
+| Factor | Weight |
+|--------|-------:|
+| Price  | [0.4](#pricing) |
+
+### Mitigation
+- [x] Annual plans
+- [ ] Win-back emails
+
+` + "```" + `
+## Not a heading
+` + "```" + `
+
+## Open Questions
+<!-- Add questions here -->
+
+## Risk Assessment ##
+Repeated heading.
+## Trailing`
+
+func TestParseBody(t *testing.T) {
+	body := ParseBody(sampleBody)
+
+	if got := body.String(); got != sampleBody {
+		t.Fatalf("Round trip changed the body:\n%s", got)
+	}
+	if body.Preamble != "Intro before any heading.\n\n" || len(body.Sections) != 1 {
+		t.Fatalf("Unexpected preamble %q or top-level sections %d", body.Preamble, len(body.Sections))
+	}
+
+	var headings []string
+	for _, section := range body.All() {
+		headings = append(headings, strings.Repeat("#", section.Level)+" "+section.Heading+" #"+section.Anchor)
+	}
+	want := []string{
+		"# Churn Risk #churn-risk",
+		"## Risk Assessment #risk-assessment",
+		"### Mitigation #mitigation",
+		"## Open Questions #open-questions",
+		"## Risk Assessment #risk-assessment-1",
+		"## Trailing #trailing",
+	}
+	if strings.Join(headings, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Unexpected sections:\n%s", strings.Join(headings, "\n"))
+	}
+
+	assessment := body.Find("risk assessment")
+	if assessment == nil || assessment.Line != 5 || len(assessment.Sections) != 1 {
+		t.Fatalf("Expected the first Risk Assessment section, got %+v", assessment)
+	}
+	if len(assessment.Links) != 2 || assessment.Links[0].URL != "https://example.com/model" || assessment.Links[1] != (Link{Text: "0.4", URL: "#pricing", Line: 11}) {
+		t.Errorf("Unexpected links: %+v", assessment.Links)
+	}
+	if len(assessment.Tables) != 1 || strings.Join(assessment.Tables[0].Header, ",") != "Factor,Weight" || assessment.Tables[0].Rows[0][0] != "Price" {
+		t.Errorf("Unexpected tables: %+v", assessment.Tables)
+	}
+
+	mitigation := body.Find("mitigation")
+	if len(mitigation.Checklist) != 2 || !mitigation.Checklist[0].Checked || mitigation.Checklist[1].Line != 15 {
+		t.Errorf("Unexpected checklist: %+v", mitigation.Checklist)
+	}
+	if !strings.Contains(mitigation.Text, "## Not a heading") {
+		t.Error("Expected the heading in the code block to be text")
+	}
+	if !strings.HasPrefix(assessment.Content(), "\nLikelihood") || !strings.Contains(assessment.String(), "### Mitigation\n") {
+		t.Errorf("Unexpected section markdown:\n%s", assessment.String())
+	}
+
+	if body.Find("risk-assessment-1").Text != "Repeated heading.\n" || body.Find("Missing") != nil {
+		t.Error("Expected sections to be found by anchor only when they exist")
+	}
+}
+
+func TestBodyEdit(t *testing.T) {
+	body := ParseBody(sampleBody)
+	trailing := body.Find("Trailing")
+	trailing.Text = "Now with text.\n"
+	questions := body.Find("Open Questions")
+	questions.Heading = "Questions"
+	questions.Level = 3
+
+	got := body.String()
+	for _, expected := range []string{"\n### Questions\n<!-- Add questions here -->\n", "## Trailing\nNow with text.\n", "## Risk Assessment ##\n"} {
+		if !strings.Contains(got, expected) {
+			t.Errorf("Expected %q in:\n%s", expected, got)
+		}
+	}
+}
+
+func TestRequireSections(t *testing.T) {
+	issues := ParseBody(sampleBody).RequireSections("Risk Assessment", "Open Questions", "Owners", "Churn Risk")
+
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 issues, got %v", issues.Messages())
+	}
+	if issues[0].Code != CodeEmptySection || issues[0].Line != 21 || issues[0].Message != `section "Open Questions" is empty` {
+		t.Errorf("Unexpected empty section issue: %+v", issues[0])
+	}
+	if issues[1].Code != CodeMissingSection || issues[1].Fix != `add a "## Owners" section` {
+		t.Errorf("Unexpected missing section issue: %+v", issues[1])
+	}
+}
+
+func TestParseBodySpecRoundTrip(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join(specDir, "*", "*.md"))
+	if err != nil || len(paths) == 0 {
+		t.Fatalf("No specifications found in %s: %v", specDir, err)
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", path, err)
+		}
+		if got := ParseBody(string(data)).String(); got != string(data) {
+			t.Errorf("Round trip changed %s", path)
+		}
+	}
+}
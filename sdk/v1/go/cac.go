@@ -1,60 +0,0 @@
-// Package bspec provides CAC document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.613522
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// CACDocument represents a Customer Acquisition (CAC) document
-// Domain: business-model
-//
-// Customer acquisition cost and strategies
-type CACDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewCACDocument creates a new CAC document with defaults
-func NewCACDocument(id, title, owner string) *CACDocument {
-	doc := &CACDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeCAC,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainBusinessModel
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the CAC document and returns any validation errors
-func (d *CACDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeCAC {
-		errors = append(errors, "document type must be CAC")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
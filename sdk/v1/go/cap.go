@@ -1,60 +0,0 @@
-// Package bspec provides CAP document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.614885
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// CAPDocument represents a Capabilities (CAP) document
-// Domain: operations
-//
-// Organizational capabilities and competencies
-type CAPDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewCAPDocument creates a new CAP document with defaults
-func NewCAPDocument(id, title, owner string) *CAPDocument {
-	doc := &CAPDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeCAP,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainOperations
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the CAP document and returns any validation errors
-func (d *CAPDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeCAP {
-		errors = append(errors, "document type must be CAP")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -0,0 +1,105 @@
+package bspec
+
+import (
+	"fmt"
+	"strings"
+)
+
+//go:generate go run ./internal/specgen -spec ../../../spec/v1 -json ../json/catalog.json
+
+// DefaultCatalog returns the specification catalog built into the SDK: the
+// business domains and the document types defined in spec/v1, including
+// their typical dependencies and enablements, followed by the types the
+// README requires beyond spec/v1.
+func DefaultCatalog() *BSpec {
+	b := &BSpec{
+		Metadata: Metadata{
+			BSpecVersion: "1.0.0",
+			Generator:    "specgen",
+			SourceSpec:   "spec/v1",
+		},
+		DocumentTypes: make([]DocumentTypeInfo, len(documentTypes)),
+	}
+	copy(b.DocumentTypes, documentTypes)
+
+	for _, info := range domainCatalog {
+		domain := Domain{
+			Name:        info.DisplayName,
+			DisplayName: info.DisplayName,
+			Emoji:       info.Emoji,
+		}
+		for _, docType := range documentTypes {
+			if docType.Domain == info.DisplayName {
+				domain.DocumentTypes = append(domain.DocumentTypes, docType.Code)
+			}
+		}
+		domain.DocumentCount = len(domain.DocumentTypes)
+		b.Domains = append(b.Domains, domain)
+	}
+
+	b.Statistics = Statistics{
+		TotalDocumentTypes: len(b.DocumentTypes),
+		TotalDomains:       len(b.Domains),
+	}
+	return b
+}
+
+// GetDependencies returns the document types a type typically depends on
+func (b *BSpec) GetDependencies(code string) []string {
+	if docType := b.GetDocumentType(code); docType != nil {
+		return docType.Dependencies
+	}
+	return nil
+}
+
+// GetEnablements returns the document types a type typically enables
+func (b *BSpec) GetEnablements(code string) []string {
+	if docType := b.GetDocumentType(code); docType != nil {
+		return docType.Enablements
+	}
+	return nil
+}
+
+// GetDependents returns the document types that typically depend on a type
+func (b *BSpec) GetDependents(code string) []string {
+	var dependents []string
+	for _, docType := range b.DocumentTypes {
+		for _, dependency := range docType.Dependencies {
+			if dependency == code {
+				dependents = append(dependents, docType.Code)
+				break
+			}
+		}
+	}
+	return dependents
+}
+
+// Skeleton returns the section headings of a document type's content
+// template as markdown, under a title heading, followed by the unchecked
+// Quality Standards and validation checklists
+func (t DocumentTypeInfo) Skeleton(title string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", title)
+	for _, section := range t.Sections {
+		fmt.Fprintf(&b, "\n%s %s\n", strings.Repeat("#", section.Level), section.Title)
+	}
+	if len(t.QualityStandards) > 0 {
+		b.WriteString("\n## Quality Standards\n")
+		for _, standard := range t.QualityStandards {
+			fmt.Fprintf(&b, "\n### %s\n\n", standard.Title)
+			writeChecklist(&b, standard.Items)
+		}
+	}
+	if len(t.Checklist) > 0 {
+		fmt.Fprintf(&b, "\n## %s\n\n", ValidationChecklistHeading)
+		writeChecklist(&b, t.Checklist)
+	}
+	return b.String()
+}
+
+// writeChecklist writes unchecked checklist items
+func writeChecklist(b *strings.Builder, items []string) {
+	for _, item := range items {
+		fmt.Fprintf(b, "- [ ] %s\n", item)
+	}
+}
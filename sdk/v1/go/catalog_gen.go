@@ -0,0 +1,8640 @@
+// Code generated by specgen from spec/v1; DO NOT EDIT.
+
+package bspec
+
+// catalogDocumentTypes lists the document types defined in spec/v1
+var catalogDocumentTypes = []DocumentTypeInfo{
+	{
+		Code:         "ADT",
+		Name:         "Adaptation and Agility",
+		Purpose:      "The Adaptation and Agility document defines systematic approaches to building organizational capabilities that enable rapid response to changing conditions and emerging opportunities. It establishes agility frameworks that transform organizations into adaptive entities that thrive in uncertainty and complexity.",
+		Domain:       "Growth & Innovation",
+		Dependencies: []string{"LEA", "ORG", "STR", "RSK"},
+		Enablements:  []string{"INN", "FUT", "EXP", "IGN"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Adaptation and Agility Framework"},
+			{Level: 3, Title: "Agility Philosophy"},
+			{Level: 3, Title: "Agility Strategy"},
+			{Level: 3, Title: "Agility Scope"},
+			{Level: 2, Title: "Sensing and Environmental Awareness"},
+			{Level: 3, Title: "Environmental Scanning"},
+			{Level: 3, Title: "Early Warning Systems"},
+			{Level: 3, Title: "Intelligence Integration"},
+			{Level: 2, Title: "Response Capabilities and Mechanisms"},
+			{Level: 3, Title: "Response Framework"},
+			{Level: 3, Title: "Rapid Decision-Making"},
+			{Level: 3, Title: "Implementation Agility"},
+			{Level: 2, Title: "Organizational Design for Agility"},
+			{Level: 3, Title: "Structure and Governance"},
+			{Level: 3, Title: "Role and Responsibility Flexibility"},
+			{Level: 3, Title: "Team Agility"},
+			{Level: 2, Title: "Cultural Agility and Mindset"},
+			{Level: 3, Title: "Agility Culture"},
+			{Level: 3, Title: "Change Leadership"},
+			{Level: 3, Title: "Learning and Development"},
+			{Level: 2, Title: "Technology and Infrastructure Agility"},
+			{Level: 3, Title: "Technology Architecture"},
+			{Level: 3, Title: "Digital Capabilities"},
+			{Level: 3, Title: "Technology Innovation"},
+			{Level: 2, Title: "Resilience and Antifragility"},
+			{Level: 3, Title: "Resilience Framework"},
+			{Level: 3, Title: "Antifragility Development"},
+			{Level: 3, Title: "Risk and Uncertainty Management"},
+			{Level: 2, Title: "Performance Measurement and Improvement"},
+			{Level: 3, Title: "Agility Metrics"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Benchmarking and Learning"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, agility framework, scope, and adaptation approach",
+			"Agility framework with philosophy, strategy, and scope definition",
+			"Sensing and awareness with environmental scanning, early warning, and intelligence",
+			"Response capabilities with frameworks, decision-making, and implementation agility",
+			"Organizational design with structure, role flexibility, and team agility",
+			"Cultural agility with culture development, change leadership, and learning",
+			"Technology agility with architecture, digital capabilities, and innovation",
+			"Resilience with framework, antifragility, and uncertainty management",
+			"Performance measurement with metrics, improvement, and benchmarking",
+			"Validation evidence of rapid response enablement, resilience support, and competitive advantage",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic agility framework with simple sensing and response capabilities",
+				"Simple organizational flexibility and change processes",
+				"Basic agility culture and mindset development",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive agility capabilities with systematic sensing and response",
+				"Structured organizational design for agility with flexible governance",
+				"Active agility culture with resilience and learning capabilities",
+				"Regular agility performance measurement and improvement",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced agility capabilities with antifragility and ecosystem integration",
+				"Sophisticated agility ecosystem with comprehensive technology integration",
+				"Agility excellence with industry leadership and innovation",
+				"Strategic agility driving business transformation and competitive advantage",
+			}},
+		},
+	},
+	{
+		Code:         "ANA",
+		Name:         "Analytics",
+		Purpose:      "The Analytics document defines systematic approaches to designing, implementing, and managing analytics capabilities that enable data-driven decision making through business intelligence, advanced analytics, and strategic insights. It establishes analytics frameworks that ensure business value, data quality, and user adoption.",
+		Domain:       "Technology & Data",
+		Dependencies: []string{"DAT", "SYS", "INF", "MET"},
+		Enablements:  []string{"PER", "QUA", "GOV", "STR"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Analytics Strategy"},
+			{Level: 3, Title: "Business Objectives"},
+			{Level: 3, Title: "Analytics Maturity"},
+			{Level: 3, Title: "Use Case Portfolio"},
+			{Level: 2, Title: "Data Architecture"},
+			{Level: 3, Title: "Data Sources"},
+			{Level: 3, Title: "Data Pipeline Architecture"},
+			{Level: 3, Title: "Data Models"},
+			{Level: 2, Title: "Analytics Platform"},
+			{Level: 3, Title: "Technology Stack"},
+			{Level: 3, Title: "Platform Capabilities"},
+			{Level: 3, Title: "Scalability and Performance"},
+			{Level: 2, Title: "Analytics Capabilities"},
+			{Level: 3, Title: "Descriptive Analytics"},
+			{Level: 3, Title: "Diagnostic Analytics"},
+			{Level: 3, Title: "Predictive Analytics"},
+			{Level: 3, Title: "Prescriptive Analytics"},
+			{Level: 2, Title: "Business Intelligence"},
+			{Level: 3, Title: "Reporting Framework"},
+			{Level: 3, Title: "Self-Service Analytics"},
+			{Level: 3, Title: "Mobile Analytics"},
+			{Level: 2, Title: "Data Science and Advanced Analytics"},
+			{Level: 3, Title: "Data Science Platform"},
+			{Level: 3, Title: "Analytics Methodology"},
+			{Level: 3, Title: "Model Lifecycle Management"},
+			{Level: 2, Title: "Data Governance and Quality"},
+			{Level: 3, Title: "Data Governance Framework"},
+			{Level: 3, Title: "Data Quality Management"},
+			{Level: 3, Title: "Privacy and Security"},
+			{Level: 2, Title: "User Experience and Adoption"},
+			{Level: 3, Title: "User Experience Design"},
+			{Level: 3, Title: "Training and Support"},
+			{Level: 3, Title: "Adoption Metrics"},
+			{Level: 2, Title: "Performance and Optimization"},
+			{Level: 3, Title: "Performance Monitoring"},
+			{Level: 3, Title: "Optimization Strategies"},
+			{Level: 2, Title: "Business Value and ROI"},
+			{Level: 3, Title: "Value Measurement"},
+			{Level: 3, Title: "ROI Analysis"},
+			{Level: 2, Title: "Future Roadmap"},
+			{Level: 3, Title: "Technology Evolution"},
+			{Level: 3, Title: "Capability Development"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, business impact, technology platform, and user community",
+			"Analytics strategy with business objectives, maturity assessment, and use case portfolio",
+			"Data architecture with data sources, pipeline architecture, and data models",
+			"Analytics platform with technology stack, capabilities, and performance characteristics",
+			"Analytics capabilities with descriptive, diagnostic, predictive, and prescriptive analytics",
+			"Business intelligence with reporting framework, self-service analytics, and mobile capabilities",
+			"Data science and advanced analytics with platform, methodology, and model lifecycle management",
+			"Data governance and quality with framework, quality management, and privacy controls",
+			"User experience and adoption with design, training, and adoption metrics",
+			"Performance and optimization with monitoring and optimization strategies",
+			"Business value and ROI with value measurement and analysis",
+			"Future roadmap with technology evolution and capability development",
+			"Validation evidence of analytics effectiveness, business value generation, and user adoption",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic reporting and dashboard capabilities",
+				"Simple data integration and basic data quality",
+				"Manual analytics processes with limited self-service",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive BI platform with self-service capabilities",
+				"Structured data governance and quality management",
+				"Advanced analytics capabilities with some automation",
+				"User training and support programs",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced analytics platform with AI/ML capabilities",
+				"Sophisticated data governance with automated quality monitoring",
+				"Comprehensive self-service and citizen data science",
+				"Strategic business value measurement and optimization",
+			}},
+		},
+	},
+	{
+		Code:         "API",
+		Name:         "APIs",
+		Purpose:      "The APIs document defines systematic approaches to designing, implementing, and managing application programming interfaces that enable business capabilities through effective integration, developer experience, and scalable service delivery. It establishes API frameworks that ensure security, performance, and strategic alignment.",
+		Domain:       "Technology & Data",
+		Dependencies: []string{"SYS", "DAT", "SEC", "ARC"},
+		Enablements:  []string{"PER", "QUA", "INT", "DEV"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "API Overview"},
+			{Level: 3, Title: "Business Purpose"},
+			{Level: 3, Title: "Technical Overview"},
+			{Level: 3, Title: "API Scope and Boundaries"},
+			{Level: 2, Title: "API Design"},
+			{Level: 3, Title: "Resource Model"},
+			{Level: 3, Title: "Endpoint Specification"},
+			{Level: 3, Title: "Data Models"},
+			{Level: 2, Title: "API Documentation"},
+			{Level: 3, Title: "OpenAPI Specification"},
+			{Level: 3, Title: "Request/Response Examples"},
+			{Level: 3, Title: "Error Handling"},
+			{Level: 2, Title: "Security and Authentication"},
+			{Level: 3, Title: "Authentication Mechanisms"},
+			{Level: 3, Title: "Authorization Model"},
+			{Level: 3, Title: "Security Controls"},
+			{Level: 2, Title: "Performance and Scalability"},
+			{Level: 3, Title: "Performance Characteristics"},
+			{Level: 3, Title: "Scalability Design"},
+			{Level: 3, Title: "Rate Limiting"},
+			{Level: 2, Title: "API Lifecycle Management"},
+			{Level: 3, Title: "Versioning Strategy"},
+			{Level: 3, Title: "Change Management"},
+			{Level: 3, Title: "API Governance"},
+			{Level: 2, Title: "Monitoring and Analytics"},
+			{Level: 3, Title: "API Monitoring"},
+			{Level: 3, Title: "Usage Analytics"},
+			{Level: 3, Title: "SLA Monitoring"},
+			{Level: 2, Title: "Consumer Experience"},
+			{Level: 3, Title: "Developer Experience"},
+			{Level: 3, Title: "Consumer Onboarding"},
+			{Level: 3, Title: "Support Model"},
+			{Level: 2, Title: "Business Continuity"},
+			{Level: 3, Title: "Availability Requirements"},
+			{Level: 3, Title: "Disaster Recovery"},
+			{Level: 2, Title: "Future Evolution"},
+			{Level: 3, Title: "API Roadmap"},
+			{Level: 3, Title: "Technology Evolution"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, type, audience, and maturity level",
+			"API overview with business purpose, technical overview, and scope boundaries",
+			"API design with resource model, endpoint specifications, and data models",
+			"API documentation with OpenAPI specification, examples, and error handling",
+			"Security and authentication with mechanisms, authorization model, and security controls",
+			"Performance and scalability with characteristics, design, and rate limiting",
+			"API lifecycle management with versioning, change management, and governance",
+			"Monitoring and analytics with monitoring framework, usage analytics, and SLA tracking",
+			"Consumer experience with developer experience, onboarding, and support model",
+			"Business continuity with availability requirements and disaster recovery procedures",
+			"Future evolution with roadmap and technology evolution planning",
+			"Validation evidence of API effectiveness, developer experience, and integration enablement",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic API specification with core endpoints",
+				"Simple authentication and basic documentation",
+				"Basic error handling and response formats",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive API design with full OpenAPI specification",
+				"Robust security, authentication, and authorization",
+				"Detailed documentation with examples and testing tools",
+				"Performance monitoring and basic analytics",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced API design with optimal developer experience",
+				"Sophisticated analytics, monitoring, and consumer insights",
+				"Comprehensive lifecycle management and governance",
+				"Strategic API evolution with ecosystem thinking",
+			}},
+		},
+	},
+	{
+		Code:         "ARC",
+		Name:         "Architecture",
+		Purpose:      "The Architecture document defines systematic approaches to designing and documenting technical architecture that enables business capabilities through coherent technology decisions, quality attribute optimization, and strategic alignment. It establishes architectural frameworks that guide technology evolution and ensure scalable, secure, and maintainable systems.",
+		Domain:       "Technology & Data",
+		Dependencies: []string{"SYS", "STR", "CAP", "REQ"},
+		Enablements:  []string{"DEV", "INF", "SEC", "API"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Architectural Vision"},
+			{Level: 3, Title: "Design Philosophy"},
+			{Level: 3, Title: "Business Alignment"},
+			{Level: 2, Title: "System Context"},
+			{Level: 3, Title: "System Landscape"},
+			{Level: 3, Title: "Stakeholder Concerns"},
+			{Level: 2, Title: "Architectural Views"},
+			{Level: 3, Title: "Logical Architecture"},
+			{Level: 3, Title: "Physical Architecture"},
+			{Level: 3, Title: "Integration Architecture"},
+			{Level: 2, Title: "Technology Stack"},
+			{Level: 3, Title: "Platform Decisions"},
+			{Level: 3, Title: "Infrastructure Choices"},
+			{Level: 2, Title: "Quality Attributes"},
+			{Level: 3, Title: "Performance Requirements"},
+			{Level: 3, Title: "Availability and Reliability"},
+			{Level: 3, Title: "Security Architecture"},
+			{Level: 2, Title: "Implementation Strategy"},
+			{Level: 3, Title: "Development Approach"},
+			{Level: 3, Title: "Risk Management"},
+			{Level: 2, Title: "Governance and Evolution"},
+			{Level: 3, Title: "Architecture Governance"},
+			{Level: 3, Title: "Evolution Planning"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, domain, architectural style, and maturity level",
+			"Architectural vision with design philosophy, quality attributes, and business alignment",
+			"System context with landscape, dependencies, and stakeholder concerns",
+			"Architectural views with logical, physical, and integration architecture",
+			"Technology stack with platform decisions and infrastructure choices",
+			"Quality attributes with performance, availability, and security requirements",
+			"Implementation strategy with development approach and risk management",
+			"Governance and evolution with decision processes and modernization strategy",
+			"Validation evidence of architecture effectiveness, quality achievement, and strategic alignment",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic architectural overview with key components",
+				"Simple technology stack documentation",
+				"Basic integration patterns identified",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive architectural views and documentation",
+				"Detailed quality attribute requirements and design",
+				"Technology decision rationale and governance",
+				"Risk assessment and mitigation strategies",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced architectural patterns and innovative solutions",
+				"Sophisticated quality attribute optimization",
+				"Comprehensive evolution and modernization strategy",
+				"Data-driven architectural decision making",
+			}},
+		},
+	},
+	{
+		Code:         "AUD",
+		Name:         "Audit",
+		Purpose:      "The Audit document defines systematic approaches to internal and external audit processes that provide independent assurance on financial reporting, internal controls, and operational effectiveness. It establishes audit frameworks that ensure compliance, risk management, and continuous improvement in business operations.",
+		Domain:       "Financial & Investment",
+		Dependencies: []string{"REP", "CTL", "RSK", "COM"},
+		Enablements:  []string{"GOV", "QUA", "PER", "COM"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Audit Framework"},
+			{Level: 3, Title: "Audit Philosophy"},
+			{Level: 3, Title: "Audit Standards"},
+			{Level: 3, Title: "Audit Universe"},
+			{Level: 2, Title: "Risk Assessment and Planning"},
+			{Level: 3, Title: "Risk Assessment"},
+			{Level: 3, Title: "Audit Planning"},
+			{Level: 3, Title: "Audit Universe Mapping"},
+			{Level: 2, Title: "Financial Statement Audit"},
+			{Level: 3, Title: "External Audit Support"},
+			{Level: 3, Title: "SOX 404 Compliance"},
+			{Level: 3, Title: "Management Assessment"},
+			{Level: 2, Title: "Internal Audit Function"},
+			{Level: 3, Title: "Internal Audit Charter"},
+			{Level: 3, Title: "Audit Execution"},
+			{Level: 3, Title: "Audit Reporting"},
+			{Level: 2, Title: "Operational Audit"},
+			{Level: 3, Title: "Process Audit"},
+			{Level: 3, Title: "Compliance Audit"},
+			{Level: 3, Title: "IT Audit"},
+			{Level: 2, Title: "Quality Assurance"},
+			{Level: 3, Title: "Audit Quality Control"},
+			{Level: 3, Title: "Training and Development"},
+			{Level: 3, Title: "Performance Management"},
+			{Level: 2, Title: "Technology and Innovation"},
+			{Level: 3, Title: "Audit Technology"},
+			{Level: 3, Title: "Continuous Auditing"},
+			{Level: 3, Title: "Innovation in Audit"},
+			{Level: 2, Title: "Regulatory and Professional Requirements"},
+			{Level: 3, Title: "Professional Standards"},
+			{Level: 3, Title: "Ethics and Independence"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, audit type, scope, and risk level",
+			"Audit framework with philosophy, standards, and audit universe",
+			"Risk assessment and planning with risk evaluation, audit planning, and universe mapping",
+			"Financial statement audit with external audit support, SOX compliance, and management assessment",
+			"Internal audit function with charter, execution, and reporting",
+			"Operational audit with process evaluation, compliance audit, and IT audit",
+			"Quality assurance with quality control, training, and performance management",
+			"Technology and innovation with audit technology, continuous auditing, and innovation",
+			"Regulatory and professional requirements with standards compliance and ethics",
+			"Validation evidence of independent assurance, improvement drive, and compliance support",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic internal audit function with annual planning",
+				"Simple audit procedures and documentation",
+				"Basic external audit coordination and support",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive risk-based audit program",
+				"Structured audit methodology with quality controls",
+				"Effective SOX 404 compliance and reporting",
+				"Regular audit committee and management reporting",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced audit analytics with continuous monitoring",
+				"Sophisticated technology integration and automation",
+				"Comprehensive quality assurance and improvement programs",
+				"Strategic audit insights driving business improvement",
+			}},
+		},
+	},
+	{
+		Code:    "BCR",
+		Name:    "Business Continuity and Recovery",
+		Purpose: "Business Continuity and Recovery focuses on maintaining essential services during and after disruption events. It complements crisis (`CRI-*`) and incident operations (`INC-*`) with restoration planning and testing discipline.",
+		Domain:  "Risk & Governance",
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Continuity Architecture"},
+			{Level: 2, Title: "Governance and Testing"},
+		},
+		Checklist: []string{
+			"Critical services and recovery priorities are explicitly ranked.",
+			"RTO/RPO targets are defined and measurable.",
+			"Failover and recovery playbooks are maintained.",
+			"Drill evidence and gaps are tracked for closure.",
+		},
+	},
+	{
+		Code:         "BEH",
+		Name:         "Behaviors",
+		Purpose:      "The Behaviors document analyzes customer usage patterns, behavioral data, and interaction analytics to understand how customers actually use products and services, revealing gaps between stated preferences and actual behavior.",
+		Domain:       "Customer & Value",
+		Dependencies: []string{"PER", "USE"},
+		Enablements:  []string{"REQ", "UXD", "GAI"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Analysis Framework"},
+			{Level: 3, Title: "Research Objectives"},
+			{Level: 3, Title: "Data Collection Framework"},
+			{Level: 3, Title: "Customer Sample"},
+			{Level: 2, Title: "Usage Behavior Analysis"},
+			{Level: 3, Title: "Overall Usage Patterns"},
+			{Level: 3, Title: "Navigation Behavior"},
+			{Level: 3, Title: "Task Completion Analysis"},
+			{Level: 2, Title: "Behavioral Patterns by Segment"},
+			{Level: 3, Title: "Segment-Specific Behaviors"},
+			{Level: 3, Title: "Persona Behavior Validation"},
+			{Level: 3, Title: "New vs. Experienced User Patterns"},
+			{Level: 2, Title: "Engagement and Retention Analysis"},
+			{Level: 3, Title: "Engagement Patterns"},
+			{Level: 3, Title: "Retention Behavior"},
+			{Level: 3, Title: "Churn Behavior Analysis"},
+			{Level: 2, Title: "Pain Point Identification"},
+			{Level: 3, Title: "Friction Analysis"},
+			{Level: 3, Title: "Efficiency Analysis"},
+			{Level: 2, Title: "Success Pattern Analysis"},
+			{Level: 3, Title: "High-Performance Behaviors"},
+			{Level: 3, Title: "Value Realization Behaviors"},
+			{Level: 2, Title: "Behavioral Change Analysis"},
+			{Level: 3, Title: "Behavior Evolution"},
+			{Level: 3, Title: "Seasonal and Temporal Patterns"},
+			{Level: 2, Title: "A/B Testing and Experimental Insights"},
+			{Level: 3, Title: "Experiment Results"},
+			{Level: 3, Title: "Behavioral Response Patterns"},
+			{Level: 2, Title: "Mobile vs. Desktop Behavior"},
+			{Level: 3, Title: "Platform-Specific Patterns"},
+			{Level: 2, Title: "Competitive Behavior Intelligence"},
+			{Level: 3, Title: "Market Behavior Comparison"},
+			{Level: 2, Title: "Actionable Insights and Recommendations"},
+			{Level: 3, Title: "Immediate Optimizations (0-30 days)"},
+			{Level: 3, Title: "Product Enhancement Opportunities (1-3 months)"},
+			{Level: 3, Title: "Strategic Initiatives (3-12 months)"},
+			{Level: 3, Title: "Continued Analysis Plan"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Analysis framework with research objectives, data collection, and customer sample",
+			"Usage behavior analysis with overall patterns, navigation, and task completion",
+			"Behavioral patterns by segment with persona validation and user type analysis",
+			"Engagement and retention analysis with patterns, cohorts, and churn behavior",
+			"Pain point identification with friction analysis and efficiency assessment",
+			"Success pattern analysis with high-performance behaviors and value realization",
+			"Behavioral change analysis with evolution and temporal patterns",
+			"A/B testing insights with experiment results and behavioral response patterns",
+			"Platform-specific behavior analysis and competitive intelligence",
+			"Actionable recommendations with immediate optimizations and strategic initiatives",
+			"Validation evidence of analysis reliability and business impact",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Analysis framework with research objectives and data collection approach",
+				"Basic usage behavior analysis with activity and engagement metrics",
+				"Pain point identification with friction analysis",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive behavioral patterns analysis by segment and persona validation",
+				"Detailed engagement and retention analysis with churn behavior insights",
+				"Success pattern analysis with high-performance behaviors and value realization",
+				"Behavioral change analysis with evolution and temporal patterns",
+				"A/B testing insights and experimental results",
+				"Actionable recommendations with immediate and strategic initiatives",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic behavior tracking with real-time analytics and predictive modeling",
+				"Advanced behavioral segmentation with machine learning clustering",
+				"Continuous behavioral optimization with automated A/B testing",
+				"Behavior-driven product development and experience personalization",
+				"Cross-platform behavioral intelligence and competitive analysis",
+				"Automated behavioral insight generation and recommendation systems",
+			}},
+		},
+	},
+	{
+		Code:         "BPO",
+		Name:         "Brand Positioning",
+		Purpose:      "The Brand Positioning document defines how the brand occupies a distinctive position in customers' minds relative to competitors. It focuses on brand-level narrative (tone, archetype, proof language, category framing in language) while `POS` owns commercial category and competitive positioning decisions.",
+		Domain:       "Brand & Marketing",
+		Dependencies: []string{"BRD", "CUS", "COM", "VPR"},
+		Enablements:  []string{"MSG", "CAM", "CNT", "SAL"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Brand Positioning Framework"},
+			{Level: 3, Title: "Positioning Philosophy"},
+			{Level: 3, Title: "Positioning Foundation"},
+			{Level: 3, Title: "Positioning Architecture"},
+			{Level: 2, Title: "Positioning Statement Development"},
+			{Level: 3, Title: "Positioning Statement Framework"},
+			{Level: 3, Title: "Differentiation Strategy"},
+			{Level: 3, Title: "Positioning Validation"},
+			{Level: 2, Title: "Competitive Positioning Analysis"},
+			{Level: 3, Title: "Competitive Frame Definition"},
+			{Level: 3, Title: "Competitive Advantage Development"},
+			{Level: 3, Title: "Repositioning Strategy"},
+			{Level: 2, Title: "Market Perception Management"},
+			{Level: 3, Title: "Perception Mapping"},
+			{Level: 3, Title: "Brand Equity Building"},
+			{Level: 3, Title: "Perception Measurement"},
+			{Level: 2, Title: "Implementation and Evolution"},
+			{Level: 3, Title: "Positioning Implementation"},
+			{Level: 3, Title: "Positioning Evolution"},
+			{Level: 3, Title: "Global Positioning Considerations"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, statement, competitive frame, and differentiation",
+			"Positioning framework with philosophy, foundation, and architecture",
+			"Statement development with framework, differentiation strategy, and validation",
+			"Competitive analysis with frame definition, advantage development, and repositioning strategy",
+			"Perception management with mapping, equity building, and measurement",
+			"Implementation and evolution with strategy, evolution planning, and global considerations",
+			"Validation evidence of differentiation creation, preference driving, and communication guiding",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic brand positioning with clear statement and competitive frame",
+				"Simple differentiation strategy and basic validation",
+				"Basic implementation guidelines and measurement approach",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive brand positioning with detailed competitive analysis",
+				"Structured perception management with measurement framework",
+				"Active positioning implementation with monitoring and optimization",
+				"Regular positioning review and evolution planning",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced positioning capabilities with sophisticated competitive intelligence",
+				"Comprehensive positioning ecosystem with integrated measurement and management",
+				"Positioning excellence with market leadership and competitive advantage",
+				"Strategic positioning driving brand preference and business growth",
+			}},
+		},
+	},
+	{
+		Code:         "BRD",
+		Name:         "Brand Strategy",
+		Purpose:      "The Brand Strategy document defines the foundational elements that shape how the brand is perceived and experienced by customers. It establishes brand frameworks that create competitive differentiation, emotional connection, and sustainable market positioning through strategic brand architecture and positioning.",
+		Domain:       "Brand & Marketing",
+		Dependencies: []string{"STR", "CUS", "MKT", "POS"},
+		Enablements:  []string{"MSG", "VID", "TON", "CNT"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Brand Strategy Framework"},
+			{Level: 3, Title: "Brand Philosophy"},
+			{Level: 3, Title: "Brand Foundation"},
+			{Level: 3, Title: "Brand Archetype"},
+			{Level: 2, Title: "Brand Positioning Strategy"},
+			{Level: 3, Title: "Positioning Development"},
+			{Level: 3, Title: "Competitive Differentiation"},
+			{Level: 3, Title: "Brand Territory"},
+			{Level: 2, Title: "Brand Experience Design"},
+			{Level: 3, Title: "Customer Experience Strategy"},
+			{Level: 3, Title: "Brand Communication Strategy"},
+			{Level: 3, Title: "Brand Culture Integration"},
+			{Level: 2, Title: "Brand Measurement and Management"},
+			{Level: 3, Title: "Brand Performance Metrics"},
+			{Level: 3, Title: "Brand Health Monitoring"},
+			{Level: 3, Title: "Brand Evolution Strategy"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, positioning, personality, and archetype",
+			"Brand framework with philosophy, foundation, and archetype definition",
+			"Positioning strategy with development, differentiation, and territory",
+			"Experience design with customer strategy, communication, and culture integration",
+			"Measurement and management with metrics, monitoring, and evolution strategy",
+			"Validation evidence of distinctive positioning, consistent experiences, and customer preference",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic brand strategy with simple positioning and personality definition",
+				"Basic brand differentiation and competitive analysis",
+				"Simple brand experience guidelines and standards",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive brand strategy with detailed positioning and archetype",
+				"Structured brand experience design with touchpoint strategy",
+				"Active brand measurement with performance tracking and monitoring",
+				"Regular brand strategy review and evolution planning",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced brand capabilities with sophisticated positioning and differentiation",
+				"Comprehensive brand ecosystem with integrated experience and culture",
+				"Brand excellence with industry leadership and market recognition",
+				"Strategic brand management driving business transformation and competitive advantage",
+			}},
+		},
+	},
+	{
+		Code:         "BUD",
+		Name:         "Budget",
+		Purpose:      "The Budget document defines systematic resource allocation and spending plans that translate strategic objectives into financial commitments. It establishes budgeting frameworks that ensure disciplined resource management, performance accountability, and financial control.",
+		Domain:       "Financial & Investment",
+		Dependencies: []string{"FIN", "STR", "OBJ", "FOR"},
+		Enablements:  []string{"MET", "REP", "CTL", "PER"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Budget Framework"},
+			{Level: 3, Title: "Budget Philosophy"},
+			{Level: 3, Title: "Budget Approach"},
+			{Level: 3, Title: "Budget Structure"},
+			{Level: 2, Title: "Revenue Budget"},
+			{Level: 3, Title: "Revenue Planning"},
+			{Level: 3, Title: "Revenue Targets"},
+			{Level: 3, Title: "Revenue Assumptions"},
+			{Level: 2, Title: "Operating Expense Budget"},
+			{Level: 3, Title: "Personnel Costs"},
+			{Level: 3, Title: "Departmental Budgets"},
+			{Level: 3, Title: "Operating Expense Categories"},
+			{Level: 2, Title: "Capital Budget"},
+			{Level: 3, Title: "Capital Investment Planning"},
+			{Level: 3, Title: "Capital Allocation"},
+			{Level: 2, Title: "Budget Controls and Monitoring"},
+			{Level: 3, Title: "Budget Controls"},
+			{Level: 3, Title: "Performance Monitoring"},
+			{Level: 2, Title: "Budget Process"},
+			{Level: 3, Title: "Planning Process"},
+			{Level: 3, Title: "Budget Communication"},
+			{Level: 2, Title: "Scenario Planning"},
+			{Level: 3, Title: "Budget Scenarios"},
+			{Level: 3, Title: "Contingency Planning"},
+			{Level: 2, Title: "Budget Analytics"},
+			{Level: 3, Title: "Budget Metrics"},
+			{Level: 3, Title: "Budget Analytics"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, budget period, type, and total amount",
+			"Budget framework with philosophy, approach, and structure",
+			"Revenue budget with planning, targets, and assumptions",
+			"Operating expense budget with personnel costs, departmental budgets, and categories",
+			"Capital budget with investment planning and allocation priorities",
+			"Budget controls and monitoring with controls framework and performance tracking",
+			"Budget process with planning calendar, approval process, and communication",
+			"Scenario planning with budget scenarios and contingency planning",
+			"Budget analytics with metrics framework and analytical capabilities",
+			"Validation evidence of strategic alignment, resource optimization, and accountability enablement",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic operating budget with revenue and expense projections",
+				"Simple budget approval and monitoring process",
+				"Basic variance reporting and analysis",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive budget framework with detailed resource allocation",
+				"Structured budget process with clear controls and approval workflows",
+				"Regular variance analysis and performance monitoring",
+				"Capital budget integration and investment planning",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced budget analytics with scenario planning and optimization",
+				"Sophisticated budget controls with automated monitoring and alerts",
+				"Strategic budget alignment with dynamic reallocation capabilities",
+				"Predictive budget modeling with AI-driven insights and recommendations",
+			}},
+		},
+	},
+	{
+		Code:         "CAM",
+		Name:         "Marketing Campaign",
+		Purpose:      "The Marketing Campaign document defines integrated marketing initiatives that achieve specific business objectives through coordinated messaging, creative execution, and multi-channel activation. It establishes campaign frameworks that drive awareness, engagement, and conversion while maintaining brand consistency and maximizing return on marketing investment.",
+		Domain:       "Brand & Marketing",
+		Dependencies: []string{"BRD", "MSG", "POS", "CNT", "SOC"},
+		Enablements:  []string{"LED", "CON", "BRA", "SAL"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Campaign Strategy Framework"},
+			{Level: 3, Title: "Campaign Philosophy"},
+			{Level: 3, Title: "Campaign Foundation"},
+			{Level: 3, Title: "Campaign Positioning"},
+			{Level: 2, Title: "Campaign Development and Planning"},
+			{Level: 3, Title: "Campaign Concept Development"},
+			{Level: 3, Title: "Campaign Architecture"},
+			{Level: 3, Title: "Campaign Timeline and Milestones"},
+			{Level: 2, Title: "Channel Strategy and Integration"},
+			{Level: 3, Title: "Multi-Channel Approach"},
+			{Level: 3, Title: "Traditional and Digital Integration"},
+			{Level: 3, Title: "Channel Optimization"},
+			{Level: 2, Title: "Content Creation and Management"},
+			{Level: 3, Title: "Content Strategy"},
+			{Level: 3, Title: "Creative Asset Management"},
+			{Level: 3, Title: "Content Performance Tracking"},
+			{Level: 2, Title: "Performance Measurement and Analytics"},
+			{Level: 3, Title: "Campaign Metrics Framework"},
+			{Level: 3, Title: "ROI and Attribution Analysis"},
+			{Level: 3, Title: "Real-Time Optimization"},
+			{Level: 2, Title: "Campaign Lifecycle Management"},
+			{Level: 3, Title: "Campaign Launch Strategy"},
+			{Level: 3, Title: "Campaign Evolution and Adaptation"},
+			{Level: 3, Title: "Campaign Conclusion and Analysis"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, objectives, audience, and messaging",
+			"Strategy framework with philosophy, foundation, and positioning",
+			"Development and planning with concept development, architecture, and timeline",
+			"Channel strategy with multi-channel approach, integration, and optimization",
+			"Content creation with strategy, asset management, and performance tracking",
+			"Performance measurement with metrics framework, ROI analysis, and optimization",
+			"Lifecycle management with launch strategy, evolution, and conclusion analysis",
+			"Validation evidence of objective achievement, brand consistency, and ROI generation",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic campaign strategy with clear objectives and target audience",
+				"Simple creative concept and multi-channel execution plan",
+				"Basic performance tracking and measurement framework",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive campaign strategy with integrated channel approach",
+				"Structured content creation with performance optimization",
+				"Active campaign management with real-time optimization",
+				"Regular performance analysis and learning integration",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced campaign capabilities with sophisticated targeting and optimization",
+				"Comprehensive campaign ecosystem with integrated measurement and attribution",
+				"Campaign excellence with industry recognition and competitive advantage",
+				"Strategic campaign management driving brand growth and market leadership",
+			}},
+		},
+	},
+	{
+		Code:         "CAP",
+		Name:         "Capability Specification",
+		Purpose:      "The Capability Specification defines systematic approaches to building and managing organizational capabilities that create competitive advantage and enable business strategy execution. It establishes capability frameworks that optimize development, performance, and continuous improvement.",
+		Domain:       "Operations & Execution",
+		Dependencies: []string{"STR", "KRS", "KAC", "PRO"},
+		Enablements:  []string{"PER", "QUA", "SVC", "ARC"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Capability Overview"},
+			{Level: 2, Title: "Capability Context"},
+			{Level: 3, Title: "Strategic Alignment"},
+			{Level: 3, Title: "Capability Classification"},
+			{Level: 2, Title: "Capability Definition"},
+			{Level: 3, Title: "Capability Components"},
+			{Level: 3, Title: "Capability Boundaries"},
+			{Level: 2, Title: "Current State Assessment"},
+			{Level: 3, Title: "Maturity Assessment"},
+			{Level: 3, Title: "Capability Strengths"},
+			{Level: 3, Title: "Capability Gaps"},
+			{Level: 3, Title: "Performance Assessment"},
+			{Level: 2, Title: "Target State Vision"},
+			{Level: 3, Title: "Future Capability Vision"},
+			{Level: 3, Title: "Capability Requirements"},
+			{Level: 3, Title: "Success Criteria"},
+			{Level: 2, Title: "Capability Development Plan"},
+			{Level: 3, Title: "Development Strategy"},
+			{Level: 3, Title: "Development Components"},
+			{Level: 3, Title: "Implementation Plan"},
+			{Level: 2, Title: "Capability Performance"},
+			{Level: 3, Title: "Performance Framework"},
+			{Level: 3, Title: "Key Performance Indicators"},
+			{Level: 3, Title: "Performance Monitoring"},
+			{Level: 2, Title: "Resource Requirements"},
+			{Level: 3, Title: "Human Resources"},
+			{Level: 3, Title: "Financial Resources"},
+			{Level: 3, Title: "Technology Resources"},
+			{Level: 3, Title: "Partner Resources"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 3, Title: "Capability Risks"},
+			{Level: 3, Title: "Risk Mitigation"},
+			{Level: 3, Title: "Business Continuity"},
+			{Level: 2, Title: "Governance and Management"},
+			{Level: 3, Title: "Capability Governance"},
+			{Level: 3, Title: "Capability Management"},
+			{Level: 3, Title: "Stakeholder Management"},
+			{Level: 2, Title: "Innovation and Evolution"},
+			{Level: 3, Title: "Capability Innovation"},
+			{Level: 3, Title: "Capability Evolution"},
+			{Level: 3, Title: "Learning and Development"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Capability overview with clear purpose, definition, strategic value, and competitive advantage",
+			"Capability context with strategic alignment, classification, and importance assessment",
+			"Capability definition with comprehensive components, boundaries, and dependencies",
+			"Current state assessment with maturity evaluation, strengths, gaps, and performance analysis",
+			"Target state vision with future requirements, performance targets, and success criteria",
+			"Capability development plan with strategy, components, and implementation roadmap",
+			"Capability performance framework with KPIs, monitoring, and improvement systems",
+			"Resource requirements across human, financial, technology, and partner dimensions",
+			"Risk management with comprehensive identification, mitigation, and business continuity",
+			"Governance and management with structure, processes, and stakeholder engagement",
+			"Innovation and evolution with opportunities, adaptation, and learning systems",
+			"Validation evidence of capability effectiveness, competitive advantage, and strategic contribution",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Capability overview with purpose, definition, and strategic value",
+				"Capability context with strategic alignment and classification",
+				"Basic capability definition with components and boundaries",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive current state assessment with maturity, strengths, and gaps analysis",
+				"Target state vision with future requirements and success criteria",
+				"Capability development plan with strategy, components, and implementation approach",
+				"Capability performance framework with KPIs and monitoring systems",
+				"Resource requirements across human, financial, technology, and partner dimensions",
+				"Risk management with identification, mitigation, and business continuity",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced governance and management with comprehensive framework",
+				"Innovation and evolution with capability innovation and learning systems",
+				"AI-driven capability optimization with predictive analytics and automated development",
+				"Real-time capability monitoring with dynamic performance adjustment",
+				"Integrated capability ecosystem with seamless cross-capability coordination",
+				"Advanced competitive intelligence driving capability strategy and investment",
+			}},
+		},
+	},
+	{
+		Code:         "CHN",
+		Name:         "Channel Strategy",
+		Purpose:      "The Channel Strategy defines sales and distribution channels for moving value to market and fulfilling customer transactions. It focuses on route-to-market structure (direct, partner, and digital distribution), not on marketing mix allocation or message channel selection.",
+		Domain:       "Business Model",
+		Dependencies: []string{"PER", "SEG", "PRI", "REV"},
+		Enablements:  []string{"CUS", "KPT", "CAP", "PRO"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Channel Overview"},
+			{Level: 2, Title: "Channel Strategy Foundation"},
+			{Level: 3, Title: "Strategic Objectives"},
+			{Level: 3, Title: "Channel Positioning"},
+			{Level: 3, Title: "Channel Portfolio Context"},
+			{Level: 2, Title: "Customer and Market Analysis"},
+			{Level: 3, Title: "Target Customer Segments"},
+			{Level: 3, Title: "Customer Journey Mapping"},
+			{Level: 3, Title: "Market Opportunity"},
+			{Level: 2, Title: "Channel Design and Structure"},
+			{Level: 3, Title: "Channel Architecture"},
+			{Level: 3, Title: "Channel Functions"},
+			{Level: 3, Title: "Channel Partners"},
+			{Level: 2, Title: "Channel Operations"},
+			{Level: 3, Title: "Channel Management"},
+			{Level: 3, Title: "Channel Performance Management"},
+			{Level: 3, Title: "Channel Marketing"},
+			{Level: 2, Title: "Customer Experience Design"},
+			{Level: 3, Title: "Experience Strategy"},
+			{Level: 3, Title: "Service Delivery"},
+			{Level: 3, Title: "Digital Integration"},
+			{Level: 2, Title: "Financial Model"},
+			{Level: 3, Title: "Channel Economics"},
+			{Level: 3, Title: "Partner Economics"},
+			{Level: 3, Title: "Financial Performance"},
+			{Level: 2, Title: "Competitive Analysis"},
+			{Level: 3, Title: "Competitive Channels"},
+			{Level: 3, Title: "Market Positioning"},
+			{Level: 2, Title: "Technology and Infrastructure"},
+			{Level: 3, Title: "Technology Platform"},
+			{Level: 3, Title: "Infrastructure Requirements"},
+			{Level: 3, Title: "Innovation and Evolution"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 3, Title: "Channel Risks"},
+			{Level: 3, Title: "Risk Mitigation"},
+			{Level: 3, Title: "Crisis Management"},
+			{Level: 2, Title: "Performance Measurement"},
+			{Level: 3, Title: "Channel Metrics"},
+			{Level: 3, Title: "Customer Experience Metrics"},
+			{Level: 3, Title: "Partner Performance Metrics"},
+			{Level: 2, Title: "Channel Evolution"},
+			{Level: 3, Title: "Lifecycle Management"},
+			{Level: 3, Title: "Innovation Strategy"},
+			{Level: 3, Title: "Strategic Planning"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Channel overview with clear purpose, strategic role, target customers, and value proposition",
+			"Channel strategy foundation with objectives, positioning, and portfolio coordination",
+			"Customer and market analysis with segments, journey mapping, and opportunity assessment",
+			"Channel design and structure with architecture, functions, and partner requirements",
+			"Channel operations with management, performance systems, and marketing support",
+			"Customer experience design with strategy, service delivery, and digital integration",
+			"Financial model with economics, partner compensation, and performance analysis",
+			"Competitive analysis with landscape assessment, differentiation, and positioning",
+			"Technology and infrastructure with platform requirements and innovation roadmap",
+			"Risk management with comprehensive risk identification, mitigation, and crisis management",
+			"Performance measurement with channel, customer experience, and partner metrics",
+			"Channel evolution with lifecycle management, innovation strategy, and strategic planning",
+			"Validation evidence of channel effectiveness, customer satisfaction, and business impact",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Channel overview with purpose, strategic role, and target customers",
+				"Channel strategy foundation with objectives, positioning, and portfolio context",
+				"Basic customer and market analysis with segments and journey mapping",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive channel design and structure with architecture, functions, and partners",
+				"Channel operations with management, performance management, and marketing",
+				"Customer experience design with strategy, service delivery, and digital integration",
+				"Financial model with economics, partner compensation, and performance metrics",
+				"Competitive analysis with channel comparison and market positioning",
+				"Technology and infrastructure with platform, requirements, and innovation plans",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced channel evolution with lifecycle management and innovation strategy",
+				"AI-driven channel optimization with predictive analytics and automated management",
+				"Dynamic partner management with performance-based resource allocation",
+				"Real-time customer experience optimization across channel touchpoints",
+				"Integrated omnichannel platform with seamless customer journey orchestration",
+				"Advanced competitive intelligence with automated market monitoring and response",
+			}},
+		},
+	},
+	{
+		Code:         "CIN",
+		Name:         "Interviews",
+		Purpose:      "The Interviews document captures structured customer research conversations that provide deep insights into needs, behaviors, motivations, and experiences. It documents qualitative research findings that inform product and strategy decisions.",
+		Domain:       "Customer & Value",
+		Dependencies: []string{"PER", "JTB"},
+		Enablements:  []string{"EMP", "REQ", "USE"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Research Design"},
+			{Level: 3, Title: "Research Objectives"},
+			{Level: 3, Title: "Interview Methodology"},
+			{Level: 3, Title: "Participant Selection"},
+			{Level: 2, Title: "Interview Findings"},
+			{Level: 3, Title: "Participant Overview"},
+			{Level: 3, Title: "Key Themes and Insights"},
+			{Level: 3, Title: "Detailed Findings by Research Question"},
+			{Level: 3, Title: "Pain Points and Challenges"},
+			{Level: 3, Title: "Opportunities and Needs"},
+			{Level: 3, Title: "Success Stories and Positive Feedback"},
+			{Level: 2, Title: "Segment-Specific Insights"},
+			{Level: 3, Title: "Segment Analysis"},
+			{Level: 3, Title: "Persona Validation"},
+			{Level: 2, Title: "Competitive Intelligence"},
+			{Level: 3, Title: "Competitive Mentions"},
+			{Level: 3, Title: "Alternative Solutions"},
+			{Level: 2, Title: "Journey and Experience Insights"},
+			{Level: 3, Title: "Customer Journey Insights"},
+			{Level: 3, Title: "Emotional Journey"},
+			{Level: 2, Title: "Decision-Making Insights"},
+			{Level: 3, Title: "Purchase Decision Factors"},
+			{Level: 3, Title: "Implementation Decision Factors"},
+			{Level: 2, Title: "Research Quality Assessment"},
+			{Level: 3, Title: "Interview Quality"},
+			{Level: 3, Title: "Sample Representativeness"},
+			{Level: 2, Title: "Actionable Recommendations"},
+			{Level: 3, Title: "Immediate Actions (0-30 days)"},
+			{Level: 3, Title: "Short-term Actions (1-3 months)"},
+			{Level: 3, Title: "Long-term Strategic Actions (3-12 months)"},
+			{Level: 3, Title: "Research Follow-up"},
+			{Level: 2, Title: "Implementation Tracking"},
+			{Level: 3, Title: "Insight Integration"},
+			{Level: 3, Title: "Impact Measurement"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Research objectives with primary questions and decision support needs",
+			"Interview methodology including structure, participant selection, and quality assessment",
+			"Comprehensive interview findings with themes, evidence, and representative quotes",
+			"Detailed findings by research question with implications and customer perspectives",
+			"Pain points and challenges with specific descriptions and customer impact",
+			"Opportunities and needs with customer descriptions and priority assessment",
+			"Success stories and satisfaction drivers with value realization examples",
+			"Segment-specific insights and persona validation",
+			"Competitive intelligence with market position and alternative solution analysis",
+			"Journey and experience insights covering touchpoints and emotional progression",
+			"Decision-making insights for both purchase and implementation factors",
+			"Research quality assessment with bias identification and sample representativeness",
+			"Actionable recommendations with immediate, short-term, and strategic actions",
+			"Implementation tracking with insight integration and impact measurement",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Research objectives clearly defined with primary questions and learning goals",
+				"Interview methodology documented with structure and participant selection",
+				"Basic interview findings with key themes and insights",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive research design with detailed methodology and quality assessment",
+				"Rich interview findings with detailed themes, quotes, and evidence",
+				"Segment-specific insights and persona validation",
+				"Competitive intelligence and journey insights",
+				"Actionable recommendations with immediate and strategic actions",
+				"Implementation tracking with integration and impact measurement",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic interview program with continuous customer engagement",
+				"Advanced analysis techniques with predictive insights",
+				"Real-time insight integration into product development",
+				"Longitudinal interview tracking and trend analysis",
+				"Automated insight synthesis and distribution",
+				"Cross-functional interview coordination and decision integration",
+			}},
+		},
+	},
+	{
+		Code:         "CJM",
+		Name:         "Customer Journey Map",
+		Purpose:      "The Customer Journey Map document visualizes the end-to-end customer experience from awareness to advocacy. It identifies touchpoints, emotions, pain points, and opportunities across the entire customer lifecycle.",
+		Domain:       "Customer & Value",
+		Dependencies: []string{"PER", "JTB"},
+		Enablements:  []string{"USE", "SUP", "REL"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Journey Framework"},
+			{Level: 3, Title: "Journey Scope"},
+			{Level: 3, Title: "Journey Methodology"},
+			{Level: 2, Title: "Journey Stages"},
+			{Level: 3, Title: "Stage 1: Awareness"},
+			{Level: 3, Title: "Stage 2: Consideration"},
+			{Level: 3, Title: "Stage 3: Purchase"},
+			{Level: 3, Title: "Stage 4: Onboarding"},
+			{Level: 3, Title: "Stage 5: Usage"},
+			{Level: 3, Title: "Stage 6: Expansion"},
+			{Level: 3, Title: "Stage 7: Advocacy"},
+			{Level: 2, Title: "Journey Analysis"},
+			{Level: 3, Title: "Cross-Stage Patterns"},
+			{Level: 3, Title: "Pain Point Analysis"},
+			{Level: 3, Title: "Moment of Truth Analysis"},
+			{Level: 2, Title: "Experience Optimization"},
+			{Level: 3, Title: "Priority Improvements"},
+			{Level: 3, Title: "Optimization Strategies"},
+			{Level: 2, Title: "Journey Governance"},
+			{Level: 3, Title: "Experience Ownership"},
+			{Level: 3, Title: "Performance Measurement"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Journey scope and boundaries clearly defined",
+			"All 7 stages (Awareness to Advocacy) documented",
+			"Touchpoints identified with experience quality metrics",
+			"Customer emotions and emotional journey mapped",
+			"Pain points categorized and impact assessed",
+			"Moments of truth identified and optimization planned",
+			"Cross-stage patterns and value realization analyzed",
+			"Experience optimization strategy with priority matrix",
+			"Journey governance and ownership established",
+			"Performance measurement framework operational",
+			"Continuous improvement process implemented",
+			"Research validation confirms journey accuracy",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Key journey stages identified and documented",
+				"Major touchpoints and pain points captured",
+				"Basic emotional journey mapped",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive 7-stage journey mapping (Awareness to Advocacy)",
+				"Detailed touchpoint analysis with experience quality metrics",
+				"Emotional journey arc with pain point impact assessment",
+				"Moment of truth identification and optimization plans",
+				"Cross-stage pattern analysis",
+				"Experience optimization strategy with priority matrix",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic journey tracking with real-time customer feedback",
+				"Advanced journey analytics and predictive modeling",
+				"Automated touchpoint monitoring and optimization",
+				"Journey governance with cross-functional ownership",
+				"Continuous journey evolution based on customer behavior",
+				"Journey-driven organizational alignment and metrics",
+			}},
+		},
+	},
+	{
+		Code:         "CMP",
+		Name:         "Competitive Analysis",
+		Purpose:      "The Competitive Analysis document maps the competitive landscape, analyzes key competitors, and identifies competitive threats and opportunities. It provides intelligence for strategic positioning and tactical responses.",
+		Domain:       "Market & Environment",
+		Dependencies: []string{"MKT", "SEG"},
+		Enablements:  []string{"POS", "MOT", "STR"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Competitive Framework"},
+			{Level: 3, Title: "Competition Definition"},
+			{Level: 3, Title: "Competitive Landscape Map"},
+			{Level: 2, Title: "Competitor Profiles"},
+			{Level: 3, Title: "Competitor 1: [Company Name]"},
+			{Level: 3, Title: "Competitor 2: [Company Name]"},
+			{Level: 3, Title: "[Continue for each major competitor]"},
+			{Level: 2, Title: "Competitive Dynamics"},
+			{Level: 3, Title: "Market Structure"},
+			{Level: 3, Title: "Competitive Intelligence"},
+			{Level: 2, Title: "Competitive Positioning"},
+			{Level: 3, Title: "Our Competitive Position"},
+			{Level: 3, Title: "Win/Loss Analysis"},
+			{Level: 2, Title: "Strategic Implications"},
+			{Level: 3, Title: "Competitive Strategy"},
+			{Level: 3, Title: "Future Scenarios"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Competitive landscape is comprehensively mapped",
+			"Key competitors are thoroughly profiled",
+			"Competitive dynamics and market structure analyzed",
+			"Our competitive position is honestly assessed",
+			"Win/loss analysis provides actionable insights",
+			"Strategic implications for product and go-to-market identified",
+			"Competitive intelligence gathering process established",
+			"Early warning systems for competitive threats operational",
+			"Sales team equipped with competitive intelligence",
+			"Regular review and update process implemented",
+			"Customer feedback validates competitive assessment",
+			"Competitive response strategies prepared",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Key competitors identified and profiled",
+				"Basic competitive positioning analysis",
+				"Competitive threats and opportunities identified",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive competitor profiles with financial and strategic analysis",
+				"Competitive dynamics and market structure analysis",
+				"Win/loss analysis with customer feedback",
+				"Competitive intelligence gathering process",
+				"Strategic implications for product and go-to-market",
+				"Early warning systems for competitive threats",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Real-time competitive intelligence system",
+				"Advanced competitive scenario planning",
+				"Competitive response playbooks",
+				"Sales team competitive enablement program",
+				"Regular competitive strategy updates",
+				"Competitive advantage measurement and tracking",
+			}},
+		},
+	},
+	{
+		Code:         "CNT",
+		Name:         "Content Strategy",
+		Purpose:      "The Content Strategy document defines what content will be created, why, and how it supports business objectives through strategic content planning and execution. It establishes content frameworks that attract and engage customers, build brand authority, and drive business outcomes through valuable and relevant content experiences.",
+		Domain:       "Brand & Marketing",
+		Dependencies: []string{"BRD", "MSG", "TON", "CUS", "SEO"},
+		Enablements:  []string{"SOC", "CAM", "LED", "EDU"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Content Strategy Framework"},
+			{Level: 3, Title: "Content Philosophy"},
+			{Level: 3, Title: "Content Strategy Foundation"},
+			{Level: 3, Title: "Content Positioning"},
+			{Level: 2, Title: "Content Planning and Development"},
+			{Level: 3, Title: "Content Type Strategy"},
+			{Level: 3, Title: "Content Theme Development"},
+			{Level: 3, Title: "Editorial Calendar Strategy"},
+			{Level: 2, Title: "Content Creation and Production"},
+			{Level: 3, Title: "Content Development Process"},
+			{Level: 3, Title: "Content Quality Framework"},
+			{Level: 3, Title: "Production Workflow"},
+			{Level: 2, Title: "Content Distribution and Promotion"},
+			{Level: 3, Title: "Distribution Strategy"},
+			{Level: 3, Title: "Channel Optimization"},
+			{Level: 3, Title: "Promotion and Amplification"},
+			{Level: 2, Title: "Performance Measurement and Optimization"},
+			{Level: 3, Title: "Content Performance Metrics"},
+			{Level: 3, Title: "Content Analytics"},
+			{Level: 3, Title: "Optimization Process"},
+			{Level: 2, Title: "Content Governance and Management"},
+			{Level: 3, Title: "Content Management System"},
+			{Level: 3, Title: "Quality Assurance"},
+			{Level: 3, Title: "Content Evolution"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, objectives, types, and themes",
+			"Strategy framework with philosophy, foundation, and positioning",
+			"Planning and development with content types, themes, and editorial calendar",
+			"Creation and production with development process, quality framework, and workflow",
+			"Distribution and promotion with strategy, optimization, and amplification",
+			"Performance measurement with metrics, analytics, and optimization processes",
+			"Governance and management with systems, quality assurance, and evolution",
+			"Validation evidence of objective alignment, engagement driving, and value creation",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic content strategy with core types and simple planning",
+				"Simple editorial calendar and basic production process",
+				"Basic distribution and simple performance tracking",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive content strategy with detailed planning and production",
+				"Structured distribution with optimization and promotion strategies",
+				"Active performance measurement with analytics and optimization",
+				"Regular strategy review and evolution planning",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced content capabilities with sophisticated planning and automation",
+				"Comprehensive content ecosystem with integrated governance and management",
+				"Content excellence with industry recognition and thought leadership",
+				"Strategic content driving brand authority and competitive advantage",
+			}},
+		},
+	},
+	{
+		Code:         "COM",
+		Name:         "Compliance",
+		Purpose:      "The Compliance document defines systematic approaches to ensuring adherence to laws, regulations, policies, and standards that govern business operations. It establishes compliance frameworks that prevent violations, manage regulatory risks, and maintain organizational integrity and reputation.",
+		Domain:       "Risk & Governance",
+		Dependencies: []string{"RSK", "CTL", "REG", "POL"},
+		Enablements:  []string{"AUD", "GOV", "REP", "ETH"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Compliance Framework"},
+			{Level: 3, Title: "Compliance Philosophy"},
+			{Level: 3, Title: "Compliance Governance"},
+			{Level: 3, Title: "Compliance Scope"},
+			{Level: 2, Title: "Regulatory Compliance"},
+			{Level: 3, Title: "Federal Regulations"},
+			{Level: 3, Title: "State and Local Compliance"},
+			{Level: 3, Title: "International Compliance"},
+			{Level: 2, Title: "Policy Compliance"},
+			{Level: 3, Title: "Internal Policy Framework"},
+			{Level: 3, Title: "Policy Management"},
+			{Level: 3, Title: "Compliance Training"},
+			{Level: 2, Title: "Compliance Monitoring and Detection"},
+			{Level: 3, Title: "Monitoring Framework"},
+			{Level: 3, Title: "Detection and Investigation"},
+			{Level: 3, Title: "Reporting and Escalation"},
+			{Level: 2, Title: "Incident Management"},
+			{Level: 3, Title: "Compliance Incident Response"},
+			{Level: 3, Title: "Corrective and Preventive Actions"},
+			{Level: 3, Title: "Lessons Learned"},
+			{Level: 2, Title: "Third-Party Compliance"},
+			{Level: 3, Title: "Vendor and Partner Compliance"},
+			{Level: 3, Title: "Joint Venture and Partnership Compliance"},
+			{Level: 2, Title: "Technology and Automation"},
+			{Level: 3, Title: "Compliance Technology"},
+			{Level: 3, Title: "Data Management and Analytics"},
+			{Level: 2, Title: "Performance Measurement"},
+			{Level: 3, Title: "Compliance Metrics and KPIs"},
+			{Level: 3, Title: "Benchmarking and Assessment"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, compliance type, regulatory scope, and monitoring approach",
+			"Compliance framework with philosophy, governance, and scope definition",
+			"Regulatory compliance with federal, state/local, and international requirements",
+			"Policy compliance with internal framework, management, and training",
+			"Compliance monitoring with framework, detection, and reporting",
+			"Incident management with response procedures, corrective actions, and lessons learned",
+			"Third-party compliance with vendor management and partnership compliance",
+			"Technology and automation with compliance technology and data management",
+			"Performance measurement with metrics, KPIs, and benchmarking",
+			"Validation evidence of violation prevention, risk management, and integrity maintenance",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic compliance program with key regulatory requirements",
+				"Simple compliance monitoring and incident response",
+				"Basic compliance training and policy framework",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive compliance framework with systematic monitoring",
+				"Structured compliance risk management and mitigation",
+				"Effective compliance training and culture development",
+				"Regular compliance performance measurement and reporting",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced compliance analytics with predictive capabilities",
+				"Sophisticated automation and real-time monitoring",
+				"Comprehensive third-party compliance management",
+				"Strategic compliance integration with business value creation",
+			}},
+		},
+	},
+	{
+		Code:    "COS",
+		Name:    "COSO Enterprise Risk Management",
+		Purpose: "Use this document when an organization needs a structured ERM framing across governance bodies, risk appetite, and control assurance.",
+		Domain:  "Risk & Governance",
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "ERM Governance"},
+			{Level: 3, Title: "Governance & Oversight"},
+			{Level: 3, Title: "Strategic Alignment"},
+			{Level: 2, Title: "Core ERM Components"},
+			{Level: 2, Title: "Risk Profile Map"},
+			{Level: 2, Title: "Integration Links"},
+			{Level: 2, Title: "Quality Standard"},
+		},
+	},
+	{
+		Code:    "CRI",
+		Name:    "Crisis Management",
+		Purpose: "Crisis Management defines severity-classified response playbooks, executive communication, and command structure for high-impact disruptions that go beyond routine incidents.",
+		Domain:  "Risk & Governance",
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Crisis Framework"},
+		},
+		Checklist: []string{
+			"Severity thresholds and activation criteria are explicit.",
+			"Crisis command chain and authority are documented.",
+			"External and internal communication templates are defined.",
+			"Closure and lessons-learned process is documented.",
+		},
+	},
+	{
+		Code:         "CST",
+		Name:         "Cost Structure",
+		Purpose:      "The Cost Structure defines systematic analysis and optimization of organizational costs to support business strategy and profitability. It establishes cost frameworks that enable efficient resource allocation, competitive positioning, and sustainable business operations.",
+		Domain:       "Business Model",
+		Dependencies: []string{"REV", "PRI", "CHN", "VAL"},
+		Enablements:  []string{"KPT", "KRS", "KAC", "CAP"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Cost Structure Overview"},
+			{Level: 2, Title: "Cost Categories and Classification"},
+			{Level: 3, Title: "Primary Cost Categories"},
+			{Level: 3, Title: "Cost Behavior Analysis"},
+			{Level: 2, Title: "Cost Driver Analysis"},
+			{Level: 3, Title: "Primary Cost Drivers"},
+			{Level: 3, Title: "Cost Driver Relationships"},
+			{Level: 2, Title: "Activity-Based Costing"},
+			{Level: 3, Title: "Activity Analysis"},
+			{Level: 3, Title: "Resource Consumption"},
+			{Level: 3, Title: "Cost Allocation"},
+			{Level: 2, Title: "Cost Structure Optimization"},
+			{Level: 3, Title: "Cost Reduction Opportunities"},
+			{Level: 3, Title: "Cost Flexibility"},
+			{Level: 2, Title: "Unit Economics"},
+			{Level: 3, Title: "Unit Cost Analysis"},
+			{Level: 3, Title: "Cost-Volume-Profit Analysis"},
+			{Level: 3, Title: "Benchmarking"},
+			{Level: 2, Title: "Cost Planning and Budgeting"},
+			{Level: 3, Title: "Cost Planning Process"},
+			{Level: 3, Title: "Budget Management"},
+			{Level: 3, Title: "Cost Control"},
+			{Level: 2, Title: "Technology and Automation Impact"},
+			{Level: 3, Title: "Automation Opportunities"},
+			{Level: 3, Title: "Technology Cost Management"},
+			{Level: 2, Title: "Risk and Sensitivity Analysis"},
+			{Level: 3, Title: "Cost Risks"},
+			{Level: 3, Title: "Sensitivity Analysis"},
+			{Level: 3, Title: "Risk Mitigation"},
+			{Level: 2, Title: "Performance Measurement"},
+			{Level: 3, Title: "Cost Performance Metrics"},
+			{Level: 3, Title: "Productivity Metrics"},
+			{Level: 3, Title: "Benchmarking Metrics"},
+			{Level: 2, Title: "Cost Structure Evolution"},
+			{Level: 3, Title: "Cost Structure Maturity"},
+			{Level: 3, Title: "Strategic Cost Management"},
+			{Level: 3, Title: "Future Considerations"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Cost structure overview with clear purpose, scope, strategic context, and optimization objectives",
+			"Cost categories and classification with comprehensive cost breakdown and behavior analysis",
+			"Cost driver analysis with primary drivers, relationships, and economies of scale assessment",
+			"Activity-based costing with activity analysis, resource consumption, and allocation methods",
+			"Cost structure optimization with reduction opportunities, flexibility strategies, and technology leverage",
+			"Unit economics with cost analysis, break-even calculations, and competitive benchmarking",
+			"Cost planning and budgeting with process design, management systems, and control mechanisms",
+			"Technology and automation impact with opportunity assessment and cost management strategies",
+			"Risk and sensitivity analysis with comprehensive risk identification, analysis, and mitigation",
+			"Performance measurement with cost metrics, productivity indicators, and benchmarking systems",
+			"Cost structure evolution with maturity assessment, strategic alignment, and future considerations",
+			"Validation evidence of cost optimization effectiveness, competitive positioning, and strategic alignment",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Cost structure overview with purpose, scope, and strategic context",
+				"Cost categories and classification with primary categories and behavior analysis",
+				"Basic cost driver analysis with primary drivers and relationships",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive activity-based costing with activity analysis and resource consumption",
+				"Cost structure optimization with reduction opportunities and flexibility strategies",
+				"Unit economics with cost analysis, break-even analysis, and benchmarking",
+				"Cost planning and budgeting with process, management, and control systems",
+				"Technology and automation impact with opportunities and cost management",
+				"Risk and sensitivity analysis with comprehensive risk assessment and mitigation",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced cost structure evolution with maturity assessment and strategic management",
+				"AI-driven cost optimization with predictive analytics and automated optimization",
+				"Dynamic cost management with real-time monitoring and adaptive budgeting",
+				"Advanced activity-based costing with predictive resource allocation",
+				"Integrated cost ecosystem with cross-functional optimization",
+				"Real-time competitive cost intelligence with automated benchmarking",
+			}},
+		},
+	},
+	{
+		Code:    "CSU",
+		Name:    "Customer Success",
+		Purpose: "Customer Success defines how the organization drives customer value realization and long-term retention after purchase. It is responsible for proactive lifecycle outcomes: onboarding, adoption, expansion, risk mitigation, and customer advocacy.",
+		Domain:  "Customer & Value",
+		Sections: []SectionTemplate{
+			{Level: 3, Title: "Customer Lifecycle Model"},
+			{Level: 3, Title: "Operational Model"},
+			{Level: 3, Title: "Metrics and Signals"},
+		},
+		Checklist: []string{
+			"Onboarding pathways are defined per customer segment.",
+			"Expansion criteria are based on measurable outcomes.",
+			"Customer risk detection and rescue controls are explicit.",
+			"Dependencies to support and product commitments are traceable.",
+			"Health metrics and governance cadence are defined.",
+		},
+	},
+	{
+		Code:         "CTL",
+		Name:         "Controls",
+		Purpose:      "The Controls document defines systematic approaches to designing, implementing, and operating internal controls that mitigate business risks, ensure compliance, and support reliable business operations. It establishes control frameworks that provide reasonable assurance for achieving business objectives while managing risk exposure.",
+		Domain:       "Risk & Governance",
+		Dependencies: []string{"RSK", "PRO", "SYS", "COM"},
+		Enablements:  []string{"AUD", "GOV", "QUA", "REP"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Control Framework"},
+			{Level: 3, Title: "Control Philosophy"},
+			{Level: 3, Title: "Control Design Principles"},
+			{Level: 3, Title: "Control Categories"},
+			{Level: 2, Title: "Control Design"},
+			{Level: 3, Title: "Entity-Level Controls"},
+			{Level: 3, Title: "Process-Level Controls"},
+			{Level: 3, Title: "Application Controls"},
+			{Level: 2, Title: "Control Implementation"},
+			{Level: 3, Title: "Control Documentation"},
+			{Level: 3, Title: "Control Operating Model"},
+			{Level: 3, Title: "Training and Competency"},
+			{Level: 2, Title: "Control Testing and Monitoring"},
+			{Level: 3, Title: "Control Testing Framework"},
+			{Level: 3, Title: "Testing Procedures"},
+			{Level: 3, Title: "Monitoring and Reporting"},
+			{Level: 2, Title: "Control Effectiveness Assessment"},
+			{Level: 3, Title: "Effectiveness Evaluation"},
+			{Level: 3, Title: "Control Maturity Assessment"},
+			{Level: 3, Title: "Performance Metrics"},
+			{Level: 2, Title: "Control Optimization"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Technology Integration"},
+			{Level: 3, Title: "Change Management"},
+			{Level: 2, Title: "Compliance and Regulatory Controls"},
+			{Level: 3, Title: "Regulatory Compliance"},
+			{Level: 3, Title: "Policy Compliance"},
+			{Level: 2, Title: "Specialized Control Areas"},
+			{Level: 3, Title: "IT General Controls"},
+			{Level: 3, Title: "Financial Controls"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, control type, nature, and effectiveness",
+			"Control framework with philosophy, design principles, and categories",
+			"Control design with entity-level, process-level, and application controls",
+			"Control implementation with documentation, operating model, and training",
+			"Control testing with framework, procedures, and monitoring",
+			"Control effectiveness with assessment, maturity evaluation, and performance metrics",
+			"Control optimization with continuous improvement, technology integration, and change management",
+			"Compliance controls with regulatory compliance and policy adherence",
+			"Specialized control areas with IT controls and financial controls",
+			"Validation evidence of risk mitigation, consistent operation, and business objective support",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic control documentation with key controls identified",
+				"Simple control testing and monitoring procedures",
+				"Basic control owner assignment and accountability",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive control framework with systematic design",
+				"Structured control testing with effectiveness assessment",
+				"Regular control monitoring with performance metrics",
+				"Control improvement process with deficiency remediation",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced control optimization with automation integration",
+				"Sophisticated control analytics with predictive monitoring",
+				"Comprehensive control governance with continuous improvement",
+				"Strategic control integration with business process optimization",
+			}},
+		},
+	},
+	{
+		Code:         "CUS",
+		Name:         "Customer Relationships",
+		Purpose:      "The Customer Relationships defines systematic approaches to building, managing, and optimizing customer relationships that drive acquisition, retention, growth, and advocacy. It establishes relationship frameworks that create sustainable competitive advantages through superior customer experiences and value delivery.",
+		Domain:       "Business Model",
+		Dependencies: []string{"PER", "SEG", "CHN", "VAL"},
+		Enablements:  []string{"REV", "CST", "VST", "SUP"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Relationship Overview"},
+			{Level: 2, Title: "Relationship Strategy"},
+			{Level: 3, Title: "Strategic Objectives"},
+			{Level: 3, Title: "Relationship Philosophy"},
+			{Level: 3, Title: "Competitive Differentiation"},
+			{Level: 2, Title: "Customer Relationship Types"},
+			{Level: 3, Title: "Personal Assistance"},
+			{Level: 3, Title: "Dedicated Personal Assistance"},
+			{Level: 3, Title: "Self-Service"},
+			{Level: 3, Title: "Automated Services"},
+			{Level: 3, Title: "Communities"},
+			{Level: 3, Title: "Co-creation"},
+			{Level: 2, Title: "Customer Lifecycle Management"},
+			{Level: 3, Title: "Acquisition Stage"},
+			{Level: 3, Title: "Onboarding Stage"},
+			{Level: 3, Title: "Growth Stage"},
+			{Level: 3, Title: "Retention Stage"},
+			{Level: 3, Title: "Advocacy Stage"},
+			{Level: 2, Title: "Relationship Personalization"},
+			{Level: 3, Title: "Customer Segmentation"},
+			{Level: 3, Title: "Personalization Strategy"},
+			{Level: 3, Title: "Dynamic Relationships"},
+			{Level: 2, Title: "Technology and Automation"},
+			{Level: 3, Title: "CRM Systems"},
+			{Level: 3, Title: "Digital Platforms"},
+			{Level: 3, Title: "AI and Machine Learning"},
+			{Level: 2, Title: "Communication Strategy"},
+			{Level: 3, Title: "Communication Channels"},
+			{Level: 3, Title: "Message Strategy"},
+			{Level: 3, Title: "Communication Frequency"},
+			{Level: 2, Title: "Performance Measurement"},
+			{Level: 3, Title: "Relationship Metrics"},
+			{Level: 3, Title: "Engagement Metrics"},
+			{Level: 3, Title: "Business Impact Metrics"},
+			{Level: 3, Title: "Operational Metrics"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 3, Title: "Relationship Risks"},
+			{Level: 3, Title: "Risk Mitigation"},
+			{Level: 3, Title: "Crisis Management"},
+			{Level: 2, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Feedback Systems"},
+			{Level: 3, Title: "Relationship Evolution"},
+			{Level: 3, Title: "Performance Optimization"},
+			{Level: 2, Title: "Future Relationship Strategy"},
+			{Level: 3, Title: "Emerging Trends"},
+			{Level: 3, Title: "Strategic Evolution"},
+			{Level: 3, Title: "Investment Priorities"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Relationship overview with clear purpose, strategic importance, customer value, and business value",
+			"Relationship strategy with objectives, philosophy, and competitive differentiation",
+			"Customer relationship types with comprehensive personal, automated, self-service, and community options",
+			"Customer lifecycle management with acquisition, onboarding, growth, retention, and advocacy stages",
+			"Relationship personalization with segmentation, strategy, and dynamic adaptation",
+			"Technology and automation with CRM systems, digital platforms, and AI integration",
+			"Communication strategy with channels, messaging, and frequency management",
+			"Performance measurement across relationship, engagement, business impact, and operational dimensions",
+			"Risk management with comprehensive relationship risk identification, mitigation, and crisis management",
+			"Continuous improvement with feedback systems, relationship evolution, and performance optimization",
+			"Future relationship strategy with emerging trends, strategic evolution, and investment priorities",
+			"Validation evidence of relationship effectiveness, customer satisfaction, and competitive advantage",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Relationship overview with purpose, strategic importance, and value proposition",
+				"Relationship strategy with objectives, philosophy, and competitive differentiation",
+				"Basic customer relationship types with personal assistance and self-service options",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive customer lifecycle management with acquisition through advocacy stages",
+				"Relationship personalization with segmentation, strategy, and dynamic adaptation",
+				"Technology and automation with CRM systems, digital platforms, and AI integration",
+				"Communication strategy with channels, messaging, and frequency management",
+				"Performance measurement across relationship, engagement, business impact, and operational metrics",
+				"Risk management with identification, mitigation, and crisis management",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced continuous improvement with feedback systems, evolution, and optimization",
+				"Future relationship strategy with emerging trends, strategic evolution, and investment priorities",
+				"AI-driven relationship optimization with predictive analytics and automated personalization",
+				"Real-time customer experience optimization with dynamic adaptation and proactive engagement",
+				"Integrated omnichannel platform with seamless cross-channel relationship management",
+				"Advanced competitive intelligence with automated relationship benchmarking and optimization",
+			}},
+		},
+	},
+	{
+		Code:         "DAT",
+		Name:         "Data Models",
+		Purpose:      "The Data Models document defines systematic approaches to designing, governing, and managing data structures that support business capabilities through coherent data architecture, quality assurance, and strategic data management. It establishes data frameworks that ensure consistency, integrity, and value creation from organizational data assets.",
+		Domain:       "Technology & Data",
+		Dependencies: []string{"SYS", "ARC", "REQ", "GOV"},
+		Enablements:  []string{"API", "ANA", "QUA", "INT"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Data Architecture"},
+			{Level: 3, Title: "Conceptual Model"},
+			{Level: 3, Title: "Logical Model"},
+			{Level: 3, Title: "Physical Model"},
+			{Level: 2, Title: "Entity Specifications"},
+			{Level: 3, Title: "Core Entities"},
+			{Level: 3, Title: "Relationship Matrix"},
+			{Level: 2, Title: "Data Governance"},
+			{Level: 3, Title: "Data Ownership"},
+			{Level: 3, Title: "Data Quality Framework"},
+			{Level: 3, Title: "Data Privacy and Security"},
+			{Level: 2, Title: "Data Integration"},
+			{Level: 3, Title: "Source Systems"},
+			{Level: 3, Title: "Data Flow"},
+			{Level: 3, Title: "Master Data Management"},
+			{Level: 2, Title: "Data Storage and Performance"},
+			{Level: 3, Title: "Storage Strategy"},
+			{Level: 3, Title: "Data Archival"},
+			{Level: 2, Title: "Analytics and Reporting"},
+			{Level: 3, Title: "Analytical Model"},
+			{Level: 3, Title: "Reporting Requirements"},
+			{Level: 2, Title: "Data Operations"},
+			{Level: 3, Title: "Data Lifecycle Management"},
+			{Level: 3, Title: "Backup and Recovery"},
+			{Level: 3, Title: "Change Management"},
+			{Level: 2, Title: "Monitoring and Metrics"},
+			{Level: 3, Title: "Data Quality Metrics"},
+			{Level: 3, Title: "Performance Metrics"},
+			{Level: 2, Title: "Compliance and Audit"},
+			{Level: 3, Title: "Regulatory Compliance"},
+			{Level: 3, Title: "Data Audit"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, domain, classification, and stakeholders",
+			"Data architecture with conceptual, logical, and physical models",
+			"Entity specifications with core entities and relationship matrix",
+			"Data governance with ownership, quality framework, and privacy controls",
+			"Data integration with source systems, data flow, and master data management",
+			"Data storage and performance with strategy, optimization, and archival procedures",
+			"Analytics and reporting with analytical model and reporting requirements",
+			"Data operations with lifecycle management, backup, and change management",
+			"Monitoring and metrics with quality and performance measurement",
+			"Compliance and audit with regulatory compliance and audit framework",
+			"Validation evidence of data model effectiveness, quality achievement, and business value",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic data model with core entities and relationships",
+				"Simple data governance and ownership definition",
+				"Basic data quality and security measures",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive logical and physical data model",
+				"Detailed data governance framework with quality controls",
+				"Structured data integration and lifecycle management",
+				"Privacy and compliance framework",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced data architecture with optimization strategies",
+				"Sophisticated data governance with automated quality monitoring",
+				"Comprehensive analytics and self-service capabilities",
+				"Strategic data management with predictive governance",
+			}},
+		},
+	},
+	{
+		Code:         "DEC",
+		Name:         "Decision Records",
+		Purpose:      "The Decision Records document captures strategic and operational decisions made within the organization, including context, rationale, alternatives considered, and outcomes. It establishes decision frameworks that preserve institutional knowledge, enable accountability, and provide historical context for future decision-making.",
+		Domain:       "Learning & Decisions",
+		Dependencies: []string{"STR", "OBJ", "THY", "RSK"},
+		Enablements:  []string{"ROL", "PRO", "POL", "PLN"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Decision Framework"},
+			{Level: 3, Title: "Decision Philosophy"},
+			{Level: 3, Title: "Decision Foundation"},
+			{Level: 3, Title: "Decision Architecture"},
+			{Level: 2, Title: "Decision Documentation"},
+			{Level: 3, Title: "Decision Record Structure"},
+			{Level: 3, Title: "Alternative Analysis"},
+			{Level: 3, Title: "Impact Assessment"},
+			{Level: 2, Title: "Decision Implementation and Tracking"},
+			{Level: 3, Title: "Implementation Planning"},
+			{Level: 3, Title: "Decision Monitoring"},
+			{Level: 3, Title: "Decision Review and Learning"},
+			{Level: 2, Title: "Decision Governance and Quality"},
+			{Level: 3, Title: "Decision Quality Framework"},
+			{Level: 3, Title: "Decision Accountability"},
+			{Level: 3, Title: "Decision Documentation Standards"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, type, status, and scope",
+			"Decision framework with philosophy, foundation, and architecture",
+			"Documentation structure with record structure, alternative analysis, and impact assessment",
+			"Implementation and tracking with planning, monitoring, and review processes",
+			"Governance and quality with framework, accountability, and documentation standards",
+			"Validation evidence of knowledge preservation, accountability enabling, and decision improvement",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic decision documentation with context and rationale",
+				"Simple implementation tracking and basic accountability",
+				"Basic decision review and learning capture process",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive decision framework with structured analysis and evaluation",
+				"Structured implementation planning with monitoring and tracking",
+				"Active decision governance with quality standards and accountability",
+				"Regular decision review and systematic learning integration",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced decision capabilities with sophisticated analysis and optimization",
+				"Comprehensive decision ecosystem with integrated governance and quality",
+				"Decision excellence with organizational learning and continuous improvement",
+				"Strategic decision management driving organizational effectiveness and growth",
+			}},
+		},
+	},
+	{
+		Code:         "DEV",
+		Name:         "Development",
+		Purpose:      "The Development document defines software development practices, methodologies, and team processes that enable efficient delivery of high-quality software solutions. It establishes development frameworks that ensure consistency, quality, and collaborative effectiveness in software creation.",
+		Domain:       "Technology & Data",
+		Dependencies: []string{"ARC", "SYS", "REQ", "QUA"},
+		Enablements:  []string{"PER", "QUA", "SEC", "OPS"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Development Philosophy"},
+			{Level: 3, Title: "Core Principles"},
+			{Level: 3, Title: "Development Values"},
+			{Level: 3, Title: "Technical Philosophy"},
+			{Level: 2, Title: "Methodology Framework"},
+			{Level: 3, Title: "Development Methodology"},
+			{Level: 3, Title: "Planning and Estimation"},
+			{Level: 3, Title: "Iteration Management"},
+			{Level: 2, Title: "Technical Practices"},
+			{Level: 3, Title: "Coding Standards"},
+			{Level: 3, Title: "Testing Strategy"},
+			{Level: 3, Title: "Code Review Process"},
+			{Level: 2, Title: "DevOps and Automation"},
+			{Level: 3, Title: "CI/CD Pipeline"},
+			{Level: 3, Title: "Infrastructure as Code"},
+			{Level: 3, Title: "Monitoring and Observability"},
+			{Level: 2, Title: "Security Integration"},
+			{Level: 3, Title: "DevSecOps Practices"},
+			{Level: 3, Title: "Security Automation"},
+			{Level: 2, Title: "Team Collaboration"},
+			{Level: 3, Title: "Communication Framework"},
+			{Level: 3, Title: "Collaboration Tools"},
+			{Level: 3, Title: "Onboarding and Mentoring"},
+			{Level: 2, Title: "Quality Assurance"},
+			{Level: 3, Title: "Quality Framework"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Innovation Culture"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, methodology, team structure, and automation level",
+			"Development philosophy with core principles, values, and technical philosophy",
+			"Methodology framework with development approach, planning, and iteration management",
+			"Technical practices with coding standards, testing strategy, and code review process",
+			"DevOps and automation with CI/CD pipeline, infrastructure as code, and observability",
+			"Security integration with DevSecOps practices and security automation",
+			"Team collaboration with communication framework, tools, and mentoring",
+			"Quality assurance with quality framework, continuous improvement, and innovation culture",
+			"Validation evidence of development effectiveness, team productivity, and quality delivery",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic development process with essential practices",
+				"Simple coding standards and basic testing",
+				"Manual deployment with basic quality gates",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive development methodology with automation",
+				"Detailed coding standards and comprehensive testing strategy",
+				"CI/CD pipeline with automated quality gates",
+				"Team collaboration and knowledge sharing practices",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced development practices with continuous optimization",
+				"Sophisticated automation and DevSecOps integration",
+				"Comprehensive metrics and data-driven improvement",
+				"Innovation culture with continuous learning",
+			}},
+		},
+	},
+	{
+		Code:         "ECO",
+		Name:         "Ecosystem",
+		Purpose:      "The Ecosystem document maps the network of partners, suppliers, distributors, and other stakeholders that create value around the organization. It analyzes ecosystem dynamics and partnership strategies.",
+		Domain:       "Market & Environment",
+		Dependencies: []string{"MKT", "STR"},
+		Enablements:  []string{"CHN", "VND", "PRT"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Ecosystem Framework"},
+			{Level: 2, Title: "Key Participants"},
+			{Level: 3, Title: "Partner Categories"},
+			{Level: 2, Title: "Ecosystem Dynamics"},
+			{Level: 2, Title: "Partnership Strategy"},
+			{Level: 2, Title: "Value Creation"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Ecosystem boundaries and scope clearly defined",
+			"Key participants categorized and profiled",
+			"Partnership strategy aligned with business objectives",
+			"Value creation mechanisms identified and quantified",
+			"Network effects and platform dynamics analyzed",
+			"Risk assessment and mitigation strategies documented",
+			"Performance measurement and governance established",
+			"Regular review and evolution process implemented",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Core ecosystem participants identified",
+				"Basic partnership strategy outlined",
+				"Key dependencies and risks assessed",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive ecosystem mapping with dynamics analysis",
+				"Partner portfolio strategy with prioritization criteria",
+				"Value creation mechanisms quantified",
+				"Risk mitigation strategies implemented",
+				"Platform strategy defined",
+				"Partnership governance established",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic ecosystem intelligence and monitoring",
+				"Advanced partnership performance tracking",
+				"Ecosystem-driven innovation programs",
+				"Network effects measurement and optimization",
+				"Competitive ecosystem strategy",
+				"Platform orchestration capabilities",
+			}},
+		},
+	},
+	{
+		Code:         "EMP",
+		Name:         "Empathy Maps",
+		Purpose:      "The Empathy Maps document captures deep understanding of customer thoughts, feelings, behaviors, and environment. It provides holistic view of customer experience and emotional context that drives behavior.",
+		Domain:       "Customer & Value",
+		Dependencies: []string{"PER", "JTB"},
+		Enablements:  []string{"CJM", "UXD", "REL"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Empathy Map Context"},
+			{Level: 3, Title: "Subject Definition"},
+			{Level: 3, Title: "Research Foundation"},
+			{Level: 2, Title: "THINKS (Cognitive Layer)"},
+			{Level: 3, Title: "Beliefs and Assumptions"},
+			{Level: 3, Title: "Thought Patterns"},
+			{Level: 3, Title: "Mental Preoccupations"},
+			{Level: 2, Title: "FEELS (Emotional Layer)"},
+			{Level: 3, Title: "Primary Emotions"},
+			{Level: 3, Title: "Emotional Needs"},
+			{Level: 3, Title: "Emotional Barriers"},
+			{Level: 2, Title: "SEES (Environmental Layer)"},
+			{Level: 3, Title: "Physical Environment"},
+			{Level: 3, Title: "Information Environment"},
+			{Level: 3, Title: "Market Environment"},
+			{Level: 2, Title: "SAYS (Communication Layer)"},
+			{Level: 3, Title: "Verbal Communication"},
+			{Level: 3, Title: "Written Communication"},
+			{Level: 3, Title: "Influence Communication"},
+			{Level: 2, Title: "DOES (Behavioral Layer)"},
+			{Level: 3, Title: "Observable Actions"},
+			{Level: 3, Title: "Behavioral Patterns"},
+			{Level: 3, Title: "Behavioral Contradictions"},
+			{Level: 2, Title: "PAINS (Challenges Layer)"},
+			{Level: 3, Title: "Frustrations and Obstacles"},
+			{Level: 3, Title: "Fears and Anxieties"},
+			{Level: 2, Title: "GAINS (Aspirations Layer)"},
+			{Level: 3, Title: "Desires and Wants"},
+			{Level: 3, Title: "Success Measures"},
+			{Level: 2, Title: "Empathy Synthesis"},
+			{Level: 3, Title: "Key Insights"},
+			{Level: 3, Title: "Design Implications"},
+			{Level: 3, Title: "Strategy Implications"},
+			{Level: 2, Title: "Validation and Evolution"},
+			{Level: 3, Title: "Empathy Validation"},
+			{Level: 3, Title: "Empathy Evolution"},
+			{Level: 2, Title: "Usage Guidelines"},
+			{Level: 3, Title: "Team Application"},
+			{Level: 3, Title: "Empathy Maintenance"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Six empathy layers (THINKS, FEELS, SEES, SAYS, DOES, PAINS/GAINS) comprehensively documented",
+			"Subject definition with persona focus and scenario context",
+			"Research foundation with multiple methods and quality assessment",
+			"Cognitive layer with beliefs, thought patterns, and mental preoccupations",
+			"Emotional layer with emotions, needs, and barriers",
+			"Environmental layer covering physical, information, and market context",
+			"Communication layer with verbal, written, and influence patterns",
+			"Behavioral layer with observable actions, patterns, and contradictions",
+			"Challenge and aspiration layers with detailed analysis",
+			"Empathy synthesis with key insights and implications",
+			"Validation and evolution framework with ongoing accuracy confirmation",
+			"Usage guidelines for team application and maintenance",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Six empathy layers documented (THINKS, FEELS, SEES, SAYS, DOES, PAINS/GAINS)",
+				"Primary persona and scenario context clearly defined",
+				"Basic research foundation with customer interviews/observation",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive empathy mapping across all six layers with detailed insights",
+				"Research foundation with multiple validation methods and quality assessment",
+				"Empathy synthesis with key insights and design implications",
+				"Strategy implications for positioning and communication",
+				"Usage guidelines for team application and stakeholder alignment",
+				"Validation and evolution framework",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic empathy tracking with real-time customer insight updates",
+				"Advanced empathy analytics with behavioral pattern recognition",
+				"Continuous empathy validation through ongoing customer engagement",
+				"Empathy-driven design and strategy optimization",
+				"Cross-functional empathy coordination and decision integration",
+				"Automated empathy evolution monitoring and insight generation",
+			}},
+		},
+	},
+	{
+		Code:    "EOL",
+		Name:    "End-of-Life and Retirement",
+		Purpose: "End-of-Life and Retirement manages planned retirement of products/services, including customer communication, migration strategy, and sunset operations across support, compliance, and security.",
+		Domain:  "Product & Service",
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Lifecycle Design"},
+			{Level: 2, Title: "Operational Controls"},
+		},
+		Checklist: []string{
+			"End-of-life decision rationale is explicitly documented.",
+			"Customer impact and migration commitments are quantified.",
+			"Support and security handoffs are complete.",
+			"Closure conditions are defined and executable.",
+		},
+	},
+	{
+		Code:         "ETH",
+		Name:         "Ethics",
+		Purpose:      "The Ethics document defines systematic approaches to promoting ethical behavior, integrity, and moral standards throughout the organization. It establishes ethics frameworks that guide decision-making, prevent misconduct, and foster a culture of ethical excellence that builds trust with stakeholders.",
+		Domain:       "Risk & Governance",
+		Dependencies: []string{"GOV", "COM", "VAL", "CUL"},
+		Enablements:  []string{"REP", "RSK", "LEG", "AUD"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Ethics Framework"},
+			{Level: 3, Title: "Ethics Philosophy"},
+			{Level: 3, Title: "Ethics Principles"},
+			{Level: 3, Title: "Ethics Scope"},
+			{Level: 2, Title: "Code of Conduct and Standards"},
+			{Level: 3, Title: "Code of Conduct Framework"},
+			{Level: 3, Title: "Ethical Standards"},
+			{Level: 3, Title: "Policy Integration"},
+			{Level: 2, Title: "Ethical Decision-Making"},
+			{Level: 3, Title: "Decision-Making Framework"},
+			{Level: 3, Title: "Ethical Dilemma Resolution"},
+			{Level: 3, Title: "Leadership Ethics"},
+			{Level: 2, Title: "Ethics Culture and Training"},
+			{Level: 3, Title: "Culture Development"},
+			{Level: 3, Title: "Training and Development"},
+			{Level: 3, Title: "Communication and Awareness"},
+			{Level: 2, Title: "Ethics Monitoring and Reporting"},
+			{Level: 3, Title: "Monitoring Framework"},
+			{Level: 3, Title: "Reporting and Whistleblowing"},
+			{Level: 3, Title: "Ethics Committee"},
+			{Level: 2, Title: "Business Ethics Applications"},
+			{Level: 3, Title: "Sales and Marketing Ethics"},
+			{Level: 3, Title: "Supply Chain Ethics"},
+			{Level: 3, Title: "Financial Ethics"},
+			{Level: 2, Title: "Technology and Innovation Ethics"},
+			{Level: 3, Title: "Digital Ethics"},
+			{Level: 3, Title: "Innovation Ethics"},
+			{Level: 2, Title: "Performance Measurement and Improvement"},
+			{Level: 3, Title: "Ethics Performance Metrics"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Benchmarking and Recognition"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, ethics framework, culture approach, and monitoring approach",
+			"Ethics framework with philosophy, principles, and scope definition",
+			"Code of conduct with framework, ethical standards, and policy integration",
+			"Ethical decision-making with framework, dilemma resolution, and leadership ethics",
+			"Culture and training with development, training programs, and communication",
+			"Monitoring and reporting with framework, reporting mechanisms, and ethics committee",
+			"Business ethics with sales/marketing, supply chain, and financial ethics",
+			"Technology ethics with digital ethics and innovation ethics",
+			"Performance measurement with metrics, continuous improvement, and benchmarking",
+			"Validation evidence of ethical behavior promotion, culture embedding, and stakeholder trust building",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic code of conduct with fundamental ethical standards",
+				"Simple ethics training and communication programs",
+				"Basic ethics reporting and violation response",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive ethics framework with decision-making guidance",
+				"Structured ethics culture development and training programs",
+				"Effective ethics monitoring and continuous improvement",
+				"Strong leadership commitment and stakeholder engagement",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced ethics culture with embedded ethical excellence",
+				"Sophisticated ethics analytics and predictive monitoring",
+				"Comprehensive stakeholder ethics integration and transparency",
+				"Strategic ethics leadership and industry recognition",
+			}},
+		},
+	},
+	{
+		Code:         "EXP",
+		Name:         "Experimentation",
+		Purpose:      "The Experimentation document governs controlled validation of priority hypotheses before deeper productization. It is the short-cycle mechanism feeding `IGN` and `FUT`, while `INN` and `RND` provide strategic direction and technical foundation.",
+		Domain:       "Growth & Innovation",
+		Dependencies: []string{"INN", "LEA", "DAT", "ANA"},
+		Enablements:  []string{"PRD", "SVC", "STR", "IGN"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Experimentation Framework"},
+			{Level: 3, Title: "Experimentation Philosophy"},
+			{Level: 3, Title: "Experimentation Strategy"},
+			{Level: 3, Title: "Experimentation Scope"},
+			{Level: 2, Title: "Experimental Design and Methodology"},
+			{Level: 3, Title: "Hypothesis Development"},
+			{Level: 3, Title: "Experimental Design"},
+			{Level: 3, Title: "Measurement and Analytics"},
+			{Level: 2, Title: "Experiment Portfolio Management"},
+			{Level: 3, Title: "Portfolio Framework"},
+			{Level: 3, Title: "Experiment Lifecycle"},
+			{Level: 3, Title: "Portfolio Performance"},
+			{Level: 2, Title: "Experimentation Process and Operations"},
+			{Level: 3, Title: "Experiment Process"},
+			{Level: 3, Title: "Experimentation Tools and Platforms"},
+			{Level: 3, Title: "Quality Assurance"},
+			{Level: 2, Title: "Experimentation Culture and Capabilities"},
+			{Level: 3, Title: "Culture Development"},
+			{Level: 3, Title: "Capability Building"},
+			{Level: 3, Title: "Centers of Excellence"},
+			{Level: 2, Title: "Results Integration and Decision Making"},
+			{Level: 3, Title: "Insight Generation"},
+			{Level: 3, Title: "Decision Integration"},
+			{Level: 3, Title: "Scaling and Implementation"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, methodology, hypothesis framework, and validation approach",
+			"Experimentation framework with philosophy, strategy, and scope definition",
+			"Experimental design with hypothesis development, design methods, and measurement",
+			"Portfolio management with framework, lifecycle, and performance measurement",
+			"Process and operations with procedures, tools, and quality assurance",
+			"Culture and capabilities with development, building, and centers of excellence",
+			"Results integration with insight generation, decision integration, and scaling",
+			"Validation evidence of rapid testing, evidence-based decisions, and organizational scaling",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic experimentation framework with simple A/B testing capabilities",
+				"Simple hypothesis development and testing processes",
+				"Basic experimental measurement and analysis",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive experimentation framework with rigorous experimental design",
+				"Structured experiment portfolio management with quality assurance",
+				"Active experimentation culture with systematic insight integration",
+				"Regular experimentation effectiveness measurement and improvement",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced experimentation capabilities with sophisticated statistical methods",
+				"Comprehensive experimentation ecosystem with centers of excellence",
+				"Experimentation excellence with industry leadership and innovation",
+				"Strategic experimentation driving business transformation and competitive advantage",
+			}},
+		},
+	},
+	{
+		Code:         "FAC",
+		Name:         "Facilities Management",
+		Purpose:      "The Facilities Management document defines systematic approaches to planning, operating, and maintaining physical facilities that support business operations through efficient space utilization, operational excellence, and employee productivity. It establishes facility frameworks that optimize cost, safety, and performance.",
+		Domain:       "Operations & Execution",
+		Dependencies: []string{"ORG", "TEA", "POL", "VND"},
+		Enablements:  []string{"PER", "QUA", "CST", "OPS"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Facility Profile"},
+			{Level: 3, Title: "Location Information"},
+			{Level: 3, Title: "Physical Specifications"},
+			{Level: 3, Title: "Infrastructure Systems"},
+			{Level: 2, Title: "Space Management"},
+			{Level: 3, Title: "Space Allocation"},
+			{Level: 3, Title: "Occupancy Planning"},
+			{Level: 3, Title: "Space Utilization"},
+			{Level: 2, Title: "Operations Management"},
+			{Level: 3, Title: "Facility Operations"},
+			{Level: 3, Title: "Service Providers"},
+			{Level: 3, Title: "Resource Management"},
+			{Level: 2, Title: "Safety and Security"},
+			{Level: 3, Title: "Safety Framework"},
+			{Level: 3, Title: "Security Framework"},
+			{Level: 3, Title: "Compliance Requirements"},
+			{Level: 2, Title: "Technology and Infrastructure"},
+			{Level: 3, Title: "IT Infrastructure"},
+			{Level: 3, Title: "Equipment and Assets"},
+			{Level: 2, Title: "Cost Management"},
+			{Level: 3, Title: "Cost Structure"},
+			{Level: 3, Title: "Budget Management"},
+			{Level: 3, Title: "Cost Optimization"},
+			{Level: 2, Title: "Sustainability and Environment"},
+			{Level: 3, Title: "Environmental Impact"},
+			{Level: 3, Title: "Sustainability Initiatives"},
+			{Level: 2, Title: "Business Continuity"},
+			{Level: 3, Title: "Continuity Planning"},
+			{Level: 3, Title: "Risk Management"},
+			{Level: 3, Title: "Emergency Response"},
+			{Level: 2, Title: "Governance and Management"},
+			{Level: 3, Title: "Facility Governance"},
+			{Level: 3, Title: "Performance Management"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, type, size, and capacity",
+			"Facility profile with location information, physical specifications, and infrastructure systems",
+			"Space management with allocation, occupancy planning, and utilization analysis",
+			"Operations management with facility operations, service providers, and resource management",
+			"Safety and security with comprehensive frameworks and compliance requirements",
+			"Technology and infrastructure with IT systems, equipment, and asset management",
+			"Cost management with structure, budget management, and optimization strategies",
+			"Sustainability and environment with impact assessment and conservation initiatives",
+			"Business continuity with planning, risk management, and emergency response",
+			"Governance and management with oversight, performance metrics, and improvement processes",
+			"Validation evidence of facility effectiveness, utilization optimization, and operational support",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic facility information and space allocation",
+				"Essential safety and security procedures",
+				"Simple cost tracking and budget management",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive facility operations and management framework",
+				"Detailed safety, security, and compliance procedures",
+				"Structured cost management and optimization",
+				"Performance measurement and utilization tracking",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced facility optimization and strategic planning",
+				"Comprehensive sustainability and environmental management",
+				"Sophisticated performance analytics and benchmarking",
+				"Integration with business continuity and risk management",
+			}},
+		},
+	},
+	{
+		Code:         "FEA",
+		Name:         "Feature Specification",
+		Purpose:      "The Feature Specification defines detailed, feature-level requirements for specific product capabilities. It sits between `PRD` and `REQ`, translating strategic product outcomes into implementation-ready feature behavior and acceptance criteria without replacing full system requirements specifications.",
+		Domain:       "Product & Service",
+		Dependencies: []string{"PRD", "USE", "UXD"},
+		Enablements:  []string{"REQ", "QUA", "INT"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Feature Summary"},
+			{Level: 2, Title: "Problem Statement"},
+			{Level: 3, Title: "User Problem"},
+			{Level: 3, Title: "Business Problem"},
+			{Level: 2, Title: "User Stories and Acceptance Criteria"},
+			{Level: 3, Title: "Primary User Stories"},
+			{Level: 3, Title: "Edge Cases and Error Scenarios"},
+			{Level: 3, Title: "Non-Functional Requirements"},
+			{Level: 2, Title: "Feature Design"},
+			{Level: 3, Title: "User Experience Design"},
+			{Level: 3, Title: "Information Architecture"},
+			{Level: 3, Title: "Technical Architecture"},
+			{Level: 2, Title: "Implementation Specification"},
+			{Level: 3, Title: "Technical Requirements"},
+			{Level: 3, Title: "Development Approach"},
+			{Level: 3, Title: "Quality Assurance"},
+			{Level: 2, Title: "Success Metrics"},
+			{Level: 3, Title: "User Metrics"},
+			{Level: 3, Title: "Business Metrics"},
+			{Level: 3, Title: "Technical Metrics"},
+			{Level: 2, Title: "Risk Assessment"},
+			{Level: 3, Title: "Development Risks"},
+			{Level: 3, Title: "Business Risks"},
+			{Level: 3, Title: "Mitigation Strategies"},
+			{Level: 2, Title: "Dependencies and Constraints"},
+			{Level: 3, Title: "Technical Dependencies"},
+			{Level: 3, Title: "Business Dependencies"},
+			{Level: 3, Title: "Known Constraints"},
+			{Level: 2, Title: "Implementation Plan"},
+			{Level: 3, Title: "Development Phases"},
+			{Level: 3, Title: "Release Strategy"},
+			{Level: 3, Title: "Support Plan"},
+			{Level: 2, Title: "Validation Plan"},
+			{Level: 3, Title: "User Validation"},
+			{Level: 3, Title: "Technical Validation"},
+			{Level: 3, Title: "Business Validation"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Feature summary with clear purpose, value proposition, and success criteria",
+			"Problem statement covering both user and business problems with evidence",
+			"User stories and acceptance criteria including edge cases and non-functional requirements",
+			"Feature design with user experience, information architecture, and technical architecture",
+			"Implementation specification with technical requirements, development approach, and quality assurance",
+			"Success metrics across user, business, and technical dimensions",
+			"Risk assessment with development and business risks plus mitigation strategies",
+			"Dependencies and constraints covering technical, business, and regulatory factors",
+			"Implementation plan with development phases, release strategy, and support plan",
+			"Validation plan covering user, technical, and business validation approaches",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Feature summary with purpose, user value, and success criteria",
+				"Problem statement covering user and business problems",
+				"Primary user stories with basic acceptance criteria",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive user stories with edge cases and non-functional requirements",
+				"Feature design with user experience and technical architecture",
+				"Implementation specification with technical requirements and QA approach",
+				"Success metrics across user, business, and technical dimensions",
+				"Risk assessment with mitigation strategies",
+				"Dependencies, constraints, and implementation plan",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Detailed validation plan with user, technical, and business validation",
+				"Comprehensive release strategy with monitoring and support plans",
+				"Advanced testing strategy including performance and security validation",
+				"Real-time feature analytics and user behavior tracking",
+				"Continuous feature improvement based on usage data",
+				"Cross-feature integration and ecosystem considerations",
+			}},
+		},
+	},
+	{
+		Code:         "FEE",
+		Name:         "Feedback",
+		Purpose:      "The Feedback document captures, analyzes, and manages customer input, reviews, and satisfaction data. It provides systematic approach to collecting, processing, and acting on customer feedback across all touchpoints.",
+		Domain:       "Customer & Value",
+		Dependencies: []string{"CJM", "SUP"},
+		Enablements:  []string{"REQ", "PRD", "SVC"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Feedback Framework"},
+			{Level: 3, Title: "Collection Strategy"},
+			{Level: 3, Title: "Collection Methods"},
+			{Level: 2, Title: "Feedback Collection Results"},
+			{Level: 3, Title: "Response Demographics"},
+			{Level: 3, Title: "Feedback Channels"},
+			{Level: 2, Title: "Feedback Analysis"},
+			{Level: 3, Title: "Quantitative Analysis"},
+			{Level: 3, Title: "Qualitative Analysis"},
+			{Level: 3, Title: "Sentiment Analysis"},
+			{Level: 2, Title: "Key Findings"},
+			{Level: 3, Title: "Positive Feedback Themes"},
+			{Level: 3, Title: "Improvement Opportunities"},
+			{Level: 3, Title: "Critical Issues"},
+			{Level: 2, Title: "Feedback Segmentation"},
+			{Level: 3, Title: "Segment-Specific Insights"},
+			{Level: 3, Title: "Persona-Based Analysis"},
+			{Level: 3, Title: "Journey Stage Analysis"},
+			{Level: 2, Title: "Competitive Intelligence"},
+			{Level: 3, Title: "Competitive Mentions"},
+			{Level: 3, Title: "Switching Indicators"},
+			{Level: 2, Title: "Action Planning"},
+			{Level: 3, Title: "Immediate Actions (0-30 days)"},
+			{Level: 3, Title: "Short-term Actions (1-3 months)"},
+			{Level: 3, Title: "Long-term Actions (3-12 months)"},
+			{Level: 3, Title: "Action Tracking"},
+			{Level: 2, Title: "Feedback Response Strategy"},
+			{Level: 3, Title: "Customer Communication"},
+			{Level: 3, Title: "Internal Communication"},
+			{Level: 2, Title: "Feedback System Improvement"},
+			{Level: 3, Title: "Collection Enhancement"},
+			{Level: 3, Title: "Analysis Enhancement"},
+			{Level: 2, Title: "Success Metrics"},
+			{Level: 3, Title: "Collection Metrics"},
+			{Level: 3, Title: "Impact Metrics"},
+			{Level: 3, Title: "Process Metrics"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Feedback framework with collection strategy, target audience, and scope",
+			"Collection methods covering structured, unstructured, behavioral, and contextual feedback",
+			"Collection results with response demographics and channel performance",
+			"Quantitative analysis with satisfaction scores, feature feedback, and experience metrics",
+			"Qualitative analysis with thematic analysis and verbatim insights",
+			"Sentiment analysis with distribution, categories, and trends",
+			"Key findings including positive themes, improvement opportunities, and critical issues",
+			"Feedback segmentation by customer segments, personas, and journey stages",
+			"Competitive intelligence with competitor mentions and switching indicators",
+			"Action planning with immediate, short-term, and long-term initiatives",
+			"Feedback response strategy with customer and internal communication plans",
+			"Success metrics framework with collection, impact, and process measurements",
+			"Validation evidence of feedback driving meaningful improvements",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Feedback framework with collection strategy and methods",
+				"Basic feedback analysis with quantitative and qualitative insights",
+				"Key findings with positive themes and improvement opportunities",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive feedback collection results with demographics and channel analysis",
+				"Detailed feedback analysis including sentiment analysis and segmentation",
+				"Action planning with immediate, short-term, and long-term initiatives",
+				"Feedback response strategy with customer and internal communication",
+				"Success metrics framework with collection, impact, and process measures",
+				"Competitive intelligence with market position insights",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic feedback tracking with real-time sentiment monitoring",
+				"Advanced feedback analytics with predictive insights and trend analysis",
+				"Automated feedback collection and response management",
+				"Feedback-driven product development and experience optimization",
+				"Cross-functional feedback coordination and stakeholder alignment",
+				"Continuous feedback system improvement and innovation",
+			}},
+		},
+	},
+	{
+		Code:         "FIN",
+		Name:         "Financial Model",
+		Purpose:      "The Financial Model document defines comprehensive financial projections and planning models that forecast business performance through detailed P&L, balance sheet, and cash flow analysis. It establishes financial frameworks that enable strategic planning, investment decisions, and performance management.",
+		Domain:       "Financial & Investment",
+		Dependencies: []string{"REV", "BUD", "FOR", "VAL"},
+		Enablements:  []string{"FND", "INV", "MET", "REP"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Financial Overview"},
+			{Level: 3, Title: "Business Economics"},
+			{Level: 3, Title: "Financial Philosophy"},
+			{Level: 3, Title: "Model Architecture"},
+			{Level: 2, Title: "Revenue Projections"},
+			{Level: 3, Title: "Revenue Streams"},
+			{Level: 3, Title: "Revenue Modeling"},
+			{Level: 3, Title: "Revenue Forecasting"},
+			{Level: 2, Title: "Cost Structure"},
+			{Level: 3, Title: "Operating Expenses"},
+			{Level: 3, Title: "Cost Modeling"},
+			{Level: 2, Title: "Financial Statements"},
+			{Level: 3, Title: "Income Statement"},
+			{Level: 3, Title: "Balance Sheet"},
+			{Level: 3, Title: "Cash Flow Statement"},
+			{Level: 2, Title: "Key Metrics and KPIs"},
+			{Level: 3, Title: "Financial Metrics"},
+			{Level: 3, Title: "Business Metrics"},
+			{Level: 2, Title: "Scenario Analysis"},
+			{Level: 3, Title: "Scenario Framework"},
+			{Level: 3, Title: "Sensitivity Analysis"},
+			{Level: 2, Title: "Validation and Controls"},
+			{Level: 3, Title: "Model Validation"},
+			{Level: 3, Title: "Financial Controls"},
+			{Level: 2, Title: "Funding and Investment Analysis"},
+			{Level: 3, Title: "Capital Requirements"},
+			{Level: 3, Title: "Funding Strategy"},
+			{Level: 2, Title: "Reporting and Communication"},
+			{Level: 3, Title: "Financial Reporting"},
+			{Level: 3, Title: "Performance Tracking"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, time horizon, model type, and currency",
+			"Financial overview with business economics, philosophy, and model architecture",
+			"Revenue projections with streams, modeling approach, and forecasting methodology",
+			"Cost structure with operating expenses, cost modeling, and scaling assumptions",
+			"Financial statements with income statement, balance sheet, and cash flow projections",
+			"Key metrics and KPIs with financial and business performance indicators",
+			"Scenario analysis with framework, sensitivity analysis, and stress testing",
+			"Validation and controls with accuracy checks, reasonableness tests, and financial controls",
+			"Funding and investment analysis with capital requirements and funding strategy",
+			"Reporting and communication with financial reporting and performance tracking",
+			"Validation evidence of model accuracy, decision support, and strategic planning value",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic three-statement financial model",
+				"Simple revenue and cost projections",
+				"Basic scenario analysis capability",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive integrated financial model",
+				"Detailed revenue and cost modeling with drivers",
+				"Multiple scenario analysis and sensitivity testing",
+				"Robust validation and control processes",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Sophisticated financial modeling with advanced analytics",
+				"Dynamic scenario modeling and real-time updates",
+				"Comprehensive risk analysis and stress testing",
+				"Strategic integration with business planning and investment decisions",
+			}},
+		},
+	},
+	{
+		Code:         "FND",
+		Name:         "Funding",
+		Purpose:      "The Funding document defines systematic approaches to raising capital and securing financial resources to support business operations, growth initiatives, and strategic investments. It establishes funding frameworks that optimize capital structure, minimize cost of capital, and align funding strategies with business objectives.",
+		Domain:       "Financial & Investment",
+		Dependencies: []string{"FIN", "VAL", "STR", "FOR"},
+		Enablements:  []string{"INV", "MET", "REP", "GOV"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Funding Strategy"},
+			{Level: 3, Title: "Capital Requirements"},
+			{Level: 3, Title: "Funding Philosophy"},
+			{Level: 3, Title: "Use of Funds"},
+			{Level: 2, Title: "Funding Sources"},
+			{Level: 3, Title: "Equity Funding"},
+			{Level: 3, Title: "Debt Funding"},
+			{Level: 3, Title: "Alternative Funding"},
+			{Level: 2, Title: "Valuation and Deal Structure"},
+			{Level: 3, Title: "Valuation Framework"},
+			{Level: 3, Title: "Deal Terms"},
+			{Level: 3, Title: "Investment Structure"},
+			{Level: 2, Title: "Due Diligence Process"},
+			{Level: 3, Title: "Due Diligence Preparation"},
+			{Level: 3, Title: "Investor Due Diligence"},
+			{Level: 3, Title: "Risk Assessment"},
+			{Level: 2, Title: "Fundraising Process"},
+			{Level: 3, Title: "Process Framework"},
+			{Level: 3, Title: "Investor Relations"},
+			{Level: 3, Title: "Negotiation Strategy"},
+			{Level: 2, Title: "Capital Deployment"},
+			{Level: 3, Title: "Fund Utilization"},
+			{Level: 3, Title: "Governance and Oversight"},
+			{Level: 2, Title: "Exit Strategy"},
+			{Level: 3, Title: "Exit Planning"},
+			{Level: 3, Title: "Investor Returns"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, funding amount, type, and timeline",
+			"Funding strategy with capital requirements, philosophy, and use of funds",
+			"Funding sources with equity, debt, and alternative funding options",
+			"Valuation and deal structure with framework, terms, and investment structure",
+			"Due diligence process with preparation, investor review, and risk assessment",
+			"Fundraising process with framework, investor relations, and negotiation strategy",
+			"Capital deployment with utilization plan, governance, and oversight",
+			"Exit strategy with planning framework and investor return expectations",
+			"Validation evidence of funding effectiveness, favorable terms, and business objective alignment",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic funding strategy with capital requirements analysis",
+				"Simple valuation and deal structure framework",
+				"Basic due diligence preparation",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive funding strategy with multiple source analysis",
+				"Detailed valuation methodology and negotiation framework",
+				"Structured due diligence and investor relations process",
+				"Capital deployment plan with milestone tracking",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Sophisticated funding optimization with strategic investor alignment",
+				"Advanced valuation modeling with scenario analysis",
+				"Comprehensive investor relations and governance framework",
+				"Strategic exit planning with value optimization initiatives",
+			}},
+		},
+	},
+	{
+		Code:         "FOR",
+		Name:         "Forecasts",
+		Purpose:      "The Forecasts document defines forward-looking financial predictions and scenarios that anticipate future business performance through analytical modeling and trend analysis. It establishes forecasting frameworks that enable strategic planning, risk assessment, and informed decision making.",
+		Domain:       "Financial & Investment",
+		Dependencies: []string{"FIN", "BUD", "TRN", "MKT"},
+		Enablements:  []string{"STR", "OBJ", "INV", "RSK"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Forecasting Framework"},
+			{Level: 3, Title: "Forecast Philosophy"},
+			{Level: 3, Title: "Forecasting Approach"},
+			{Level: 3, Title: "Forecast Scope"},
+			{Level: 2, Title: "Financial Forecasting"},
+			{Level: 3, Title: "Revenue Forecasting"},
+			{Level: 3, Title: "Cost Forecasting"},
+			{Level: 3, Title: "Profitability Forecasting"},
+			{Level: 2, Title: "Operational Forecasting"},
+			{Level: 3, Title: "Demand Forecasting"},
+			{Level: 3, Title: "Capacity Forecasting"},
+			{Level: 2, Title: "Market and Industry Forecasting"},
+			{Level: 3, Title: "Market Trend Analysis"},
+			{Level: 3, Title: "Competitive Analysis"},
+			{Level: 2, Title: "Forecasting Models and Methods"},
+			{Level: 3, Title: "Statistical Models"},
+			{Level: 3, Title: "Advanced Analytics"},
+			{Level: 2, Title: "Scenario Planning"},
+			{Level: 3, Title: "Scenario Framework"},
+			{Level: 3, Title: "Stress Testing"},
+			{Level: 2, Title: "Forecast Accuracy and Validation"},
+			{Level: 3, Title: "Accuracy Measurement"},
+			{Level: 3, Title: "Model Validation"},
+			{Level: 2, Title: "Forecast Communication and Reporting"},
+			{Level: 3, Title: "Forecast Reports"},
+			{Level: 3, Title: "Stakeholder Communication"},
+			{Level: 2, Title: "Forecast Integration and Planning"},
+			{Level: 3, Title: "Strategic Planning Integration"},
+			{Level: 3, Title: "Operational Planning"},
+			{Level: 2, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Forecast Enhancement"},
+			{Level: 3, Title: "Learning and Adaptation"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, forecast period, type, and confidence level",
+			"Forecasting framework with philosophy, approach, and scope definition",
+			"Financial forecasting with revenue, cost, and profitability predictions",
+			"Operational forecasting with demand and capacity predictions",
+			"Market and industry forecasting with trend analysis and competitive assessment",
+			"Forecasting models and methods with statistical and advanced analytics approaches",
+			"Scenario planning with framework and stress testing capabilities",
+			"Forecast accuracy and validation with measurement and model validation",
+			"Forecast communication and reporting with stakeholder-specific outputs",
+			"Forecast integration and planning with strategic and operational integration",
+			"Continuous improvement with enhancement processes and learning mechanisms",
+			"Validation evidence of forecast accuracy, planning enablement, and decision support value",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic forecasting models with simple trend analysis",
+				"Regular forecast updates and accuracy tracking",
+				"Simple scenario analysis and risk consideration",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive forecasting framework with multiple models",
+				"Advanced statistical methods and validation processes",
+				"Detailed scenario planning and stress testing",
+				"Integrated planning and decision support",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Sophisticated forecasting with machine learning and AI",
+				"Real-time forecasting with continuous model updates",
+				"Advanced scenario simulation and optimization",
+				"Strategic forecasting integration with automated insights",
+			}},
+		},
+	},
+	{
+		Code:         "FUT",
+		Name:         "Future Planning",
+		Purpose:      "The Future Planning document defines systematic approaches to understanding and preparing for future possibilities through scenario development and strategic planning. It establishes future planning frameworks that enable organizations to navigate uncertainty and build capabilities for multiple possible futures.",
+		Domain:       "Growth & Innovation",
+		Dependencies: []string{"STR", "IGN", "LEA", "ADT"},
+		Enablements:  []string{"INN", "RSK", "ADT", "STR"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Future Planning Framework"},
+			{Level: 3, Title: "Future Planning Philosophy"},
+			{Level: 3, Title: "Planning Strategy"},
+			{Level: 3, Title: "Planning Scope"},
+			{Level: 2, Title: "Environmental Analysis and Trend Identification"},
+			{Level: 3, Title: "Environmental Scanning"},
+			{Level: 3, Title: "Uncertainty Assessment"},
+			{Level: 3, Title: "Information Synthesis"},
+			{Level: 2, Title: "Scenario Development and Construction"},
+			{Level: 3, Title: "Scenario Framework"},
+			{Level: 3, Title: "Scenario Construction Methods"},
+			{Level: 3, Title: "Scenario Validation"},
+			{Level: 2, Title: "Strategic Planning Integration"},
+			{Level: 3, Title: "Strategy Development"},
+			{Level: 3, Title: "Strategic Options"},
+			{Level: 3, Title: "Future Readiness"},
+			{Level: 2, Title: "Scenario Monitoring and Updating"},
+			{Level: 3, Title: "Monitoring Systems"},
+			{Level: 3, Title: "Scenario Updates"},
+			{Level: 3, Title: "Adaptive Planning"},
+			{Level: 2, Title: "Communication and Engagement"},
+			{Level: 3, Title: "Stakeholder Communication"},
+			{Level: 3, Title: "Engagement Processes"},
+			{Level: 3, Title: "Cultural Integration"},
+			{Level: 2, Title: "Technology and Analytics"},
+			{Level: 3, Title: "Planning Technologies"},
+			{Level: 3, Title: "Advanced Analytics"},
+			{Level: 3, Title: "Digital Platforms"},
+			{Level: 2, Title: "Performance and Value Assessment"},
+			{Level: 3, Title: "Planning Effectiveness Metrics"},
+			{Level: 3, Title: "Return on Planning Investment"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, planning methodology, scenario framework, and time horizon",
+			"Future planning framework with philosophy, strategy, and scope definition",
+			"Environmental analysis with scanning, uncertainty assessment, and synthesis",
+			"Scenario development with framework, construction methods, and validation",
+			"Strategic integration with strategy development, options, and readiness",
+			"Monitoring and updating with systems, updates, and adaptive planning",
+			"Communication with stakeholder engagement, processes, and cultural integration",
+			"Technology with planning technologies, analytics, and digital platforms",
+			"Performance assessment with metrics, ROI, and continuous improvement",
+			"Validation evidence of scenario preparation, strategic insight, readiness building, and uncertainty integration",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic future planning with simple scenario development",
+				"Simple environmental scanning and trend analysis",
+				"Basic scenario communication and stakeholder engagement",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive future planning with systematic scenario development",
+				"Structured environmental analysis with robust monitoring systems",
+				"Active stakeholder engagement with cultural integration",
+				"Regular planning performance measurement and improvement",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced future planning with sophisticated analytical capabilities",
+				"Comprehensive planning ecosystem with technology integration",
+				"Planning excellence with industry leadership and innovation",
+				"Strategic future planning driving business transformation and competitive advantage",
+			}},
+		},
+	},
+	{
+		Code:         "GAI",
+		Name:         "Gains",
+		Purpose:      "The Gains document identifies and analyzes the positive outcomes, benefits, and value that customers achieve or seek. It captures the upside potential that motivates customer behavior and creates opportunities for value delivery.",
+		Domain:       "Customer & Value",
+		Dependencies: []string{"PAI", "JTB", "PER"},
+		Enablements:  []string{"VPR", "REV", "POS"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Gain Definition"},
+			{Level: 3, Title: "Gain Statement"},
+			{Level: 3, Title: "Gain Categories"},
+			{Level: 2, Title: "Customer Context"},
+			{Level: 3, Title: "Gain Recipients"},
+			{Level: 3, Title: "Gain Context"},
+			{Level: 2, Title: "Gain Analysis"},
+			{Level: 3, Title: "Gain Significance"},
+			{Level: 3, Title: "Gain Frequency"},
+			{Level: 3, Title: "Gain Magnitude"},
+			{Level: 2, Title: "Value Creation Mechanisms"},
+			{Level: 3, Title: "How Gains Are Created"},
+			{Level: 3, Title: "Gain Delivery Process"},
+			{Level: 3, Title: "Value Measurement"},
+			{Level: 2, Title: "Customer Value Perception"},
+			{Level: 3, Title: "Value Recognition"},
+			{Level: 3, Title: "Value Communication"},
+			{Level: 2, Title: "Competitive Advantage"},
+			{Level: 3, Title: "Unique Value Delivery"},
+			{Level: 3, Title: "Competitive Comparison"},
+			{Level: 2, Title: "Gain Optimization"},
+			{Level: 3, Title: "Current Gain Delivery"},
+			{Level: 3, Title: "Enhancement Opportunities"},
+			{Level: 2, Title: "Success Stories and Evidence"},
+			{Level: 3, Title: "Customer Success Examples"},
+			{Level: 3, Title: "Gain Validation Evidence"},
+			{Level: 2, Title: "Measurement Framework"},
+			{Level: 3, Title: "Gain Metrics"},
+			{Level: 3, Title: "Tracking Methods"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Gain statement with core benefit and customer/business value",
+			"Gain categories across functional, emotional, financial, social, and personal dimensions",
+			"Customer context with gain recipients, stakeholder benefits, and customer segments",
+			"Gain analysis including significance, frequency, and magnitude assessment",
+			"Value creation mechanisms with direct/indirect creation and amplification",
+			"Customer value perception covering immediate, long-term, and hidden value",
+			"Competitive advantage with unique value delivery and comparison",
+			"Gain optimization with current delivery assessment and enhancement opportunities",
+			"Success stories and validation evidence from customer research and business metrics",
+			"Measurement framework with gain metrics and tracking methods",
+			"Research validation confirms gain value and customer satisfaction",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Gain statement clearly defined with core benefit and value",
+				"Gain categories (functional, emotional, financial, social) identified",
+				"Basic customer context and gain recipients documented",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive gain analysis with significance, frequency, and magnitude",
+				"Detailed value creation mechanisms and gain delivery process",
+				"Customer value perception with recognition and communication patterns",
+				"Competitive advantage assessment with unique value delivery",
+				"Gain optimization with current delivery assessment and enhancement opportunities",
+				"Success stories and validation evidence",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic gain tracking with real-time value measurement",
+				"Advanced gain analytics and optimization algorithms",
+				"Continuous gain evolution monitoring and enhancement",
+				"Gain-driven product development and customer success programs",
+				"Automated gain delivery optimization and monitoring",
+				"Cross-functional gain coordination and business alignment",
+			}},
+		},
+	},
+	{
+		Code:         "GOV",
+		Name:         "Governance",
+		Purpose:      "The Governance document defines systematic approaches to corporate governance that ensure effective oversight, accountability, and decision-making throughout the organization. It establishes governance frameworks that protect stakeholder interests, promote ethical behavior, and drive sustainable business performance.",
+		Domain:       "Risk & Governance",
+		Dependencies: []string{"STR", "RSK", "COM", "ETH"},
+		Enablements:  []string{"REP", "AUD", "PER", "CTL"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Governance Framework"},
+			{Level: 3, Title: "Governance Philosophy"},
+			{Level: 3, Title: "Governance Principles"},
+			{Level: 3, Title: "Governance Scope"},
+			{Level: 2, Title: "Board Governance"},
+			{Level: 3, Title: "Board Composition and Structure"},
+			{Level: 3, Title: "Board Responsibilities"},
+			{Level: 3, Title: "Board Operations"},
+			{Level: 2, Title: "Board Committees"},
+			{Level: 3, Title: "Audit Committee"},
+			{Level: 3, Title: "Compensation Committee"},
+			{Level: 3, Title: "Nominating and Governance Committee"},
+			{Level: 3, Title: "Risk Committee"},
+			{Level: 2, Title: "Executive Governance"},
+			{Level: 3, Title: "Executive Leadership Structure"},
+			{Level: 3, Title: "Management Decision-Making"},
+			{Level: 3, Title: "Management Reporting"},
+			{Level: 2, Title: "Governance Policies and Procedures"},
+			{Level: 3, Title: "Core Governance Policies"},
+			{Level: 3, Title: "Governance Procedures"},
+			{Level: 3, Title: "Policy Management"},
+			{Level: 2, Title: "Stakeholder Governance"},
+			{Level: 3, Title: "Stakeholder Identification and Engagement"},
+			{Level: 3, Title: "Shareholder Rights and Protections"},
+			{Level: 3, Title: "ESG Governance"},
+			{Level: 2, Title: "Governance Technology and Infrastructure"},
+			{Level: 3, Title: "Governance Technology"},
+			{Level: 3, Title: "Data and Analytics"},
+			{Level: 2, Title: "Governance Performance and Effectiveness"},
+			{Level: 3, Title: "Governance Metrics and KPIs"},
+			{Level: 3, Title: "Governance Assessment"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Governance Risk Management"},
+			{Level: 3, Title: "Governance Risk Assessment"},
+			{Level: 3, Title: "Crisis Governance"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, governance model, board structure, and oversight approach",
+			"Governance framework with philosophy, principles, and scope definition",
+			"Board governance with composition, responsibilities, and operations",
+			"Board committees with audit, compensation, nominating, and risk committees",
+			"Executive governance with leadership structure, decision-making, and reporting",
+			"Governance policies with core policies, procedures, and policy management",
+			"Stakeholder governance with identification, engagement, and ESG governance",
+			"Technology and infrastructure with governance technology and data analytics",
+			"Performance and effectiveness with metrics, assessment, and continuous improvement",
+			"Validation evidence of effective oversight, accountability, and sustainable performance",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic governance structure with board and management oversight",
+				"Simple governance policies and procedures",
+				"Basic stakeholder communication and reporting",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive governance framework with effective board oversight",
+				"Structured governance policies with compliance monitoring",
+				"Active stakeholder engagement and transparent communication",
+				"Regular governance performance assessment and improvement",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced governance practices with leading board effectiveness",
+				"Sophisticated governance technology and analytics integration",
+				"Comprehensive ESG governance with sustainability leadership",
+				"Strategic governance excellence driving stakeholder value creation",
+			}},
+		},
+	},
+	{
+		Code:         "HYP",
+		Name:         "Hypothesis Management",
+		Purpose:      "The Hypothesis Management document captures testable assumptions and beliefs about business, customers, markets, and solutions that guide organizational decision-making and learning. It establishes hypothesis frameworks that enable evidence-based validation, systematic experimentation, and continuous learning through iterative hypothesis development and testing.",
+		Domain:       "Learning & Decisions",
+		Dependencies: []string{"THY", "STR", "CUS", "MKT"},
+		Enablements:  []string{"EXP", "LRN", "DEC", "PRD"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Hypothesis Framework"},
+			{Level: 3, Title: "Hypothesis Philosophy"},
+			{Level: 3, Title: "Hypothesis Foundation"},
+			{Level: 3, Title: "Hypothesis Architecture"},
+			{Level: 2, Title: "Hypothesis Development and Formulation"},
+			{Level: 3, Title: "Hypothesis Identification Process"},
+			{Level: 3, Title: "Hypothesis Formulation Standards"},
+			{Level: 3, Title: "Hypothesis Documentation Structure"},
+			{Level: 2, Title: "Hypothesis Testing and Validation"},
+			{Level: 3, Title: "Experiment Design Framework"},
+			{Level: 3, Title: "Testing Implementation"},
+			{Level: 3, Title: "Results Analysis and Interpretation"},
+			{Level: 2, Title: "Hypothesis Lifecycle Management"},
+			{Level: 3, Title: "Hypothesis Status Tracking"},
+			{Level: 3, Title: "Hypothesis Evolution and Refinement"},
+			{Level: 3, Title: "Portfolio Management"},
+			{Level: 2, Title: "Learning Integration and Application"},
+			{Level: 3, Title: "Learning Synthesis"},
+			{Level: 3, Title: "Organizational Learning Integration"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Hypothesis Culture and Governance"},
+			{Level: 3, Title: "Hypothesis-Driven Culture"},
+			{Level: 3, Title: "Governance and Standards"},
+			{Level: 3, Title: "Advanced Hypothesis Practices"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, category, scope, and confidence level",
+			"Hypothesis framework with philosophy, foundation, and architecture",
+			"Development and formulation with identification process, standards, and documentation",
+			"Testing and validation with experiment design, implementation, and analysis",
+			"Lifecycle management with status tracking, evolution, and portfolio management",
+			"Learning integration with synthesis, organizational integration, and continuous improvement",
+			"Culture and governance with development, standards, and advanced practices",
+			"Validation evidence of learning driving, decision informing, and organizational development",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic hypothesis formulation with simple testing and validation approaches",
+				"Simple tracking and basic learning capture from hypothesis work",
+				"Basic hypothesis governance and quality standards",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive hypothesis framework with systematic development and testing",
+				"Structured lifecycle management with portfolio approach and learning integration",
+				"Active hypothesis governance with quality standards and continuous improvement",
+				"Regular hypothesis effectiveness review and process optimization",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced hypothesis capabilities with sophisticated testing and analysis",
+				"Comprehensive hypothesis ecosystem with integrated culture and governance",
+				"Hypothesis excellence driving organizational learning and decision-making",
+				"Strategic hypothesis management enabling innovation and competitive advantage",
+			}},
+		},
+	},
+	{
+		Code:         "IFL",
+		Name:         "Influencer Marketing",
+		Purpose:      "The Influencer Marketing document defines strategies for partnering with influencers to amplify brand messaging, build credibility, and reach target audiences through authentic endorsements. It establishes influencer frameworks that create genuine partnerships, drive engagement, and generate measurable business value through strategic influencer collaboration.",
+		Domain:       "Brand & Marketing",
+		Dependencies: []string{"BRD", "SOC", "MSG", "TON"},
+		Enablements:  []string{"CAM", "BRA", "LED", "CON"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Influencer Marketing Framework"},
+			{Level: 3, Title: "Influencer Philosophy"},
+			{Level: 3, Title: "Influencer Strategy Foundation"},
+			{Level: 3, Title: "Influencer Ecosystem"},
+			{Level: 2, Title: "Influencer Discovery and Selection"},
+			{Level: 3, Title: "Influencer Identification Strategy"},
+			{Level: 3, Title: "Due Diligence Process"},
+			{Level: 3, Title: "Influencer Relationship Building"},
+			{Level: 2, Title: "Content Collaboration and Creation"},
+			{Level: 3, Title: "Content Strategy Framework"},
+			{Level: 3, Title: "Brand Integration Standards"},
+			{Level: 3, Title: "Content Performance Optimization"},
+			{Level: 2, Title: "Partnership Models and Compensation"},
+			{Level: 3, Title: "Partnership Structure Framework"},
+			{Level: 3, Title: "Performance-Based Partnerships"},
+			{Level: 3, Title: "Legal and Compliance Framework"},
+			{Level: 2, Title: "Performance Measurement and ROI"},
+			{Level: 3, Title: "Influencer Marketing Metrics"},
+			{Level: 3, Title: "ROI Analysis Framework"},
+			{Level: 3, Title: "Attribution and Analytics"},
+			{Level: 2, Title: "Influencer Program Management"},
+			{Level: 3, Title: "Program Structure and Governance"},
+			{Level: 3, Title: "Technology and Tools"},
+			{Level: 3, Title: "Scaling and Evolution"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, strategy, platform focus, and content collaboration",
+			"Marketing framework with philosophy, foundation, and ecosystem design",
+			"Discovery and selection with identification strategy, due diligence, and relationship building",
+			"Content collaboration with strategy framework, integration standards, and optimization",
+			"Partnership models with structure framework, performance-based partnerships, and compliance",
+			"Performance measurement with metrics, ROI analysis, and attribution framework",
+			"Program management with structure, technology tools, and scaling strategies",
+			"Validation evidence of awareness building, engagement driving, and outcome generation",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic influencer marketing strategy with selection criteria and partnership models",
+				"Simple content collaboration framework and performance tracking",
+				"Basic legal compliance and relationship management processes",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive influencer marketing strategy with detailed discovery and selection",
+				"Structured content collaboration with performance optimization",
+				"Active program management with analytics and relationship building",
+				"Regular strategy review and program evolution",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced influencer marketing capabilities with sophisticated targeting and optimization",
+				"Comprehensive influencer ecosystem with integrated measurement and management",
+				"Influencer marketing excellence with industry recognition and competitive advantage",
+				"Strategic influencer partnerships driving brand growth and market leadership",
+			}},
+		},
+	},
+	{
+		Code:         "IGN",
+		Name:         "Insight Generation",
+		Purpose:      "The Insight Generation document defines the synthesis layer that integrates evidence from `EXP`, market signals, and operational learning. It converts raw data into strategic decisions for `STR`, `INN`, and `FUT` rather than running experiments or primary research itself.",
+		Domain:       "Growth & Innovation",
+		Dependencies: []string{"DAT", "ANA", "LEA", "EXP"},
+		Enablements:  []string{"STR", "INN", "FUT", "RSK"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Insight Generation Framework"},
+			{Level: 3, Title: "Insight Philosophy"},
+			{Level: 3, Title: "Insight Strategy"},
+			{Level: 3, Title: "Insight Scope"},
+			{Level: 2, Title: "Data Collection and Sources"},
+			{Level: 3, Title: "Data Architecture"},
+			{Level: 3, Title: "Information Collection"},
+			{Level: 3, Title: "Data Integration"},
+			{Level: 2, Title: "Analysis and Processing"},
+			{Level: 3, Title: "Analytical Framework"},
+			{Level: 3, Title: "Advanced Analytics"},
+			{Level: 3, Title: "Analytical Processes"},
+			{Level: 2, Title: "Insight Synthesis and Development"},
+			{Level: 3, Title: "Synthesis Methodology"},
+			{Level: 3, Title: "Insight Development"},
+			{Level: 3, Title: "Insight Quality"},
+			{Level: 2, Title: "Insight Management and Repository"},
+			{Level: 3, Title: "Insight Repository"},
+			{Level: 3, Title: "Knowledge Management"},
+			{Level: 3, Title: "Insight Discovery"},
+			{Level: 2, Title: "Insight Application and Decision Support"},
+			{Level: 3, Title: "Application Framework"},
+			{Level: 3, Title: "Decision Support Systems"},
+			{Level: 3, Title: "Communication and Storytelling"},
+			{Level: 2, Title: "Performance Measurement and Improvement"},
+			{Level: 3, Title: "Insight Performance Metrics"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Innovation and Enhancement"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, methodology, scope, and analysis approach",
+			"Insight framework with philosophy, strategy, and scope definition",
+			"Data collection with architecture, information sources, and integration",
+			"Analysis and processing with frameworks, advanced analytics, and processes",
+			"Synthesis and development with methodology, development, and quality standards",
+			"Management and repository with systems, knowledge management, and discovery",
+			"Application and decision support with frameworks, systems, and communication",
+			"Validation evidence of information transformation, decision-driving, and business value creation",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic insight generation with simple analytical capabilities",
+				"Simple data collection and basic analysis processes",
+				"Basic insight documentation and sharing",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive insight generation with advanced analytical capabilities",
+				"Structured insight management with quality assurance and validation",
+				"Active insight application with decision support systems",
+				"Regular insight performance measurement and improvement",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced insight capabilities with AI-enhanced analytics and automation",
+				"Sophisticated insight ecosystem with comprehensive quality management",
+				"Insight excellence with industry leadership and innovation",
+				"Strategic insight generation driving business transformation and competitive advantage",
+			}},
+		},
+	},
+	{
+		Code:         "INC",
+		Name:         "Incidents",
+		Purpose:      "The Incidents document defines systematic approaches to identifying, responding to, and managing incidents that disrupt business operations, threaten stakeholder safety, or impact organizational objectives. It establishes incident management frameworks that enable rapid response, effective recovery, and organizational learning from disruptive events.",
+		Domain:       "Risk & Governance",
+		Dependencies: []string{"RSK", "OPS", "CTL", "COM"},
+		Enablements:  []string{"LRN", "REP", "BCR", "CRI"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Incident Management Framework"},
+			{Level: 3, Title: "Incident Management Philosophy"},
+			{Level: 3, Title: "Incident Management Approach"},
+			{Level: 3, Title: "Incident Categories"},
+			{Level: 2, Title: "Incident Classification and Severity"},
+			{Level: 3, Title: "Incident Classification Framework"},
+			{Level: 3, Title: "Severity Assessment"},
+			{Level: 3, Title: "Impact Assessment"},
+			{Level: 2, Title: "Incident Response Procedures"},
+			{Level: 3, Title: "Incident Response Framework"},
+			{Level: 3, Title: "Response Team Structure"},
+			{Level: 3, Title: "Emergency Procedures"},
+			{Level: 2, Title: "Crisis Management"},
+			{Level: 3, Title: "Crisis Management Framework"},
+			{Level: 3, Title: "Business Continuity Integration"},
+			{Level: 3, Title: "Stakeholder Management"},
+			{Level: 2, Title: "Investigation and Analysis"},
+			{Level: 3, Title: "Investigation Framework"},
+			{Level: 3, Title: "Analysis Techniques"},
+			{Level: 3, Title: "Documentation and Reporting"},
+			{Level: 2, Title: "Recovery and Restoration"},
+			{Level: 3, Title: "Recovery Planning"},
+			{Level: 3, Title: "Corrective Actions"},
+			{Level: 3, Title: "Monitoring and Validation"},
+			{Level: 2, Title: "Learning and Improvement"},
+			{Level: 3, Title: "Lessons Learned Framework"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Organizational Learning"},
+			{Level: 2, Title: "Technology and Automation"},
+			{Level: 3, Title: "Incident Management Technology"},
+			{Level: 3, Title: "Analytics and Intelligence"},
+			{Level: 2, Title: "Performance Measurement"},
+			{Level: 3, Title: "Incident Management Metrics"},
+			{Level: 3, Title: "Continuous Monitoring"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, incident scope, response model, and automation level",
+			"Incident management framework with philosophy, approach, and categories",
+			"Incident classification with framework, severity assessment, and impact assessment",
+			"Response procedures with framework, team structure, and emergency procedures",
+			"Crisis management with framework, business continuity, and stakeholder management",
+			"Investigation and analysis with framework, techniques, and documentation",
+			"Recovery and restoration with planning, corrective actions, and monitoring",
+			"Learning and improvement with lessons learned, continuous improvement, and organizational learning",
+			"Technology and automation with incident technology and analytics",
+			"Validation evidence of impact minimization, recovery enablement, and organizational learning",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic incident response procedures with team assignments",
+				"Simple incident classification and escalation processes",
+				"Basic investigation and documentation procedures",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive incident management framework with crisis management",
+				"Structured investigation processes with lessons learned integration",
+				"Effective recovery procedures with stakeholder communication",
+				"Regular incident response training and preparedness testing",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced incident management with predictive capabilities",
+				"Sophisticated automation and intelligent response systems",
+				"Comprehensive organizational learning and resilience building",
+				"Strategic incident management excellence with industry leadership",
+			}},
+		},
+	},
+	{
+		Code:         "INF",
+		Name:         "Infrastructure",
+		Purpose:      "The Infrastructure document defines systematic approaches to designing, deploying, and managing technology infrastructure that supports business operations through reliable, secure, and scalable platforms. It establishes infrastructure frameworks that ensure availability, performance, and cost optimization.",
+		Domain:       "Technology & Data",
+		Dependencies: []string{"ARC", "SYS", "SEC", "REQ"},
+		Enablements:  []string{"PER", "QUA", "SLA", "MON"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Infrastructure Overview"},
+			{Level: 3, Title: "Business Context"},
+			{Level: 3, Title: "Technical Overview"},
+			{Level: 3, Title: "Service Scope"},
+			{Level: 2, Title: "Architecture and Design"},
+			{Level: 3, Title: "Network Architecture"},
+			{Level: 3, Title: "Compute Architecture"},
+			{Level: 3, Title: "Storage Architecture"},
+			{Level: 2, Title: "Deployment and Configuration"},
+			{Level: 3, Title: "Infrastructure as Code"},
+			{Level: 3, Title: "Configuration Management"},
+			{Level: 3, Title: "Deployment Automation"},
+			{Level: 2, Title: "Security and Compliance"},
+			{Level: 3, Title: "Security Architecture"},
+			{Level: 3, Title: "Compliance Framework"},
+			{Level: 3, Title: "Security Monitoring"},
+			{Level: 2, Title: "Operations and Management"},
+			{Level: 3, Title: "Monitoring and Observability"},
+			{Level: 3, Title: "Capacity Management"},
+			{Level: 3, Title: "Backup and Recovery"},
+			{Level: 2, Title: "Performance and Scalability"},
+			{Level: 3, Title: "Performance Optimization"},
+			{Level: 3, Title: "Scalability Design"},
+			{Level: 3, Title: "Performance Monitoring"},
+			{Level: 2, Title: "Cost Management"},
+			{Level: 3, Title: "Cost Optimization"},
+			{Level: 3, Title: "Budget Control"},
+			{Level: 2, Title: "Business Continuity"},
+			{Level: 3, Title: "High Availability Design"},
+			{Level: 3, Title: "Disaster Recovery"},
+			{Level: 3, Title: "Incident Management"},
+			{Level: 2, Title: "Governance and Evolution"},
+			{Level: 3, Title: "Infrastructure Governance"},
+			{Level: 3, Title: "Technology Evolution"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, platform, environment, and criticality level",
+			"Infrastructure overview with business context, technical overview, and service scope",
+			"Architecture and design with network, compute, and storage architecture",
+			"Deployment and configuration with IaC framework, configuration management, and automation",
+			"Security and compliance with architecture, framework, and monitoring",
+			"Operations and management with monitoring, capacity management, and backup procedures",
+			"Performance and scalability with optimization, design, and monitoring",
+			"Cost management with optimization strategies and budget control",
+			"Business continuity with high availability, disaster recovery, and incident management",
+			"Governance and evolution with infrastructure governance, technology evolution, and improvement",
+			"Validation evidence of infrastructure reliability, requirement fulfillment, and operational efficiency",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic infrastructure documentation with core components",
+				"Simple monitoring and basic security controls",
+				"Basic backup and recovery procedures",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive infrastructure architecture and design",
+				"Detailed security framework with compliance controls",
+				"Structured monitoring, alerting, and capacity management",
+				"Infrastructure as Code with automated deployment",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced infrastructure optimization and automation",
+				"Sophisticated monitoring, observability, and analytics",
+				"Comprehensive business continuity and disaster recovery",
+				"Strategic infrastructure evolution and innovation adoption",
+			}},
+		},
+	},
+	{
+		Code:         "INN",
+		Name:         "Innovation Strategy",
+		Purpose:      "The Innovation Strategy document defines portfolio-level choices: which innovation bets to pursue and why. It translates market and strategic intent into a coherent portfolio, while `RND` and `EXP` execute that portfolio through technical development and evidence generation.",
+		Domain:       "Growth & Innovation",
+		Dependencies: []string{"STR", "RND", "EXP", "LEA"},
+		Enablements:  []string{"PRD", "SVC", "FUT", "ADT"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Innovation Framework"},
+			{Level: 3, Title: "Innovation Philosophy"},
+			{Level: 3, Title: "Innovation Strategy"},
+			{Level: 3, Title: "Innovation Scope"},
+			{Level: 2, Title: "Innovation Portfolio Management"},
+			{Level: 3, Title: "Portfolio Framework"},
+			{Level: 3, Title: "Innovation Pipeline"},
+			{Level: 3, Title: "Portfolio Optimization"},
+			{Level: 2, Title: "Innovation Process and Methodology"},
+			{Level: 3, Title: "Innovation Process"},
+			{Level: 3, Title: "Innovation Methodologies"},
+			{Level: 3, Title: "Decision Making"},
+			{Level: 2, Title: "Innovation Culture and Environment"},
+			{Level: 3, Title: "Culture Development"},
+			{Level: 3, Title: "Innovation Environment"},
+			{Level: 3, Title: "External Innovation Networks"},
+			{Level: 2, Title: "Innovation Technology and Infrastructure"},
+			{Level: 3, Title: "Innovation Technology"},
+			{Level: 3, Title: "Innovation Infrastructure"},
+			{Level: 2, Title: "Innovation Performance Measurement"},
+			{Level: 3, Title: "Innovation Metrics"},
+			{Level: 3, Title: "Business Impact"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, innovation framework, horizon, and resource allocation",
+			"Innovation framework with philosophy, strategy, and scope definition",
+			"Portfolio management with framework, pipeline, and optimization approaches",
+			"Innovation process with methodology, decision-making, and governance",
+			"Culture and environment with development, external networks, and collaboration",
+			"Technology and infrastructure with innovation platforms and facilities",
+			"Performance measurement with metrics, business impact, and continuous improvement",
+			"Validation evidence of competitive advantage creation, portfolio balance, and rapid cycles",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic innovation strategy with portfolio framework",
+				"Simple innovation process and project management",
+				"Basic innovation culture initiatives and recognition",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive innovation strategy with balanced portfolio management",
+				"Structured innovation process with validation and scaling methodologies",
+				"Active innovation culture with external partnerships and networks",
+				"Regular innovation performance measurement and improvement",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced innovation capabilities with systematic competitive advantage creation",
+				"Sophisticated innovation ecosystem with extensive external collaboration",
+				"Innovation excellence with industry leadership and thought leadership",
+				"Strategic innovation driving business transformation and market leadership",
+			}},
+		},
+	},
+	{
+		Code:         "INS",
+		Name:         "Insurance",
+		Purpose:      "The Insurance document defines systematic approaches to managing insurance programs that transfer financial risks and protect organizational assets, operations, and stakeholders. It establishes insurance frameworks that optimize risk transfer, minimize total cost of risk, and ensure adequate protection against potential losses.",
+		Domain:       "Risk & Governance",
+		Dependencies: []string{"RSK", "FIN", "LEG", "OPS"},
+		Enablements:  []string{"CTL", "COM", "REP", "AUD"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Insurance Framework"},
+			{Level: 3, Title: "Insurance Philosophy"},
+			{Level: 3, Title: "Insurance Strategy"},
+			{Level: 3, Title: "Insurance Scope"},
+			{Level: 2, Title: "Risk Assessment and Coverage Analysis"},
+			{Level: 3, Title: "Risk Identification and Assessment"},
+			{Level: 3, Title: "Coverage Gap Analysis"},
+			{Level: 3, Title: "Insurance Needs Assessment"},
+			{Level: 2, Title: "Insurance Program Design"},
+			{Level: 3, Title: "Program Architecture"},
+			{Level: 3, Title: "Coverage Types and Specifications"},
+			{Level: 3, Title: "Policy Terms and Conditions"},
+			{Level: 2, Title: "Insurance Procurement and Vendor Management"},
+			{Level: 3, Title: "Procurement Strategy"},
+			{Level: 3, Title: "Carrier Relationship Management"},
+			{Level: 3, Title: "Contract Management"},
+			{Level: 2, Title: "Claims Management"},
+			{Level: 3, Title: "Claims Handling Process"},
+			{Level: 3, Title: "Claims Prevention and Mitigation"},
+			{Level: 3, Title: "Recovery and Subrogation"},
+			{Level: 2, Title: "Alternative Risk Transfer"},
+			{Level: 3, Title: "Self-Insurance Programs"},
+			{Level: 3, Title: "Captive Insurance"},
+			{Level: 3, Title: "Risk Pooling and Mutual Programs"},
+			{Level: 2, Title: "Regulatory Compliance and Requirements"},
+			{Level: 3, Title: "Insurance Regulation Compliance"},
+			{Level: 3, Title: "Contractual Requirements"},
+			{Level: 3, Title: "Reporting and Disclosure"},
+			{Level: 2, Title: "Technology and Analytics"},
+			{Level: 3, Title: "Insurance Technology"},
+			{Level: 3, Title: "Data Management and Analytics"},
+			{Level: 3, Title: "Digital Innovation"},
+			{Level: 2, Title: "Performance Measurement and Optimization"},
+			{Level: 3, Title: "Insurance Performance Metrics"},
+			{Level: 3, Title: "Benchmarking and Analysis"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Specialized Insurance Programs"},
+			{Level: 3, Title: "Executive and Professional Lines"},
+			{Level: 3, Title: "Cyber and Technology Insurance"},
+			{Level: 3, Title: "International Insurance"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, insurance strategy, coverage approach, and risk retention",
+			"Insurance framework with philosophy, strategy, and scope definition",
+			"Risk assessment with identification, gap analysis, and needs assessment",
+			"Program design with architecture, coverage types, and policy management",
+			"Procurement and vendor management with strategy, relationships, and contracts",
+			"Claims management with handling process, prevention, and recovery",
+			"Alternative risk transfer with self-insurance, captives, and pooling programs",
+			"Regulatory compliance with requirements, reporting, and disclosure",
+			"Technology and analytics with systems, data management, and innovation",
+			"Validation evidence of effective risk transfer, cost optimization, and adequate protection",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic insurance program with essential coverage types",
+				"Simple claims management and vendor relationships",
+				"Basic compliance with regulatory and contractual requirements",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive insurance program with systematic risk transfer",
+				"Structured claims management with recovery optimization",
+				"Effective vendor management and performance monitoring",
+				"Regular insurance program review and optimization",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced insurance program with alternative risk transfer strategies",
+				"Sophisticated analytics and technology integration",
+				"Comprehensive risk management integration and value creation",
+				"Strategic insurance excellence with industry leadership",
+			}},
+		},
+	},
+	{
+		Code:         "INT",
+		Name:         "Integration Specification",
+		Purpose:      "The Integration Specification defines detailed technical and business requirements for connecting systems, applications, and services. It ensures reliable, secure, and performant data exchange and functionality sharing between integrated components while maintaining system integrity and compliance.",
+		Domain:       "Product & Service",
+		Dependencies: []string{"FEA", "REQ", "QUA"},
+		Enablements:  []string{"SUP", "PER", "UXD"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Integration Overview"},
+			{Level: 2, Title: "Business Context"},
+			{Level: 3, Title: "Business Problem"},
+			{Level: 3, Title: "Stakeholders"},
+			{Level: 3, Title: "Business Requirements"},
+			{Level: 2, Title: "System Integration Architecture"},
+			{Level: 3, Title: "System Overview"},
+			{Level: 3, Title: "Integration Pattern"},
+			{Level: 3, Title: "Architecture Components"},
+			{Level: 2, Title: "Technical Specification"},
+			{Level: 3, Title: "API Specification"},
+			{Level: 3, Title: "Data Exchange Format"},
+			{Level: 3, Title: "Interface Definitions"},
+			{Level: 3, Title: "Security Specification"},
+			{Level: 2, Title: "Data Specification"},
+			{Level: 3, Title: "Data Model"},
+			{Level: 3, Title: "Data Flow"},
+			{Level: 3, Title: "Data Quality"},
+			{Level: 2, Title: "Integration Implementation"},
+			{Level: 3, Title: "Development Approach"},
+			{Level: 3, Title: "Configuration Management"},
+			{Level: 3, Title: "Deployment Strategy"},
+			{Level: 2, Title: "Performance and Scalability"},
+			{Level: 3, Title: "Performance Requirements"},
+			{Level: 3, Title: "Scalability Design"},
+			{Level: 3, Title: "Performance Monitoring"},
+			{Level: 2, Title: "Error Handling and Recovery"},
+			{Level: 3, Title: "Error Management"},
+			{Level: 3, Title: "Resilience Patterns"},
+			{Level: 3, Title: "Data Consistency"},
+			{Level: 2, Title: "Security and Compliance"},
+			{Level: 3, Title: "Security Architecture"},
+			{Level: 3, Title: "Compliance Requirements"},
+			{Level: 3, Title: "Security Monitoring"},
+			{Level: 2, Title: "Testing and Validation"},
+			{Level: 3, Title: "Testing Strategy"},
+			{Level: 3, Title: "Test Scenarios"},
+			{Level: 3, Title: "Validation Criteria"},
+			{Level: 2, Title: "Operations and Maintenance"},
+			{Level: 3, Title: "Operational Procedures"},
+			{Level: 3, Title: "Support and Maintenance"},
+			{Level: 3, Title: "Change Management"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Integration overview with clear purpose, scope, type, and business value",
+			"Business context covering problem definition, stakeholders, and requirements",
+			"System integration architecture with comprehensive system overview and integration patterns",
+			"Technical specification with API, data exchange format, interface definitions, and security",
+			"Data specification with model, flow, and quality requirements",
+			"Integration implementation with development approach, configuration, and deployment strategy",
+			"Performance and scalability with requirements, design, and monitoring",
+			"Error handling and recovery with comprehensive error management and resilience patterns",
+			"Security and compliance with architecture, requirements, and monitoring",
+			"Testing and validation with strategy, scenarios, and criteria",
+			"Operations and maintenance with procedures, support model, and change management",
+			"Validation evidence of integration completeness, security, and operational readiness",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Integration overview with purpose, scope, and business value",
+				"Business context with problem definition and stakeholder identification",
+				"Basic technical specification with API and data exchange format",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"System integration architecture with comprehensive system overview and integration patterns",
+				"Detailed technical specification with complete API, data, and security specifications",
+				"Integration implementation approach with development and deployment strategy",
+				"Performance and scalability design with requirements and monitoring",
+				"Error handling and recovery with resilience patterns",
+				"Security and compliance with comprehensive protection measures",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced testing and validation with comprehensive test coverage",
+				"Complete operations and maintenance procedures with support model",
+				"Sophisticated error handling with predictive failure detection",
+				"Real-time integration monitoring with automated optimization",
+				"Advanced security with threat detection and automated response",
+				"Integration ecosystem management with cross-system optimization",
+			}},
+		},
+	},
+	{
+		Code:         "INV",
+		Name:         "Investment",
+		Purpose:      "The Investment document defines systematic approaches to capital allocation and investment decisions that optimize return on investment while managing risk and supporting strategic business objectives. It establishes investment frameworks that ensure disciplined capital deployment, rigorous evaluation, and performance accountability.",
+		Domain:       "Financial & Investment",
+		Dependencies: []string{"FIN", "STR", "VAL", "FOR"},
+		Enablements:  []string{"MET", "REP", "RSK", "PER"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Investment Strategy"},
+			{Level: 3, Title: "Investment Philosophy"},
+			{Level: 3, Title: "Investment Framework"},
+			{Level: 3, Title: "Investment Categories"},
+			{Level: 2, Title: "Investment Analysis"},
+			{Level: 3, Title: "Financial Analysis"},
+			{Level: 3, Title: "Strategic Analysis"},
+			{Level: 3, Title: "Risk Analysis"},
+			{Level: 2, Title: "Investment Portfolio"},
+			{Level: 3, Title: "Portfolio Management"},
+			{Level: 3, Title: "Investment Prioritization"},
+			{Level: 3, Title: "Capital Allocation"},
+			{Level: 2, Title: "Investment Execution"},
+			{Level: 3, Title: "Implementation Framework"},
+			{Level: 3, Title: "Performance Monitoring"},
+			{Level: 3, Title: "Success Measurement"},
+			{Level: 2, Title: "Due Diligence Process"},
+			{Level: 3, Title: "Investment Due Diligence"},
+			{Level: 3, Title: "Risk Due Diligence"},
+			{Level: 2, Title: "Alternative Analysis"},
+			{Level: 3, Title: "Option Evaluation"},
+			{Level: 3, Title: "Make vs. Buy Analysis"},
+			{Level: 2, Title: "Post-Investment Review"},
+			{Level: 3, Title: "Performance Review"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, investment amount, type, and expected returns",
+			"Investment strategy with philosophy, framework, and category definition",
+			"Investment analysis with financial, strategic, and risk evaluation",
+			"Investment portfolio with management framework, prioritization, and capital allocation",
+			"Investment execution with implementation framework, monitoring, and success measurement",
+			"Due diligence process with investment and risk due diligence",
+			"Alternative analysis with option evaluation and make vs. buy analysis",
+			"Post-investment review with performance assessment and continuous improvement",
+			"Validation evidence of investment effectiveness, strategic value creation, and business objective support",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic investment analysis with financial metrics",
+				"Simple risk assessment and approval process",
+				"Basic performance monitoring and reporting",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive investment framework with portfolio management",
+				"Detailed financial and strategic analysis with scenario modeling",
+				"Structured due diligence and alternative evaluation",
+				"Regular performance review and continuous improvement",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Sophisticated investment optimization with portfolio analytics",
+				"Advanced risk modeling and mitigation strategies",
+				"Comprehensive post-investment review and knowledge management",
+				"Strategic investment integration with business planning and execution",
+			}},
+		},
+	},
+	{
+		Code:         "JTB",
+		Name:         "Jobs-to-be-Done (JTBD)",
+		Purpose:      "The Jobs-to-be-Done document defines the specific outcomes customers hire products or services to achieve. It captures the functional, emotional, and social jobs that drive customer behavior and innovation opportunities.",
+		Domain:       "Customer & Value",
+		Dependencies: []string{"PER"},
+		Enablements:  []string{"USE", "PRD", "SVC"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Job Definition"},
+			{Level: 3, Title: "Job Statement"},
+			{Level: 3, Title: "Job Scope"},
+			{Level: 2, Title: "Job Context"},
+			{Level: 3, Title: "Job Circumstances"},
+			{Level: 3, Title: "Job Constraints"},
+			{Level: 2, Title: "Job Process"},
+			{Level: 3, Title: "Job Steps"},
+			{Level: 3, Title: "Job Workflow"},
+			{Level: 2, Title: "Desired Outcomes"},
+			{Level: 3, Title: "Primary Outcomes"},
+			{Level: 3, Title: "Success Criteria"},
+			{Level: 2, Title: "Current Solutions"},
+			{Level: 3, Title: "Existing Approaches"},
+			{Level: 3, Title: "Solution Evaluation"},
+			{Level: 2, Title: "Pain Points and Frustrations"},
+			{Level: 3, Title: "Job Performance Challenges"},
+			{Level: 3, Title: "Emotional Frustrations"},
+			{Level: 2, Title: "Opportunity Analysis"},
+			{Level: 3, Title: "Underserved Outcomes"},
+			{Level: 3, Title: "Market Opportunities"},
+			{Level: 2, Title: "Our Solution Fit"},
+			{Level: 3, Title: "Job Addressing Approach"},
+			{Level: 3, Title: "Solution Performance"},
+			{Level: 2, Title: "Measurement Framework"},
+			{Level: 3, Title: "Job Success Metrics"},
+			{Level: 3, Title: "Research Methods"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Job statement follows \"When I... I want to... so I can...\" format",
+			"Functional, emotional, and social job dimensions documented",
+			"Job context and trigger events clearly identified",
+			"Job process steps and workflow mapped",
+			"Desired outcomes and success criteria defined",
+			"Current solutions evaluated for job performance",
+			"Pain points and frustrations documented",
+			"Opportunity analysis identifies innovation areas",
+			"Our solution fit and competitive advantages assessed",
+			"Measurement framework established with metrics",
+			"Research validation confirms job accuracy",
+			"Regular review process ensures job evolution tracking",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Job statement clearly defined with situation-motivation-outcome",
+				"Job categories (functional, emotional, social) identified",
+				"Basic job process and desired outcomes documented",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive job context and constraints analysis",
+				"Detailed job process with steps and workflow",
+				"Current solution evaluation and gap analysis",
+				"Pain points and opportunity identification",
+				"Our solution fit assessment",
+				"Research validation with customer interviews",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic job tracking with outcome measurement",
+				"Advanced job analytics and pattern recognition",
+				"Continuous job evolution monitoring",
+				"Solution performance optimization based on job insights",
+				"Cross-functional job utilization in development",
+				"Competitive job analysis and positioning",
+			}},
+		},
+	},
+	{
+		Code:         "KAC",
+		Name:         "Key Activities",
+		Purpose:      "The Key Activities defines systematic approaches to critical business activities that create customer value, drive competitive advantage, and enable business model execution. It establishes activity frameworks that optimize operational excellence, resource utilization, and performance management.",
+		Domain:       "Business Model",
+		Dependencies: []string{"STR", "KRS", "VAL", "CAP"},
+		Enablements:  []string{"PER", "QUA", "PRO", "SVC"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Activity Overview"},
+			{Level: 2, Title: "Activity Strategy"},
+			{Level: 3, Title: "Strategic Context"},
+			{Level: 3, Title: "Activity Philosophy"},
+			{Level: 2, Title: "Activity Portfolio"},
+			{Level: 3, Title: "Production Activities"},
+			{Level: 3, Title: "Platform Activities"},
+			{Level: 3, Title: "Problem-Solving Activities"},
+			{Level: 3, Title: "Network Activities"},
+			{Level: 2, Title: "Activity Analysis"},
+			{Level: 3, Title: "Value Stream Analysis"},
+			{Level: 3, Title: "Process Analysis"},
+			{Level: 3, Title: "Activity Dependencies"},
+			{Level: 2, Title: "Performance Management"},
+			{Level: 3, Title: "Activity Metrics"},
+			{Level: 3, Title: "Performance Optimization"},
+			{Level: 2, Title: "Capability Requirements"},
+			{Level: 3, Title: "Core Capabilities"},
+			{Level: 3, Title: "Capability Development"},
+			{Level: 3, Title: "Capability Gaps"},
+			{Level: 2, Title: "Resource Requirements"},
+			{Level: 3, Title: "Human Resources"},
+			{Level: 3, Title: "Technology Resources"},
+			{Level: 3, Title: "Financial Resources"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 3, Title: "Activity Risks"},
+			{Level: 3, Title: "Risk Mitigation"},
+			{Level: 3, Title: "Business Continuity"},
+			{Level: 2, Title: "Innovation and Improvement"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Innovation Initiatives"},
+			{Level: 3, Title: "Future Evolution"},
+			{Level: 2, Title: "Outsourcing and Partnerships"},
+			{Level: 3, Title: "Make vs. Buy Analysis"},
+			{Level: 3, Title: "Outsourcing Strategy"},
+			{Level: 3, Title: "Partnership Strategy"},
+			{Level: 2, Title: "Activity Governance"},
+			{Level: 3, Title: "Governance Framework"},
+			{Level: 3, Title: "Standards and Procedures"},
+			{Level: 3, Title: "Measurement and Reporting"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Activity overview with clear purpose, strategic role, value creation, and competitive advantage",
+			"Activity strategy with context, philosophy, and strategic focus areas",
+			"Activity portfolio with comprehensive production, platform, problem-solving, and network activities",
+			"Activity analysis with value stream analysis, process mapping, and dependency identification",
+			"Performance management with metrics, optimization strategies, and improvement approaches",
+			"Capability requirements with development strategies, training programs, and gap analysis",
+			"Resource requirements across human, technology, and financial dimensions",
+			"Risk management with comprehensive activity risk identification, mitigation, and business continuity",
+			"Innovation and improvement with continuous improvement culture and future evolution planning",
+			"Outsourcing and partnerships with make vs. buy analysis and strategic sourcing approaches",
+			"Activity governance with framework, standards, procedures, and measurement systems",
+			"Validation evidence of activity excellence, value creation, and competitive advantage achievement",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Activity overview with purpose, strategic role, and value creation",
+				"Activity strategy with context, philosophy, and focus areas",
+				"Basic activity portfolio with production, platform, and problem-solving categories",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive activity analysis with value stream analysis and process mapping",
+				"Performance management with metrics, optimization, and improvement approaches",
+				"Capability requirements with development strategies and gap analysis",
+				"Resource requirements across human, technology, and financial dimensions",
+				"Risk management with comprehensive risk identification and mitigation",
+				"Innovation and improvement with continuous improvement and future evolution",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced outsourcing and partnerships with sophisticated make vs. buy analysis",
+				"Comprehensive activity governance with framework, standards, and measurement",
+				"AI-driven activity optimization with predictive analytics and automated improvement",
+				"Dynamic resource allocation with real-time performance monitoring",
+				"Integrated innovation ecosystem with systematic process and technology innovation",
+				"Advanced competitive intelligence driving activity strategy and optimization",
+			}},
+		},
+	},
+	{
+		Code:         "KNO",
+		Name:         "Knowledge Management",
+		Purpose:      "The Knowledge Management document defines systematic approaches to capturing, organizing, sharing, and leveraging organizational knowledge assets. It establishes knowledge frameworks that preserve institutional knowledge, accelerate learning, enable innovation, and create competitive advantage through effective knowledge creation, retention, and application.",
+		Domain:       "Learning & Decisions",
+		Dependencies: []string{"LRN", "DEC", "THY", "ORG"},
+		Enablements:  []string{"INN", "CAP", "PRO", "STR"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Knowledge Management Framework"},
+			{Level: 3, Title: "Knowledge Philosophy"},
+			{Level: 3, Title: "Knowledge Foundation"},
+			{Level: 3, Title: "Knowledge Management Architecture"},
+			{Level: 2, Title: "Knowledge Capture and Creation"},
+			{Level: 3, Title: "Knowledge Identification Process"},
+			{Level: 3, Title: "Knowledge Creation Framework"},
+			{Level: 3, Title: "Knowledge Documentation Standards"},
+			{Level: 2, Title: "Knowledge Organization and Storage"},
+			{Level: 3, Title: "Knowledge Architecture"},
+			{Level: 3, Title: "Content Management"},
+			{Level: 3, Title: "Technology Infrastructure"},
+			{Level: 2, Title: "Knowledge Sharing and Distribution"},
+			{Level: 3, Title: "Sharing Strategy Framework"},
+			{Level: 3, Title: "Knowledge Transfer Methods"},
+			{Level: 3, Title: "Knowledge Access and Permissions"},
+			{Level: 2, Title: "Knowledge Application and Impact"},
+			{Level: 3, Title: "Application Strategy"},
+			{Level: 3, Title: "Knowledge Analytics"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Knowledge Culture and Governance"},
+			{Level: 3, Title: "Knowledge Culture Development"},
+			{Level: 3, Title: "Knowledge Governance"},
+			{Level: 3, Title: "Advanced Knowledge Practices"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, type, domain, and lifecycle",
+			"Knowledge framework with philosophy, foundation, and architecture",
+			"Capture and creation with identification process, creation framework, and documentation standards",
+			"Organization and storage with architecture, content management, and technology infrastructure",
+			"Sharing and distribution with strategy framework, transfer methods, and access permissions",
+			"Application and impact with strategy, analytics, and continuous improvement",
+			"Culture and governance with development, governance structure, and advanced practices",
+			"Validation evidence of knowledge capture, sharing, application, and organizational value creation",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic knowledge capture and documentation processes",
+				"Simple knowledge sharing mechanisms and basic access controls",
+				"Basic knowledge governance and quality standards",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive knowledge management framework with systematic capture and organization",
+				"Structured sharing processes with effective access and application mechanisms",
+				"Active knowledge governance with quality standards and continuous improvement",
+				"Regular knowledge effectiveness review and strategic integration",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced knowledge capabilities with intelligent systems and sophisticated analytics",
+				"Comprehensive knowledge ecosystem with integrated culture and innovation support",
+				"Knowledge excellence with competitive advantage and strategic value creation",
+				"Strategic knowledge management driving organizational learning and transformation",
+			}},
+		},
+	},
+	{
+		Code:         "KPT",
+		Name:         "Key Partnerships",
+		Purpose:      "The Key Partnerships defines systematic approaches to strategic alliances and partnerships that create mutual value and competitive advantages. It establishes partnership frameworks that optimize collaboration, resource sharing, and market access while minimizing risks and costs.",
+		Domain:       "Business Model",
+		Dependencies: []string{"STR", "KRS", "KAC", "CMP"},
+		Enablements:  []string{"REV", "CHN", "CST", "CAP"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Partnership Overview"},
+			{Level: 2, Title: "Partnership Strategy"},
+			{Level: 3, Title: "Strategic Context"},
+			{Level: 3, Title: "Partnership Philosophy"},
+			{Level: 3, Title: "Partnership Portfolio"},
+			{Level: 2, Title: "Partnership Types and Structure"},
+			{Level: 3, Title: "Strategic Partnerships"},
+			{Level: 3, Title: "Operational Partnerships"},
+			{Level: 3, Title: "Technology Partnerships"},
+			{Level: 2, Title: "Partnership Development"},
+			{Level: 3, Title: "Partner Selection"},
+			{Level: 3, Title: "Due Diligence Process"},
+			{Level: 3, Title: "Partnership Formation"},
+			{Level: 2, Title: "Partnership Operations"},
+			{Level: 3, Title: "Governance Structure"},
+			{Level: 3, Title: "Operational Integration"},
+			{Level: 3, Title: "Performance Management"},
+			{Level: 2, Title: "Value Creation and Economics"},
+			{Level: 3, Title: "Mutual Value Proposition"},
+			{Level: 3, Title: "Economic Model"},
+			{Level: 3, Title: "Financial Framework"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 3, Title: "Partnership Risks"},
+			{Level: 3, Title: "Risk Mitigation"},
+			{Level: 3, Title: "Relationship Management"},
+			{Level: 2, Title: "Partnership Evolution"},
+			{Level: 3, Title: "Lifecycle Management"},
+			{Level: 3, Title: "Partnership Development"},
+			{Level: 3, Title: "Strategic Adaptation"},
+			{Level: 2, Title: "Partnership Lifecycle Management"},
+			{Level: 3, Title: "Partnership Phases"},
+			{Level: 3, Title: "Lifecycle Management"},
+			{Level: 3, Title: "Partnership Renewal"},
+			{Level: 2, Title: "Market and Competitive Impact"},
+			{Level: 3, Title: "Market Impact"},
+			{Level: 3, Title: "Competitive Advantage"},
+			{Level: 3, Title: "Industry Influence"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Partnership overview with clear purpose, strategic importance, value creation, and competitive impact",
+			"Partnership strategy with context, philosophy, and portfolio approach",
+			"Partnership types and structure with strategic, operational, and technology partnership categories",
+			"Partnership development with selection criteria, due diligence, and formation processes",
+			"Partnership operations with governance, integration, and performance management systems",
+			"Value creation and economics with mutual value proposition and economic framework",
+			"Risk management with comprehensive risk identification, mitigation, and relationship management",
+			"Partnership evolution with lifecycle management, development, and strategic adaptation",
+			"Partnership lifecycle management with phases, transitions, and renewal processes",
+			"Market and competitive impact with positioning, competitive advantage, and industry influence",
+			"Validation evidence of partnership effectiveness, mutual value creation, and competitive advantage",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Partnership overview with purpose, strategic importance, and value creation",
+				"Partnership strategy with context, philosophy, and portfolio approach",
+				"Basic partnership types and structure with strategic and operational categories",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive partnership development with selection, due diligence, and formation",
+				"Partnership operations with governance, integration, and performance management",
+				"Value creation and economics with mutual value proposition and economic model",
+				"Risk management with identification, mitigation, and relationship management",
+				"Partnership evolution with lifecycle management and strategic adaptation",
+				"Market and competitive impact with positioning and competitive advantage",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced partnership lifecycle management with sophisticated phase transitions",
+				"AI-driven partnership optimization with predictive analytics and performance modeling",
+				"Dynamic partnership portfolio management with real-time performance tracking",
+				"Integrated partnership ecosystem with seamless cross-partner collaboration",
+				"Advanced competitive intelligence driving partnership strategy",
+				"Automated partnership governance with intelligent decision support systems",
+			}},
+		},
+	},
+	{
+		Code:         "KRS",
+		Name:         "Key Resources",
+		Purpose:      "The Key Resources defines systematic identification, development, and management of critical organizational assets that create competitive advantage and enable business strategy execution. It establishes resource frameworks that optimize asset utilization, investment allocation, and capability building.",
+		Domain:       "Business Model",
+		Dependencies: []string{"STR", "KAC", "CAP", "KPT"},
+		Enablements:  []string{"PER", "QUA", "SVC", "PRO"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Resource Overview"},
+			{Level: 2, Title: "Resource Strategy"},
+			{Level: 3, Title: "Strategic Context"},
+			{Level: 3, Title: "Resource Philosophy"},
+			{Level: 2, Title: "Resource Inventory"},
+			{Level: 3, Title: "Physical Resources"},
+			{Level: 3, Title: "Intellectual Resources"},
+			{Level: 3, Title: "Human Resources"},
+			{Level: 3, Title: "Financial Resources"},
+			{Level: 3, Title: "Digital Resources"},
+			{Level: 2, Title: "Resource Assessment"},
+			{Level: 3, Title: "Resource Criticality Analysis"},
+			{Level: 3, Title: "Resource Quality Assessment"},
+			{Level: 3, Title: "Resource Utilization"},
+			{Level: 2, Title: "Resource Development Strategy"},
+			{Level: 3, Title: "Capability Building"},
+			{Level: 3, Title: "External Acquisition"},
+			{Level: 3, Title: "Resource Optimization"},
+			{Level: 2, Title: "Resource Management"},
+			{Level: 3, Title: "Resource Planning"},
+			{Level: 3, Title: "Resource Allocation"},
+			{Level: 3, Title: "Performance Management"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 3, Title: "Resource Risks"},
+			{Level: 3, Title: "Risk Mitigation"},
+			{Level: 3, Title: "Business Continuity"},
+			{Level: 2, Title: "Resource Optimization"},
+			{Level: 3, Title: "Efficiency Improvement"},
+			{Level: 3, Title: "Cost Optimization"},
+			{Level: 3, Title: "Innovation and Modernization"},
+			{Level: 2, Title: "Competitive Analysis"},
+			{Level: 3, Title: "Resource Benchmarking"},
+			{Level: 3, Title: "Competitive Advantage"},
+			{Level: 2, Title: "Future Resource Strategy"},
+			{Level: 3, Title: "Resource Evolution"},
+			{Level: 3, Title: "Investment Roadmap"},
+			{Level: 3, Title: "Capability Building"},
+			{Level: 2, Title: "Resource Governance"},
+			{Level: 3, Title: "Governance Framework"},
+			{Level: 3, Title: "Resource Policies"},
+			{Level: 3, Title: "Measurement and Reporting"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Resource overview with clear purpose, strategic importance, competitive advantage, and value creation role",
+			"Resource strategy with context, philosophy, and investment approaches",
+			"Resource inventory with comprehensive physical, intellectual, human, financial, and digital assets",
+			"Resource assessment with criticality analysis, quality evaluation, and utilization measurement",
+			"Resource development strategy with capability building, acquisition, and optimization approaches",
+			"Resource management with planning, allocation, and performance management systems",
+			"Risk management with comprehensive resource risk identification, mitigation, and business continuity",
+			"Resource optimization with efficiency improvement, cost optimization, and modernization strategies",
+			"Competitive analysis with benchmarking, comparison, and competitive advantage assessment",
+			"Future resource strategy with evolution planning, investment roadmap, and capability building",
+			"Resource governance with framework, policies, and measurement systems",
+			"Validation evidence of resource strategic alignment, efficient utilization, and competitive advantage creation",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Resource overview with purpose, strategic importance, and value creation role",
+				"Resource strategy with context, philosophy, and investment approach",
+				"Basic resource inventory with major resource categories and assets",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive resource assessment with criticality analysis and utilization evaluation",
+				"Resource development strategy with capability building and acquisition approaches",
+				"Resource management with planning, allocation, and performance systems",
+				"Risk management with comprehensive risk identification and mitigation strategies",
+				"Resource optimization with efficiency improvement and cost optimization",
+				"Competitive analysis with benchmarking and competitive advantage assessment",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced future resource strategy with evolution roadmap and investment planning",
+				"AI-driven resource optimization with predictive analytics and automated allocation",
+				"Dynamic resource management with real-time performance monitoring and adjustment",
+				"Integrated resource governance with intelligent decision support and compliance automation",
+				"Advanced competitive intelligence with automated resource benchmarking",
+				"Real-time resource optimization with adaptive capacity planning and utilization",
+			}},
+		},
+	},
+	{
+		Code:         "LEA",
+		Name:         "Learning Organization",
+		Purpose:      "The Learning Organization document defines systematic approaches to building organizational capabilities for continuous learning and adaptation. It establishes learning frameworks that transform organizations into adaptive entities that learn faster than their environment changes, creating sustainable competitive advantage through superior learning capabilities.",
+		Domain:       "Growth & Innovation",
+		Dependencies: []string{"KNO", "INN", "ADT", "CUL"},
+		Enablements:  []string{"EXP", "FUT", "ORG", "SKI"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Learning Organization Framework"},
+			{Level: 3, Title: "Learning Philosophy"},
+			{Level: 3, Title: "Learning Strategy"},
+			{Level: 3, Title: "Learning Scope"},
+			{Level: 2, Title: "Learning Culture Development"},
+			{Level: 3, Title: "Culture Framework"},
+			{Level: 3, Title: "Culture Enablers"},
+			{Level: 3, Title: "Culture Assessment"},
+			{Level: 2, Title: "Knowledge Management Systems"},
+			{Level: 3, Title: "Knowledge Architecture"},
+			{Level: 3, Title: "Knowledge Processes"},
+			{Level: 3, Title: "Knowledge Systems"},
+			{Level: 2, Title: "Learning Systems and Processes"},
+			{Level: 3, Title: "Formal Learning Systems"},
+			{Level: 3, Title: "Informal Learning Systems"},
+			{Level: 3, Title: "Experiential Learning"},
+			{Level: 2, Title: "Capability Development Framework"},
+			{Level: 3, Title: "Core Capabilities"},
+			{Level: 3, Title: "Competency Management"},
+			{Level: 3, Title: "Learning Pathways"},
+			{Level: 2, Title: "Learning Performance and Effectiveness"},
+			{Level: 3, Title: "Learning Metrics"},
+			{Level: 3, Title: "Learning Analytics"},
+			{Level: 3, Title: "Return on Learning Investment"},
+			{Level: 2, Title: "External Learning Networks"},
+			{Level: 3, Title: "Learning Partnerships"},
+			{Level: 3, Title: "Knowledge Exchange"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, learning approach, scope, and knowledge strategy",
+			"Learning framework with philosophy, strategy, and scope definition",
+			"Culture development with framework, enablers, and assessment approaches",
+			"Knowledge management with architecture, processes, and systems",
+			"Learning systems with formal, informal, and experiential learning",
+			"Capability development with frameworks, competency management, and pathways",
+			"Performance measurement with metrics, analytics, and ROI assessment",
+			"Validation evidence of adaptation enablement, decision support, and improvement culture",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic learning framework with formal training programs",
+				"Simple knowledge management and sharing systems",
+				"Basic learning culture initiatives and recognition",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive learning organization with systematic capability development",
+				"Structured knowledge management with effective sharing and application",
+				"Active learning culture with experiential and informal learning",
+				"Regular learning effectiveness measurement and improvement",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced learning organization with superior adaptation capabilities",
+				"Sophisticated knowledge systems with AI-enhanced learning",
+				"Learning excellence with industry leadership and thought leadership",
+				"Strategic learning driving organizational transformation and competitive advantage",
+			}},
+		},
+	},
+	{
+		Code:         "LEG",
+		Name:         "Legal",
+		Purpose:      "The Legal document defines systematic approaches to managing legal affairs, protecting legal interests, and ensuring compliance with applicable laws and regulations. It establishes legal frameworks that mitigate legal risks, manage contracts and disputes, protect intellectual property, and support business operations within appropriate legal boundaries.",
+		Domain:       "Risk & Governance",
+		Dependencies: []string{"COM", "RSK", "GOV", "ETH"},
+		Enablements:  []string{"CTL", "REP", "AUD", "INS"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Legal Framework"},
+			{Level: 3, Title: "Legal Philosophy"},
+			{Level: 3, Title: "Legal Governance"},
+			{Level: 3, Title: "Legal Service Areas"},
+			{Level: 2, Title: "Contract Management"},
+			{Level: 3, Title: "Contract Lifecycle Management"},
+			{Level: 3, Title: "Contract Types and Standards"},
+			{Level: 3, Title: "Contract Risk Management"},
+			{Level: 2, Title: "Litigation and Dispute Resolution"},
+			{Level: 3, Title: "Litigation Management"},
+			{Level: 3, Title: "Alternative Dispute Resolution"},
+			{Level: 3, Title: "Dispute Prevention"},
+			{Level: 2, Title: "Intellectual Property Management"},
+			{Level: 3, Title: "IP Strategy and Portfolio"},
+			{Level: 3, Title: "IP Licensing and Transactions"},
+			{Level: 3, Title: "Open Source and Third-Party IP"},
+			{Level: 2, Title: "Regulatory Compliance and Government Relations"},
+			{Level: 2, Title: "Corporate Legal and Governance"},
+			{Level: 3, Title: "Corporate Structure and Governance"},
+			{Level: 3, Title: "Employment Law"},
+			{Level: 3, Title: "Real Estate and Facilities"},
+			{Level: 2, Title: "Legal Technology and Operations"},
+			{Level: 3, Title: "Legal Technology"},
+			{Level: 3, Title: "Legal Operations"},
+			{Level: 3, Title: "Knowledge Management"},
+			{Level: 2, Title: "Legal Risk Management"},
+			{Level: 3, Title: "Legal Risk Assessment"},
+			{Level: 3, Title: "Crisis Legal Management"},
+			{Level: 2, Title: "Performance Measurement and Reporting"},
+			{Level: 3, Title: "Legal Performance Metrics"},
+			{Level: 3, Title: "Legal Reporting"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, legal scope, legal model, and risk tolerance",
+			"Legal framework with philosophy, governance, and service areas",
+			"Contract management with lifecycle management, types/standards, and risk management",
+			"Litigation and dispute resolution with management, alternative resolution, and prevention",
+			"Intellectual property with strategy/portfolio, licensing, and third-party IP management",
+			"Regulatory compliance with framework, industry-specific, and international compliance",
+			"Corporate legal with structure/governance, employment law, and real estate",
+			"Technology and operations with legal technology, operations, and knowledge management",
+			"Legal risk management with assessment, crisis management, and mitigation",
+			"Validation evidence of business interest protection, legal risk minimization, and business operation support",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic legal framework with essential contract and compliance management",
+				"Simple litigation management and dispute resolution procedures",
+				"Basic intellectual property protection and regulatory compliance",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive legal framework with systematic risk management",
+				"Structured contract lifecycle management and dispute prevention",
+				"Effective IP portfolio management and regulatory compliance programs",
+				"Regular legal performance measurement and stakeholder reporting",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced legal operations with technology integration and automation",
+				"Sophisticated legal risk management with predictive analytics",
+				"Comprehensive legal excellence with industry leadership and innovation",
+				"Strategic legal function driving business value and competitive advantage",
+			}},
+		},
+	},
+	{
+		Code:         "LRN",
+		Name:         "Learning Records",
+		Purpose:      "The Learning Records document captures key discoveries, insights, and knowledge gained through organizational activities, experiments, and experiences. It establishes learning frameworks that preserve institutional knowledge, accelerate organizational learning, and enable evidence-based decision-making and continuous improvement.",
+		Domain:       "Learning & Decisions",
+		Dependencies: []string{"EXP", "HYP", "DEC", "RET"},
+		Enablements:  []string{"STR", "PRO", "POL", "THY"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Learning Framework"},
+			{Level: 3, Title: "Learning Philosophy"},
+			{Level: 3, Title: "Learning Foundation"},
+			{Level: 3, Title: "Learning Architecture"},
+			{Level: 2, Title: "Learning Documentation and Analysis"},
+			{Level: 3, Title: "Learning Discovery Process"},
+			{Level: 3, Title: "Learning Content Structure"},
+			{Level: 3, Title: "Learning Validation and Verification"},
+			{Level: 2, Title: "Learning Application and Implementation"},
+			{Level: 3, Title: "Knowledge Translation"},
+			{Level: 3, Title: "Learning Sharing and Dissemination"},
+			{Level: 3, Title: "Learning Impact Measurement"},
+			{Level: 2, Title: "Learning Quality and Governance"},
+			{Level: 3, Title: "Learning Quality Framework"},
+			{Level: 3, Title: "Learning Governance"},
+			{Level: 3, Title: "Learning Knowledge Management"},
+			{Level: 2, Title: "Learning Culture and Continuous Improvement"},
+			{Level: 3, Title: "Learning Culture Development"},
+			{Level: 3, Title: "Continuous Learning Process"},
+			{Level: 3, Title: "Learning Network Development"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, type, source, and scope",
+			"Learning framework with philosophy, foundation, and architecture",
+			"Documentation and analysis with discovery process, content structure, and validation",
+			"Application and implementation with translation, sharing, and impact measurement",
+			"Quality and governance with framework, governance, and knowledge management",
+			"Culture and improvement with development, continuous process, and network building",
+			"Validation evidence of documentation, sharing, application, and organizational improvement",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic learning documentation with key insights and supporting evidence",
+				"Simple sharing mechanisms and basic application processes",
+				"Basic quality assurance and learning governance",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive learning framework with systematic discovery and validation",
+				"Structured application processes with impact measurement",
+				"Active learning governance with quality standards and knowledge management",
+				"Regular learning review and continuous improvement integration",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced learning capabilities with sophisticated analysis and synthesis",
+				"Comprehensive learning ecosystem with integrated culture and networks",
+				"Learning excellence with organizational transformation and competitive advantage",
+				"Strategic learning management driving innovation and organizational evolution",
+			}},
+		},
+	},
+	{
+		Code:         "MAC",
+		Name:         "Macro Environment",
+		Purpose:      "The Macro Environment document analyzes broad economic, political, social, and technological factors that influence the business environment. It examines PESTEL factors and their implications.",
+		Domain:       "Market & Environment",
+		Dependencies: []string{"STR"},
+		Enablements:  []string{"TRN", "THR", "OPP", "FIN"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "PESTEL Framework"},
+			{Level: 3, Title: "Political Factors"},
+			{Level: 3, Title: "Economic Factors"},
+			{Level: 3, Title: "Social Factors"},
+			{Level: 3, Title: "Technological Factors"},
+			{Level: 3, Title: "Environmental Factors"},
+			{Level: 3, Title: "Legal Factors"},
+			{Level: 2, Title: "Regional Analysis"},
+			{Level: 3, Title: "Primary Markets"},
+			{Level: 2, Title: "Scenario Planning"},
+			{Level: 3, Title: "Base Case Scenario"},
+			{Level: 3, Title: "Optimistic Scenario"},
+			{Level: 3, Title: "Pessimistic Scenario"},
+			{Level: 3, Title: "Disruptive Scenario"},
+			{Level: 2, Title: "Strategic Implications"},
+			{Level: 3, Title: "Business Model Impact"},
+			{Level: 3, Title: "Investment Priorities"},
+			{Level: 3, Title: "Risk Management"},
+			{Level: 2, Title: "Monitoring and Intelligence"},
+			{Level: 3, Title: "Information Sources"},
+			{Level: 3, Title: "Analysis Framework"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"PESTEL framework comprehensively applied",
+			"Regional analysis covers all major markets",
+			"Scenario planning addresses multiple futures",
+			"Strategic implications clearly identified",
+			"Investment and risk implications assessed",
+			"Monitoring system tracks relevant macro factors",
+			"Intelligence sources provide reliable data",
+			"Analysis integrates with strategic planning",
+			"Cross-functional input ensures completeness",
+			"Regular review and update process established",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"PESTEL factors identified and analyzed",
+				"Basic regional analysis for major markets",
+				"Key macro trends and implications documented",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive PESTEL analysis with interconnections",
+				"Scenario planning with strategic implications",
+				"Regional analysis covering all major markets",
+				"Macro trend monitoring and intelligence system",
+				"Investment and risk management implications",
+				"Strategic planning integration",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic macro intelligence monitoring",
+				"Advanced scenario planning and stress testing",
+				"Predictive analytics and early warning systems",
+				"Integrated macro-strategic planning process",
+				"Cross-functional macro analysis team",
+				"Continuous macro environment adaptation",
+			}},
+		},
+	},
+	{
+		Code:         "MCH",
+		Name:         "Marketing Channel Strategy",
+		Purpose:      "The Marketing Channel Strategy document defines how the brand reaches and engages target audiences through marketing, social, and owned/earned paid content channels. It focuses on awareness, demand generation, and campaign channel mix, not product distribution mechanics.",
+		Domain:       "Brand & Marketing",
+		Dependencies: []string{"CUS", "MSG", "BRD", "POS"},
+		Enablements:  []string{"CAM", "SOC", "LED", "CON"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Channel Strategy Framework"},
+			{Level: 3, Title: "Channel Philosophy"},
+			{Level: 3, Title: "Channel Strategy Foundation"},
+			{Level: 3, Title: "Channel Ecosystem"},
+			{Level: 2, Title: "Digital Channel Strategy"},
+			{Level: 3, Title: "Digital Channel Portfolio"},
+			{Level: 3, Title: "Digital Channel Integration"},
+			{Level: 3, Title: "Digital Channel Optimization"},
+			{Level: 2, Title: "Traditional Channel Strategy"},
+			{Level: 3, Title: "Traditional Channel Portfolio"},
+			{Level: 3, Title: "Traditional-Digital Integration"},
+			{Level: 3, Title: "Traditional Channel ROI"},
+			{Level: 2, Title: "Channel Integration and Customer Journey"},
+			{Level: 3, Title: "Cross-Channel Customer Journey"},
+			{Level: 3, Title: "Channel Attribution and Analytics"},
+			{Level: 3, Title: "Channel Experience Optimization"},
+			{Level: 2, Title: "Performance Measurement and Optimization"},
+			{Level: 3, Title: "Channel Performance Metrics"},
+			{Level: 3, Title: "Channel ROI Analysis"},
+			{Level: 3, Title: "Optimization Framework"},
+			{Level: 2, Title: "Channel Evolution and Future Planning"},
+			{Level: 3, Title: "Emerging Channel Opportunities"},
+			{Level: 3, Title: "Channel Strategy Evolution"},
+			{Level: 3, Title: "Strategic Channel Planning"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, channel mix, integration, and audience mapping",
+			"Strategy framework with philosophy, foundation, and ecosystem design",
+			"Digital channel strategy with portfolio, integration, and optimization",
+			"Traditional channel strategy with portfolio, integration, and ROI measurement",
+			"Channel integration with customer journey mapping, attribution, and experience optimization",
+			"Performance measurement with metrics, ROI analysis, and optimization framework",
+			"Evolution and planning with emerging opportunities, strategy evolution, and strategic planning",
+			"Validation evidence of efficient audience reach, seamless experiences, and measurable outcomes",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic channel strategy with key channel selection and simple integration",
+				"Simple performance tracking and basic optimization approach",
+				"Basic customer journey mapping and channel coordination",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive channel strategy with detailed integration and optimization",
+				"Structured performance measurement with cross-channel attribution",
+				"Active channel optimization with testing and continuous improvement",
+				"Regular channel strategy review and evolution planning",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced channel capabilities with sophisticated integration and personalization",
+				"Comprehensive channel ecosystem with advanced analytics and attribution",
+				"Channel excellence with industry leadership and competitive advantage",
+				"Strategic channel management driving optimal ROI and customer experience",
+			}},
+		},
+	},
+	{
+		Code:         "MET",
+		Name:         "Metrics",
+		Purpose:      "The Metrics document defines systematic approaches to measuring, monitoring, and managing business performance through key performance indicators, financial metrics, and operational measures. It establishes measurement frameworks that enable data-driven decision making, performance accountability, and continuous improvement.",
+		Domain:       "Financial & Investment",
+		Dependencies: []string{"FIN", "BUD", "STR", "OBJ"},
+		Enablements:  []string{"REP", "PER", "QUA", "GOV"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Metrics Framework"},
+			{Level: 3, Title: "Performance Philosophy"},
+			{Level: 3, Title: "Metrics Strategy"},
+			{Level: 3, Title: "Measurement Categories"},
+			{Level: 2, Title: "Financial Metrics"},
+			{Level: 3, Title: "Revenue Metrics"},
+			{Level: 3, Title: "Profitability Metrics"},
+			{Level: 3, Title: "Cash Flow Metrics"},
+			{Level: 2, Title: "Operational Metrics"},
+			{Level: 3, Title: "Productivity Metrics"},
+			{Level: 3, Title: "Quality Metrics"},
+			{Level: 3, Title: "Capacity Metrics"},
+			{Level: 2, Title: "Customer Metrics"},
+			{Level: 3, Title: "Acquisition Metrics"},
+			{Level: 3, Title: "Satisfaction Metrics"},
+			{Level: 3, Title: "Value Metrics"},
+			{Level: 2, Title: "Employee Metrics"},
+			{Level: 3, Title: "Engagement Metrics"},
+			{Level: 3, Title: "Performance Metrics"},
+			{Level: 2, Title: "Metrics Dashboard and Reporting"},
+			{Level: 3, Title: "Dashboard Design"},
+			{Level: 3, Title: "Reporting Structure"},
+			{Level: 3, Title: "Data Visualization"},
+			{Level: 2, Title: "Performance Management"},
+			{Level: 3, Title: "Target Setting"},
+			{Level: 3, Title: "Performance Review"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Data Quality and Governance"},
+			{Level: 3, Title: "Data Management"},
+			{Level: 3, Title: "Automation and Technology"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, measurement focus, reporting level, and frequency",
+			"Metrics framework with performance philosophy, strategy, and measurement categories",
+			"Financial metrics with revenue, profitability, and cash flow measurements",
+			"Operational metrics with productivity, quality, and capacity measurements",
+			"Customer metrics with acquisition, satisfaction, and value measurements",
+			"Employee metrics with engagement, retention, and performance measurements",
+			"Dashboard and reporting with design framework, structure, and visualization",
+			"Performance management with target setting, review, and continuous improvement",
+			"Data quality and governance with management, automation, and technology",
+			"Validation evidence of actionable insights, accountability, and strategic objective support",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic financial and operational metrics with manual reporting",
+				"Simple dashboard with key performance indicators",
+				"Monthly performance review and variance analysis",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive metric framework with balanced scorecard approach",
+				"Automated data collection and reporting with quality controls",
+				"Interactive dashboards with drill-down capabilities",
+				"Regular performance management and improvement processes",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced analytics with predictive and prescriptive metrics",
+				"Real-time performance monitoring with automated alerting",
+				"Sophisticated data governance with self-service capabilities",
+				"Strategic performance optimization with continuous improvement culture",
+			}},
+		},
+	},
+	{
+		Code:         "MKT",
+		Name:         "Market Definition",
+		Purpose:      "The Market Definition document establishes the boundaries, size, and characteristics of the addressable market. It defines TAM (Total Addressable Market), SAM (Serviceable Addressable Market), and SOM (Serviceable Obtainable Market).",
+		Domain:       "Market & Environment",
+		Dependencies: []string{"MSN", "STR"},
+		Enablements:  []string{"SEG", "CMP", "FIN", "GTM"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Market Framework"},
+			{Level: 3, Title: "Market Definition"},
+			{Level: 3, Title: "Market Sizing"},
+			{Level: 3, Title: "Total Addressable Market (TAM)"},
+			{Level: 3, Title: "Serviceable Addressable Market (SAM)"},
+			{Level: 3, Title: "Serviceable Obtainable Market (SOM)"},
+			{Level: 2, Title: "Market Characteristics"},
+			{Level: 3, Title: "Market Maturity"},
+			{Level: 3, Title: "Market Dynamics"},
+			{Level: 3, Title: "Customer Behavior"},
+			{Level: 2, Title: "Market Segmentation Preview"},
+			{Level: 3, Title: "Primary Segments"},
+			{Level: 3, Title: "Segment Characteristics"},
+			{Level: 3, Title: "Segment Priorities"},
+			{Level: 2, Title: "Market Research Foundation"},
+			{Level: 3, Title: "Primary Research"},
+			{Level: 3, Title: "Secondary Research"},
+			{Level: 3, Title: "Data Quality Assessment"},
+			{Level: 2, Title: "Market Evolution"},
+			{Level: 3, Title: "Historical Trends"},
+			{Level: 3, Title: "Future Projections"},
+			{Level: 3, Title: "Scenario Planning"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Market boundaries are clearly and precisely defined",
+			"TAM, SAM, and SOM calculations are methodology-based",
+			"Multiple validation approaches confirm market size",
+			"Market characteristics and dynamics are analyzed",
+			"Customer behavior patterns are documented",
+			"Market research foundation is comprehensive",
+			"Market evolution and trends are projected",
+			"Scenario planning addresses market uncertainty",
+			"Data quality assessment validates research sources",
+			"Market definition enables strategic decision-making",
+			"Regular review and update process established",
+			"Cross-validation with other market documents completed",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Clear market definition with boundaries",
+				"TAM, SAM, and SOM estimates provided",
+				"Basic market characteristics described",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Multiple calculation methods for market sizing",
+				"Market research foundation documented",
+				"Market dynamics and trends analyzed",
+				"Segmentation preview provided",
+				"Data quality assessment included",
+				"Market evolution timeline outlined",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Regular market size validation and updates",
+				"Comprehensive primary and secondary research",
+				"Scenario planning for market futures",
+				"Customer behavior analysis detailed",
+				"Market intelligence system established",
+				"Cross-validation with competitive analysis",
+			}},
+		},
+	},
+	{
+		Code:    "MNA",
+		Name:    "M&A and Corporate Development",
+		Purpose: "M&A Strategy defines how the organization evaluates acquisition and strategic transaction opportunities, manages diligence, governs execution risk, and integrates outcomes into operating model and reporting.",
+		Domain:  "Risk & Governance",
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Core Workflow"},
+			{Level: 2, Title: "Governance"},
+		},
+		Checklist: []string{
+			"Deal thesis and valuation logic are explicit.",
+			"Diligence findings are linked to go/no-go criteria.",
+			"Exit and integration risks are defined with owners.",
+			"Governance and approval routing are clearly documented.",
+		},
+	},
+	{
+		Code:         "MOT",
+		Name:         "Competitive Moats",
+		Purpose:      "The Moats document identifies and analyzes the competitive advantages that protect the organization's market position. Moats are sustainable advantages that make it difficult for competitors to replicate success.",
+		Domain:       "Strategic Foundation",
+		Dependencies: []string{"STR", "CMP", "BMC"},
+		Enablements:  []string{"POS", "VAL", "GTM"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Moat Analysis Framework"},
+			{Level: 3, Title: "Moat Categories"},
+			{Level: 2, Title: "Current Moats"},
+			{Level: 3, Title: "Moat 1: [Advantage Name]"},
+			{Level: 3, Title: "Moat 2: [Advantage Name]"},
+			{Level: 3, Title: "[Continue for each identified moat]"},
+			{Level: 2, Title: "Moat Interaction"},
+			{Level: 3, Title: "Synergistic Effects"},
+			{Level: 3, Title: "Compound Advantages"},
+			{Level: 3, Title: "Strategic Priorities"},
+			{Level: 2, Title: "Competitive Threats"},
+			{Level: 3, Title: "Moat Vulnerabilities"},
+			{Level: 3, Title: "Disruption Risks"},
+			{Level: 3, Title: "Defensive Strategies"},
+			{Level: 2, Title: "Future Moat Development"},
+			{Level: 3, Title: "Emerging Opportunities"},
+			{Level: 3, Title: "Investment Requirements"},
+			{Level: 3, Title: "Timeline and Milestones"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Competitive advantages clearly identified and described",
+			"Moat strength assessed and validated",
+			"Customer value of each moat confirmed",
+			"Competitive barriers documented and analyzed",
+			"Measurement framework for tracking moat strength",
+			"Development strategy for strengthening moats",
+			"Competitive threats and vulnerabilities assessed",
+			"Moat interaction and synergies understood",
+			"Future moat development opportunities identified",
+			"Evidence of moat effectiveness documented",
+			"Regular review and updating process established",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"At least 2-3 competitive advantages identified",
+				"Basic description of each moat",
+				"Customer value explanation for each advantage",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive moat analysis framework",
+				"Strength assessment for each moat",
+				"Competitive barrier analysis",
+				"Measurement framework defined",
+				"Development strategy for key moats",
+				"Competitive threat assessment",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Regular moat strength monitoring system",
+				"Moat development investment tracking",
+				"Competitive intelligence integration",
+				"Synergistic effects analysis",
+				"Future moat development roadmap",
+				"Stakeholder moat education program",
+			}},
+		},
+	},
+	{
+		Code:         "MSG",
+		Name:         "Messaging Framework",
+		Purpose:      "The Messaging Framework document defines what the brand says and how it says it to different audiences across various touchpoints. It establishes messaging architecture that ensures consistent communication, builds brand recognition, and drives desired customer actions through strategic message development and adaptation.",
+		Domain:       "Brand & Marketing",
+		Dependencies: []string{"BRD", "POS", "CUS", "VPR"},
+		Enablements:  []string{"CNT", "CAM", "TON", "SAL"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Messaging Framework Foundation"},
+			{Level: 3, Title: "Messaging Philosophy"},
+			{Level: 3, Title: "Message Architecture"},
+			{Level: 3, Title: "Message Foundation"},
+			{Level: 2, Title: "Core Messaging Architecture"},
+			{Level: 3, Title: "Primary Message Development"},
+			{Level: 3, Title: "Supporting Message Framework"},
+			{Level: 3, Title: "Message Validation"},
+			{Level: 2, Title: "Audience-Specific Messaging"},
+			{Level: 3, Title: "Audience Segmentation Strategy"},
+			{Level: 3, Title: "Customer Journey Messaging"},
+			{Level: 3, Title: "Channel-Specific Adaptation"},
+			{Level: 2, Title: "Message Testing and Optimization"},
+			{Level: 3, Title: "Testing Methodology"},
+			{Level: 3, Title: "Performance Measurement"},
+			{Level: 3, Title: "Optimization Process"},
+			{Level: 2, Title: "Implementation and Governance"},
+			{Level: 3, Title: "Messaging Implementation"},
+			{Level: 3, Title: "Governance Framework"},
+			{Level: 3, Title: "Evolution and Maintenance"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, hierarchy, audiences, and differentiation",
+			"Messaging foundation with philosophy, architecture, and message foundation",
+			"Core messaging with primary development, supporting framework, and validation",
+			"Audience-specific messaging with segmentation, journey mapping, and channel adaptation",
+			"Testing and optimization with methodology, measurement, and optimization processes",
+			"Implementation and governance with strategy, framework, and evolution planning",
+			"Validation evidence of consistent communication, competitive differentiation, and action-driving",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic messaging framework with core messages and key points",
+				"Simple audience adaptation and channel guidelines",
+				"Basic message testing and validation processes",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive messaging framework with detailed architecture",
+				"Structured audience segmentation with channel-specific adaptation",
+				"Active message testing with performance measurement and optimization",
+				"Regular messaging review and evolution processes",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced messaging capabilities with sophisticated testing and optimization",
+				"Comprehensive message ecosystem with integrated governance",
+				"Messaging excellence with industry recognition and differentiation",
+				"Strategic messaging driving brand recognition and competitive advantage",
+			}},
+		},
+	},
+	{
+		Code:        "MSN",
+		Name:        "Mission Statement",
+		Purpose:     "This specification SHALL define the requirements and structure for Mission Statement documents that articulate an organization's fundamental purpose and reason for existence. Organizations MUST use this specification to document their core mission in a manner that enables strategic alignment, decision-making guidance, and stakeholder communication. This specification covers mission definition, beneficiary identification, value creation articulation, and mission validation approaches.",
+		Domain:      "Strategic Foundation",
+		Enablements: []string{"VSN", "VAL", "STR", "PUR"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Mission Statement"},
+			{Level: 2, Title: "Core Purpose"},
+			{Level: 3, Title: "Primary Beneficiaries"},
+			{Level: 3, Title: "Fundamental Value Creation"},
+			{Level: 3, Title: "Scope and Boundaries"},
+			{Level: 2, Title: "Mission Evolution"},
+			{Level: 2, Title: "Living the Mission"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Mission statement is clear and memorable",
+			"Primary beneficiaries are specifically identified",
+			"Value creation is unique and meaningful",
+			"Mission provides decision-making guidance",
+			"All stakeholders understand and support mission",
+			"Mission differentiates from competitors",
+			"Evidence of mission fulfillment exists",
+			"Regular review process established",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Clear, one-sentence mission statement exists",
+				"Primary beneficiaries are identified",
+				"Basic value creation is described",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Mission statement is memorable and distinctive",
+				"Detailed beneficiary analysis completed",
+				"Value creation is quantifiable",
+				"Mission guides documented decisions",
+				"Stakeholder feedback incorporated",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Mission evolution history documented",
+				"Regular mission review process established",
+				"Mission measurement framework implemented",
+				"Cultural integration practices documented",
+				"Mission validation evidence collected",
+			}},
+		},
+	},
+	{
+		Code:         "OBJ",
+		Name:         "Strategic Objectives",
+		Purpose:      "The Objectives document sets specific, measurable goals with timeframes that advance the organization toward its vision. These are typically structured as OKRs (Objectives and Key Results) or similar goal-setting frameworks.",
+		Domain:       "Strategic Foundation",
+		Dependencies: []string{"STR", "VSN"},
+		Enablements:  []string{"MET", "PRC", "GTM"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Objective Framework"},
+			{Level: 3, Title: "Time Horizon"},
+			{Level: 3, Title: "Success Philosophy"},
+			{Level: 2, Title: "Strategic Objectives"},
+			{Level: 3, Title: "Objective 1: [Strategic Goal]"},
+			{Level: 3, Title: "Objective 2: [Strategic Goal]"},
+			{Level: 3, Title: "[Continue for each strategic objective - typically 3-5 per period]"},
+			{Level: 2, Title: "Cascade Framework"},
+			{Level: 3, Title: "Company-Level Objectives"},
+			{Level: 3, Title: "Department Objectives"},
+			{Level: 3, Title: "Team Objectives"},
+			{Level: 3, Title: "Individual Objectives"},
+			{Level: 2, Title: "Tracking and Accountability"},
+			{Level: 3, Title: "Review Cadence"},
+			{Level: 3, Title: "Progress Reporting"},
+			{Level: 3, Title: "Course Correction"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"3-5 strategic objectives clearly defined",
+			"Each objective has 2-3 measurable key results",
+			"Strategic rationale clear for each objective",
+			"Baselines and targets quantified",
+			"Time horizons and owners specified",
+			"Dependencies and risks identified",
+			"Resource requirements estimated",
+			"Cascade framework defined",
+			"Review cadence established",
+			"Progress tracking system implemented",
+			"Course correction protocols defined",
+			"All teams understand their contribution to objectives",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"3-5 clear strategic objectives defined",
+				"Each objective has 2-3 measurable key results",
+				"Time horizons and owners specified",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Strategic rationale clear for each objective",
+				"Baselines and targets quantified",
+				"Cascade framework to departments",
+				"Dependencies and risks identified",
+				"Resource requirements estimated",
+				"Review cadence established",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Full cascade to individual level",
+				"Automated progress tracking system",
+				"Regular review and adjustment process",
+				"Course correction protocols defined",
+				"Historical objective achievement analysis",
+				"Continuous improvement in objective setting",
+			}},
+		},
+	},
+	{
+		Code:         "OPP",
+		Name:         "Opportunities",
+		Purpose:      "The Opportunities document identifies market gaps, growth potential, and strategic opportunities available to the organization. It analyzes opportunity attractiveness and prioritizes pursuit strategies.",
+		Domain:       "Market & Environment",
+		Dependencies: []string{"MKT", "TRN", "CMP"},
+		Enablements:  []string{"STR", "INN", "EXP", "GTM"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Opportunity Framework"},
+			{Level: 3, Title: "Categories"},
+			{Level: 3, Title: "Identification Process"},
+			{Level: 2, Title: "Identified Opportunities"},
+			{Level: 3, Title: "Opportunity 1: [Name]"},
+			{Level: 3, Title: "[Continue for each opportunity]"},
+			{Level: 2, Title: "Prioritization Matrix"},
+			{Level: 2, Title: "Development Strategy"},
+			{Level: 2, Title: "Tracking and Management"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Opportunities identified across all relevant categories",
+			"Market gaps validated through customer research",
+			"Business cases include sizing, investment, and ROI",
+			"Competitive landscape and response assessed",
+			"Requirements for success clearly defined",
+			"Pursuit strategies and timelines established",
+			"Prioritization criteria and portfolio balance",
+			"Performance tracking and learning integration",
+			"Regular review and opportunity pipeline management",
+			"Customer validation confirms opportunity viability",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Key opportunities identified and described",
+				"Basic sizing and business case provided",
+				"Prioritization criteria established",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive opportunity analysis with validation",
+				"Detailed business cases and ROI projections",
+				"Pursuit strategies and resource requirements",
+				"Risk assessment and mitigation plans",
+				"Portfolio prioritization matrix",
+				"Development governance process",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic opportunity pipeline management",
+				"Advanced opportunity tracking and analytics",
+				"Systematic customer validation process",
+				"Innovation pipeline integration",
+				"Portfolio optimization and reallocation",
+				"Learning-driven opportunity evolution",
+			}},
+		},
+	},
+	{
+		Code:         "OPS",
+		Name:         "Operations Manual",
+		Purpose:      "The Operations Manual defines systematic approaches to day-to-day operational management that ensure consistent service delivery, efficient resource utilization, and continuous operational excellence. It establishes operational frameworks that optimize performance and reliability.",
+		Domain:       "Operations & Execution",
+		Dependencies: []string{"PRO", "SLA", "CAP", "KAC"},
+		Enablements:  []string{"PER", "QUA", "INC", "MON"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Operations Overview"},
+			{Level: 2, Title: "Operational Context"},
+			{Level: 3, Title: "Business Context"},
+			{Level: 3, Title: "Operational Scope"},
+			{Level: 3, Title: "Operating Model"},
+			{Level: 2, Title: "Operational Structure"},
+			{Level: 3, Title: "Organizational Structure"},
+			{Level: 3, Title: "Roles and Responsibilities"},
+			{Level: 3, Title: "Escalation Structure"},
+			{Level: 2, Title: "Service Delivery Operations"},
+			{Level: 3, Title: "Service Portfolio"},
+			{Level: 3, Title: "Service Delivery Processes"},
+			{Level: 3, Title: "Quality Assurance"},
+			{Level: 2, Title: "Standard Operating Procedures"},
+			{Level: 3, Title: "Daily Operations"},
+			{Level: 3, Title: "Weekly Operations"},
+			{Level: 3, Title: "Monthly Operations"},
+			{Level: 2, Title: "Monitoring and Performance Management"},
+			{Level: 3, Title: "Performance Framework"},
+			{Level: 3, Title: "Monitoring Systems"},
+			{Level: 3, Title: "Reporting and Analytics"},
+			{Level: 2, Title: "Incident and Problem Management"},
+			{Level: 3, Title: "Incident Management"},
+			{Level: 3, Title: "Problem Management"},
+			{Level: 3, Title: "Crisis Management"},
+			{Level: 2, Title: "Resource Management"},
+			{Level: 3, Title: "Human Resources"},
+			{Level: 3, Title: "Technology Resources"},
+			{Level: 3, Title: "Financial Resources"},
+			{Level: 3, Title: "Physical Resources"},
+			{Level: 2, Title: "Change Management"},
+			{Level: 3, Title: "Change Control Process"},
+			{Level: 3, Title: "Emergency Changes"},
+			{Level: 3, Title: "Planned Changes"},
+			{Level: 2, Title: "Risk and Compliance Management"},
+			{Level: 3, Title: "Operational Risks"},
+			{Level: 3, Title: "Risk Mitigation"},
+			{Level: 3, Title: "Compliance Requirements"},
+			{Level: 2, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Improvement Framework"},
+			{Level: 3, Title: "Performance Optimization"},
+			{Level: 3, Title: "Innovation Initiatives"},
+			{Level: 2, Title: "Communication and Coordination"},
+			{Level: 3, Title: "Internal Communication"},
+			{Level: 3, Title: "External Communication"},
+			{Level: 3, Title: "Crisis Communication"},
+			{Level: 2, Title: "Training and Knowledge Management"},
+			{Level: 3, Title: "Training Programs"},
+			{Level: 3, Title: "Knowledge Management"},
+			{Level: 3, Title: "Skills Development"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Operations overview with clear purpose, scope, audience, and authority",
+			"Operational context with business alignment, scope, and operating model",
+			"Operational structure with teams, roles, responsibilities, and escalation procedures",
+			"Service delivery operations with portfolio, processes, and quality assurance",
+			"Standard operating procedures with comprehensive daily, weekly, and monthly operations",
+			"Monitoring and performance management with KPIs, systems, and analytics",
+			"Incident and problem management with classification, response, and crisis procedures",
+			"Resource management across human, technology, financial, and physical dimensions",
+			"Change management with control processes, emergency procedures, and communication",
+			"Risk and compliance management with identification, mitigation, and requirements",
+			"Continuous improvement with framework, optimization, and innovation initiatives",
+			"Communication and coordination with internal, external, and crisis communication",
+			"Training and knowledge management with programs, knowledge sharing, and skills development",
+			"Validation evidence of operational effectiveness, service performance, and continuous improvement",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Operations overview with purpose, scope, audience, and authority",
+				"Operational context with business alignment and operating model",
+				"Basic operational structure with teams, roles, and responsibilities",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive service delivery operations with portfolio, processes, and quality assurance",
+				"Standard operating procedures with daily, weekly, and monthly operations",
+				"Monitoring and performance management with KPIs, systems, and reporting",
+				"Incident and problem management with classification, response, and crisis procedures",
+				"Resource management across human, technology, financial, and physical dimensions",
+				"Change management with control processes and emergency procedures",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced risk and compliance management with comprehensive framework",
+				"Continuous improvement with innovation initiatives and optimization programs",
+				"Communication and coordination with comprehensive internal and external frameworks",
+				"Training and knowledge management with comprehensive development programs",
+				"AI-driven operations optimization with predictive analytics and automated improvement",
+				"Real-time operations monitoring with dynamic performance adjustment and proactive management",
+			}},
+		},
+	},
+	{
+		Code:         "ORG",
+		Name:         "Organization Structure",
+		Purpose:      "The Organization Structure defines systematic approaches to designing and managing organizational hierarchies, reporting relationships, and team structures that enable effective execution and coordination. It establishes organizational frameworks that optimize authority, accountability, and communication.",
+		Domain:       "Operations & Execution",
+		Dependencies: []string{"STR", "ROL", "TEA", "CAP"},
+		Enablements:  []string{"PER", "QUA", "COM", "GOV"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Organizational Design"},
+			{Level: 3, Title: "Structure Model"},
+			{Level: 3, Title: "Reporting Relationships"},
+			{Level: 3, Title: "Communication Patterns"},
+			{Level: 2, Title: "Roles and Responsibilities"},
+			{Level: 3, Title: "Core Functions"},
+			{Level: 3, Title: "Decision Framework"},
+			{Level: 2, Title: "Team Composition"},
+			{Level: 3, Title: "Team Structure"},
+			{Level: 3, Title: "Skill Distribution"},
+			{Level: 2, Title: "Performance Framework"},
+			{Level: 3, Title: "Success Metrics"},
+			{Level: 3, Title: "Accountability Model"},
+			{Level: 2, Title: "Change Management"},
+			{Level: 3, Title: "Organizational Evolution"},
+			{Level: 3, Title: "Adaptation Mechanisms"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, scope, and organizational size",
+			"Organizational design with structure model, reporting relationships, and communication patterns",
+			"Roles and responsibilities with core functions and decision framework",
+			"Team composition with structure, skill distribution, and requirements",
+			"Performance framework with success metrics and accountability model",
+			"Change management with evolution planning and adaptation mechanisms",
+			"Validation evidence of structural effectiveness, accountability clarity, and communication efficiency",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic organizational chart with reporting relationships",
+				"Clear role definitions and primary responsibilities",
+				"Basic decision authority framework",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Detailed organizational design rationale",
+				"Comprehensive decision framework with escalation paths",
+				"Performance metrics and accountability framework",
+				"Change management process",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced organizational design with adaptation mechanisms",
+				"Sophisticated performance management and development framework",
+				"Comprehensive succession planning and leadership development",
+				"Data-driven organizational optimization",
+			}},
+		},
+	},
+	{
+		Code:         "PAI",
+		Name:         "Pain Points",
+		Purpose:      "The Pain Points document identifies and analyzes customer problems, frustrations, and obstacles. It captures the negative experiences that drive customers to seek solutions and creates opportunities for value creation.",
+		Domain:       "Customer & Value",
+		Dependencies: []string{"PER", "JTB"},
+		Enablements:  []string{"GAI", "OPP", "REQ"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Pain Point Definition"},
+			{Level: 3, Title: "Pain Point Statement"},
+			{Level: 3, Title: "Pain Categories"},
+			{Level: 2, Title: "Customer Context"},
+			{Level: 3, Title: "Affected Customers"},
+			{Level: 3, Title: "Pain Context"},
+			{Level: 2, Title: "Pain Point Analysis"},
+			{Level: 3, Title: "Pain Severity Assessment"},
+			{Level: 3, Title: "Frequency Analysis"},
+			{Level: 3, Title: "Duration and Persistence"},
+			{Level: 2, Title: "Root Cause Analysis"},
+			{Level: 3, Title: "Contributing Factors"},
+			{Level: 3, Title: "Causal Relationships"},
+			{Level: 2, Title: "Customer Impact Assessment"},
+			{Level: 3, Title: "Productivity Impact"},
+			{Level: 3, Title: "Emotional Impact"},
+			{Level: 3, Title: "Financial Impact"},
+			{Level: 2, Title: "Current Solutions and Workarounds"},
+			{Level: 3, Title: "Existing Solutions"},
+			{Level: 3, Title: "Customer Workarounds"},
+			{Level: 2, Title: "Gap Analysis"},
+			{Level: 3, Title: "Solution Gaps"},
+			{Level: 3, Title: "Market Gaps"},
+			{Level: 2, Title: "Opportunity Assessment"},
+			{Level: 3, Title: "Value Creation Potential"},
+			{Level: 3, Title: "Solution Opportunities"},
+			{Level: 2, Title: "Solution Requirements"},
+			{Level: 3, Title: "Functional Requirements"},
+			{Level: 3, Title: "Experience Requirements"},
+			{Level: 3, Title: "Business Requirements"},
+			{Level: 2, Title: "Success Metrics"},
+			{Level: 3, Title: "Pain Reduction Metrics"},
+			{Level: 3, Title: "Solution Adoption Metrics"},
+			{Level: 2, Title: "Research Evidence"},
+			{Level: 3, Title: "Customer Research"},
+			{Level: 3, Title: "Support Data"},
+			{Level: 3, Title: "Market Research"},
+			{Level: 2, Title: "Action Planning"},
+			{Level: 3, Title: "Immediate Actions"},
+			{Level: 3, Title: "Strategic Actions"},
+			{Level: 3, Title: "Success Tracking"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Pain point statement clearly defines core problem and impact",
+			"Pain categories (functional, emotional, financial, social) analyzed",
+			"Affected customers and segments identified with context",
+			"Pain severity, frequency, and duration assessed",
+			"Root cause analysis identifies contributing factors",
+			"Customer impact quantified across productivity, emotional, financial dimensions",
+			"Current solutions and workarounds documented with effectiveness",
+			"Gap analysis reveals functional, experience, and value gaps",
+			"Opportunity assessment identifies value creation potential",
+			"Solution requirements defined with functional and experience needs",
+			"Success metrics established for pain reduction and solution adoption",
+			"Research evidence validates pain through customer data and market analysis",
+			"Action planning provides immediate and strategic response approaches",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Pain point clearly defined with core problem statement",
+				"Basic customer context and affected personas identified",
+				"Pain severity and frequency assessment documented",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive pain analysis with root cause identification",
+				"Detailed customer impact assessment across multiple dimensions",
+				"Current solutions and workarounds analysis",
+				"Gap analysis and opportunity assessment",
+				"Solution requirements and success metrics defined",
+				"Research evidence supporting pain validation",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic pain tracking with real-time customer feedback",
+				"Advanced pain analytics and trend monitoring",
+				"Systematic solution opportunity prioritization",
+				"Pain-driven product development integration",
+				"Continuous pain evolution and resolution tracking",
+				"Cross-functional pain resolution coordination",
+			}},
+		},
+	},
+	{
+		Code:         "PER",
+		Name:         "Personas",
+		Purpose:      "The Personas document creates detailed archetypal representations of key customer segments. Personas humanize customer data and provide shared understanding of who the organization serves.",
+		Domain:       "Customer & Value",
+		Dependencies: []string{"SEG"},
+		Enablements:  []string{"JTB", "USE", "CJM"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Persona Profile"},
+			{Level: 3, Title: "Basic Demographics"},
+			{Level: 3, Title: "Psychographic Profile"},
+			{Level: 3, Title: "Behavioral Patterns"},
+			{Level: 2, Title: "Context and Environment"},
+			{Level: 3, Title: "Daily Life/Work Context"},
+			{Level: 3, Title: "Constraints and Pressures"},
+			{Level: 2, Title: "Goals and Motivations"},
+			{Level: 3, Title: "Primary Goals"},
+			{Level: 3, Title: "Success Metrics"},
+			{Level: 2, Title: "Pain Points and Frustrations"},
+			{Level: 3, Title: "Current State Problems"},
+			{Level: 3, Title: "Impact of Problems"},
+			{Level: 2, Title: "Jobs-to-be-Done Connection"},
+			{Level: 3, Title: "Primary Jobs"},
+			{Level: 3, Title: "Job Context"},
+			{Level: 3, Title: "Job Constraints"},
+			{Level: 2, Title: "Decision-Making Process"},
+			{Level: 3, Title: "Decision Journey"},
+			{Level: 3, Title: "Influencers and Stakeholders"},
+			{Level: 2, Title: "Relationship with Our Solution"},
+			{Level: 3, Title: "Current Relationship"},
+			{Level: 3, Title: "Value Perception"},
+			{Level: 2, Title: "Communication and Engagement"},
+			{Level: 3, Title: "Preferred Channels"},
+			{Level: 3, Title: "Messaging Resonance"},
+			{Level: 2, Title: "Research Foundation"},
+			{Level: 3, Title: "Data Sources"},
+			{Level: 3, Title: "Validation Evidence"},
+			{Level: 3, Title: "Research Quality"},
+			{Level: 2, Title: "Persona Evolution"},
+			{Level: 3, Title: "Historical Changes"},
+			{Level: 3, Title: "Current Trends"},
+			{Level: 3, Title: "Future Considerations"},
+			{Level: 2, Title: "Usage Guidelines"},
+			{Level: 3, Title: "Application Areas"},
+			{Level: 3, Title: "Team Alignment"},
+			{Level: 2, Title: "Validation"},
+			{Level: 3, Title: "Validation Metrics"},
+			{Level: 3, Title: "Ongoing Validation"},
+		},
+		Checklist: []string{
+			"Personas based on actual customer research not assumptions",
+			"Demographics, psychographics, and behaviors documented",
+			"Pain points, goals, and motivations clearly identified",
+			"Decision-making process and influencers mapped",
+			"Current relationship with solution assessed",
+			"Communication preferences and messaging guidance provided",
+			"Research sources and validation evidence documented",
+			"Evolution tracking and update process established",
+			"Usage guidelines for cross-functional teams defined",
+			"Regular validation and refinement process implemented",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Key personas identified and categorized",
+				"Basic demographic and behavioral data documented",
+				"Core pain points and motivations captured",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive persona research with quantitative validation",
+				"Detailed behavioral patterns and decision-making process",
+				"Jobs-to-be-done connection established",
+				"Communication preferences and messaging guidance",
+				"Research methodology and validation documented",
+				"Team usage guidelines established",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic persona tracking with real-time updates",
+				"Advanced behavioral analytics integration",
+				"Predictive persona evolution modeling",
+				"Cross-functional persona utilization measurement",
+				"Continuous validation and refinement process",
+				"Persona-driven organizational decision making",
+			}},
+		},
+	},
+	{
+		Code:    "PFO",
+		Name:    "Porter's Five Forces",
+		Purpose: "Use this template to provide a defensible competitive-pressure assessment that supports strategic choices. It should be updated as market structure changes and as strategic priorities evolve.",
+		Domain:  "Market & Environment",
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Competitive Force Assessment"},
+			{Level: 3, Title: "Supplier Power"},
+			{Level: 3, Title: "Buyer Power"},
+			{Level: 3, Title: "Threat of New Entrants"},
+			{Level: 3, Title: "Threat of Substitutes"},
+			{Level: 3, Title: "Competitive Rivalry"},
+			{Level: 2, Title: "Heat Map and Priorities"},
+			{Level: 2, Title: "Strategic Linkage"},
+			{Level: 2, Title: "Governance"},
+			{Level: 2, Title: "Quality Standard"},
+		},
+	},
+	{
+		Code:         "POL",
+		Name:         "Policies",
+		Purpose:      "The Policies document defines systematic approaches to establishing, implementing, and managing organizational policies that guide behavior, ensure compliance, and mitigate risks. It establishes policy frameworks that provide clear guidance, consistent enforcement, and effective governance.",
+		Domain:       "Operations & Execution",
+		Dependencies: []string{"ROL", "PRO", "RSK", "ORG"},
+		Enablements:  []string{"PER", "QUA", "COM", "GOV"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Policy Statement"},
+			{Level: 3, Title: "Objective"},
+			{Level: 3, Title: "Policy Declaration"},
+			{Level: 2, Title: "Scope and Applicability"},
+			{Level: 3, Title: "Coverage"},
+			{Level: 3, Title: "Applicability Matrix"},
+			{Level: 3, Title: "Exceptions"},
+			{Level: 2, Title: "Requirements and Standards"},
+			{Level: 3, Title: "Mandatory Requirements"},
+			{Level: 3, Title: "Performance Standards"},
+			{Level: 2, Title: "Implementation Guidelines"},
+			{Level: 3, Title: "Implementation Framework"},
+			{Level: 3, Title: "Roles and Responsibilities"},
+			{Level: 3, Title: "Resource Requirements"},
+			{Level: 2, Title: "Procedures and Processes"},
+			{Level: 3, Title: "Standard Procedures"},
+			{Level: 3, Title: "Process Integration"},
+			{Level: 2, Title: "Compliance and Monitoring"},
+			{Level: 3, Title: "Compliance Framework"},
+			{Level: 3, Title: "Audit and Review"},
+			{Level: 2, Title: "Training and Communication"},
+			{Level: 3, Title: "Training Requirements"},
+			{Level: 3, Title: "Communication Plan"},
+			{Level: 2, Title: "Enforcement and Sanctions"},
+			{Level: 3, Title: "Violation Categories"},
+			{Level: 3, Title: "Disciplinary Framework"},
+			{Level: 2, Title: "Policy Governance"},
+			{Level: 3, Title: "Ownership and Authority"},
+			{Level: 3, Title: "Change Management"},
+			{Level: 3, Title: "Version Control"},
+			{Level: 2, Title: "Related Documents and References"},
+			{Level: 3, Title: "Supporting Documents"},
+			{Level: 3, Title: "External References"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, applicability, effective date, and review cycle",
+			"Policy statement with objective, scope, principles, and clear policy declaration",
+			"Scope and applicability with coverage, applicability matrix, and exception processes",
+			"Requirements and standards with mandatory requirements and performance standards",
+			"Implementation guidelines with framework, roles, and resource requirements",
+			"Procedures and processes with standard procedures and process integration",
+			"Compliance and monitoring with framework, methods, and audit procedures",
+			"Training and communication with requirements, plans, and awareness campaigns",
+			"Enforcement and sanctions with violation categories and disciplinary framework",
+			"Policy governance with ownership, change management, and version control",
+			"Related documents and references with supporting materials and external sources",
+			"Validation evidence of policy effectiveness, compliance achievement, and business support",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Clear policy statement with basic requirements",
+				"Defined scope and applicability",
+				"Basic compliance and monitoring approach",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive requirements with implementation guidelines",
+				"Detailed roles and responsibilities framework",
+				"Structured compliance monitoring and enforcement",
+				"Training and communication program",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced policy governance with change management",
+				"Sophisticated compliance measurement and optimization",
+				"Integration with risk management and business continuity",
+				"Data-driven policy effectiveness measurement",
+			}},
+		},
+	},
+	{
+		Code:         "POS",
+		Name:         "Positioning",
+		Purpose:      "The Positioning document defines how the organization wants to be perceived in the market relative to competitors and alternatives. It focuses on category framing, competitive map position, and market narrative; brand voice and storytelling are handled by `BPO`.",
+		Domain:       "Market & Environment",
+		Dependencies: []string{"SEG", "CMP", "VPR"},
+		Enablements:  []string{"REV", "GTM", "BMC"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Positioning Framework"},
+			{Level: 3, Title: "Positioning Statement"},
+			{Level: 3, Title: "Positioning Elements"},
+			{Level: 2, Title: "Positioning Strategy"},
+			{Level: 3, Title: "Strategic Positioning Choice"},
+			{Level: 3, Title: "Positioning Architecture"},
+			{Level: 2, Title: "Competitive Positioning"},
+			{Level: 3, Title: "Competitive Context"},
+			{Level: 3, Title: "Positioning Map"},
+			{Level: 2, Title: "Market Perception"},
+			{Level: 3, Title: "Current Perception"},
+			{Level: 3, Title: "Perception Building Strategy"},
+			{Level: 2, Title: "Positioning Implementation"},
+			{Level: 3, Title: "Internal Alignment"},
+			{Level: 3, Title: "External Execution"},
+			{Level: 2, Title: "Positioning Evolution"},
+			{Level: 3, Title: "Market Response"},
+			{Level: 3, Title: "Positioning Adjustments"},
+			{Level: 3, Title: "Future Positioning"},
+			{Level: 3, Title: "Competitive Response"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Positioning statement is clear, compelling, and distinctive",
+			"Target customer and competitive frame are well-defined",
+			"Unique value proposition is authentic and deliverable",
+			"Competitive differentiation is sustainable and meaningful",
+			"Evidence and proof points support positioning claims",
+			"Message hierarchy addresses different audiences and use cases",
+			"Internal alignment ensures organizational delivery on positioning",
+			"External execution plan covers all customer touchpoints",
+			"Market perception tracking measures positioning effectiveness",
+			"Competitive response is monitored and addressed",
+			"Positioning evolution process adapts to market changes",
+			"Customer validation confirms positioning resonance",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Clear positioning statement defined",
+				"Basic positioning elements identified",
+				"Target customer and competitive frame established",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive positioning strategy with competitive context",
+				"Market perception analysis and building strategy",
+				"Positioning implementation plan for internal and external execution",
+				"Message hierarchy and audience adaptation",
+				"Evidence and proof points supporting positioning",
+				"Initial market validation of positioning effectiveness",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic positioning evolution based on market feedback",
+				"Comprehensive market perception tracking",
+				"Advanced positioning measurement and optimization",
+				"Full organizational alignment on positioning delivery",
+				"Competitive response monitoring and adaptation",
+				"Integrated positioning across all customer touchpoints",
+			}},
+		},
+	},
+	{
+		Code:    "PPL",
+		Name:    "People Strategy",
+		Purpose: "People Strategy governs how the organization attracts, develops, rewards, evaluates, and retains talent across functions. It is distinct from `SKI`, `ROL`, and `TEA` by handling cross-functional people policies and workforce planning.",
+		Domain:  "Operations & Execution",
+		Sections: []SectionTemplate{
+			{Level: 3, Title: "People Architecture"},
+			{Level: 3, Title: "People Lifecycle"},
+			{Level: 3, Title: "People Governance"},
+		},
+		Checklist: []string{
+			"People strategy is linked to role model and capability requirements.",
+			"Hiring and development plans map to growth/transition priorities.",
+			"Compensation and performance frameworks are explicit and auditable.",
+			"Succession and retention risks are tracked and owned.",
+			"Governance interfaces to policy and finance are clear.",
+		},
+	},
+	{
+		Code:         "PRD",
+		Name:         "Product Requirements Document",
+		Purpose:      "The Product Requirements Document defines product-level requirements, capturing the outcome-oriented problem, value proposition, and strategic fit of a product initiative. It is the authoritative upstream artifact for product planning; teams should use `FEA` for feature-level specification and `REQ` for system-implementable, traceable technical requirements.",
+		Domain:       "Product & Service",
+		Dependencies: []string{"PER", "JTB", "GAI", "PAI"},
+		Enablements:  []string{"FEA", "REQ", "UXD"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Market Context"},
+			{Level: 3, Title: "Target Market"},
+			{Level: 3, Title: "Customer Problem"},
+			{Level: 2, Title: "Product Definition"},
+			{Level: 3, Title: "Value Proposition"},
+			{Level: 3, Title: "Product Vision"},
+			{Level: 2, Title: "Functional Requirements"},
+			{Level: 3, Title: "Core Features"},
+			{Level: 3, Title: "User Experience Requirements"},
+			{Level: 3, Title: "Integration Requirements"},
+			{Level: 2, Title: "Non-Functional Requirements"},
+			{Level: 3, Title: "Performance Requirements"},
+			{Level: 3, Title: "Quality Attributes"},
+			{Level: 3, Title: "Compliance Requirements"},
+			{Level: 2, Title: "Success Metrics"},
+			{Level: 3, Title: "Business Metrics"},
+			{Level: 3, Title: "Product Metrics"},
+			{Level: 3, Title: "Operational Metrics"},
+			{Level: 2, Title: "Risk Assessment"},
+			{Level: 3, Title: "Product Risks"},
+			{Level: 3, Title: "Mitigation Strategies"},
+			{Level: 2, Title: "Implementation Approach"},
+			{Level: 3, Title: "Development Methodology"},
+			{Level: 3, Title: "Resource Requirements"},
+			{Level: 3, Title: "Dependencies and Constraints"},
+			{Level: 2, Title: "Assumptions and Constraints"},
+			{Level: 3, Title: "Key Assumptions"},
+			{Level: 3, Title: "Known Constraints"},
+			{Level: 2, Title: "Validation Plan"},
+			{Level: 3, Title: "Customer Validation"},
+			{Level: 3, Title: "Technical Validation"},
+			{Level: 3, Title: "Business Validation"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with problem statement, solution overview, and success criteria",
+			"Market context with target market and customer problem analysis",
+			"Product definition with value proposition and product vision",
+			"Functional requirements with core features and user experience specifications",
+			"Non-functional requirements including performance, quality, and compliance",
+			"Success metrics across business, product, and operational dimensions",
+			"Risk assessment with mitigation strategies",
+			"Implementation approach with methodology and resource requirements",
+			"Assumptions and constraints documentation",
+			"Validation plan covering customer, technical, and business validation",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic problem statement and solution overview",
+				"High-level feature list with priorities",
+				"Basic success metrics identified",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Detailed market research and competitive analysis",
+				"Comprehensive functional and non-functional requirements",
+				"Risk assessment with mitigation strategies",
+				"Clear validation plan",
+				"Implementation approach with resource requirements",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Evidence-based customer problem validation",
+				"Detailed user experience and interaction design",
+				"Comprehensive metrics framework with baselines",
+				"Continuous requirements evolution and validation",
+				"Cross-functional stakeholder alignment",
+			}},
+		},
+	},
+	{
+		Code:         "PRF",
+		Name:         "Performance Marketing",
+		Purpose:      "The Performance Marketing document defines data-driven marketing strategies focused on measurable outcomes and return on investment. It establishes performance frameworks that optimize marketing spend, maximize conversions, and deliver accountable results through systematic testing, measurement, and optimization across all marketing channels.",
+		Domain:       "Brand & Marketing",
+		Dependencies: []string{"CHN", "CAM", "ANA", "CUS"},
+		Enablements:  []string{"LED", "CON", "ROI", "GRO"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Performance Marketing Framework"},
+			{Level: 3, Title: "Performance Philosophy"},
+			{Level: 3, Title: "Performance Foundation"},
+			{Level: 3, Title: "Performance Marketing Architecture"},
+			{Level: 2, Title: "Paid Acquisition Strategy"},
+			{Level: 3, Title: "Paid Search Marketing"},
+			{Level: 3, Title: "Paid Social Marketing"},
+			{Level: 3, Title: "Display and Programmatic Advertising"},
+			{Level: 2, Title: "Conversion Rate Optimization"},
+			{Level: 3, Title: "CRO Strategy Framework"},
+			{Level: 3, Title: "User Experience Optimization"},
+			{Level: 3, Title: "Testing and Experimentation"},
+			{Level: 2, Title: "Attribution and Analytics"},
+			{Level: 3, Title: "Attribution Modeling"},
+			{Level: 3, Title: "Performance Analytics"},
+			{Level: 3, Title: "Advanced Measurement"},
+			{Level: 2, Title: "Performance Optimization"},
+			{Level: 3, Title: "Optimization Strategy"},
+			{Level: 3, Title: "Channel-Specific Optimization"},
+			{Level: 3, Title: "Technology and Automation"},
+			{Level: 2, Title: "ROI and Financial Performance"},
+			{Level: 3, Title: "ROI Measurement Framework"},
+			{Level: 3, Title: "Financial Planning and Forecasting"},
+			{Level: 3, Title: "Scale and Growth Strategy"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, channels, attribution model, and optimization strategy",
+			"Marketing framework with philosophy, foundation, and architecture",
+			"Paid acquisition strategy with search, social, and display advertising approaches",
+			"Conversion rate optimization with strategy framework, UX optimization, and testing",
+			"Attribution and analytics with modeling, performance analytics, and advanced measurement",
+			"Performance optimization with strategy, channel-specific optimization, and technology",
+			"ROI and financial performance with measurement framework, planning, and scaling strategy",
+			"Validation evidence of measurable ROI delivery, efficiency improvement, and scalable growth enabling",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic performance marketing strategy with key channels and measurement",
+				"Simple optimization approach and basic ROI tracking",
+				"Basic attribution model and performance reporting",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive performance marketing strategy with advanced measurement",
+				"Structured optimization framework with systematic testing",
+				"Active performance management with advanced analytics and attribution",
+				"Regular strategy review and optimization based on performance data",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced performance marketing capabilities with sophisticated optimization",
+				"Comprehensive performance ecosystem with predictive analytics and automation",
+				"Performance marketing excellence with industry-leading efficiency and ROI",
+				"Strategic performance marketing driving scalable business growth",
+			}},
+		},
+	},
+	{
+		Code:         "PRI",
+		Name:         "Pricing Strategy",
+		Purpose:      "The Pricing Strategy defines systematic approaches to product and service pricing that optimize value capture while supporting competitive positioning and business objectives. It establishes pricing frameworks that balance customer value, market dynamics, and financial goals.",
+		Domain:       "Business Model",
+		Dependencies: []string{"REV", "VAL", "CMP", "SEG"},
+		Enablements:  []string{"CST", "KPT", "CHN", "CUS"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Pricing Overview"},
+			{Level: 2, Title: "Pricing Strategy Foundation"},
+			{Level: 3, Title: "Strategic Objectives"},
+			{Level: 3, Title: "Value Proposition Analysis"},
+			{Level: 3, Title: "Customer Willingness to Pay"},
+			{Level: 2, Title: "Pricing Model Design"},
+			{Level: 3, Title: "Pricing Structure"},
+			{Level: 3, Title: "Pricing Components"},
+			{Level: 3, Title: "Price Differentiation"},
+			{Level: 2, Title: "Competitive Pricing Analysis"},
+			{Level: 3, Title: "Competitive Landscape"},
+			{Level: 3, Title: "Price Positioning"},
+			{Level: 3, Title: "Competitive Response"},
+			{Level: 2, Title: "Cost Analysis and Margins"},
+			{Level: 3, Title: "Cost Structure"},
+			{Level: 3, Title: "Margin Analysis"},
+			{Level: 3, Title: "Break-Even Analysis"},
+			{Level: 2, Title: "Dynamic Pricing Considerations"},
+			{Level: 3, Title: "Market Dynamics"},
+			{Level: 3, Title: "Dynamic Pricing Mechanisms"},
+			{Level: 3, Title: "Price Optimization"},
+			{Level: 2, Title: "Customer Segmentation and Pricing"},
+			{Level: 3, Title: "Segment-Based Pricing"},
+			{Level: 3, Title: "Geographic Pricing"},
+			{Level: 2, Title: "Pricing Implementation"},
+			{Level: 3, Title: "Pricing Operations"},
+			{Level: 3, Title: "Sales and Pricing"},
+			{Level: 3, Title: "Deal Desk and Contract Terms"},
+			{Level: 3, Title: "Technology and Systems"},
+			{Level: 2, Title: "Pricing Metrics and Analytics"},
+			{Level: 3, Title: "Pricing Performance Metrics"},
+			{Level: 3, Title: "Customer Response Metrics"},
+			{Level: 3, Title: "Financial Impact Metrics"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 3, Title: "Pricing Risks"},
+			{Level: 3, Title: "Risk Mitigation"},
+			{Level: 3, Title: "Scenario Planning"},
+			{Level: 2, Title: "Pricing Evolution"},
+			{Level: 3, Title: "Pricing Lifecycle"},
+			{Level: 3, Title: "Pricing Innovation"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Pricing overview with clear purpose, strategic objectives, market position, and value philosophy",
+			"Pricing strategy foundation with objectives, value proposition analysis, and customer willingness to pay",
+			"Pricing model design with structure, components, and differentiation strategies",
+			"Competitive pricing analysis with landscape assessment, positioning, and response strategies",
+			"Cost analysis and margins with structure, margin analysis, and break-even calculations",
+			"Dynamic pricing considerations with market dynamics, mechanisms, and optimization approaches",
+			"Customer segmentation and pricing with segment-specific strategies and geographic considerations",
+			"Pricing implementation with operations, sales integration, and technology systems",
+			"Pricing metrics and analytics with performance measurement and optimization tracking",
+			"Risk management with comprehensive risk identification, mitigation, and scenario planning",
+			"Pricing evolution with lifecycle management, innovation opportunities, and continuous improvement",
+			"Validation evidence of pricing effectiveness, competitive advantage, and optimization success",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Pricing overview with purpose, strategic objectives, and market position",
+				"Pricing strategy foundation with objectives and value proposition analysis",
+				"Basic pricing model design with structure and components",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive competitive pricing analysis with landscape assessment and positioning",
+				"Cost analysis and margins with break-even analysis and sensitivity testing",
+				"Dynamic pricing considerations with market dynamics and optimization strategies",
+				"Customer segmentation and pricing with segment-specific approaches",
+				"Pricing implementation with operations, sales integration, and technology systems",
+				"Pricing metrics and analytics with performance tracking and optimization",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced pricing evolution with lifecycle management and innovation roadmap",
+				"AI-driven pricing optimization with real-time market responsiveness",
+				"Predictive pricing analytics with demand forecasting and elasticity modeling",
+				"Dynamic competitive intelligence with automated market monitoring",
+				"Integrated pricing ecosystem with seamless sales and billing operations",
+				"Advanced risk management with scenario modeling and automated adjustments",
+			}},
+		},
+	},
+	{
+		Code:         "PRO",
+		Name:         "Process Specification",
+		Purpose:      "The Process Specification defines systematic approaches to executing business activities through documented, repeatable, and optimized processes. It establishes process frameworks that ensure consistent execution, continuous improvement, and strategic alignment.",
+		Domain:       "Operations & Execution",
+		Dependencies: []string{"STR", "OBJ", "KAC", "VAL"},
+		Enablements:  []string{"PER", "QUA", "SVC", "ARC"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Process Overview"},
+			{Level: 2, Title: "Process Context"},
+			{Level: 3, Title: "Business Context"},
+			{Level: 3, Title: "Process Classification"},
+			{Level: 2, Title: "Process Definition"},
+			{Level: 3, Title: "Process Inputs"},
+			{Level: 3, Title: "Process Outputs"},
+			{Level: 3, Title: "Process Activities"},
+			{Level: 3, Title: "Process Flow"},
+			{Level: 2, Title: "Roles and Responsibilities"},
+			{Level: 3, Title: "Process Roles"},
+			{Level: 3, Title: "RACI Matrix"},
+			{Level: 2, Title: "Process Performance"},
+			{Level: 3, Title: "Key Performance Indicators (KPIs)"},
+			{Level: 3, Title: "Performance Targets"},
+			{Level: 2, Title: "Process Controls"},
+			{Level: 3, Title: "Quality Controls"},
+			{Level: 3, Title: "Risk Controls"},
+			{Level: 3, Title: "Compliance Controls"},
+			{Level: 2, Title: "Process Improvement"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Innovation Opportunities"},
+			{Level: 3, Title: "Change Management"},
+			{Level: 2, Title: "Technology and Tools"},
+			{Level: 3, Title: "Supporting Technology"},
+			{Level: 3, Title: "Data and Information"},
+			{Level: 2, Title: "Documentation and Training"},
+			{Level: 3, Title: "Process Documentation"},
+			{Level: 3, Title: "Training and Competency"},
+			{Level: 2, Title: "Governance and Oversight"},
+			{Level: 3, Title: "Process Governance"},
+			{Level: 3, Title: "Compliance and Audit"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Process overview with clear purpose, scope, strategic value, and customer impact",
+			"Process context with business alignment, classification, and maturity assessment",
+			"Process definition with comprehensive inputs, outputs, activities, and flow",
+			"Roles and responsibilities with clear ownership and RACI matrix",
+			"Process performance with KPIs, targets, and business impact metrics",
+			"Process controls with quality, risk, and compliance measures",
+			"Process improvement with continuous improvement and innovation approaches",
+			"Technology and tools with supporting systems and data requirements",
+			"Documentation and training with competency standards and knowledge management",
+			"Governance and oversight with framework, decision rights, and compliance",
+			"Validation evidence of process effectiveness, efficiency, and strategic alignment",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Process overview with purpose, scope, and strategic value",
+				"Process context with business alignment and classification",
+				"Basic process definition with inputs, outputs, and key activities",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive roles and responsibilities with RACI matrix",
+				"Process performance metrics with KPIs and targets",
+				"Process controls with quality, risk, and compliance measures",
+				"Process improvement framework with continuous improvement approach",
+				"Technology and tools with supporting systems and data requirements",
+				"Documentation and training with competency standards",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced governance and oversight with comprehensive framework",
+				"AI-driven process optimization with predictive analytics and automated improvement",
+				"Real-time process monitoring with dynamic performance adjustment",
+				"Integrated process ecosystem with seamless cross-process coordination",
+				"Advanced change management with intelligent impact assessment",
+				"Continuous process innovation with automated optimization recommendations",
+			}},
+		},
+	},
+	{
+		Code:    "PRV",
+		Name:    "Privacy Program",
+		Purpose: "Privacy Program defines lawful data processing practices, privacy rights management, and regulator-facing controls for privacy obligations. It complements security (`SEC-*`) by focusing on rights, consent, and purpose discipline.",
+		Domain:  "Risk & Governance",
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Program Structure"},
+			{Level: 2, Title: "Jurisdictional Context"},
+		},
+		Checklist: []string{
+			"Data processing purposes are explicitly defined and traceable.",
+			"Legal basis is documented for each sensitive data stream.",
+			"Privacy rights workflows are testable and measurable.",
+			"Transfer and third-party controls are explicitly governed.",
+			"Incident and breach pathways are connected to governance routines.",
+		},
+	},
+	{
+		Code:         "PSP",
+		Name:         "Performance Specification",
+		Purpose:      "The Performance Specification defines comprehensive performance requirements, targets, and measurement frameworks for systems, applications, and services. It ensures optimal system performance that meets user expectations, business objectives, and technical scalability requirements.",
+		Domain:       "Product & Service",
+		Dependencies: []string{"REQ", "FEA", "QUA"},
+		Enablements:  []string{"SUP", "INT", "UXD"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Performance Overview"},
+			{Level: 2, Title: "Performance Objectives"},
+			{Level: 3, Title: "Business Performance Objectives"},
+			{Level: 3, Title: "Technical Performance Objectives"},
+			{Level: 3, Title: "User Performance Objectives"},
+			{Level: 2, Title: "Performance Requirements"},
+			{Level: 3, Title: "Response Time Requirements"},
+			{Level: 3, Title: "Throughput Requirements"},
+			{Level: 3, Title: "Scalability Requirements"},
+			{Level: 3, Title: "Resource Utilization Requirements"},
+			{Level: 2, Title: "Performance Measurement"},
+			{Level: 3, Title: "Performance Metrics"},
+			{Level: 3, Title: "Measurement Tools"},
+			{Level: 3, Title: "Performance Baselines"},
+			{Level: 2, Title: "Performance Testing"},
+			{Level: 3, Title: "Testing Strategy"},
+			{Level: 3, Title: "Test Planning"},
+			{Level: 3, Title: "Test Execution"},
+			{Level: 2, Title: "Performance Optimization"},
+			{Level: 3, Title: "Optimization Strategy"},
+			{Level: 3, Title: "Optimization Areas"},
+			{Level: 2, Title: "Performance Monitoring and Alerting"},
+			{Level: 3, Title: "Monitoring Strategy"},
+			{Level: 3, Title: "Alert Management"},
+			{Level: 2, Title: "Performance Governance"},
+			{Level: 3, Title: "Performance Standards"},
+			{Level: 3, Title: "Performance Culture"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 3, Title: "Performance Risks"},
+			{Level: 3, Title: "Risk Mitigation"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Performance overview with clear purpose, scope, philosophy, and business impact",
+			"Performance objectives covering business, technical, and user dimensions",
+			"Performance requirements with response time, throughput, scalability, and resource utilization",
+			"Performance measurement with metrics, tools, and baseline establishment",
+			"Performance testing strategy with comprehensive testing approach and execution",
+			"Performance optimization with strategy, focus areas, and continuous improvement",
+			"Performance monitoring and alerting with real-time capabilities and incident response",
+			"Performance governance with standards, culture, and organizational alignment",
+			"Risk management addressing capacity, scalability, and degradation risks",
+			"Validation evidence of performance specification effectiveness and continuous optimization",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Performance overview with purpose, scope, and business impact",
+				"Basic performance objectives and requirements",
+				"Core performance metrics and measurement approach",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive performance requirements across response time, throughput, and scalability",
+				"Performance measurement framework with metrics, tools, and baselines",
+				"Performance testing strategy with planning and execution approach",
+				"Performance optimization strategy with areas and methods",
+				"Performance monitoring and alerting with real-time capabilities",
+				"Performance governance with standards and culture",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced performance optimization with AI-driven insights and automated tuning",
+				"Predictive performance management with capacity forecasting and proactive scaling",
+				"Continuous performance culture with organization-wide performance mindset",
+				"Real-time performance adaptation based on usage patterns and system behavior",
+				"Integrated performance ecosystem with cross-system optimization",
+				"Advanced performance analytics with trend prediction and automatic optimization",
+			}},
+		},
+	},
+	{
+		Code:         "PUR",
+		Name:         "Organizational Purpose",
+		Purpose:      "The Purpose document articulates the organization's social impact and stakeholder value beyond profit. It defines the broader positive change the organization creates in the world.",
+		Domain:       "Strategic Foundation",
+		Dependencies: []string{"MSN", "VAL"},
+		Enablements:  []string{"THY", "ETH", "STA"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Purpose Statement"},
+			{Level: 2, Title: "Stakeholder Value Creation"},
+			{Level: 3, Title: "Primary Beneficiaries"},
+			{Level: 3, Title: "Value Creation Mechanisms"},
+			{Level: 2, Title: "Theory of Change"},
+			{Level: 3, Title: "Problem We Address"},
+			{Level: 3, Title: "Our Contribution"},
+			{Level: 3, Title: "Intended Outcomes"},
+			{Level: 3, Title: "Impact Measurement"},
+			{Level: 2, Title: "Purpose Integration"},
+			{Level: 3, Title: "Business Model Alignment"},
+			{Level: 3, Title: "Decision Framework"},
+			{Level: 3, Title: "Stakeholder Engagement"},
+			{Level: 2, Title: "Impact Measurement"},
+			{Level: 3, Title: "Quantitative Metrics"},
+			{Level: 3, Title: "Qualitative Evidence"},
+			{Level: 3, Title: "Third-Party Validation"},
+			{Level: 2, Title: "Purpose Evolution"},
+			{Level: 3, Title: "Origin and Development"},
+			{Level: 3, Title: "Future Aspirations"},
+			{Level: 3, Title: "Stakeholder Feedback"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Purpose statement is clear and compelling",
+			"Primary beneficiaries specifically identified",
+			"Value creation mechanisms described",
+			"Business model alignment demonstrated",
+			"Impact measurement framework defined",
+			"Decision framework incorporates purpose",
+			"Stakeholder engagement strategy outlined",
+			"Quantitative and qualitative evidence provided",
+			"Third-party validation sought or obtained",
+			"Purpose evolution and feedback integration",
+			"Evidence of authentic and effective purpose",
+			"Regular review and updating process established",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Clear purpose statement articulated",
+				"Primary beneficiaries identified",
+				"Basic impact description provided",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive stakeholder value analysis",
+				"Business model alignment demonstrated",
+				"Impact measurement framework defined",
+				"Purpose integration in decision making",
+				"Stakeholder engagement strategy outlined",
+				"Evidence of authentic impact provided",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Regular impact measurement and reporting",
+				"Third-party validation of purpose claims",
+				"Purpose evolution history documented",
+				"Comprehensive stakeholder feedback integration",
+				"Purpose-driven decision framework implemented",
+				"Cultural integration practices established",
+			}},
+		},
+	},
+	{
+		Code:         "QUA",
+		Name:         "Quality Specification",
+		Purpose:      "The Quality Specification defines comprehensive quality standards, metrics, and assurance processes for products, services, and systems. It establishes quality frameworks that ensure consistent delivery of value while meeting stakeholder expectations and regulatory requirements.",
+		Domain:       "Product & Service",
+		Dependencies: []string{"REQ", "FEA", "ROD"},
+		Enablements:  []string{"PER", "UXD", "SUP"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Framework and Attribution"},
+			{Level: 2, Title: "Quality Overview"},
+			{Level: 2, Title: "Quality Framework"},
+			{Level: 3, Title: "Quality Model"},
+			{Level: 3, Title: "Quality Objectives"},
+		},
+		Checklist: []string{
+			"Quality overview with clear purpose, scope, philosophy, and success definition",
+			"Quality framework with comprehensive model and stakeholder-driven objectives",
+			"Quality standards covering both internal development and external product/service quality",
+			"Quality metrics across product functionality, performance, reliability, and customer satisfaction",
+			"Quality assurance processes with planning, control, and continuous improvement",
+			"Quality testing strategy with comprehensive approach, planning, and execution",
+			"Quality monitoring and measurement with real-time and periodic assessment",
+			"Quality roles and responsibilities with clear organization and culture",
+			"Quality risk management with comprehensive risk identification and mitigation",
+			"Quality tools and technology with appropriate infrastructure and automation",
+			"Compliance and standards with industry alignment and verification processes",
+			"Validation evidence of quality specification effectiveness and continuous improvement",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Quality overview with purpose, scope, and philosophy",
+				"Basic quality framework with model and objectives",
+				"Core quality standards for internal and external quality",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive quality metrics across product, process, and customer dimensions",
+				"Quality assurance processes with planning, control, and improvement",
+				"Quality testing strategy with approach, planning, and execution",
+				"Quality monitoring and measurement with real-time and periodic assessment",
+				"Quality roles, responsibilities, and risk management",
+				"Quality tools, technology, and compliance framework",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic quality optimization with AI-driven insights and automated improvement",
+				"Predictive quality management with early issue detection and prevention",
+				"Continuous quality culture evolution with advanced training and recognition",
+				"Real-time quality adaptation based on customer behavior and system performance",
+				"Integrated quality ecosystem with cross-functional collaboration",
+				"Advanced quality analytics with trend prediction and optimization recommendations",
+			}},
+		},
+	},
+	{
+		Code:         "REG",
+		Name:         "Regulatory Environment",
+		Purpose:      "The Regulatory Environment document analyzes laws, regulations, and compliance requirements affecting the business. It tracks regulatory changes and assesses compliance obligations.",
+		Domain:       "Market & Environment",
+		Dependencies: []string{"MKT", "STR"},
+		Enablements:  []string{"CMP", "RSK", "POL"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Regulatory Framework"},
+			{Level: 3, Title: "Scope and Bodies"},
+			{Level: 3, Title: "Current Requirements"},
+			{Level: 3, Title: "Licensing and Permits"},
+			{Level: 3, Title: "Reporting and Disclosure"},
+			{Level: 2, Title: "Geographic Analysis"},
+			{Level: 3, Title: "Primary Markets"},
+			{Level: 2, Title: "Regulatory Change Analysis"},
+			{Level: 3, Title: "Pending Changes"},
+			{Level: 3, Title: "Trends and Evolution"},
+			{Level: 3, Title: "Impact Assessment"},
+			{Level: 2, Title: "Compliance Strategy"},
+			{Level: 3, Title: "Framework and Organization"},
+			{Level: 3, Title: "Risk Management"},
+			{Level: 3, Title: "Monitoring and Performance"},
+			{Level: 2, Title: "Regulatory Intelligence"},
+			{Level: 3, Title: "Information Sources and Process"},
+			{Level: 3, Title: "Stakeholder Engagement"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Regulatory scope and applicability clearly defined",
+			"Core compliance areas comprehensively analyzed",
+			"Licensing and permit requirements documented",
+			"Reporting and disclosure obligations established",
+			"Geographic regulatory variations addressed",
+			"Regulatory change monitoring system operational",
+			"Compliance strategy and organization defined",
+			"Risk assessment and mitigation measures implemented",
+			"Regulatory intelligence process established",
+			"Stakeholder engagement and industry coordination active",
+			"Regular review and update process implemented",
+			"Business integration ensures strategic alignment",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Core regulatory requirements identified",
+				"Basic compliance obligations documented",
+				"Key regulatory bodies and contacts established",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive regulatory landscape mapping",
+				"Detailed compliance framework and controls",
+				"Regulatory change monitoring system",
+				"Risk assessment and mitigation strategies",
+				"Stakeholder engagement strategy",
+				"Geographic analysis for major markets",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic regulatory intelligence system",
+				"Advanced compliance monitoring and automation",
+				"Proactive regulatory engagement program",
+				"Integrated business planning with regulatory considerations",
+				"Cross-jurisdictional compliance optimization",
+				"Regulatory trend anticipation and preparation",
+			}},
+		},
+	},
+	{
+		Code:         "REP",
+		Name:         "Reporting",
+		Purpose:      "The Reporting document defines systematic approaches to financial and business reporting that provide stakeholders with accurate, timely, and relevant information for decision making. It establishes reporting frameworks that ensure regulatory compliance, transparency, and effective communication of business performance.",
+		Domain:       "Financial & Investment",
+		Dependencies: []string{"MET", "FIN", "BUD", "AUD"},
+		Enablements:  []string{"GOV", "COM", "INV", "STR"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Reporting Framework"},
+			{Level: 3, Title: "Reporting Philosophy"},
+			{Level: 3, Title: "Reporting Standards"},
+			{Level: 3, Title: "Stakeholder Requirements"},
+			{Level: 2, Title: "Financial Reporting"},
+			{Level: 3, Title: "Primary Financial Statements"},
+			{Level: 3, Title: "Financial Statement Notes"},
+			{Level: 3, Title: "Segment Reporting"},
+			{Level: 2, Title: "Management Reporting"},
+			{Level: 3, Title: "Management Dashboard"},
+			{Level: 3, Title: "Budget and Forecast Reporting"},
+			{Level: 3, Title: "Business Unit Reporting"},
+			{Level: 2, Title: "Regulatory Reporting"},
+			{Level: 3, Title: "SEC Reporting"},
+			{Level: 3, Title: "Tax Reporting"},
+			{Level: 3, Title: "Industry-Specific Reporting"},
+			{Level: 2, Title: "Investor Reporting"},
+			{Level: 3, Title: "Investor Communications"},
+			{Level: 3, Title: "Performance Metrics"},
+			{Level: 3, Title: "ESG Reporting"},
+			{Level: 2, Title: "Reporting Technology and Automation"},
+			{Level: 3, Title: "Reporting Systems"},
+			{Level: 3, Title: "Data Management"},
+			{Level: 3, Title: "Report Distribution"},
+			{Level: 2, Title: "Quality Control and Assurance"},
+			{Level: 3, Title: "Reporting Controls"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Compliance and Risk Management"},
+			{Level: 3, Title: "Regulatory Compliance"},
+			{Level: 3, Title: "Risk Management"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, reporting type, audience, and standards",
+			"Reporting framework with philosophy, standards, and stakeholder requirements",
+			"Financial reporting with primary statements, notes, and segment reporting",
+			"Management reporting with dashboard, budget variance, and business unit reports",
+			"Regulatory reporting with SEC, tax, and industry-specific requirements",
+			"Investor reporting with communications, performance metrics, and ESG reporting",
+			"Technology and automation with systems, data management, and distribution",
+			"Quality control with reporting controls and continuous improvement",
+			"Compliance and risk management with regulatory compliance and risk management",
+			"Validation evidence of reporting accuracy, stakeholder satisfaction, and decision support",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic financial statements with manual preparation",
+				"Simple management reports with key metrics",
+				"Basic regulatory compliance and filing",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive financial reporting with detailed analysis",
+				"Automated management reporting with dashboards",
+				"Full regulatory compliance with quality controls",
+				"Stakeholder-specific reporting and communication",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced reporting analytics with real-time capabilities",
+				"Sophisticated automation with self-service access",
+				"Comprehensive ESG and sustainability reporting",
+				"Strategic reporting integration with continuous improvement",
+			}},
+		},
+	},
+	{
+		Code:         "REQ",
+		Name:         "Requirements Specification",
+		Purpose:      "The Requirements Specification defines comprehensive, system-level functional and non-functional requirements with traceability and testability as first-class constraints. It is the implementation contract downstream of `FEA`, while `USE` and `STO` provide user-facing narrative variants of requirements.",
+		Domain:       "Product & Service",
+		Dependencies: []string{"PRD", "FEA", "USE"},
+		Enablements:  []string{"QUA", "UXD", "PER"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Requirements Overview"},
+			{Level: 2, Title: "Stakeholder Analysis"},
+			{Level: 3, Title: "Primary Stakeholders"},
+			{Level: 3, Title: "Secondary Stakeholders"},
+			{Level: 2, Title: "Functional Requirements"},
+			{Level: 3, Title: "Core Functions"},
+			{Level: 3, Title: "User Interface Requirements"},
+			{Level: 3, Title: "Data Management Requirements"},
+			{Level: 3, Title: "Integration Requirements"},
+			{Level: 2, Title: "Non-Functional Requirements"},
+			{Level: 3, Title: "Performance Requirements"},
+			{Level: 3, Title: "Scalability Requirements"},
+			{Level: 3, Title: "Reliability Requirements"},
+			{Level: 3, Title: "Security Requirements"},
+			{Level: 3, Title: "Usability Requirements"},
+			{Level: 2, Title: "Quality Requirements"},
+			{Level: 3, Title: "Maintainability Requirements"},
+			{Level: 3, Title: "Portability Requirements"},
+			{Level: 3, Title: "Compatibility Requirements"},
+			{Level: 2, Title: "Constraint Requirements"},
+			{Level: 3, Title: "Technical Constraints"},
+			{Level: 3, Title: "Business Constraints"},
+			{Level: 3, Title: "Environmental Constraints"},
+			{Level: 2, Title: "Compliance Requirements"},
+			{Level: 3, Title: "Regulatory Compliance"},
+			{Level: 3, Title: "Security Compliance"},
+			{Level: 3, Title: "Quality Standards Compliance"},
+			{Level: 2, Title: "Requirements Validation"},
+			{Level: 3, Title: "Validation Criteria"},
+			{Level: 3, Title: "Validation Methods"},
+			{Level: 3, Title: "Traceability Matrix"},
+			{Level: 2, Title: "Risk Analysis"},
+			{Level: 3, Title: "Requirements Risks"},
+			{Level: 3, Title: "Technical Risks"},
+			{Level: 2, Title: "Change Management"},
+			{Level: 3, Title: "Change Control Process"},
+			{Level: 3, Title: "Version Control"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Requirements overview with clear purpose, scope, context, and stakeholder identification",
+			"Stakeholder analysis covering primary and secondary stakeholders with interests and constraints",
+			"Comprehensive functional requirements with core functions, UI, data management, and integration",
+			"Complete non-functional requirements covering performance, scalability, reliability, security, and usability",
+			"Quality requirements addressing maintainability, portability, and compatibility",
+			"Constraint requirements covering technical, business, and environmental limitations",
+			"Compliance requirements addressing regulatory, security, and quality standards",
+			"Requirements validation with criteria, methods, and traceability matrix",
+			"Risk analysis covering requirements and technical risks with mitigation strategies",
+			"Change management with control process and version management",
+			"Validation evidence of completeness, clarity, and verifiability",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Requirements overview with purpose, scope, and stakeholder identification",
+				"Core functional requirements with basic acceptance criteria",
+				"Key non-functional requirements for performance and security",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive stakeholder analysis with interests and constraints",
+				"Detailed functional requirements across all system areas",
+				"Complete non-functional requirements covering all quality attributes",
+				"Quality, constraint, and compliance requirements",
+				"Requirements validation criteria and methods",
+				"Risk analysis with mitigation strategies",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Full traceability matrix linking business needs to implementation",
+				"Comprehensive change management and version control",
+				"Automated requirements validation and testing integration",
+				"Real-time requirements compliance monitoring",
+				"Continuous requirements optimization based on system performance",
+				"Cross-functional requirements alignment and stakeholder collaboration",
+			}},
+		},
+	},
+	{
+		Code:         "RET",
+		Name:         "Retrospective Analysis",
+		Purpose:      "The Retrospective Analysis document captures structured reflection on completed projects, initiatives, or time periods to identify successes, failures, lessons learned, and improvement opportunities. It establishes retrospective frameworks that promote continuous learning, team development, and organizational improvement through systematic reflection and analysis.",
+		Domain:       "Learning & Decisions",
+		Dependencies: []string{"PRO", "OBJ", "MET", "PRJ"},
+		Enablements:  []string{"LRN", "PRO", "POL", "THY"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Retrospective Framework"},
+			{Level: 3, Title: "Retrospective Philosophy"},
+			{Level: 3, Title: "Retrospective Foundation"},
+			{Level: 3, Title: "Retrospective Architecture"},
+			{Level: 2, Title: "Retrospective Process and Methodology"},
+			{Level: 3, Title: "Retrospective Planning and Preparation"},
+			{Level: 3, Title: "Retrospective Facilitation Structure"},
+			{Level: 3, Title: "Retrospective Analysis Framework"},
+			{Level: 2, Title: "Retrospective Outcomes and Action Planning"},
+			{Level: 3, Title: "Learning Synthesis"},
+			{Level: 3, Title: "Improvement Action Planning"},
+			{Level: 3, Title: "Implementation and Follow-Through"},
+			{Level: 2, Title: "Retrospective Quality and Effectiveness"},
+			{Level: 3, Title: "Retrospective Quality Framework"},
+			{Level: 3, Title: "Retrospective Effectiveness Measurement"},
+			{Level: 3, Title: "Retrospective Improvement Process"},
+			{Level: 2, Title: "Retrospective Culture and Maturity"},
+			{Level: 3, Title: "Building Retrospective Culture"},
+			{Level: 3, Title: "Retrospective Maturity Model"},
+			{Level: 3, Title: "Advanced Retrospective Practices"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, type, scope, and time period",
+			"Retrospective framework with philosophy, foundation, and architecture",
+			"Process and methodology with planning, facilitation, and analysis frameworks",
+			"Outcomes and action planning with learning synthesis, improvement planning, and implementation",
+			"Quality and effectiveness with framework, measurement, and improvement processes",
+			"Culture and maturity with development, maturity model, and advanced practices",
+			"Validation evidence of continuous learning, improvement, and team development",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic retrospective process with regular reflection and simple action planning",
+				"Simple documentation and basic follow-through on improvements",
+				"Basic retrospective facilitation and team participation",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive retrospective framework with structured analysis and improvement planning",
+				"Structured implementation tracking with measurement and accountability",
+				"Active retrospective culture with quality standards and continuous enhancement",
+				"Regular retrospective effectiveness review and process improvement",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced retrospective capabilities with sophisticated analysis and organizational learning",
+				"Comprehensive retrospective ecosystem with integrated culture and innovation",
+				"Retrospective excellence driving team effectiveness and organizational transformation",
+				"Strategic retrospective management enabling continuous adaptation and growth",
+			}},
+		},
+	},
+	{
+		Code:         "REV",
+		Name:         "Revenue Model",
+		Purpose:      "The Revenue Model defines how organizations create, capture, and optimize revenue streams. It establishes value exchange mechanisms that align customer value with business profitability while ensuring sustainable and scalable monetization strategies.",
+		Domain:       "Business Model",
+		Dependencies: []string{"VSN", "VAL", "PER", "JTB"},
+		Enablements:  []string{"CST", "PRO", "CAP", "SLA"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Revenue Overview"},
+			{Level: 2, Title: "Revenue Stream Definition"},
+			{Level: 3, Title: "Revenue Stream Characteristics"},
+			{Level: 3, Title: "Value Creation Logic"},
+			{Level: 2, Title: "Revenue Mechanics"},
+			{Level: 3, Title: "Pricing Mechanism"},
+			{Level: 3, Title: "Payment Structure"},
+			{Level: 3, Title: "Revenue Optimization"},
+			{Level: 2, Title: "Customer Value Analysis"},
+			{Level: 3, Title: "Customer Segments"},
+			{Level: 3, Title: "Value Proposition Alignment"},
+			{Level: 3, Title: "Customer Lifetime Value"},
+			{Level: 2, Title: "Market Analysis"},
+			{Level: 3, Title: "Market Opportunity"},
+			{Level: 3, Title: "Competitive Landscape"},
+			{Level: 3, Title: "Market Validation"},
+			{Level: 2, Title: "Financial Projections"},
+			{Level: 3, Title: "Revenue Forecasting"},
+			{Level: 3, Title: "Unit Economics"},
+			{Level: 3, Title: "Revenue Dependencies"},
+			{Level: 2, Title: "Revenue Operations"},
+			{Level: 3, Title: "Revenue Generation Process"},
+			{Level: 3, Title: "Revenue Infrastructure"},
+			{Level: 3, Title: "Revenue Optimization Operations"},
+			{Level: 2, Title: "Risk Assessment"},
+			{Level: 3, Title: "Revenue Risks"},
+			{Level: 3, Title: "Risk Mitigation Strategies"},
+			{Level: 3, Title: "Scenario Planning"},
+			{Level: 2, Title: "Success Metrics"},
+			{Level: 3, Title: "Revenue Metrics"},
+			{Level: 3, Title: "Customer Metrics"},
+			{Level: 3, Title: "Operational Metrics"},
+			{Level: 2, Title: "Revenue Evolution"},
+			{Level: 3, Title: "Revenue Stream Maturity"},
+			{Level: 3, Title: "Revenue Model Innovation"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Revenue overview with clear purpose, strategic importance, value exchange, and market position",
+			"Revenue stream definition with characteristics, value creation logic, and monetization approach",
+			"Revenue mechanics with pricing mechanism, payment structure, and optimization strategies",
+			"Customer value analysis with segments, value proposition alignment, and lifetime value",
+			"Market analysis with opportunity assessment, competitive landscape, and validation evidence",
+			"Financial projections with forecasting, unit economics, and dependency analysis",
+			"Revenue operations with generation process, infrastructure, and optimization capabilities",
+			"Risk assessment with comprehensive risk identification, mitigation, and scenario planning",
+			"Success metrics covering revenue performance, customer economics, and operational efficiency",
+			"Revenue evolution with maturity assessment, innovation opportunities, and adaptation strategies",
+			"Validation evidence of revenue model sustainability, market validation, and optimization effectiveness",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Revenue overview with purpose, strategic importance, and value exchange",
+				"Revenue stream definition with characteristics and value creation logic",
+				"Basic revenue mechanics with pricing and payment structure",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive customer value analysis with segments, value proposition, and CLV",
+				"Market analysis with opportunity assessment and competitive positioning",
+				"Financial projections with forecasting, unit economics, and dependencies",
+				"Revenue operations with generation process and infrastructure",
+				"Risk assessment with identification, mitigation, and scenario planning",
+				"Success metrics across revenue, customer, and operational dimensions",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced revenue evolution with maturity assessment and innovation roadmap",
+				"Dynamic pricing optimization with real-time market responsiveness",
+				"Predictive revenue analytics with AI-driven forecasting and optimization",
+				"Automated revenue operations with intelligent billing and collection",
+				"Integrated customer success platform driving revenue expansion",
+				"Real-time competitive intelligence informing pricing strategy",
+			}},
+		},
+	},
+	{
+		Code:         "RND",
+		Name:         "Research and Development",
+		Purpose:      "The Research and Development document governs long-cycle technical investigation and capability creation behind innovation bets. It is execution for deep learning and applied science, with `EXP` providing near-term validation loops and `IGN` converting outcomes into reusable organizational learning.",
+		Domain:       "Growth & Innovation",
+		Dependencies: []string{"INN", "STR", "ARC", "LEA"},
+		Enablements:  []string{"PRD", "SVC", "ARC", "FUT"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Research and Development Framework"},
+			{Level: 3, Title: "R&D Philosophy"},
+			{Level: 3, Title: "R&D Strategy"},
+			{Level: 3, Title: "R&D Scope"},
+			{Level: 2, Title: "Research Portfolio Management"},
+			{Level: 3, Title: "Portfolio Framework"},
+			{Level: 3, Title: "Innovation Pipeline"},
+			{Level: 3, Title: "Portfolio Optimization"},
+			{Level: 2, Title: "Research Process and Methodology"},
+			{Level: 3, Title: "Research Process"},
+			{Level: 3, Title: "Research Methodologies"},
+			{Level: 3, Title: "Quality Assurance"},
+			{Level: 2, Title: "Technology Development and Innovation"},
+			{Level: 3, Title: "Technology Development"},
+			{Level: 3, Title: "Innovation Integration"},
+			{Level: 3, Title: "Intellectual Property Management"},
+			{Level: 2, Title: "External Collaboration and Networks"},
+			{Level: 3, Title: "Collaboration Framework"},
+			{Level: 3, Title: "Innovation Networks"},
+			{Level: 3, Title: "Knowledge Networks"},
+			{Level: 2, Title: "R&D Infrastructure and Capabilities"},
+			{Level: 3, Title: "Research Infrastructure"},
+			{Level: 3, Title: "Human Capabilities"},
+			{Level: 3, Title: "Technology Platforms"},
+			{Level: 2, Title: "Performance Measurement and Value Creation"},
+			{Level: 3, Title: "R&D Performance Metrics"},
+			{Level: 3, Title: "Value Creation"},
+			{Level: 3, Title: "Return on Investment"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, research strategy, scope, and collaboration model",
+			"R&D framework with philosophy, strategy, and scope definition",
+			"Portfolio management with framework, innovation pipeline, and optimization",
+			"Research process with methodology, quality assurance, and knowledge development",
+			"Technology development with stages, processes, and IP management",
+			"External collaboration with framework, partnerships, and knowledge networks",
+			"Infrastructure and capabilities with research facilities, human capabilities, and platforms",
+			"Validation evidence of innovation driving, portfolio balancing, and value generation",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic R&D framework with simple research project management",
+				"Simple research processes and basic portfolio management",
+				"Basic external collaboration and knowledge sharing",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive R&D strategy with balanced portfolio management",
+				"Structured research processes with quality assurance and IP management",
+				"Active external collaboration with universities and industry partners",
+				"Regular R&D performance measurement and value assessment",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced R&D capabilities with systematic innovation pipeline",
+				"Sophisticated research ecosystem with extensive external networks",
+				"R&D excellence with industry leadership and thought leadership",
+				"Strategic R&D driving business transformation and competitive advantage",
+			}},
+		},
+	},
+	{
+		Code:         "ROD",
+		Name:         "Roadmap",
+		Purpose:      "The Roadmap document defines strategic product and technology direction over multiple time horizons, aligning development efforts with business objectives while managing resource constraints and market dynamics. It provides stakeholders with visibility into planned evolution and investment priorities.",
+		Domain:       "Product & Service",
+		Dependencies: []string{"STR", "OBJ", "PRD"},
+		Enablements:  []string{"FEA", "REQ", "QUA"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Roadmap Overview"},
+			{Level: 2, Title: "Strategic Context"},
+			{Level: 3, Title: "Strategic Objectives"},
+			{Level: 3, Title: "Market Context"},
+			{Level: 3, Title: "Resource Context"},
+			{Level: 2, Title: "Roadmap Structure"},
+			{Level: 3, Title: "Planning Horizons"},
+			{Level: 3, Title: "Initiative Prioritization"},
+			{Level: 2, Title: "Feature and Capability Evolution"},
+			{Level: 3, Title: "Current State Assessment"},
+			{Level: 3, Title: "Capability Development Plan"},
+			{Level: 3, Title: "Feature Roadmap"},
+			{Level: 2, Title: "Technology Evolution"},
+			{Level: 3, Title: "Technology Strategy"},
+			{Level: 3, Title: "Technology Investments"},
+			{Level: 3, Title: "Technology Risks"},
+			{Level: 2, Title: "Resource Planning"},
+			{Level: 3, Title: "Team Planning"},
+			{Level: 3, Title: "Budget Planning"},
+			{Level: 3, Title: "Capacity Management"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 3, Title: "Schedule Risks"},
+			{Level: 3, Title: "Resource Risks"},
+			{Level: 3, Title: "Market Risks"},
+			{Level: 2, Title: "Success Metrics and Tracking"},
+			{Level: 3, Title: "Business Metrics"},
+			{Level: 3, Title: "Product Metrics"},
+			{Level: 3, Title: "Progress Tracking"},
+			{Level: 2, Title: "Communication and Alignment"},
+			{Level: 3, Title: "Stakeholder Communication"},
+			{Level: 3, Title: "Review and Update Process"},
+			{Level: 3, Title: "Assumptions and Dependencies"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Roadmap overview with clear purpose, scope, time horizon, and planning philosophy",
+			"Strategic context covering objectives, market conditions, and resource constraints",
+			"Roadmap structure with detailed planning horizons and initiative prioritization",
+			"Feature and capability evolution with current state and development planning",
+			"Technology evolution strategy with vision, investments, and risk management",
+			"Resource planning covering team, budget, and capacity management",
+			"Risk management addressing schedule, resource, and market risks",
+			"Success metrics and tracking with business, product, and progress measurements",
+			"Communication and alignment with stakeholder communication and review processes",
+			"Validation evidence of roadmap feasibility and strategic alignment",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Roadmap overview with purpose, scope, and time horizon",
+				"Strategic context with objectives and market conditions",
+				"Basic planning horizons with near-term focus areas",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive roadmap structure with detailed planning horizons and initiative prioritization",
+				"Feature and capability evolution with current state assessment and development plan",
+				"Technology evolution strategy with investments and risk management",
+				"Resource planning with team, budget, and capacity management",
+				"Risk management covering schedule, resource, and market risks",
+				"Success metrics and progress tracking framework",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic roadmap management with real-time progress tracking",
+				"Advanced scenario planning with multiple roadmap options",
+				"Continuous stakeholder alignment and communication",
+				"Data-driven roadmap optimization and course correction",
+				"Integrated portfolio management across multiple roadmaps",
+				"Automated progress reporting and stakeholder communication",
+			}},
+		},
+	},
+	{
+		Code:         "ROL",
+		Name:         "Role Definition",
+		Purpose:      "The Role Definition defines systematic approaches to designing and documenting organizational roles that clarify responsibilities, authorities, and requirements. It establishes role frameworks that enable effective hiring, performance management, and career development.",
+		Domain:       "Operations & Execution",
+		Dependencies: []string{"ORG", "TEA", "SKI", "CAP"},
+		Enablements:  []string{"PER", "QUA", "DEV", "SUC"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Role Overview"},
+			{Level: 3, Title: "Core Purpose"},
+			{Level: 3, Title: "Position Context"},
+			{Level: 2, Title: "Responsibilities"},
+			{Level: 3, Title: "Primary Responsibilities"},
+			{Level: 3, Title: "Key Activities"},
+			{Level: 3, Title: "Decision Authority"},
+			{Level: 2, Title: "Requirements"},
+			{Level: 3, Title: "Experience Requirements"},
+			{Level: 3, Title: "Technical Skills"},
+			{Level: 3, Title: "Competencies"},
+			{Level: 2, Title: "Performance Framework"},
+			{Level: 3, Title: "Success Metrics"},
+			{Level: 3, Title: "Career Development"},
+			{Level: 2, Title: "Working Relationships"},
+			{Level: 3, Title: "Internal Stakeholders"},
+			{Level: 3, Title: "External Stakeholders"},
+			{Level: 2, Title: "Work Environment"},
+			{Level: 3, Title: "Work Arrangements"},
+			{Level: 3, Title: "Tools and Resources"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, level, reporting relationship, and team assignment",
+			"Role overview with core purpose, impact, scope, and position context",
+			"Responsibilities with primary duties, key activities, and decision authority framework",
+			"Requirements with experience, technical skills, and competency specifications",
+			"Performance framework with success metrics and career development pathways",
+			"Working relationships with internal and external stakeholder mapping",
+			"Work environment with arrangements, tools, and resource requirements",
+			"Validation evidence of role effectiveness, hiring success, and performance clarity",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Clear role title and reporting relationship",
+				"Basic responsibilities and requirements",
+				"Essential qualifications and experience",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Detailed responsibility breakdown with decision authority",
+				"Comprehensive skill and competency requirements",
+				"Performance metrics and success criteria",
+				"Career development framework",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Strategic role contribution and business impact",
+				"Advanced competency modeling and assessment",
+				"Comprehensive stakeholder relationship mapping",
+				"Dynamic role evolution and adaptation framework",
+			}},
+		},
+	},
+	{
+		Code:         "RSK",
+		Name:         "Risks",
+		Purpose:      "The Risks document defines systematic approaches to identifying, assessing, and managing business risks that could impact organizational objectives, operations, and stakeholder value. It establishes risk management frameworks that enable proactive risk mitigation, informed decision making, and resilient business operations.",
+		Domain:       "Risk & Governance",
+		Dependencies: []string{"STR", "OBJ", "OPS", "FIN"},
+		Enablements:  []string{"CTL", "COM", "GOV", "AUD"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Risk Management Framework"},
+			{Level: 3, Title: "Risk Philosophy"},
+			{Level: 3, Title: "Risk Management Approach"},
+			{Level: 3, Title: "Risk Categories"},
+			{Level: 2, Title: "Risk Identification"},
+			{Level: 3, Title: "Risk Universe"},
+			{Level: 3, Title: "Risk Sources"},
+			{Level: 3, Title: "Risk Identification Methods"},
+			{Level: 2, Title: "Risk Assessment"},
+			{Level: 3, Title: "Risk Measurement"},
+			{Level: 3, Title: "Quantitative Analysis"},
+			{Level: 3, Title: "Qualitative Assessment"},
+			{Level: 2, Title: "Risk Treatment and Mitigation"},
+			{Level: 3, Title: "Risk Response Strategies"},
+			{Level: 3, Title: "Control Implementation"},
+			{Level: 3, Title: "Risk Mitigation Plans"},
+			{Level: 2, Title: "Risk Monitoring and Reporting"},
+			{Level: 3, Title: "Risk Monitoring Framework"},
+			{Level: 3, Title: "Risk Reporting"},
+			{Level: 3, Title: "Performance Measurement"},
+			{Level: 2, Title: "Business Continuity and Crisis Management"},
+			{Level: 3, Title: "Business Continuity Planning"},
+			{Level: 3, Title: "Incident Response"},
+			{Level: 2, Title: "Risk Culture and Governance"},
+			{Level: 3, Title: "Risk Culture Development"},
+			{Level: 3, Title: "Risk Governance Structure"},
+			{Level: 3, Title: "Risk Appetite and Tolerance"},
+			{Level: 2, Title: "Technology and Innovation"},
+			{Level: 3, Title: "Risk Technology"},
+			{Level: 3, Title: "Emerging Risk Management"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, risk category, scope, and assessment method",
+			"Risk management framework with philosophy, approach, and categories",
+			"Risk identification with risk universe, sources, and identification methods",
+			"Risk assessment with measurement, quantitative analysis, and qualitative assessment",
+			"Risk treatment with response strategies, control implementation, and mitigation plans",
+			"Risk monitoring with framework, reporting, and performance measurement",
+			"Business continuity with planning, incident response, and crisis management",
+			"Risk culture with development, governance structure, and appetite definition",
+			"Technology and innovation with risk technology, analytics, and emerging risk management",
+			"Validation evidence of informed decision enabling, threat mitigation, and resilience support",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic risk register with identification and assessment",
+				"Simple risk monitoring and reporting",
+				"Basic risk mitigation and control processes",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive risk framework with systematic processes",
+				"Structured risk assessment with quantitative and qualitative methods",
+				"Effective risk mitigation with performance monitoring",
+				"Regular risk reporting and governance oversight",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced risk analytics with predictive capabilities",
+				"Sophisticated risk integration with business planning",
+				"Comprehensive business continuity and crisis management",
+				"Strategic risk culture with continuous improvement",
+			}},
+		},
+	},
+	{
+		Code:         "SAL",
+		Name:         "Sales Strategy",
+		Purpose:      "The Sales Strategy document defines how the organization acquires, converts, and retains customers through repeatable sales motions. It establishes strategy, process design, and performance governance for sales planning, demand generation handoff, and commercial execution.",
+		Domain:       "Brand & Marketing",
+		Dependencies: []string{"POS", "CJM", "VPR"},
+		Enablements:  []string{"REV", "PRI", "SUP", "REP"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Sales Motion"},
+			{Level: 2, Title: "Pipeline Structure"},
+			{Level: 3, Title: "Lead Management"},
+			{Level: 3, Title: "Opportunity Management"},
+			{Level: 3, Title: "Commercial Execution"},
+			{Level: 2, Title: "Sales Enablement Interfaces"},
+			{Level: 2, Title: "Performance Management"},
+			{Level: 3, Title: "Metrics and KPIs"},
+			{Level: 3, Title: "Coaching and Improvement"},
+			{Level: 2, Title: "Governance"},
+		},
+		Checklist: []string{
+			"Sales stages, qualifications, and handoff rules are explicitly defined",
+			"Channel strategy and segment focus are codified",
+			"Pricing, approval, and concession governance is explicit",
+			"Pipeline performance metrics are owned and reviewed",
+			"Downstream dependencies and enablements are documented",
+		},
+	},
+	{
+		Code:         "SEC",
+		Name:         "Security",
+		Purpose:      "The Security document defines systematic approaches to designing, implementing, and managing security controls that protect business assets through comprehensive risk management, compliance adherence, and threat mitigation. It establishes security frameworks that ensure confidentiality, integrity, and availability of organizational resources.",
+		Domain:       "Technology & Data",
+		Dependencies: []string{"ARC", "INF", "POL", "RSK"},
+		Enablements:  []string{"PER", "QUA", "SLA", "GOV"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Security Architecture"},
+			{Level: 3, Title: "Security Framework"},
+			{Level: 3, Title: "Security Domains"},
+			{Level: 3, Title: "Threat Landscape"},
+			{Level: 2, Title: "Identity and Access Management"},
+			{Level: 3, Title: "Authentication Framework"},
+			{Level: 3, Title: "Authorization Model"},
+			{Level: 3, Title: "Identity Lifecycle"},
+			{Level: 2, Title: "Data Protection and Privacy"},
+			{Level: 3, Title: "Data Classification"},
+			{Level: 3, Title: "Encryption Framework"},
+			{Level: 3, Title: "Privacy Controls"},
+			{Level: 2, Title: "Network and Infrastructure Security"},
+			{Level: 3, Title: "Network Security Architecture"},
+			{Level: 3, Title: "Infrastructure Security"},
+			{Level: 3, Title: "Cloud Security"},
+			{Level: 2, Title: "Application Security"},
+			{Level: 3, Title: "Secure Development Lifecycle"},
+			{Level: 3, Title: "Application Security Controls"},
+			{Level: 3, Title: "API Security"},
+			{Level: 2, Title: "Security Operations"},
+			{Level: 3, Title: "Security Monitoring"},
+			{Level: 3, Title: "Incident Response"},
+			{Level: 3, Title: "Vulnerability Management"},
+			{Level: 2, Title: "Compliance and Governance"},
+			{Level: 3, Title: "Regulatory Compliance"},
+			{Level: 3, Title: "Security Governance"},
+			{Level: 3, Title: "Audit and Assessment"},
+			{Level: 2, Title: "Business Continuity and Recovery"},
+			{Level: 3, Title: "Security in Business Continuity"},
+			{Level: 3, Title: "Cyber Resilience"},
+			{Level: 2, Title: "Emerging Technologies and Threats"},
+			{Level: 3, Title: "Technology Security"},
+			{Level: 3, Title: "Threat Evolution"},
+			{Level: 2, Title: "Security Culture and Training"},
+			{Level: 3, Title: "Security Awareness"},
+			{Level: 3, Title: "Security Culture"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, domain, risk level, and compliance framework",
+			"Security architecture with framework, domains, and threat landscape",
+			"Identity and access management with authentication, authorization, and lifecycle",
+			"Data protection and privacy with classification, encryption, and privacy controls",
+			"Network and infrastructure security with architecture, controls, and cloud security",
+			"Application security with SDLC, controls, and API security",
+			"Security operations with monitoring, incident response, and vulnerability management",
+			"Compliance and governance with frameworks, governance, and audit programs",
+			"Business continuity with security considerations and cyber resilience",
+			"Emerging technologies with technology security and threat evolution",
+			"Security culture and training with awareness programs and culture development",
+			"Validation evidence of security effectiveness, compliance achievement, and business enablement",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic security controls with essential protections",
+				"Simple identity and access management",
+				"Basic incident response capabilities",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive security framework with defense in depth",
+				"Advanced identity and access management with automation",
+				"Structured security operations with monitoring and response",
+				"Compliance framework with regular assessments",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced security architecture with zero trust principles",
+				"Sophisticated threat detection and automated response",
+				"Comprehensive compliance and governance framework",
+				"Continuous security improvement and innovation",
+			}},
+		},
+	},
+	{
+		Code:         "SEG",
+		Name:         "Market Segments",
+		Purpose:      "The Market Segments document identifies and analyzes distinct customer groups within the broader market. It defines segmentation criteria, profiles key segments, and prioritizes target segments.",
+		Domain:       "Market & Environment",
+		Dependencies: []string{"MKT", "PER"},
+		Enablements:  []string{"POS", "REV", "GTM"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Segmentation Framework"},
+			{Level: 3, Title: "Segmentation Methodology"},
+			{Level: 2, Title: "Market Segments"},
+			{Level: 3, Title: "Segment 1: [Segment Name]"},
+			{Level: 3, Title: "Segment 2: [Segment Name]"},
+			{Level: 3, Title: "[Continue for each identified segment]"},
+			{Level: 2, Title: "Segment Prioritization"},
+			{Level: 3, Title: "Evaluation Criteria"},
+			{Level: 3, Title: "Priority Matrix"},
+			{Level: 3, Title: "Target Segment Selection"},
+			{Level: 2, Title: "Segmentation Insights"},
+			{Level: 3, Title: "Cross-Segment Patterns"},
+			{Level: 3, Title: "Unique Segment Needs"},
+			{Level: 3, Title: "Evolution Trends"},
+			{Level: 3, Title: "Emerging Segments"},
+			{Level: 2, Title: "Go-to-Market Implications"},
+			{Level: 3, Title: "Segment-Specific Strategies"},
+			{Level: 2, Title: "Validation and Testing"},
+			{Level: 3, Title: "Segment Validation"},
+			{Level: 3, Title: "Customer Research"},
+			{Level: 3, Title: "Market Testing"},
+			{Level: 3, Title: "Performance Tracking"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Segmentation methodology is clearly defined and justified",
+			"Segments are distinct, measurable, and actionable",
+			"Comprehensive segment profiles include demographics, psychographics, and behavior",
+			"Segment sizes and values are quantified",
+			"Prioritization criteria and target segment selection are clear",
+			"Go-to-market implications are detailed for each target segment",
+			"Competitive landscape is analyzed by segment",
+			"Customer research validates segment definitions",
+			"Performance tracking system is established",
+			"Segment evolution and emerging segments are monitored",
+			"Cross-segment patterns and insights are identified",
+			"Regular review and refinement process is implemented",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Clear segmentation criteria defined",
+				"Primary segments identified and profiled",
+				"Basic size and value estimates provided",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive segment profiles with demographics, psychographics, and behavior",
+				"Segment prioritization with evaluation criteria",
+				"Go-to-market implications by segment",
+				"Competitive landscape analysis by segment",
+				"Segment accessibility and channel strategy",
+				"Initial validation through customer research",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Regular segment validation and refinement",
+				"Comprehensive customer research foundation",
+				"Segment-specific performance tracking",
+				"Dynamic segment evolution monitoring",
+				"Integrated segment-based decision making",
+				"Advanced analytics and predictive segmentation",
+			}},
+		},
+	},
+	{
+		Code:         "SEO",
+		Name:         "Search Engine Optimization",
+		Purpose:      "The Search Engine Optimization document defines strategies for improving organic search visibility and traffic through technical optimization, content strategy, and authority building. It establishes SEO frameworks that increase search rankings, drive qualified traffic, and support business objectives through strategic search engine optimization.",
+		Domain:       "Brand & Marketing",
+		Dependencies: []string{"CNT", "ANA", "WEB", "KWD"},
+		Enablements:  []string{"TRA", "BRA", "CON"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "SEO Strategy Framework"},
+			{Level: 3, Title: "SEO Philosophy"},
+			{Level: 3, Title: "SEO Foundation"},
+			{Level: 3, Title: "Search Market Analysis"},
+			{Level: 2, Title: "Keyword Strategy and Research"},
+			{Level: 3, Title: "Keyword Research Framework"},
+			{Level: 3, Title: "Search Intent Mapping"},
+			{Level: 3, Title: "Content-Keyword Alignment"},
+			{Level: 2, Title: "Technical SEO Foundation"},
+			{Level: 3, Title: "Technical Optimization Framework"},
+			{Level: 3, Title: "Technical Infrastructure"},
+			{Level: 3, Title: "Site Performance Monitoring"},
+			{Level: 2, Title: "Content Optimization Strategy"},
+			{Level: 3, Title: "On-Page Optimization Framework"},
+			{Level: 3, Title: "Content Creation Guidelines"},
+			{Level: 3, Title: "Featured Snippet Optimization"},
+			{Level: 2, Title: "Link Building and Authority"},
+			{Level: 3, Title: "Link Building Strategy"},
+			{Level: 3, Title: "Content Marketing for Links"},
+			{Level: 3, Title: "Authority Measurement"},
+			{Level: 2, Title: "Local SEO and Geographic Optimization"},
+			{Level: 3, Title: "Local Search Strategy"},
+			{Level: 3, Title: "Local Content Development"},
+			{Level: 2, Title: "Performance Measurement and Analytics"},
+			{Level: 3, Title: "SEO Performance Metrics"},
+			{Level: 3, Title: "Analytics and Reporting"},
+			{Level: 3, Title: "Optimization and Iteration"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, keywords, content strategy, and technical approach",
+			"SEO framework with philosophy, foundation, and market analysis",
+			"Keyword strategy with research framework, intent mapping, and content alignment",
+			"Technical SEO with optimization framework, infrastructure, and monitoring",
+			"Content optimization with framework, guidelines, and snippet optimization",
+			"Link building with strategy, content marketing, and authority measurement",
+			"Local SEO with strategy, content development, and geographic optimization",
+			"Performance measurement with metrics, analytics, and optimization processes",
+			"Validation evidence of visibility improvement, performance enhancement, and value generation",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic SEO strategy with keyword research and basic optimization",
+				"Simple technical SEO foundation and basic content optimization",
+				"Basic performance tracking and simple reporting",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive SEO strategy with detailed keyword and content planning",
+				"Structured technical optimization with advanced on-page strategies",
+				"Active performance measurement with analytics and optimization",
+				"Regular SEO review and strategy evolution",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced SEO capabilities with sophisticated technical and content optimization",
+				"Comprehensive search ecosystem with integrated link building and authority development",
+				"SEO excellence with industry recognition and competitive advantage",
+				"Strategic SEO driving significant business growth and market visibility",
+			}},
+		},
+	},
+	{
+		Code:         "SKI",
+		Name:         "Skills Framework",
+		Purpose:      "The Skills Framework defines systematic approaches to identifying, assessing, and developing organizational skills and competencies that enable strategic execution and competitive advantage. It establishes skill frameworks that optimize talent development, career planning, and organizational capability building.",
+		Domain:       "Operations & Execution",
+		Dependencies: []string{"ROL", "TEA", "ORG", "CAP"},
+		Enablements:  []string{"PER", "QUA", "DEV", "SUC"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Skills Framework"},
+			{Level: 3, Title: "Framework Structure"},
+			{Level: 3, Title: "Skill Categories"},
+			{Level: 2, Title: "Proficiency Levels"},
+			{Level: 3, Title: "Level Definitions"},
+			{Level: 3, Title: "Progression Criteria"},
+			{Level: 2, Title: "Skills Assessment"},
+			{Level: 3, Title: "Assessment Framework"},
+			{Level: 3, Title: "Skill Inventory"},
+			{Level: 2, Title: "Development Framework"},
+			{Level: 3, Title: "Learning Pathways"},
+			{Level: 3, Title: "Development Methods"},
+			{Level: 3, Title: "Career Integration"},
+			{Level: 2, Title: "Skills Planning"},
+			{Level: 3, Title: "Organizational Planning"},
+			{Level: 3, Title: "Individual Planning"},
+			{Level: 3, Title: "Team Planning"},
+			{Level: 2, Title: "Quality Assurance"},
+			{Level: 3, Title: "Validation Methods"},
+			{Level: 3, Title: "Continuous Improvement"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, scope, and application",
+			"Skills framework with structure, categories, and comprehensive skill definitions",
+			"Proficiency levels with clear definitions, characteristics, and progression criteria",
+			"Skills assessment with framework, methods, and inventory analysis",
+			"Development framework with learning pathways, methods, and career integration",
+			"Skills planning with organizational, individual, and team planning approaches",
+			"Quality assurance with validation methods and continuous improvement processes",
+			"Validation evidence of framework effectiveness, assessment accuracy, and development success",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic skill categories with clear definitions",
+				"Simple proficiency levels (3-4 levels)",
+				"Basic assessment approach",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive skill framework with detailed competencies",
+				"Well-defined progression criteria and assessment methods",
+				"Individual development planning integration",
+				"Learning pathway definition",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced competency modeling with behavioral indicators",
+				"Sophisticated assessment and validation framework",
+				"Integration with career development and succession planning",
+				"Data-driven skills optimization and forecasting",
+			}},
+		},
+	},
+	{
+		Code:         "SLA",
+		Name:         "Service Level Agreement",
+		Purpose:      "The Service Level Agreement defines systematic approaches to establishing, measuring, and managing service level commitments that ensure consistent service delivery and customer satisfaction. It establishes performance frameworks that optimize service quality and accountability.",
+		Domain:       "Operations & Execution",
+		Dependencies: []string{"SVC", "PRO", "CAP", "PER"},
+		Enablements:  []string{"QUA", "CUS", "SUP", "MON"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "SLA Overview"},
+			{Level: 2, Title: "Service Definition"},
+			{Level: 3, Title: "Service Description"},
+			{Level: 3, Title: "Service Classification"},
+			{Level: 3, Title: "Service Availability"},
+			{Level: 3, Title: "Service Users"},
+			{Level: 2, Title: "Service Level Commitments"},
+			{Level: 3, Title: "Availability Commitments"},
+			{Level: 3, Title: "Performance Commitments"},
+			{Level: 3, Title: "Quality Commitments"},
+			{Level: 3, Title: "Support Commitments"},
+			{Level: 2, Title: "Measurement and Monitoring"},
+			{Level: 3, Title: "Measurement Framework"},
+			{Level: 3, Title: "Monitoring Systems"},
+			{Level: 3, Title: "Reporting"},
+			{Level: 2, Title: "Roles and Responsibilities"},
+			{Level: 3, Title: "Service Provider Responsibilities"},
+			{Level: 3, Title: "Service User Responsibilities"},
+			{Level: 3, Title: "Shared Responsibilities"},
+			{Level: 2, Title: "Exception Handling"},
+			{Level: 3, Title: "Planned Exceptions"},
+			{Level: 3, Title: "Unplanned Exceptions"},
+			{Level: 3, Title: "Exception Procedures"},
+			{Level: 2, Title: "Penalties and Remedies"},
+			{Level: 3, Title: "SLA Breach Definition"},
+			{Level: 3, Title: "Penalty Structure"},
+			{Level: 3, Title: "Remediation Actions"},
+			{Level: 2, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Performance Review"},
+			{Level: 3, Title: "SLA Evolution"},
+			{Level: 3, Title: "Best Practice Integration"},
+			{Level: 2, Title: "Governance and Management"},
+			{Level: 3, Title: "SLA Governance"},
+			{Level: 3, Title: "Contract Management"},
+			{Level: 3, Title: "Stakeholder Management"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 3, Title: "SLA Risks"},
+			{Level: 3, Title: "Risk Mitigation"},
+			{Level: 3, Title: "Business Continuity"},
+			{Level: 2, Title: "Technology and Infrastructure"},
+			{Level: 3, Title: "Technology Requirements"},
+			{Level: 3, Title: "Capacity Management"},
+			{Level: 3, Title: "Change Management"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"SLA overview with clear purpose, parties, service scope, and business context",
+			"Service definition with comprehensive description, classification, availability, and user identification",
+			"Service level commitments with availability, performance, quality, and support commitments",
+			"Measurement and monitoring with KPIs, systems, and comprehensive reporting framework",
+			"Roles and responsibilities with clear provider, user, and shared accountability",
+			"Exception handling with planned and unplanned exception procedures and communication",
+			"Penalties and remedies with breach definitions, penalty structure, and remediation actions",
+			"Continuous improvement with performance review, SLA evolution, and best practice integration",
+			"Governance and management with structure, contract integration, and stakeholder management",
+			"Risk management with comprehensive identification, mitigation, and business continuity",
+			"Technology and infrastructure with requirements, capacity management, and change control",
+			"Validation evidence of consistent SLA achievement, accurate measurement, and continuous improvement",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"SLA overview with purpose, parties, service scope, and business context",
+				"Service definition with description, classification, and availability",
+				"Basic service level commitments with availability and performance targets",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive measurement and monitoring with KPIs, systems, and reporting",
+				"Roles and responsibilities with clear provider, user, and shared accountability",
+				"Exception handling with planned and unplanned exception procedures",
+				"Penalties and remedies with breach definitions and remediation actions",
+				"Continuous improvement with review processes and SLA evolution",
+				"Governance and management with structure, stakeholder engagement, and risk management",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced technology and infrastructure with comprehensive capacity management",
+				"AI-driven SLA optimization with predictive analytics and automated improvement",
+				"Real-time performance monitoring with dynamic threshold adjustment",
+				"Integrated service ecosystem with seamless cross-service SLA coordination",
+				"Advanced exception prediction with proactive issue prevention",
+				"Automated remediation with intelligent response and recovery systems",
+			}},
+		},
+	},
+	{
+		Code:         "SOC",
+		Name:         "Social Media Strategy",
+		Purpose:      "The Social Media Strategy document defines how the brand will engage with audiences across social platforms to build community, drive engagement, and support business objectives. It establishes social media frameworks that create authentic connections, amplify brand messaging, and generate measurable business value through strategic platform engagement.",
+		Domain:       "Brand & Marketing",
+		Dependencies: []string{"BRD", "CNT", "TON", "MSG"},
+		Enablements:  []string{"CAM", "CUS", "LED", "BRA"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Social Media Strategy Framework"},
+			{Level: 3, Title: "Social Philosophy"},
+			{Level: 3, Title: "Social Strategy Foundation"},
+			{Level: 3, Title: "Social Media Positioning"},
+			{Level: 2, Title: "Platform-Specific Strategies"},
+			{Level: 3, Title: "Platform Strategy Development"},
+			{Level: 3, Title: "Content Adaptation by Platform"},
+			{Level: 3, Title: "Emerging Platform Strategy"},
+			{Level: 2, Title: "Content Strategy and Planning"},
+			{Level: 3, Title: "Social Content Framework"},
+			{Level: 3, Title: "Editorial Calendar Planning"},
+			{Level: 3, Title: "Visual Content Guidelines"},
+			{Level: 2, Title: "Community Management and Engagement"},
+			{Level: 3, Title: "Engagement Strategy"},
+			{Level: 3, Title: "Customer Service Integration"},
+			{Level: 3, Title: "Crisis Management Protocol"},
+			{Level: 2, Title: "Performance Measurement and Analytics"},
+			{Level: 3, Title: "Social Media Metrics"},
+			{Level: 3, Title: "Analytics and Reporting"},
+			{Level: 3, Title: "Optimization Process"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, platform strategy, content mix, and engagement approach",
+			"Strategy framework with philosophy, foundation, and positioning",
+			"Platform-specific strategies with audience targeting, content types, and success metrics",
+			"Content strategy with framework, planning, and visual guidelines",
+			"Community management with engagement strategy, customer service, and crisis management",
+			"Performance measurement with metrics, analytics, and optimization processes",
+			"Validation evidence of engagement building, message amplification, and value generation",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic social media strategy with platform selection and content planning",
+				"Simple engagement guidelines and posting schedule",
+				"Basic performance tracking and community management",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive social media strategy with detailed platform strategies",
+				"Structured content planning with community management framework",
+				"Active performance measurement with analytics and optimization",
+				"Regular strategy review and platform evaluation",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced social media capabilities with sophisticated engagement strategies",
+				"Comprehensive social ecosystem with integrated crisis management",
+				"Social media excellence with industry recognition and community leadership",
+				"Strategic social media driving brand awareness and competitive advantage",
+			}},
+		},
+	},
+	{
+		Code:    "STA",
+		Name:    "Stakeholder Map",
+		Purpose: "Stakeholder Map establishes who can materially influence outcomes, operations, compliance posture, funding outcomes, and execution feasibility. It is used for strategy, governance, ethics, and risk communication planning.",
+		Domain:  "Risk & Governance",
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Structure"},
+		},
+		Checklist: []string{
+			"Critical stakeholders and owners are all represented.",
+			"Influence and urgency are explicitly rated.",
+			"Engagement cadence and escalation paths are documented.",
+			"Cross-domain linkages to risk, compliance, strategy, and ethics are explicit.",
+		},
+	},
+	{
+		Code:         "STO",
+		Name:         "Stories (User Stories)",
+		Purpose:      "The User Stories (STO) document captures implementation-sized requirements from the user perspective using the standard \"As a... I want... So that...\" format. The `STO` code is retained for the three-letter namespace constraint; this is equivalent to **Stories** in most frameworks and is not a storage concept.",
+		Domain:       "Customer & Value",
+		Dependencies: []string{"USE", "PER"},
+		Enablements:  []string{"REQ", "TSK"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Story Statement"},
+			{Level: 2, Title: "Story Details"},
+			{Level: 3, Title: "Story Information"},
+			{Level: 3, Title: "User Context"},
+			{Level: 3, Title: "Business Context"},
+			{Level: 2, Title: "Acceptance Criteria"},
+			{Level: 3, Title: "Functional Criteria"},
+			{Level: 3, Title: "Non-Functional Criteria"},
+			{Level: 2, Title: "Definition of Done"},
+			{Level: 3, Title: "Development Criteria"},
+			{Level: 3, Title: "Quality Criteria"},
+			{Level: 3, Title: "Documentation Criteria"},
+			{Level: 3, Title: "Deployment Criteria"},
+			{Level: 2, Title: "Story Dependencies"},
+			{Level: 3, Title: "Dependent Stories"},
+			{Level: 3, Title: "Technical Dependencies"},
+			{Level: 2, Title: "User Interface Mockups"},
+			{Level: 3, Title: "UI Requirements"},
+			{Level: 3, Title: "Interaction Design"},
+			{Level: 2, Title: "Technical Considerations"},
+			{Level: 3, Title: "Implementation Approach"},
+			{Level: 3, Title: "Complexity Assessment"},
+			{Level: 3, Title: "Risk Assessment"},
+			{Level: 2, Title: "Testing Strategy"},
+			{Level: 3, Title: "Test Cases"},
+			{Level: 3, Title: "Test Data Requirements"},
+			{Level: 3, Title: "Automation Strategy"},
+			{Level: 2, Title: "Metrics and Validation"},
+			{Level: 3, Title: "Success Metrics"},
+			{Level: 3, Title: "Validation Approach"},
+			{Level: 2, Title: "Notes and Assumptions"},
+			{Level: 3, Title: "Development Notes"},
+			{Level: 3, Title: "Assumptions"},
+			{Level: 2, Title: "Story History"},
+			{Level: 3, Title: "Story Evolution"},
+			{Level: 3, Title: "Decision Log"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Story statement follows As a/I want/So that format",
+			"Story details include ID, title, type, epic, theme, and component",
+			"User and business context clearly defined",
+			"Functional acceptance criteria with Given/When/Then scenarios",
+			"Non-functional criteria covering performance, usability, security, compliance",
+			"Complete definition of done with measurable criteria",
+			"Story dependencies and technical constraints identified",
+			"UI requirements and interaction design specified",
+			"Technical considerations with complexity and risk assessment",
+			"Testing strategy with functional and non-functional approaches",
+			"Success metrics and validation approach defined",
+			"Assumptions and development notes documented",
+			"Story history and decision log maintained",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Story statement with As a/I want/So that format",
+				"Basic acceptance criteria with Given/When/Then scenarios",
+				"Story details including type, epic, and component",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive acceptance criteria with functional and non-functional aspects",
+				"Complete definition of done with development, quality, and documentation criteria",
+				"Detailed technical considerations with complexity and risk assessment",
+				"Testing strategy with functional and non-functional approaches",
+				"Success metrics and validation approach",
+				"Story dependencies and technical constraints mapped",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic story tracking with real-time progress metrics",
+				"Automated acceptance criteria validation",
+				"Advanced testing automation and continuous validation",
+				"Story analytics with adoption and impact measurement",
+				"Continuous story evolution based on user feedback",
+				"Cross-functional story coordination and dependency management",
+			}},
+		},
+	},
+	{
+		Code:         "STR",
+		Name:         "Business Strategy",
+		Purpose:      "The Strategy document defines how the organization will achieve its vision and compete in its chosen markets. It articulates the key choices about where to play, how to win, and what capabilities to build.",
+		Domain:       "Strategic Foundation",
+		Dependencies: []string{"MSN", "VSN", "VAL", "MKT", "CMP"},
+		Enablements:  []string{"OBJ", "GTM", "GRW", "BMC"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Strategic Framework"},
+			{Level: 3, Title: "Where to Play"},
+			{Level: 3, Title: "How to Win"},
+			{Level: 3, Title: "Capabilities Required"},
+			{Level: 2, Title: "Strategic Choices"},
+			{Level: 3, Title: "Market Strategy"},
+			{Level: 3, Title: "Product Strategy"},
+			{Level: 3, Title: "Customer Strategy"},
+			{Level: 3, Title: "Operational Strategy"},
+			{Level: 3, Title: "Financial Strategy"},
+			{Level: 2, Title: "Strategic Initiatives"},
+			{Level: 3, Title: "Year 1 Priorities"},
+			{Level: 3, Title: "Multi-Year Programs"},
+			{Level: 3, Title: "Resource Requirements"},
+			{Level: 2, Title: "Risk and Contingencies"},
+			{Level: 3, Title: "Key Strategic Risks"},
+			{Level: 3, Title: "Contingency Plans"},
+			{Level: 3, Title: "Success Metrics"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Clear where-to-play choices defined",
+			"Compelling how-to-win approach articulated",
+			"Core capabilities identified and development planned",
+			"Strategic initiatives mapped to strategy",
+			"Resource requirements understood and planned",
+			"Key strategic risks identified and mitigated",
+			"Success metrics defined and trackable",
+			"Strategy differentiates from competitors",
+			"Strategy aligns with organizational capabilities",
+			"Leadership team aligned on strategic direction",
+			"Strategy guides major decisions and investments",
+			"Regular review and updating process established",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Clear where-to-play choices defined",
+				"Basic how-to-win approach articulated",
+				"Core capabilities identified",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive strategic framework",
+				"Detailed competitive analysis integrated",
+				"Strategic initiatives mapped and prioritized",
+				"Resource requirements quantified",
+				"Risk assessment and contingency planning",
+				"Success metrics defined",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Regular strategy review and updating process",
+				"Strategy execution tracking system",
+				"Cross-functional strategy alignment",
+				"Strategy communication and cascading process",
+				"Strategy-based decision framework implemented",
+				"Strategy evolution history documented",
+			}},
+		},
+	},
+	{
+		Code:         "SUP",
+		Name:         "Support Specification",
+		Purpose:      "The Support Specification defines comprehensive customer support strategies, processes, and service standards that ensure exceptional customer experience throughout the product lifecycle. It establishes support frameworks that maximize customer success while optimizing operational efficiency and business outcomes.",
+		Domain:       "Product & Service",
+		Dependencies: []string{"SVC", "PER", "INT"},
+		Enablements:  []string{"QUA", "UXD", "REQ"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Support Overview"},
+			{Level: 2, Title: "Support Strategy"},
+			{Level: 3, Title: "Support Objectives"},
+			{Level: 3, Title: "Support Principles"},
+			{Level: 3, Title: "Value Proposition"},
+			{Level: 2, Title: "Support Service Definition"},
+			{Level: 3, Title: "Service Offerings"},
+			{Level: 3, Title: "Support Channels"},
+			{Level: 2, Title: "Support Process Design"},
+			{Level: 3, Title: "Issue Management Process"},
+			{Level: 3, Title: "Escalation Process"},
+			{Level: 3, Title: "Knowledge Management"},
+			{Level: 2, Title: "Support Team Structure"},
+			{Level: 3, Title: "Team Organization"},
+			{Level: 3, Title: "Role Definitions"},
+			{Level: 3, Title: "Training and Development"},
+			{Level: 2, Title: "Service Level Agreements (SLAs)"},
+			{Level: 3, Title: "Response Time SLAs"},
+			{Level: 3, Title: "Resolution Time SLAs"},
+			{Level: 3, Title: "Quality SLAs"},
+			{Level: 2, Title: "Support Metrics and KPIs"},
+			{Level: 3, Title: "Operational Metrics"},
+			{Level: 3, Title: "Quality Metrics"},
+			{Level: 3, Title: "Business Impact Metrics"},
+			{Level: 2, Title: "Support Tools and Technology"},
+			{Level: 3, Title: "Support Platform"},
+			{Level: 3, Title: "Integration Systems"},
+			{Level: 3, Title: "Automation and AI"},
+			{Level: 2, Title: "Customer Communication"},
+			{Level: 3, Title: "Communication Standards"},
+			{Level: 3, Title: "Proactive Communication"},
+			{Level: 3, Title: "Feedback Collection"},
+			{Level: 2, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Improvement Process"},
+			{Level: 3, Title: "Innovation Initiatives"},
+			{Level: 3, Title: "Learning and Development"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 3, Title: "Support Risks"},
+			{Level: 3, Title: "Risk Mitigation"},
+			{Level: 3, Title: "Crisis Management"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Support overview with clear purpose, scope, philosophy, and success definition",
+			"Support strategy with objectives, principles, and value proposition",
+			"Support service definition with comprehensive tiers, channels, and offerings",
+			"Support process design with issue management, escalation, and knowledge management",
+			"Support team structure with organization, roles, and training programs",
+			"Service level agreements with response times, resolution targets, and quality standards",
+			"Support metrics and KPIs covering operational, quality, and business impact dimensions",
+			"Support tools and technology with platform, integration, and automation capabilities",
+			"Customer communication with standards, proactive outreach, and feedback collection",
+			"Continuous improvement with processes, innovation, and learning initiatives",
+			"Risk management with support risks, mitigation strategies, and crisis management",
+			"Validation evidence of support excellence and customer satisfaction achievement",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Support overview with purpose, scope, and philosophy",
+				"Basic support strategy with objectives and principles",
+				"Core support service definition with tiers and channels",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive support process design with issue management and escalation",
+				"Support team structure with roles, responsibilities, and training",
+				"Service level agreements with response and resolution targets",
+				"Support metrics and KPIs across operational, quality, and business dimensions",
+				"Support tools and technology with platform and integration capabilities",
+				"Customer communication standards and feedback collection",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced continuous improvement with innovation initiatives and learning programs",
+				"Comprehensive risk management with crisis response and business continuity",
+				"AI-powered support automation with predictive analytics and personalization",
+				"Real-time support optimization with dynamic resource allocation",
+				"Integrated customer success platform with proactive support capabilities",
+				"Advanced analytics with customer journey optimization and business impact measurement",
+			}},
+		},
+	},
+	{
+		Code:         "SUR",
+		Name:         "Surveys",
+		Purpose:      "The Surveys document captures quantitative customer research through structured questionnaires that provide statistical insights into customer attitudes, behaviors, preferences, and satisfaction levels.",
+		Domain:       "Customer & Value",
+		Dependencies: []string{"PER", "SEG"},
+		Enablements:  []string{"FEE", "MET", "CMP"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Survey Design"},
+			{Level: 3, Title: "Research Objectives"},
+			{Level: 3, Title: "Survey Methodology"},
+			{Level: 3, Title: "Target Population"},
+			{Level: 2, Title: "Survey Implementation"},
+			{Level: 3, Title: "Distribution Strategy"},
+			{Level: 3, Title: "Response Management"},
+			{Level: 2, Title: "Survey Results"},
+			{Level: 3, Title: "Response Summary"},
+			{Level: 3, Title: "Respondent Profile"},
+			{Level: 2, Title: "Key Findings"},
+			{Level: 3, Title: "Primary Metrics"},
+			{Level: 3, Title: "Detailed Analysis"},
+			{Level: 3, Title: "Statistical Analysis"},
+			{Level: 2, Title: "Competitive Intelligence"},
+			{Level: 3, Title: "Competitive Comparison"},
+			{Level: 3, Title: "Market Position Analysis"},
+			{Level: 2, Title: "Segment-Specific Insights"},
+			{Level: 3, Title: "Segment Analysis"},
+			{Level: 3, Title: "Persona Validation"},
+			{Level: 2, Title: "Open-Ended Feedback Analysis"},
+			{Level: 3, Title: "Qualitative Themes"},
+			{Level: 3, Title: "Verbatim Insights"},
+			{Level: 2, Title: "Actionable Recommendations"},
+			{Level: 3, Title: "Immediate Actions (0-30 days)"},
+			{Level: 3, Title: "Short-term Actions (1-3 months)"},
+			{Level: 3, Title: "Long-term Strategic Actions (3-12 months)"},
+			{Level: 3, Title: "Survey Program Enhancement"},
+			{Level: 2, Title: "Statistical Appendix"},
+			{Level: 3, Title: "Survey Instrument"},
+			{Level: 3, Title: "Statistical Details"},
+			{Level: 3, Title: "Data Quality Assessment"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Survey design with research objectives, methodology, and target population",
+			"Survey implementation strategy with distribution and response management",
+			"Comprehensive survey results with response summary and respondent profile",
+			"Key findings including primary metrics and detailed analysis",
+			"Statistical analysis with correlation, segmentation, and trend analysis",
+			"Competitive intelligence with comparison and market position insights",
+			"Segment-specific insights and persona validation",
+			"Open-ended feedback analysis with qualitative themes and verbatim insights",
+			"Actionable recommendations with immediate, short-term, and strategic actions",
+			"Statistical appendix with survey instrument and data quality assessment",
+			"Validation evidence of survey reliability and business impact",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Survey design with clear objectives and methodology",
+				"Target population and sampling strategy defined",
+				"Basic survey results with response metrics and key findings",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive survey implementation with distribution strategy and response management",
+				"Detailed survey results with statistical analysis and segmentation insights",
+				"Competitive intelligence and market position analysis",
+				"Open-ended feedback analysis with qualitative themes",
+				"Actionable recommendations with immediate and strategic actions",
+				"Statistical appendix with survey instrument and data quality assessment",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic survey tracking with real-time response monitoring",
+				"Advanced survey analytics with predictive modeling and trend analysis",
+				"Continuous survey optimization and methodology refinement",
+				"Survey-driven product development and customer experience improvement",
+				"Automated survey distribution and analysis systems",
+				"Cross-functional survey coordination and stakeholder integration",
+			}},
+		},
+	},
+	{
+		Code:         "SVC",
+		Name:         "Service Specification",
+		Purpose:      "The Service Specification defines comprehensive requirements for services, including delivery models, quality standards, and operational requirements. It ensures services are designed to deliver customer value effectively and efficiently.",
+		Domain:       "Product & Service",
+		Dependencies: []string{"CJM", "CAP", "PRO"},
+		Enablements:  []string{"SLA", "PER", "SUP"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Service Overview"},
+			{Level: 2, Title: "Service Value Proposition"},
+			{Level: 3, Title: "Customer Value"},
+			{Level: 3, Title: "Business Value"},
+			{Level: 2, Title: "Service Description"},
+			{Level: 3, Title: "Service Offering"},
+			{Level: 3, Title: "Service Features"},
+			{Level: 3, Title: "Service Journey"},
+			{Level: 2, Title: "Service Delivery Model"},
+			{Level: 3, Title: "Delivery Channels"},
+			{Level: 3, Title: "Service Levels"},
+			{Level: 3, Title: "Delivery Process"},
+			{Level: 2, Title: "Operational Requirements"},
+			{Level: 3, Title: "Staffing Requirements"},
+			{Level: 3, Title: "Technology Requirements"},
+			{Level: 3, Title: "Infrastructure Requirements"},
+			{Level: 2, Title: "Service Quality Framework"},
+			{Level: 3, Title: "Quality Standards"},
+			{Level: 3, Title: "Customer Experience Standards"},
+			{Level: 3, Title: "Performance Standards"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 3, Title: "Service Risks"},
+			{Level: 3, Title: "Risk Mitigation"},
+			{Level: 2, Title: "Financial Model"},
+			{Level: 3, Title: "Cost Structure"},
+			{Level: 3, Title: "Pricing Strategy"},
+			{Level: 3, Title: "Financial Metrics"},
+			{Level: 2, Title: "Success Metrics"},
+			{Level: 3, Title: "Business Metrics"},
+			{Level: 3, Title: "Operational Metrics"},
+			{Level: 3, Title: "Customer Metrics"},
+			{Level: 2, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Improvement Framework"},
+			{Level: 3, Title: "Change Management"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Service overview with purpose, scope, target customers, and classification",
+			"Service value proposition covering customer and business value",
+			"Service description with offering, features, and customer journey",
+			"Service delivery model with channels, service levels, and processes",
+			"Operational requirements covering staffing, technology, and infrastructure",
+			"Service quality framework with standards, experience design, and performance",
+			"Risk management with service risks and mitigation strategies",
+			"Financial model with cost structure, pricing strategy, and metrics",
+			"Success metrics across business, operational, and customer dimensions",
+			"Continuous improvement framework with performance review and change management",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Service overview with purpose, scope, and target customers",
+				"Basic service value proposition and description",
+				"High-level delivery model and operational requirements",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive service delivery model with channels and processes",
+				"Service quality framework with standards and performance metrics",
+				"Risk management with mitigation strategies",
+				"Financial model with cost structure and pricing strategy",
+				"Success metrics across business, operational, and customer dimensions",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Detailed operational requirements with staffing and technology specifications",
+				"Continuous improvement framework with change management",
+				"Real-time service monitoring and performance optimization",
+				"Service evolution and innovation pipeline",
+				"Cross-service integration and modularity",
+			}},
+		},
+	},
+	{
+		Code:    "SWO",
+		Name:    "SWOT Synthesis",
+		Purpose: "SWOT Synthesis converts Opportunity and Threat inputs into consolidated strategic implications and option sets, and connects them to strategy and action priorities.",
+		Domain:  "Market & Environment",
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Framework"},
+			{Level: 2, Title: "Strategic Synthesis"},
+		},
+		Checklist: []string{
+			"Inputs are explicitly linked to OPP and Threat analysis.",
+			"Strategic implications are converted to concrete decisions.",
+			"Ownership and review cycle are specified.",
+			"Assumptions and confidence are recorded.",
+		},
+	},
+	{
+		Code:         "SYS",
+		Name:         "Systems",
+		Purpose:      "The Systems document defines systematic approaches to designing, implementing, and managing technology systems that deliver business capabilities through functional features, technical architecture, and operational excellence. It establishes system frameworks that ensure scalability, maintainability, and business value delivery.",
+		Domain:       "Technology & Data",
+		Dependencies: []string{"ARC", "REQ", "DAT", "API"},
+		Enablements:  []string{"PER", "QUA", "INT", "MON"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "System Overview"},
+			{Level: 3, Title: "Business Purpose"},
+			{Level: 3, Title: "Technical Overview"},
+			{Level: 3, Title: "System Boundaries"},
+			{Level: 2, Title: "Functional Capabilities"},
+			{Level: 3, Title: "Core Features"},
+			{Level: 3, Title: "Use Cases"},
+			{Level: 3, Title: "Business Rules"},
+			{Level: 2, Title: "Technical Architecture"},
+			{Level: 3, Title: "System Architecture"},
+			{Level: 3, Title: "Data Management"},
+			{Level: 3, Title: "Integration Points"},
+			{Level: 2, Title: "Operations and Management"},
+			{Level: 3, Title: "Deployment Model"},
+			{Level: 3, Title: "Monitoring and Observability"},
+			{Level: 3, Title: "Maintenance and Support"},
+			{Level: 2, Title: "Performance and Scalability"},
+			{Level: 3, Title: "Performance Characteristics"},
+			{Level: 3, Title: "Scalability Design"},
+			{Level: 2, Title: "Security and Compliance"},
+			{Level: 3, Title: "Security Architecture"},
+			{Level: 3, Title: "Compliance Requirements"},
+			{Level: 2, Title: "Quality Assurance"},
+			{Level: 3, Title: "Testing Strategy"},
+			{Level: 3, Title: "Quality Metrics"},
+			{Level: 2, Title: "Business Continuity"},
+			{Level: 3, Title: "Availability Requirements"},
+			{Level: 3, Title: "Disaster Recovery"},
+			{Level: 2, Title: "Governance and Evolution"},
+			{Level: 3, Title: "System Governance"},
+			{Level: 3, Title: "Evolution Strategy"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, type, criticality, and user base",
+			"System overview with business purpose, technical overview, and system boundaries",
+			"Functional capabilities with core features, use cases, and business rules",
+			"Technical architecture with system architecture, data management, and integration points",
+			"Operations and management with deployment model, monitoring, and maintenance procedures",
+			"Performance and scalability with characteristics, scaling design, and optimization",
+			"Security and compliance with architecture, requirements, and compliance framework",
+			"Quality assurance with testing strategy and quality metrics",
+			"Business continuity with availability requirements and disaster recovery procedures",
+			"Governance and evolution with ownership, change management, and evolution strategy",
+			"Validation evidence of system effectiveness, requirement fulfillment, and operational success",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic system overview with core functionality",
+				"Simple technical architecture documentation",
+				"Basic deployment and operational procedures",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive system documentation with detailed capabilities",
+				"Thorough technical architecture and integration specification",
+				"Structured operations, monitoring, and maintenance procedures",
+				"Security and compliance framework",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced system optimization and performance management",
+				"Sophisticated monitoring, observability, and analytics",
+				"Comprehensive business continuity and disaster recovery",
+				"Strategic evolution and modernization planning",
+			}},
+		},
+	},
+	{
+		Code:         "TAX",
+		Name:         "Tax Strategy",
+		Purpose:      "The Tax Strategy document defines systematic approaches to tax planning, compliance, and optimization that minimize tax liability while ensuring full compliance with tax laws and regulations. It establishes tax frameworks that support business objectives, manage tax risks, and create sustainable tax efficiency.",
+		Domain:       "Financial & Investment",
+		Dependencies: []string{"FIN", "STR", "ORG", "INV"},
+		Enablements:  []string{"COM", "RSK", "REP", "GOV"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Tax Strategy Framework"},
+			{Level: 3, Title: "Tax Philosophy"},
+			{Level: 3, Title: "Strategic Approach"},
+			{Level: 3, Title: "Business Integration"},
+			{Level: 2, Title: "Domestic Tax Strategy"},
+			{Level: 3, Title: "Corporate Income Tax"},
+			{Level: 3, Title: "State and Local Tax"},
+			{Level: 3, Title: "Employment Tax"},
+			{Level: 2, Title: "International Tax Strategy"},
+			{Level: 3, Title: "Global Tax Structure"},
+			{Level: 3, Title: "Cross-Border Transactions"},
+			{Level: 3, Title: "Compliance and Reporting"},
+			{Level: 2, Title: "Tax Compliance Management"},
+			{Level: 3, Title: "Compliance Framework"},
+			{Level: 3, Title: "Documentation and Records"},
+			{Level: 3, Title: "Technology and Automation"},
+			{Level: 2, Title: "Tax Planning and Optimization"},
+			{Level: 3, Title: "Strategic Tax Planning"},
+			{Level: 3, Title: "Tax Incentives and Credits"},
+			{Level: 3, Title: "Advanced Strategies"},
+			{Level: 2, Title: "Risk Management and Compliance"},
+			{Level: 3, Title: "Tax Risk Assessment"},
+			{Level: 3, Title: "Uncertainty Management"},
+			{Level: 3, Title: "Regulatory Monitoring"},
+			{Level: 2, Title: "Performance Measurement"},
+			{Level: 3, Title: "Tax Metrics and KPIs"},
+			{Level: 3, Title: "Value Creation Measurement"},
+			{Level: 3, Title: "Benchmarking and Analysis"},
+			{Level: 2, Title: "Governance and Organization"},
+			{Level: 3, Title: "Tax Function Organization"},
+			{Level: 3, Title: "Tax Governance"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, tax scope, compliance approach, and planning horizon",
+			"Tax strategy framework with philosophy, strategic approach, and business integration",
+			"Domestic tax strategy with income tax, state and local tax, and employment tax",
+			"International tax strategy with global structure, cross-border transactions, and compliance",
+			"Tax compliance management with framework, documentation, and technology",
+			"Tax planning and optimization with strategic planning, incentives, and advanced strategies",
+			"Risk management and compliance with risk assessment, uncertainty management, and regulatory monitoring",
+			"Performance measurement with tax metrics, value creation measurement, and benchmarking",
+			"Governance and organization with tax function organization and governance structure",
+			"Validation evidence of tax optimization, compliance assurance, and business objective support",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic tax compliance with filing requirements",
+				"Simple tax planning for routine transactions",
+				"Basic tax risk identification and management",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive tax strategy with planning optimization",
+				"Structured compliance management with quality controls",
+				"Systematic tax risk management and mitigation",
+				"Regular tax performance measurement and reporting",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Sophisticated tax optimization with advanced planning strategies",
+				"Highly automated compliance processes with real-time monitoring",
+				"Comprehensive tax risk management with predictive analytics",
+				"Strategic tax function integration with business planning and value creation",
+			}},
+		},
+	},
+	{
+		Code:         "TEA",
+		Name:         "Team Structure",
+		Purpose:      "The Team Structure defines systematic approaches to organizing and managing teams that deliver business outcomes through effective collaboration, clear accountability, and continuous improvement. It establishes team frameworks that optimize performance, engagement, and value delivery.",
+		Domain:       "Operations & Execution",
+		Dependencies: []string{"ORG", "ROL", "SKI", "PRO"},
+		Enablements:  []string{"PER", "QUA", "COL", "INK"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Team Charter"},
+			{Level: 3, Title: "Mission and Purpose"},
+			{Level: 3, Title: "Scope and Boundaries"},
+			{Level: 2, Title: "Team Composition"},
+			{Level: 3, Title: "Role Structure"},
+			{Level: 3, Title: "Skill Matrix"},
+			{Level: 3, Title: "Team Development"},
+			{Level: 2, Title: "Operating Model"},
+			{Level: 3, Title: "Working Agreements"},
+			{Level: 3, Title: "Meeting Cadence"},
+			{Level: 3, Title: "Workflow and Processes"},
+			{Level: 2, Title: "Performance Framework"},
+			{Level: 3, Title: "Team Objectives"},
+			{Level: 3, Title: "Success Metrics"},
+			{Level: 3, Title: "Performance Rituals"},
+			{Level: 2, Title: "Team Dynamics"},
+			{Level: 3, Title: "Culture and Values"},
+			{Level: 3, Title: "Conflict Resolution"},
+			{Level: 3, Title: "Growth and Development"},
+			{Level: 2, Title: "Dependencies and Relationships"},
+			{Level: 3, Title: "Internal Dependencies"},
+			{Level: 3, Title: "External Dependencies"},
+			{Level: 2, Title: "Risk and Contingency"},
+			{Level: 3, Title: "Team Risks"},
+			{Level: 3, Title: "Mitigation Strategies"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, type, size, and duration",
+			"Team charter with mission, vision, value proposition, and scope definition",
+			"Team composition with role structure, skill matrix, and development planning",
+			"Operating model with working agreements, meeting cadence, and workflow processes",
+			"Performance framework with objectives, success metrics, and performance rituals",
+			"Team dynamics with culture, conflict resolution, and growth development",
+			"Dependencies and relationships with internal, external, and system dependencies",
+			"Risk and contingency with comprehensive risk identification and mitigation strategies",
+			"Validation evidence of team effectiveness, collaboration quality, and performance improvement",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Clear team purpose and basic composition",
+				"Defined roles and responsibilities",
+				"Basic communication and meeting structure",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive team charter and operating model",
+				"Detailed skill matrix and development planning",
+				"Performance framework with metrics and objectives",
+				"Conflict resolution and team development processes",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced team dynamics and culture development",
+				"Sophisticated performance measurement and optimization",
+				"Comprehensive risk management and contingency planning",
+				"Continuous team learning and adaptation mechanisms",
+			}},
+		},
+	},
+	{
+		Code:         "THR",
+		Name:         "Threats",
+		Purpose:      "The Threats document identifies external risks to market position and business model. It analyzes potential threats from competitors, market changes, and environmental factors and is distinct from `THY` (Theory of Change), which maps causality for intentional transformation.",
+		Domain:       "Market & Environment",
+		Dependencies: []string{"CMP", "TRN", "MAC"},
+		Enablements:  []string{"RSK", "STR", "MIT"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Threat Framework"},
+			{Level: 3, Title: "Categories"},
+			{Level: 3, Title: "Assessment Criteria"},
+			{Level: 2, Title: "Identified Threats"},
+			{Level: 3, Title: "Threat 1: [Name]"},
+			{Level: 3, Title: "[Continue for each significant threat]"},
+			{Level: 2, Title: "Threat Interactions"},
+			{Level: 2, Title: "Threat Prioritization"},
+			{Level: 2, Title: "Defensive Strategy"},
+			{Level: 2, Title: "Monitoring System"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Threats identified across all relevant categories",
+			"Probability and impact assessments documented",
+			"Vulnerability analysis explains exposure factors",
+			"Early warning indicators and monitoring established",
+			"Mitigation strategies and response plans prepared",
+			"Threat interactions and compound effects analyzed",
+			"Prioritization drives resource allocation decisions",
+			"Crisis preparedness and business continuity addressed",
+			"Regular monitoring and assessment process operational",
+			"Defensive strategies integrated with business planning",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Key threats identified and categorized",
+				"Basic probability and impact assessment",
+				"Early warning indicators defined",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive threat analysis with interactions",
+				"Detailed impact assessment and vulnerability analysis",
+				"Mitigation strategies and response plans",
+				"Threat prioritization and resource allocation",
+				"Monitoring system with early warning capabilities",
+				"Crisis preparedness protocols",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic threat intelligence and monitoring",
+				"Advanced scenario planning and stress testing",
+				"Automated early warning and response systems",
+				"Integrated business continuity management",
+				"Regular threat landscape updates and adaptation",
+				"Competitive threat response capabilities",
+			}},
+		},
+	},
+	{
+		Code:         "THY",
+		Name:         "Theory of Change",
+		Purpose:      "The Theory of Change document maps the logic model connecting the organization's activities to its intended outcomes. It explains how and why specific actions will lead to desired changes.",
+		Domain:       "Strategic Foundation",
+		Dependencies: []string{"PUR", "MSN", "STR"},
+		Enablements:  []string{"MET", "EXP", "LRN"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Change Logic Model"},
+			{Level: 3, Title: "Ultimate Goal"},
+			{Level: 3, Title: "Intended Outcomes"},
+			{Level: 3, Title: "Activities and Outputs"},
+			{Level: 3, Title: "Input Requirements"},
+			{Level: 2, Title: "Causal Assumptions"},
+			{Level: 3, Title: "Activity-Output Links"},
+			{Level: 3, Title: "Output-Outcome Links"},
+			{Level: 3, Title: "Outcome-Impact Links"},
+			{Level: 3, Title: "External Factors"},
+			{Level: 2, Title: "Evidence Base"},
+			{Level: 3, Title: "Research Foundation"},
+			{Level: 3, Title: "Historical Precedents"},
+			{Level: 3, Title: "Pilot Results"},
+			{Level: 3, Title: "Expert Validation"},
+			{Level: 2, Title: "Measurement Framework"},
+			{Level: 3, Title: "Outcome Indicators"},
+			{Level: 3, Title: "Data Collection Methods"},
+			{Level: 3, Title: "Evaluation Timeline"},
+			{Level: 3, Title: "Learning Integration"},
+			{Level: 2, Title: "Theory Testing"},
+			{Level: 3, Title: "Key Hypotheses"},
+			{Level: 3, Title: "Validation Methods"},
+			{Level: 3, Title: "Adaptation Triggers"},
+			{Level: 3, Title: "Learning Culture"},
+			{Level: 2, Title: "Risk Factors"},
+			{Level: 3, Title: "Theory Risks"},
+			{Level: 3, Title: "Implementation Risks"},
+			{Level: 3, Title: "External Risks"},
+			{Level: 3, Title: "Mitigation Strategies"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Clear logic model from activities to ultimate goal",
+			"Short, medium, and long-term outcomes defined",
+			"Core activities and direct outputs specified",
+			"Input requirements (human, financial, physical) identified",
+			"Causal assumptions explicitly stated",
+			"Evidence base supporting theory documented",
+			"Outcome indicators and measurement methods defined",
+			"Key hypotheses and validation approaches outlined",
+			"Risk factors and mitigation strategies identified",
+			"External factors and conditions acknowledged",
+			"Theory testing and adaptation protocols established",
+			"Regular review and updating process implemented",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Clear logic model from activities to outcomes",
+				"Ultimate goal and intended outcomes defined",
+				"Core activities and outputs identified",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Detailed causal assumptions articulated",
+				"Evidence base supporting theory provided",
+				"Measurement framework for outcomes",
+				"Key hypotheses and validation methods",
+				"Risk assessment and mitigation strategies",
+				"Input requirements clearly specified",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Regular theory testing and validation",
+				"Evidence-based theory refinement process",
+				"Comprehensive data collection system",
+				"Learning integration and adaptation protocols",
+				"External expert validation obtained",
+				"Theory-driven program design implemented",
+			}},
+		},
+	},
+	{
+		Code:         "TON",
+		Name:         "Tone of Voice",
+		Purpose:      "The Tone of Voice document defines how the brand sounds in all communications, establishing voice characteristics that express brand personality and create consistent customer experiences. It provides guidelines that ensure authentic, recognizable, and engaging brand communication across all touchpoints and channels.",
+		Domain:       "Brand & Marketing",
+		Dependencies: []string{"BRD", "MSG", "PER", "CUS"},
+		Enablements:  []string{"CNT", "CAM", "SOC", "SUP"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Tone of Voice Framework"},
+			{Level: 3, Title: "Voice Philosophy"},
+			{Level: 3, Title: "Voice Foundation"},
+			{Level: 3, Title: "Voice Identity"},
+			{Level: 2, Title: "Voice Characteristics Definition"},
+			{Level: 3, Title: "Personality Dimensions"},
+			{Level: 3, Title: "Character Traits"},
+			{Level: 3, Title: "Voice Archetypes"},
+			{Level: 2, Title: "Communication Guidelines"},
+			{Level: 3, Title: "Language and Vocabulary"},
+			{Level: 3, Title: "Writing Style Guidelines"},
+			{Level: 3, Title: "Grammar and Usage"},
+			{Level: 2, Title: "Channel-Specific Applications"},
+			{Level: 3, Title: "Digital Channel Adaptations"},
+			{Level: 3, Title: "Traditional Channel Adaptations"},
+			{Level: 3, Title: "Interactive Channel Guidelines"},
+			{Level: 2, Title: "Implementation and Consistency"},
+			{Level: 3, Title: "Voice Implementation Strategy"},
+			{Level: 3, Title: "Consistency Framework"},
+			{Level: 3, Title: "Voice Evolution Management"},
+			{Level: 2, Title: "Measurement and Optimization"},
+			{Level: 3, Title: "Voice Performance Metrics"},
+			{Level: 3, Title: "Optimization Process"},
+			{Level: 3, Title: "Voice Research and Testing"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, characteristics, style, and guidelines",
+			"Voice framework with philosophy, foundation, and identity definition",
+			"Characteristics definition with personality dimensions, traits, and archetypes",
+			"Communication guidelines with language, style, and grammar standards",
+			"Channel applications with digital, traditional, and interactive adaptations",
+			"Implementation and consistency with strategy, framework, and evolution management",
+			"Measurement and optimization with metrics, optimization, and research processes",
+			"Validation evidence of authentic personality, consistent communication, and audience connection",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic tone of voice with core characteristics and simple guidelines",
+				"Basic language guidelines and communication standards",
+				"Simple channel adaptations and usage examples",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive tone of voice with detailed characteristics and guidelines",
+				"Structured channel adaptations with implementation strategy",
+				"Active voice measurement with performance tracking and optimization",
+				"Regular voice review and evolution planning",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced voice capabilities with sophisticated guidelines and adaptations",
+				"Comprehensive voice ecosystem with integrated implementation and governance",
+				"Voice excellence with industry recognition and differentiation",
+				"Strategic voice management driving brand recognition and customer connection",
+			}},
+		},
+	},
+	{
+		Code:         "TRN",
+		Name:         "Trends",
+		Purpose:      "The Trends document identifies and analyzes market forces and changes shaping the industry. It examines technology trends, social shifts, regulatory changes, and other factors that could impact the business.",
+		Domain:       "Market & Environment",
+		Dependencies: []string{"MKT", "MAC"},
+		Enablements:  []string{"STR", "INN", "OPP", "THR"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Trend Analysis Framework"},
+			{Level: 3, Title: "Trend Categories"},
+			{Level: 3, Title: "Trend Identification Process"},
+			{Level: 2, Title: "Key Trends"},
+			{Level: 3, Title: "Trend 1: [Trend Name]"},
+			{Level: 3, Title: "Trend 2: [Trend Name]"},
+			{Level: 3, Title: "[Continue for each significant trend]"},
+			{Level: 2, Title: "Trend Interaction Analysis"},
+			{Level: 3, Title: "Convergent Trends"},
+			{Level: 3, Title: "Conflicting Trends"},
+			{Level: 3, Title: "Trend Amplification"},
+			{Level: 3, Title: "Meta-Trends"},
+			{Level: 2, Title: "Strategic Implications"},
+			{Level: 3, Title: "Market Evolution"},
+			{Level: 3, Title: "Business Model Impact"},
+			{Level: 3, Title: "Innovation Implications"},
+			{Level: 2, Title: "Scenario Planning"},
+			{Level: 3, Title: "Trend Scenarios"},
+			{Level: 3, Title: "Strategic Preparedness"},
+			{Level: 2, Title: "Trend Monitoring System"},
+			{Level: 3, Title: "Monitoring Process"},
+			{Level: 3, Title: "Early Warning Indicators"},
+			{Level: 3, Title: "Update Frequency"},
+			{Level: 3, Title: "Decision Triggers"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Key trends are identified across all relevant categories",
+			"Trend descriptions include current state and evolution timeline",
+			"Driving forces and evidence validate each trend",
+			"Geographic variation and cultural factors considered",
+			"Direct and indirect business impacts assessed",
+			"Opportunity and threat potential evaluated",
+			"Strategic response options identified",
+			"Trend interactions and meta-trends analyzed",
+			"Strategic implications for business model detailed",
+			"Scenario planning addresses multiple futures",
+			"Trend monitoring system established",
+			"Regular review and update process implemented",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Key trends identified and described",
+				"Basic impact assessment for each trend",
+				"Trend sources and validation documented",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive trend analysis with driving forces and evidence",
+				"Trend interaction analysis showing convergent and conflicting patterns",
+				"Strategic implications for business model and innovation",
+				"Scenario planning based on trend development",
+				"Trend monitoring process established",
+				"Geographic variation analysis included",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic trend monitoring with early warning systems",
+				"Advanced scenario planning with strategic preparedness",
+				"Trend-driven innovation pipeline",
+				"Regular trend validation through multiple sources",
+				"Automated trend monitoring and alerting",
+				"Integration with strategic planning and decision making",
+			}},
+		},
+	},
+	{
+		Code:         "USE",
+		Name:         "Use Cases",
+		Purpose:      "The Use Cases document describes customer workflow scenarios using the solution in context. It is used for contextualizing product behavior and should remain scenario-level; use `STO` for implementation-sized, story-level requirements when decomposing work for teams.",
+		Domain:       "Customer & Value",
+		Dependencies: []string{"JTB", "PER"},
+		Enablements:  []string{"REQ", "STO", "PRD"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Use Case Summary"},
+			{Level: 3, Title: "Use Case Details"},
+			{Level: 3, Title: "Scope and Context"},
+			{Level: 2, Title: "Actors and Stakeholders"},
+			{Level: 3, Title: "Primary Actors"},
+			{Level: 3, Title: "Secondary Actors"},
+			{Level: 2, Title: "Use Case Scenario"},
+			{Level: 3, Title: "Preconditions"},
+			{Level: 3, Title: "Trigger Events"},
+			{Level: 3, Title: "Main Success Scenario"},
+			{Level: 3, Title: "Alternative Flows"},
+			{Level: 3, Title: "Exception Flows"},
+			{Level: 2, Title: "Postconditions"},
+			{Level: 3, Title: "Success Postconditions"},
+			{Level: 3, Title: "Failure Postconditions"},
+			{Level: 2, Title: "Business Rules"},
+			{Level: 3, Title: "Functional Rules"},
+			{Level: 3, Title: "Non-Functional Rules"},
+			{Level: 2, Title: "Success Metrics"},
+			{Level: 3, Title: "Functional Metrics"},
+			{Level: 3, Title: "Business Metrics"},
+			{Level: 3, Title: "User Experience Metrics"},
+			{Level: 2, Title: "Requirements Traceability"},
+			{Level: 3, Title: "Functional Requirements"},
+			{Level: 3, Title: "Non-Functional Requirements"},
+			{Level: 2, Title: "Integration Points"},
+			{Level: 3, Title: "System Integrations"},
+			{Level: 3, Title: "Data Dependencies"},
+			{Level: 2, Title: "Risk Assessment"},
+			{Level: 3, Title: "Technical Risks"},
+			{Level: 3, Title: "Business Risks"},
+			{Level: 3, Title: "User Adoption Risks"},
+			{Level: 2, Title: "Testing Considerations"},
+			{Level: 3, Title: "Test Scenarios"},
+			{Level: 3, Title: "Test Data Requirements"},
+			{Level: 2, Title: "Documentation Requirements"},
+			{Level: 3, Title: "User Documentation"},
+			{Level: 3, Title: "Technical Documentation"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Use case details include type, value, frequency, and complexity",
+			"Functional, business, and technical scope clearly defined",
+			"Primary and secondary actors with roles and responsibilities",
+			"Preconditions and trigger events documented",
+			"Main success scenario with detailed step-by-step flow",
+			"Alternative and exception flows identified and documented",
+			"Success and failure postconditions specified",
+			"Business rules covering functional and non-functional aspects",
+			"Success metrics across functional, business, and UX dimensions",
+			"Requirements traceability to functional and non-functional requirements",
+			"Integration points and data dependencies mapped",
+			"Risk assessment with technical, business, and adoption risks",
+			"Testing considerations with scenarios and data requirements",
+			"Documentation requirements for users and technical teams",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Use case summary with basic details and scope",
+				"Primary actors and stakeholders identified",
+				"Main success scenario documented",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive use case scenario with preconditions and postconditions",
+				"Alternative and exception flows documented",
+				"Business rules and success metrics defined",
+				"Requirements traceability established",
+				"Integration points and data dependencies mapped",
+				"Risk assessment with mitigation strategies",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Dynamic use case tracking with real-time metrics",
+				"Automated testing scenarios and validation",
+				"Advanced integration monitoring and optimization",
+				"User behavior analytics and optimization",
+				"Continuous use case evolution based on usage data",
+				"Cross-functional use case governance and alignment",
+			}},
+		},
+	},
+	{
+		Code:         "UXD",
+		Name:         "User Experience Design",
+		Purpose:      "The User Experience Design document defines comprehensive user experience strategies, interaction patterns, and design specifications that ensure products and services deliver intuitive, accessible, and delightful user experiences aligned with user needs and business objectives.",
+		Domain:       "Product & Service",
+		Dependencies: []string{"PER", "CJM", "USE"},
+		Enablements:  []string{"FEA", "REQ", "QUA"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Design Overview"},
+			{Level: 2, Title: "User Research Foundation"},
+			{Level: 3, Title: "User Understanding"},
+			{Level: 3, Title: "Research Insights"},
+			{Level: 2, Title: "Experience Strategy"},
+			{Level: 3, Title: "Experience Principles"},
+			{Level: 3, Title: "Experience Goals"},
+			{Level: 3, Title: "Success Metrics"},
+			{Level: 2, Title: "User Journey Design"},
+			{Level: 3, Title: "Current State Journey"},
+			{Level: 3, Title: "Future State Journey"},
+			{Level: 3, Title: "Journey Orchestration"},
+			{Level: 2, Title: "Information Architecture"},
+			{Level: 3, Title: "Content Strategy"},
+			{Level: 3, Title: "Navigation Design"},
+			{Level: 3, Title: "Information Organization"},
+			{Level: 2, Title: "Interaction Design"},
+			{Level: 3, Title: "Interaction Principles"},
+			{Level: 3, Title: "Interaction Patterns"},
+			{Level: 3, Title: "Microinteractions"},
+			{Level: 2, Title: "Visual Design"},
+			{Level: 3, Title: "Design System Integration"},
+			{Level: 3, Title: "Visual Hierarchy"},
+			{Level: 3, Title: "Accessibility Design"},
+			{Level: 2, Title: "Prototype and Testing"},
+			{Level: 3, Title: "Prototype Strategy"},
+			{Level: 3, Title: "Usability Testing"},
+			{Level: 3, Title: "Design Validation"},
+			{Level: 2, Title: "Implementation Guidelines"},
+			{Level: 3, Title: "Design Handoff"},
+			{Level: 3, Title: "Responsive Design"},
+			{Level: 3, Title: "Technical Constraints"},
+			{Level: 2, Title: "Design Operations"},
+			{Level: 3, Title: "Design Process"},
+			{Level: 3, Title: "Design Tools"},
+			{Level: 3, Title: "Design Quality"},
+			{Level: 2, Title: "Measurement and Optimization"},
+			{Level: 3, Title: "UX Metrics Framework"},
+			{Level: 3, Title: "Data Collection"},
+			{Level: 3, Title: "Optimization Process"},
+			{Level: 2, Title: "Design Evolution"},
+			{Level: 3, Title: "Design Maintenance"},
+			{Level: 3, Title: "Future Considerations"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Design overview with clear purpose, scope, vision, and success definition",
+			"User research foundation with user understanding and validated insights",
+			"Experience strategy with principles, goals, and measurable success metrics",
+			"User journey design with current state analysis and future state vision",
+			"Information architecture with content strategy, navigation, and organization",
+			"Interaction design with principles, patterns, and microinteraction specifications",
+			"Visual design with system integration, hierarchy, and accessibility standards",
+			"Prototype and testing strategy with validation methods and usability testing",
+			"Implementation guidelines with design handoff, responsive design, and technical constraints",
+			"Design operations with process, tools, and quality management",
+			"Measurement and optimization framework with UX metrics and data collection",
+			"Design evolution strategy with maintenance and future considerations",
+			"Validation evidence of user experience effectiveness and accessibility compliance",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Design overview with purpose, scope, and vision",
+				"User research foundation with understanding and insights",
+				"Basic experience strategy with principles and goals",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive user journey design with current and future state mapping",
+				"Information architecture with content strategy and navigation design",
+				"Interaction design with principles, patterns, and microinteractions",
+				"Visual design with system integration and accessibility considerations",
+				"Prototype and testing strategy with validation methods",
+				"Implementation guidelines with design handoff and technical constraints",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced design operations with comprehensive workflow and quality management",
+				"Measurement and optimization framework with behavioral and outcome metrics",
+				"Design evolution strategy with maintenance and future considerations",
+				"Real-time UX monitoring with automated optimization recommendations",
+				"Continuous user research integration with design iteration",
+				"Cross-platform experience consistency with design system maturity",
+			}},
+		},
+	},
+	{
+		Code:         "VAL",
+		Name:         "Organizational Values",
+		Purpose:      "The Values document defines the guiding principles that shape culture, guide decisions, and determine how the organization behaves. Values are the non-negotiable beliefs that influence every action.",
+		Domain:       "Strategic Foundation",
+		Dependencies: []string{"MSN"},
+		Enablements:  []string{"ORG", "POL", "ETH", "ROL"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Core Values"},
+			{Level: 3, Title: "Value 1: [Name]"},
+			{Level: 3, Title: "Value 2: [Name]"},
+			{Level: 3, Title: "[Continue for each core value - typically 3-7 values total]"},
+			{Level: 2, Title: "Values Integration"},
+			{Level: 3, Title: "Hiring and Onboarding"},
+			{Level: 3, Title: "Performance and Development"},
+			{Level: 3, Title: "Decision Making"},
+			{Level: 2, Title: "Values Evolution"},
+			{Level: 3, Title: "Origin Story"},
+			{Level: 3, Title: "Evolution History"},
+			{Level: 3, Title: "Future Considerations"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"3-7 core values clearly defined",
+			"Specific behavioral indicators for each value",
+			"Decision frameworks incorporate values",
+			"Anti-patterns identified and documented",
+			"Values integration in hiring process",
+			"Performance evaluation includes values assessment",
+			"Real examples of values-based decisions",
+			"Leadership team embodies stated values",
+			"Employee behavior reflects values",
+			"Values differentiate organizational culture",
+			"Regular review and evolution process established",
+			"Cultural measurement system implemented",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"3-7 core values clearly defined",
+				"Basic behavioral descriptions for each value",
+				"Values origin story documented",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Detailed behavioral indicators for each value",
+				"Decision frameworks that incorporate values",
+				"Anti-patterns clearly identified",
+				"Values integration in hiring process",
+				"Performance evaluation criteria include values",
+				"Real examples of values-based decisions",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Comprehensive values integration across all HR processes",
+				"Regular values assessment and feedback systems",
+				"Values evolution history well-documented",
+				"Recognition and reward systems aligned with values",
+				"Values-based conflict resolution processes",
+				"Cultural measurement and improvement programs",
+			}},
+		},
+	},
+	{
+		Code:    "VCH",
+		Name:    "Value Chain Analysis",
+		Purpose: "Use this template to produce a repeatable map of value-driving activities, dependencies, and optimization opportunities across inbound logistics, operations, and customer-facing delivery.",
+		Domain:  "Market & Environment",
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Activity Mapping"},
+			{Level: 3, Title: "Primary Activities"},
+			{Level: 3, Title: "Support Activities"},
+			{Level: 2, Title: "Value Measurement"},
+			{Level: 2, Title: "Improvement Opportunities"},
+			{Level: 2, Title: "Strategic Linkage"},
+			{Level: 2, Title: "Quality Standard"},
+		},
+	},
+	{
+		Code:         "VID",
+		Name:         "Visual Identity",
+		Purpose:      "The Visual Identity document defines the visual language that expresses brand personality and creates recognition across all customer touchpoints. It establishes design frameworks that ensure consistent brand expression, enhance customer recognition, and communicate brand values through visual elements.",
+		Domain:       "Brand & Marketing",
+		Dependencies: []string{"BRD", "MSG", "TON"},
+		Enablements:  []string{"CNT", "CAM", "UXD", "INT"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Visual Identity Framework"},
+			{Level: 3, Title: "Visual Philosophy"},
+			{Level: 3, Title: "Design Foundation"},
+			{Level: 3, Title: "Brand Recognition"},
+			{Level: 2, Title: "Logo Design System"},
+			{Level: 3, Title: "Logo Architecture"},
+			{Level: 3, Title: "Logo Application Guidelines"},
+			{Level: 3, Title: "Logo Protection"},
+			{Level: 2, Title: "Color System Design"},
+			{Level: 3, Title: "Color Strategy"},
+			{Level: 3, Title: "Color Application"},
+			{Level: 3, Title: "Color Management"},
+			{Level: 2, Title: "Typography System"},
+			{Level: 3, Title: "Typography Strategy"},
+			{Level: 3, Title: "Typography Applications"},
+			{Level: 3, Title: "Typography Guidelines"},
+			{Level: 2, Title: "Imagery and Iconography"},
+			{Level: 3, Title: "Visual Style Guidelines"},
+			{Level: 3, Title: "Content Guidelines"},
+			{Level: 3, Title: "Asset Management"},
+			{Level: 2, Title: "Implementation and Governance"},
+			{Level: 3, Title: "Design System Implementation"},
+			{Level: 3, Title: "Governance Framework"},
+			{Level: 3, Title: "Evolution and Maintenance"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, maturity level, colors, and typography",
+			"Visual framework with philosophy, foundation, and recognition strategy",
+			"Logo system with architecture, applications, and protection guidelines",
+			"Color system with strategy, applications, and management standards",
+			"Typography system with strategy, applications, and guidelines",
+			"Imagery and iconography with style guidelines, content rules, and asset management",
+			"Implementation and governance with strategy, framework, and evolution planning",
+			"Validation evidence of recognition creation, consistency ensuring, and personality communication",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic visual identity with logo, colors, and typography defined",
+				"Simple application guidelines and usage standards",
+				"Basic asset organization and file management",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive visual identity with detailed design system",
+				"Structured implementation with governance and quality standards",
+				"Active identity management with monitoring and compliance",
+				"Regular identity review and evolution planning",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced visual identity with sophisticated design systems",
+				"Comprehensive brand ecosystem with integrated applications",
+				"Identity excellence with industry recognition and differentiation",
+				"Strategic visual identity driving brand recognition and competitive advantage",
+			}},
+		},
+	},
+	{
+		Code:         "VLU",
+		Name:         "Valuation",
+		Purpose:      "The Valuation document defines systematic approaches to determining business value, asset worth, and enterprise valuation through multiple methodologies and analytical frameworks. It establishes valuation standards that support investment decisions, transaction analysis, and strategic planning with objective, defensible value assessments.",
+		Domain:       "Financial & Investment",
+		Dependencies: []string{"FIN", "FOR", "MKT", "IND"},
+		Enablements:  []string{"FND", "INV", "STR", "NEG"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Valuation Framework"},
+			{Level: 3, Title: "Valuation Purpose and Scope"},
+			{Level: 3, Title: "Valuation Standards"},
+			{Level: 3, Title: "Business Overview"},
+			{Level: 2, Title: "Valuation Approaches"},
+			{Level: 3, Title: "Income Approach"},
+			{Level: 3, Title: "Market Approach"},
+			{Level: 3, Title: "Asset Approach"},
+			{Level: 2, Title: "Market Analysis"},
+			{Level: 3, Title: "Industry Analysis"},
+			{Level: 3, Title: "Comparable Analysis"},
+			{Level: 3, Title: "Market Multiples"},
+			{Level: 2, Title: "Discount and Premium Analysis"},
+			{Level: 3, Title: "Discount Factors"},
+			{Level: 3, Title: "Premium Factors"},
+			{Level: 3, Title: "Size Premium Analysis"},
+			{Level: 2, Title: "Sensitivity Analysis"},
+			{Level: 3, Title: "Key Variable Analysis"},
+			{Level: 3, Title: "Scenario Analysis"},
+			{Level: 3, Title: "Monte Carlo Analysis"},
+			{Level: 2, Title: "Valuation Reconciliation"},
+			{Level: 3, Title: "Approach Weighting"},
+			{Level: 3, Title: "Value Conclusion"},
+			{Level: 2, Title: "Special Considerations"},
+			{Level: 3, Title: "Complex Securities"},
+			{Level: 3, Title: "Special Situations"},
+			{Level: 2, Title: "Quality Control and Review"},
+			{Level: 3, Title: "Valuation Review Process"},
+			{Level: 3, Title: "Documentation Standards"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, valuation date, standard, and value conclusion",
+			"Valuation framework with purpose, scope, standards, and business overview",
+			"Valuation approaches with income, market, and asset-based methodologies",
+			"Market analysis with industry assessment, comparable analysis, and market multiples",
+			"Discount and premium analysis with discount factors, premiums, and size analysis",
+			"Sensitivity analysis with key variable testing, scenarios, and Monte Carlo simulation",
+			"Valuation reconciliation with approach weighting and value conclusion",
+			"Special considerations for complex securities and special situations",
+			"Quality control with review process and documentation standards",
+			"Validation evidence of valuation accuracy, professional standards compliance, and decision support",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic valuation using single approach",
+				"Simple comparable analysis with market multiples",
+				"Basic sensitivity analysis and documentation",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Multiple valuation approaches with appropriate weighting",
+				"Comprehensive market analysis and comparable selection",
+				"Detailed sensitivity analysis and scenario modeling",
+				"Professional quality documentation and review",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Sophisticated valuation modeling with advanced analytics",
+				"Comprehensive risk analysis and Monte Carlo simulation",
+				"Complex security valuation and special situation analysis",
+				"Expert-level documentation meeting professional standards",
+			}},
+		},
+	},
+	{
+		Code:         "VND",
+		Name:         "Vendor Management",
+		Purpose:      "The Vendor Management document defines systematic approaches to selecting, managing, and optimizing vendor relationships that deliver business value through effective partnership, performance management, and risk mitigation. It establishes vendor frameworks that ensure quality service delivery, cost optimization, and strategic alignment.",
+		Domain:       "Operations & Execution",
+		Dependencies: []string{"PRO", "POL", "SLA", "RSK"},
+		Enablements:  []string{"PER", "QUA", "CST", "SLA"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Vendor Profile"},
+			{Level: 3, Title: "Company Information"},
+			{Level: 3, Title: "Contact Information"},
+			{Level: 3, Title: "Capabilities Assessment"},
+			{Level: 2, Title: "Services and Deliverables"},
+			{Level: 3, Title: "Service Catalog"},
+			{Level: 3, Title: "Deliverables Framework"},
+			{Level: 3, Title: "Performance Expectations"},
+			{Level: 2, Title: "Commercial Framework"},
+			{Level: 3, Title: "Contract Structure"},
+			{Level: 3, Title: "Pricing Model"},
+			{Level: 3, Title: "Financial Management"},
+			{Level: 2, Title: "Performance Management"},
+			{Level: 3, Title: "KPI Framework"},
+			{Level: 3, Title: "Performance Review"},
+			{Level: 3, Title: "Escalation Framework"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 3, Title: "Risk Assessment"},
+			{Level: 3, Title: "Contingency Planning"},
+			{Level: 2, Title: "Relationship Management"},
+			{Level: 3, Title: "Governance Structure"},
+			{Level: 3, Title: "Communication Plan"},
+			{Level: 3, Title: "Relationship Development"},
+			{Level: 2, Title: "Compliance and Security"},
+			{Level: 3, Title: "Compliance Requirements"},
+			{Level: 3, Title: "Security Framework"},
+			{Level: 3, Title: "Audit and Monitoring"},
+			{Level: 2, Title: "Contract Management"},
+			{Level: 3, Title: "Contract Administration"},
+			{Level: 3, Title: "Performance Against Contract"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, category, criticality, and relationship type",
+			"Vendor profile with company information, contact details, and capabilities assessment",
+			"Services and deliverables with catalog, framework, and performance expectations",
+			"Commercial framework with contract structure, pricing model, and financial management",
+			"Performance management with KPI framework, review processes, and escalation procedures",
+			"Risk management with assessment, mitigation strategies, and contingency planning",
+			"Relationship management with governance structure, communication plan, and development initiatives",
+			"Compliance and security with requirements, framework, and audit procedures",
+			"Contract management with administration, performance tracking, and issue resolution",
+			"Validation evidence of vendor effectiveness, strategic value, and risk management",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic vendor information and contact details",
+				"Clear service definitions and basic performance expectations",
+				"Simple contract and pricing framework",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive vendor assessment and capability mapping",
+				"Detailed performance management framework with KPIs",
+				"Structured risk management and contingency planning",
+				"Formal governance and communication structure",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced vendor relationship optimization",
+				"Sophisticated performance analytics and benchmarking",
+				"Comprehensive compliance and security framework",
+				"Strategic vendor partnership development",
+			}},
+		},
+	},
+	{
+		Code:    "VPR",
+		Name:    "Value Proposition",
+		Purpose: "The Value Proposition document converts customer insight into a clearly testable proposition statement. It includes pains, gains, gain creators, and gain alleviators and defines the boundary between what customers need and what the organization can credibly deliver.",
+		Domain:  "Customer & Value",
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Value Proposition"},
+			{Level: 2, Title: "Customer Segment"},
+			{Level: 2, Title: "Problem and Outcome Hypothesis"},
+			{Level: 2, Title: "Value Proposition Design"},
+			{Level: 3, Title: "Pain Reduction"},
+			{Level: 3, Title: "Gain Creation"},
+			{Level: 2, Title: "Delivery Fit"},
+			{Level: 2, Title: "Validation and Measurement"},
+			{Level: 3, Title: "Evidence Plan"},
+			{Level: 3, Title: "KPI Set"},
+			{Level: 2, Title: "Delivery and Positioning References"},
+		},
+		Checklist: []string{
+			"Value proposition statement is clear and differentiated",
+			"Jobs, pains, and gains are mapped and consistent",
+			"Claims are tied to evidence and measurable outcomes",
+			"Dependencies and execution scope are complete",
+			"Positioning and sales enablement references are explicit",
+		},
+	},
+	{
+		Code:         "VSN",
+		Name:         "Vision Statement",
+		Purpose:      "The Vision document articulates the future state the organization aims to create—both for itself and the world. It describes what success looks like at a meaningful time horizon (typically 3-10 years).",
+		Domain:       "Strategic Foundation",
+		Dependencies: []string{"MSN", "VAL"},
+		Enablements:  []string{"STR", "OBJ", "GTM", "GRW"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Overview"},
+			{Level: 2, Title: "Vision Statement"},
+			{Level: 2, Title: "Future State Description"},
+			{Level: 3, Title: "Organizational Vision"},
+			{Level: 3, Title: "Market Vision"},
+			{Level: 3, Title: "Societal Vision"},
+			{Level: 2, Title: "Success Metrics"},
+			{Level: 3, Title: "Quantitative Indicators"},
+			{Level: 3, Title: "Qualitative Indicators"},
+			{Level: 2, Title: "Vision Timeline"},
+			{Level: 3, Title: "Year 1-2: Foundation"},
+			{Level: 3, Title: "Year 3-5: Growth"},
+			{Level: 3, Title: "Year 5-10: Realization"},
+			{Level: 2, Title: "Enabling Strategies"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Vision statement is clear and memorable",
+			"Future state description is specific and compelling",
+			"Success metrics are defined and measurable",
+			"Timeline includes realistic milestones",
+			"Enabling strategies are identified",
+			"Vision aligns with mission and values",
+			"Leadership team is aligned on vision",
+			"Market research supports vision viability",
+			"Progress measurement system established",
+			"Regular review process implemented",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Clear vision statement exists (1-3 sentences)",
+				"Future state description provided",
+				"Basic success metrics identified",
+				"Timeline with major milestones",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Compelling and inspirational vision",
+				"Quantitative and qualitative success metrics",
+				"Detailed timeline with phases",
+				"Enabling strategies identified",
+				"Market research supports vision viability",
+				"Leadership team alignment documented",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Regular vision progress measurement",
+				"Vision evolution history documented",
+				"Stakeholder feedback integration process",
+				"Vision-strategy alignment validation",
+				"Cultural integration practices established",
+				"External validation evidence collected",
+			}},
+		},
+	},
+	{
+		Code:         "VST",
+		Name:         "Value Stream",
+		Purpose:      "The Value Stream defines systematic analysis and optimization of end-to-end value creation processes that deliver customer value. It establishes value flow frameworks that eliminate waste, optimize performance, and align organizational activities with customer value realization.",
+		Domain:       "Business Model",
+		Dependencies: []string{"KAC", "CJM", "PRO", "CAP"},
+		Enablements:  []string{"PER", "QUA", "CUS", "REV"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Value Stream Overview"},
+			{Level: 2, Title: "Value Stream Strategy"},
+			{Level: 3, Title: "Strategic Context"},
+			{Level: 3, Title: "Value Proposition"},
+			{Level: 2, Title: "Value Stream Definition"},
+			{Level: 3, Title: "Value Stream Scope"},
+			{Level: 3, Title: "Value Flow"},
+			{Level: 3, Title: "Customer Journey Integration"},
+			{Level: 2, Title: "Current State Analysis"},
+			{Level: 3, Title: "Process Mapping"},
+			{Level: 3, Title: "Performance Analysis"},
+			{Level: 3, Title: "Waste Identification"},
+			{Level: 2, Title: "Future State Design"},
+			{Level: 3, Title: "Vision and Objectives"},
+			{Level: 3, Title: "Value Stream Redesign"},
+			{Level: 3, Title: "Technology Enablement"},
+			{Level: 2, Title: "Value Stream Metrics"},
+			{Level: 3, Title: "Flow Metrics"},
+			{Level: 3, Title: "Quality Metrics"},
+			{Level: 3, Title: "Financial Metrics"},
+			{Level: 3, Title: "Innovation Metrics"},
+			{Level: 2, Title: "Organizational Design"},
+			{Level: 3, Title: "Team Structure"},
+			{Level: 3, Title: "Governance Model"},
+			{Level: 3, Title: "Culture and Mindset"},
+			{Level: 2, Title: "Technology and Automation"},
+			{Level: 3, Title: "Current Technology"},
+			{Level: 3, Title: "Automation Strategy"},
+			{Level: 3, Title: "Technology Roadmap"},
+			{Level: 2, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Improvement Framework"},
+			{Level: 3, Title: "Performance Management"},
+			{Level: 3, Title: "Innovation Pipeline"},
+			{Level: 2, Title: "Risk Management"},
+			{Level: 3, Title: "Value Stream Risks"},
+			{Level: 3, Title: "Risk Mitigation"},
+			{Level: 3, Title: "Business Continuity"},
+			{Level: 2, Title: "Customer Experience Integration"},
+			{Level: 3, Title: "Customer Journey Mapping"},
+			{Level: 3, Title: "Customer Feedback"},
+			{Level: 3, Title: "Experience Measurement"},
+			{Level: 2, Title: "Financial Impact"},
+			{Level: 3, Title: "Cost Structure"},
+			{Level: 3, Title: "Revenue Impact"},
+			{Level: 3, Title: "Investment and ROI"},
+			{Level: 2, Title: "Future Evolution"},
+			{Level: 3, Title: "Value Stream Maturity"},
+			{Level: 3, Title: "Strategic Evolution"},
+			{Level: 3, Title: "Innovation Pipeline"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Value stream overview with clear purpose, customer value, business value, and strategic importance",
+			"Value stream strategy with business alignment, value proposition, and competitive strategy",
+			"Value stream definition with scope, flow, and customer journey integration",
+			"Current state analysis with process mapping, performance analysis, and waste identification",
+			"Future state design with vision, redesign, and technology enablement",
+			"Value stream metrics covering flow, quality, financial, and innovation dimensions",
+			"Organizational design with team structure, governance model, and culture development",
+			"Technology and automation with current state, strategy, and roadmap",
+			"Continuous improvement with framework, performance management, and innovation pipeline",
+			"Risk management with comprehensive risk identification, mitigation, and business continuity",
+			"Customer experience integration with journey mapping, feedback, and measurement",
+			"Financial impact analysis with cost structure, revenue impact, and investment ROI",
+			"Future evolution with maturity assessment, strategic evolution, and innovation opportunities",
+			"Validation evidence of customer value delivery, flow optimization, and continuous improvement",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Value stream overview with purpose, customer value, and strategic importance",
+				"Value stream strategy with alignment, proposition, and competitive context",
+				"Basic value stream definition with scope, flow, and customer integration",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive current state analysis with process mapping and waste identification",
+				"Future state design with vision, redesign, and technology enablement",
+				"Value stream metrics across flow, quality, financial, and innovation dimensions",
+				"Organizational design with team structure, governance, and culture",
+				"Technology and automation with current state, strategy, and roadmap",
+				"Continuous improvement with framework, performance management, and innovation",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced risk management with comprehensive identification, mitigation, and business continuity",
+				"Customer experience integration with journey mapping, feedback, and measurement",
+				"Financial impact analysis with cost structure, revenue attribution, and ROI tracking",
+				"Future evolution with maturity assessment, strategic evolution, and innovation pipeline",
+				"AI-driven value stream optimization with predictive analytics and automated improvement",
+				"Real-time performance monitoring with dynamic optimization and adaptive capacity",
+			}},
+		},
+	},
+	{
+		Code:         "WFL",
+		Name:         "Workflow Specification",
+		Purpose:      "The Workflow Specification defines systematic approaches to designing, implementing, and managing business workflows that automate and optimize operational processes. It establishes workflow frameworks that ensure efficient execution, robust exception handling, and continuous improvement.",
+		Domain:       "Operations & Execution",
+		Dependencies: []string{"PRO", "CAP", "SVC", "ARC"},
+		Enablements:  []string{"PER", "QUA", "MON", "AUT"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Workflow Overview"},
+			{Level: 2, Title: "Workflow Definition"},
+			{Level: 3, Title: "Workflow Scope"},
+			{Level: 3, Title: "Workflow Classification"},
+			{Level: 3, Title: "Business Rules"},
+			{Level: 2, Title: "Workflow Design"},
+			{Level: 3, Title: "Workflow Triggers"},
+			{Level: 3, Title: "Workflow Steps"},
+			{Level: 3, Title: "Workflow Decisions"},
+			{Level: 3, Title: "Workflow Paths"},
+			{Level: 2, Title: "Workflow Execution"},
+			{Level: 3, Title: "Execution Model"},
+			{Level: 3, Title: "Task Management"},
+			{Level: 3, Title: "Data Management"},
+			{Level: 3, Title: "Error Handling"},
+			{Level: 2, Title: "Automation and Technology"},
+			{Level: 3, Title: "Automation Strategy"},
+			{Level: 3, Title: "Technology Integration"},
+			{Level: 3, Title: "Workflow Engine"},
+			{Level: 2, Title: "Performance and Monitoring"},
+			{Level: 3, Title: "Performance Metrics"},
+			{Level: 3, Title: "Monitoring Framework"},
+			{Level: 3, Title: "Analytics and Optimization"},
+			{Level: 2, Title: "Exception Management"},
+			{Level: 3, Title: "Exception Types"},
+			{Level: 3, Title: "Exception Handling"},
+			{Level: 3, Title: "Recovery Procedures"},
+			{Level: 2, Title: "Compliance and Governance"},
+			{Level: 3, Title: "Governance Framework"},
+			{Level: 3, Title: "Compliance Requirements"},
+			{Level: 3, Title: "Documentation and Audit"},
+			{Level: 2, Title: "Security and Access Control"},
+			{Level: 3, Title: "Security Framework"},
+			{Level: 3, Title: "Authentication and Authorization"},
+			{Level: 3, Title: "Data Security"},
+			{Level: 2, Title: "Continuous Improvement"},
+			{Level: 3, Title: "Improvement Framework"},
+			{Level: 3, Title: "Optimization Strategies"},
+			{Level: 3, Title: "Innovation Opportunities"},
+			{Level: 2, Title: "Testing and Validation"},
+			{Level: 3, Title: "Testing Strategy"},
+			{Level: 3, Title: "Test Execution"},
+			{Level: 3, Title: "Validation Criteria"},
+			{Level: 2, Title: "Deployment and Maintenance"},
+			{Level: 3, Title: "Deployment Strategy"},
+			{Level: 3, Title: "Maintenance Framework"},
+			{Level: 3, Title: "Support and Operations"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Workflow overview with clear purpose, business context, value creation, and success criteria",
+			"Workflow definition with comprehensive scope, classification, and business rules",
+			"Workflow design with triggers, steps, decisions, and execution paths",
+			"Workflow execution with model, task management, data management, and error handling",
+			"Automation and technology with strategy, integration, and engine specification",
+			"Performance and monitoring with metrics, framework, and analytics",
+			"Exception management with types, handling, and recovery procedures",
+			"Compliance and governance with framework, requirements, and audit support",
+			"Security and access control with comprehensive framework and data protection",
+			"Continuous improvement with optimization strategies and innovation opportunities",
+			"Testing and validation with strategy, execution, and criteria",
+			"Deployment and maintenance with strategy, framework, and support operations",
+			"Validation evidence of workflow effectiveness, efficiency, and continuous improvement",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Workflow overview with purpose, business context, and value creation",
+				"Workflow definition with scope, classification, and business rules",
+				"Basic workflow design with triggers, steps, and paths",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive workflow execution with model, task management, and error handling",
+				"Automation and technology with strategy, integration, and engine specification",
+				"Performance and monitoring with metrics, framework, and analytics",
+				"Exception management with types, handling, and recovery procedures",
+				"Compliance and governance with framework, requirements, and audit support",
+				"Security and access control with comprehensive framework",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced continuous improvement with optimization and innovation strategies",
+				"Testing and validation with comprehensive strategy and execution",
+				"Deployment and maintenance with strategy, framework, and support",
+				"AI-driven workflow optimization with predictive analytics and automated improvement",
+				"Real-time workflow monitoring with dynamic performance adjustment",
+				"Advanced exception prediction with proactive issue prevention",
+			}},
+		},
+	},
+	{
+		Code:         "WIS",
+		Name:         "Wisdom Synthesis",
+		Purpose:      "The Wisdom Synthesis document captures the practical wisdom, judgment principles, and synthesized insights that guide organizational decision-making and action. It establishes wisdom frameworks that combine knowledge, experience, and judgment to create actionable guidance for complex situations, ethical dilemmas, and strategic choices.",
+		Domain:       "Learning & Decisions",
+		Dependencies: []string{"KNO", "LRN", "DEC", "THY"},
+		Enablements:  []string{"STR", "LRN", "ETH", "GOV"},
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Wisdom Framework"},
+			{Level: 3, Title: "Wisdom Philosophy"},
+			{Level: 3, Title: "Wisdom Foundation"},
+			{Level: 3, Title: "Wisdom Architecture"},
+			{Level: 2, Title: "Wisdom Capture and Development"},
+			{Level: 3, Title: "Wisdom Identification Process"},
+			{Level: 3, Title: "Wisdom Synthesis Framework"},
+			{Level: 3, Title: "Wisdom Documentation Standards"},
+			{Level: 2, Title: "Practical Wisdom Application"},
+			{Level: 3, Title: "Decision-Making Wisdom"},
+			{Level: 3, Title: "Leadership Wisdom"},
+			{Level: 3, Title: "Contextual Wisdom Application"},
+			{Level: 2, Title: "Wisdom Transmission and Sharing"},
+			{Level: 3, Title: "Wisdom Sharing Framework"},
+			{Level: 3, Title: "Wisdom Integration"},
+			{Level: 3, Title: "Wisdom Community Building"},
+			{Level: 2, Title: "Wisdom Quality and Evolution"},
+			{Level: 3, Title: "Wisdom Quality Framework"},
+			{Level: 3, Title: "Wisdom Evolution"},
+			{Level: 3, Title: "Wisdom Governance"},
+			{Level: 2, Title: "Validation"},
+		},
+		Checklist: []string{
+			"Executive summary with clear purpose, type, domain, and source",
+			"Wisdom framework with philosophy, foundation, and architecture",
+			"Capture and development with identification process, synthesis framework, and documentation standards",
+			"Practical application with decision-making wisdom, leadership wisdom, and contextual application",
+			"Transmission and sharing with framework, integration, and community building",
+			"Quality and evolution with assessment framework, evolution processes, and governance",
+			"Validation evidence of effective decision guidance, complexity navigation, and ethical choice promotion",
+		},
+		QualityStandards: []QualityStandard{
+			{Level: "bronze", Title: "Bronze Level (Minimum Viable)", Items: []string{
+				"Basic wisdom capture and documentation from key organizational experiences",
+				"Simple wisdom sharing mechanisms and basic application guidance",
+				"Basic wisdom quality standards and validation processes",
+				"All required metadata fields completed",
+			}},
+			{Level: "silver", Title: "Silver Level (Investment Ready)", Items: []string{
+				"Comprehensive wisdom framework with systematic capture and synthesis",
+				"Structured transmission processes with effective integration and application",
+				"Active wisdom governance with quality standards and continuous evolution",
+				"Regular wisdom effectiveness review and cultural integration",
+			}},
+			{Level: "gold", Title: "Gold Level (Operational Excellence)", Items: []string{
+				"Advanced wisdom capabilities with sophisticated synthesis and application",
+				"Comprehensive wisdom ecosystem with integrated culture and community",
+				"Wisdom excellence with transformational guidance and ethical leadership",
+				"Strategic wisdom management driving organizational evolution and sustainable success",
+			}},
+		},
+	},
+	{
+		Code:    "WRD",
+		Name:    "Wardley Mapping",
+		Purpose: "Use this template to represent value components, user needs, and evolutionary movement in a way that informs investment and operational decisions.",
+		Domain:  "Market & Environment",
+		Sections: []SectionTemplate{
+			{Level: 2, Title: "Executive Summary"},
+			{Level: 2, Title: "Strategic Context"},
+			{Level: 2, Title: "Evolution Analysis"},
+			{Level: 2, Title: "Strategic Actions"},
+			{Level: 2, Title: "Link to Strategy"},
+			{Level: 2, Title: "Quality Standard"},
+		},
+	},
+}
+
+// readmeDocumentTypes lists the document types that the conformance levels of
+// the README require and spec/v1 does not define, without the template of a
+// specification
+var readmeDocumentTypes = []DocumentTypeInfo{
+	{Code: "MIT", Name: "Mitigations", Purpose: "Risk response strategies and controls.", Domain: "Risk & Governance"},
+}
@@ -0,0 +1,153 @@
+package bspec
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ValidationChecklistHeading is the heading of the validation checklist
+const ValidationChecklistHeading = "Validation Checklist"
+
+var (
+	checklistItemPattern    = regexp.MustCompile(`^\s*[-*+]\s+\[([ xX])\]\s+(.*?)\s*$`)
+	checklistHeadingPattern = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	checklistFencePattern   = regexp.MustCompile("^\\s*(```|~~~)")
+)
+
+// QualityLevels returns the conformance levels of the Quality Standards
+// checklists, from lowest to highest
+func QualityLevels() []ConformanceLevel {
+	return []ConformanceLevel{ConformanceLevelBronze, ConformanceLevelSilver, ConformanceLevelGold}
+}
+
+// ChecklistItem is a "- [ ]" or "- [x]" item of a document body
+type ChecklistItem struct {
+	Text    string `json:"text"`
+	Checked bool   `json:"checked"`
+	Line    int    `json:"line"` // Line of the body, starting at 1
+}
+
+// Checklist is the checklist items under a heading
+type Checklist struct {
+	Heading string          `json:"heading"`
+	Line    int             `json:"line"` // Line of the heading in the body
+	Items   []ChecklistItem `json:"items"`
+}
+
+// Checked returns the number of checked items
+func (c *Checklist) Checked() int {
+	checked := 0
+	for _, item := range c.Items {
+		if item.Checked {
+			checked++
+		}
+	}
+	return checked
+}
+
+// Complete reports whether the checklist has items and all are checked
+func (c *Checklist) Complete() bool {
+	return c != nil && len(c.Items) > 0 && c.Checked() == len(c.Items)
+}
+
+// Completion returns the share of checked items, from 0 to 1
+func (c *Checklist) Completion() float64 {
+	if c == nil || len(c.Items) == 0 {
+		return 0
+	}
+	return float64(c.Checked()) / float64(len(c.Items))
+}
+
+// DocumentChecklists are the Quality Standards checklists of each level and
+// the validation checklist of a document body
+type DocumentChecklists struct {
+	Bronze     *Checklist `json:"bronze,omitempty"`
+	Silver     *Checklist `json:"silver,omitempty"`
+	Gold       *Checklist `json:"gold,omitempty"`
+	Validation *Checklist `json:"validation,omitempty"`
+}
+
+// Level returns the Quality Standards checklist of a conformance level, or
+// nil if the document has none
+func (c *DocumentChecklists) Level(level ConformanceLevel) *Checklist {
+	switch level {
+	case ConformanceLevelBronze:
+		return c.Bronze
+	case ConformanceLevelSilver:
+		return c.Silver
+	case ConformanceLevelGold:
+		return c.Gold
+	}
+	return nil
+}
+
+// ReadyLevel returns the highest conformance level whose checklist is
+// complete along with the checklists of the levels below it, or "" if the
+// Bronze checklist is not complete
+func (c *DocumentChecklists) ReadyLevel() ConformanceLevel {
+	var ready ConformanceLevel
+	for _, level := range QualityLevels() {
+		if !c.Level(level).Complete() {
+			break
+		}
+		ready = level
+	}
+	return ready
+}
+
+// ParseChecklists reads the checklists of a document body: the items under
+// the headings that start with a level name, such as "### Silver Level
+// (Investment Ready)", and under the "Validation Checklist" heading,
+// including their subsections. The first checklist of each kind counts.
+func ParseChecklists(body string) DocumentChecklists {
+	var checklists DocumentChecklists
+	var walk func(sections []*Section)
+	walk = func(sections []*Section) {
+		for _, section := range sections {
+			target := checklists.target(section.Heading)
+			if target == nil || *target != nil {
+				walk(section.Sections)
+				continue
+			}
+			checklist := &Checklist{Heading: section.Heading, Line: section.Line, Items: []ChecklistItem{}}
+			collectItems(section, checklist)
+			*target = checklist
+		}
+	}
+	walk(ParseBody(body).Sections)
+	return checklists
+}
+
+// collectItems appends the checklist items of a section and its subsections
+func collectItems(section *Section, checklist *Checklist) {
+	checklist.Items = append(checklist.Items, section.Checklist...)
+	for _, subsection := range section.Sections {
+		collectItems(subsection, checklist)
+	}
+}
+
+// target returns the field of the checklist a heading starts, or nil
+func (c *DocumentChecklists) target(heading string) **Checklist {
+	lower := strings.ToLower(heading)
+	if strings.HasPrefix(lower, strings.ToLower(ValidationChecklistHeading)) {
+		return &c.Validation
+	}
+	for _, level := range QualityLevels() {
+		name := string(level)
+		if !strings.HasPrefix(lower, name) {
+			continue
+		}
+		if rest := lower[len(name):]; rest != "" && rest[0] >= 'a' && rest[0] <= 'z' {
+			continue
+		}
+		switch level {
+		case ConformanceLevelBronze:
+			return &c.Bronze
+		case ConformanceLevelSilver:
+			return &c.Silver
+		case ConformanceLevelGold:
+			return &c.Gold
+		}
+	}
+	return nil
+}
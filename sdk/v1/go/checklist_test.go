@@ -0,0 +1,69 @@
+package bspec
+
+import "testing"
+
+func TestParseChecklists(t *testing.T) {
+	body := `# Churn
+
+## Quality Standards
+
+### Bronze Level (Minimum Viable)
+- [x] Risk register
+- [X] Owners assigned
+
+### Silver Level (Investment Ready)
+- [x] Quantified impact
+- [ ] Monitoring
+
+#### Notes
+- [ ] Reviewed by the board
+
+### Goldilocks zone
+- [ ] Not a level
+
+## Validation Checklist
+
+` + "```" + `
+- [ ] In a code block
+` + "```" + `
+- [x] Executive summary
+- Not an item
+`
+	checklists := ParseChecklists(body)
+
+	if !checklists.Bronze.Complete() || checklists.Bronze.Line != 5 || len(checklists.Bronze.Items) != 2 {
+		t.Errorf("Expected a complete Bronze checklist, got %+v", checklists.Bronze)
+	}
+	if silver := checklists.Silver; silver.Checked() != 1 || len(silver.Items) != 3 || silver.Items[2].Line != 14 {
+		t.Errorf("Expected 1 of 3 Silver items with the nested heading, got %+v", silver)
+	}
+	if checklists.Gold != nil {
+		t.Errorf("Expected no Gold checklist, got %+v", checklists.Gold)
+	}
+	if validation := checklists.Validation; validation == nil || len(validation.Items) != 1 || !validation.Complete() {
+		t.Errorf("Expected one checked validation item, got %+v", validation)
+	}
+	if level := checklists.ReadyLevel(); level != ConformanceLevelBronze {
+		t.Errorf("ReadyLevel = %q, want bronze", level)
+	}
+	if completion := checklists.Level(ConformanceLevelSilver).Completion(); completion < 0.33 || completion > 0.34 {
+		t.Errorf("Silver completion = %f", completion)
+	}
+	if (&DocumentChecklists{}).ReadyLevel() != "" || checklists.Gold.Completion() != 0 {
+		t.Error("Expected no ready level and no completion without checklists")
+	}
+}
+
+func TestSkeletonChecklists(t *testing.T) {
+	info := DefaultCatalog().GetDocumentType("RSK")
+	checklists := ParseChecklists(info.Skeleton("Churn"))
+	for _, level := range QualityLevels() {
+		checklist := checklists.Level(level)
+		if checklist == nil || len(checklist.Items) == 0 || checklist.Checked() != 0 {
+			t.Errorf("Expected an unchecked %s checklist in the skeleton, got %+v", level, checklist)
+		}
+	}
+	if checklists.Validation == nil || len(checklists.Validation.Items) != len(info.Checklist) {
+		t.Errorf("Expected the validation checklist in the skeleton, got %+v", checklists.Validation)
+	}
+}
@@ -1,60 +0,0 @@
-// Package bspec provides CHN document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.612806
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// CHNDocument represents a Channels (CHN) document
-// Domain: business-model
-//
-// Distribution and sales channel strategy
-type CHNDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewCHNDocument creates a new CHN document with defaults
-func NewCHNDocument(id, title, owner string) *CHNDocument {
-	doc := &CHNDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeCHN,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainBusinessModel
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the CHN document and returns any validation errors
-func (d *CHNDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeCHN {
-		errors = append(errors, "document type must be CHN")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides CTL document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.617086
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// CTLDocument represents a Controls (CTL) document
-// Domain: risk
-//
-// Internal controls and process safeguards
-type CTLDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewCTLDocument creates a new CTL document with defaults
-func NewCTLDocument(id, title, owner string) *CTLDocument {
-	doc := &CTLDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeCTL,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainRisk
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the CTL document and returns any validation errors
-func (d *CTLDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeCTL {
-		errors = append(errors, "document type must be CTL")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
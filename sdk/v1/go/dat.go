@@ -1,60 +0,0 @@
-// Package bspec provides DAT document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.615206
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// DATDocument represents a Data Models (DAT) document
-// Domain: technology
-//
-// Data structures, schemas, and information architecture
-type DATDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewDATDocument creates a new DAT document with defaults
-func NewDATDocument(id, title, owner string) *DATDocument {
-	doc := &DATDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeDAT,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainTechnology
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the DAT document and returns any validation errors
-func (d *DATDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeDAT {
-		errors = append(errors, "document type must be DAT")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
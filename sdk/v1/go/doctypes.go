@@ -0,0 +1,103 @@
+package bspec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Document is implemented by the typed documents, such as *RSKDocument
+type Document interface {
+	Validate() ValidationIssues
+	IsValid() bool
+	GetDomain() BusinessDomain
+}
+
+// documentTypes are the document types of the specification followed by
+// those the README requires beyond it, such as MIT
+var documentTypes = append(append([]DocumentTypeInfo{}, catalogDocumentTypes...), readmeDocumentTypes...)
+
+// DocumentTypes returns the document types of the specification in catalog
+// order, followed by those the README requires beyond it
+func DocumentTypes() []DocumentType {
+	types := make([]DocumentType, len(documentTypes))
+	for i, info := range documentTypes {
+		types[i] = DocumentType(info.Code)
+	}
+	return types
+}
+
+// IsValid returns true if the document type is defined by the specification
+// or required by the README
+func (t DocumentType) IsValid() bool {
+	for _, info := range documentTypes {
+		if info.Code == string(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLegacy returns true if the document type is from an earlier draft of the
+// specification
+func (t DocumentType) IsLegacy() bool {
+	_, ok := legacyDocumentTypes[t]
+	return ok
+}
+
+// Successor returns the specification type that replaces a legacy type
+func (t DocumentType) Successor() (DocumentType, bool) {
+	successor := legacyDocumentTypes[t]
+	return successor, successor != ""
+}
+
+// LegacyDocumentType returns the name, purpose and domain of a legacy
+// document type, or nil if the type is not a legacy type
+func LegacyDocumentType(code string) *DocumentTypeInfo {
+	for i := range legacyCatalog {
+		if legacyCatalog[i].Code == code {
+			info := legacyCatalog[i]
+			return &info
+		}
+	}
+	return nil
+}
+
+// DomainForType returns the business domain a document type belongs to
+func DomainForType(docType DocumentType) (BusinessDomain, bool) {
+	domain, ok := documentTypeDomains[docType]
+	return domain, ok
+}
+
+// ExpectedReference is a document type that documents of another type are
+// expected to reference, as their validation checks
+type ExpectedReference struct {
+	Key  string       `json:"key"`  // Frontmatter list of the reference, e.g. "related"
+	Type DocumentType `json:"type"` // Type of the referenced documents
+	Code string       `json:"code"` // Issue code when the reference is missing
+}
+
+// ExpectedReferences returns the document types the validation of a type
+// expects its documents to reference, such as MIT for RSK and RSK for MIT
+func ExpectedReferences(docType DocumentType) []ExpectedReference {
+	return expectedReferences[docType]
+}
+
+// validateType validates the base document of a typed document
+func (d *BaseBSpecDocument) validateType(docType DocumentType) ValidationIssues {
+	issues := d.Validate()
+	if d.Type != docType {
+		issues = append(issues, newIssue(SeverityError, CodeTypeMismatch, "type", fmt.Sprintf("document type must be %s", docType)).
+			withFix(fmt.Sprintf("set type to %s", docType)))
+	}
+	return issues
+}
+
+// hasEntry returns true if a list has an entry starting with prefix
+func hasEntry(values []string, prefix string) bool {
+	for _, value := range values {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
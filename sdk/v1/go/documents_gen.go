@@ -0,0 +1,3648 @@
+// Code generated by specgen from spec/v1; DO NOT EDIT.
+
+package bspec
+
+// MOTDocument is a typed Competitive Moats (MOT) document of the Strategic
+// Foundation domain
+//
+// The Moats document identifies and analyzes the competitive advantages that
+// protect the organization's market position.
+type MOTDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewMOTDocument creates a new MOT document with defaults
+func NewMOTDocument(id, title, owner string) *MOTDocument {
+	return &MOTDocument{BaseBSpecDocument: *NewDocument(DocumentTypeMOT, id, title, owner)}
+}
+
+// Validate validates the MOT document and returns the validation issues
+func (d *MOTDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeMOT)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *MOTDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// MSNDocument is a typed Mission Statement (MSN) document of the Strategic
+// Foundation domain
+//
+// This specification SHALL define the requirements and structure for Mission
+// Statement documents that articulate an organization's fundamental purpose and
+// reason for existence.
+type MSNDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+
+	// Strategic foundation documents are required for all conformance levels
+	StrategicFoundation bool `json:"strategic_foundation" yaml:"strategic_foundation"`
+}
+
+// NewMSNDocument creates a new MSN document with defaults
+func NewMSNDocument(id, title, owner string) *MSNDocument {
+	doc := &MSNDocument{BaseBSpecDocument: *NewDocument(DocumentTypeMSN, id, title, owner)}
+	doc.StrategicFoundation = true
+	return doc
+}
+
+// Validate validates the MSN document and returns the validation issues
+func (d *MSNDocument) Validate() ValidationIssues {
+	issues := d.validateType(DocumentTypeMSN)
+
+	// Strategic foundation documents require success criteria
+	if len(d.SuccessCriteria) == 0 {
+		issues = append(issues, newIssue(SeverityError, CodeMissingSuccessCriteria, "success_criteria", "Strategic foundation documents must have success_criteria defined").
+			withFix("list measurable success_criteria"))
+	}
+	return issues
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *MSNDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// OBJDocument is a typed Strategic Objectives (OBJ) document of the Strategic
+// Foundation domain
+//
+// The Objectives document sets specific, measurable goals with timeframes that
+// advance the organization toward its vision.
+type OBJDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+
+	KeyResults []KeyResult `json:"key_results,omitempty" yaml:"key_results,omitempty"` // Measurable results of the objectives
+}
+
+// NewOBJDocument creates a new OBJ document with defaults
+func NewOBJDocument(id, title, owner string) *OBJDocument {
+	return &OBJDocument{BaseBSpecDocument: *NewDocument(DocumentTypeOBJ, id, title, owner)}
+}
+
+// Validate validates the OBJ document and returns the validation issues
+func (d *OBJDocument) Validate() ValidationIssues {
+	issues := d.validateType(DocumentTypeOBJ)
+	issues = append(issues, d.validateFields()...)
+	return issues
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *OBJDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// PURDocument is a typed Organizational Purpose (PUR) document of the Strategic
+// Foundation domain
+//
+// The Purpose document articulates the organization's social impact and
+// stakeholder value beyond profit.
+type PURDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewPURDocument creates a new PUR document with defaults
+func NewPURDocument(id, title, owner string) *PURDocument {
+	return &PURDocument{BaseBSpecDocument: *NewDocument(DocumentTypePUR, id, title, owner)}
+}
+
+// Validate validates the PUR document and returns the validation issues
+func (d *PURDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypePUR)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *PURDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// STRDocument is a typed Business Strategy (STR) document of the Strategic
+// Foundation domain
+//
+// The Strategy document defines how the organization will achieve its vision
+// and compete in its chosen markets.
+type STRDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSTRDocument creates a new STR document with defaults
+func NewSTRDocument(id, title, owner string) *STRDocument {
+	return &STRDocument{BaseBSpecDocument: *NewDocument(DocumentTypeSTR, id, title, owner)}
+}
+
+// Validate validates the STR document and returns the validation issues
+func (d *STRDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSTR)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *STRDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// THYDocument is a typed Theory of Change (THY) document of the Strategic
+// Foundation domain
+//
+// The Theory of Change document maps the logic model connecting the
+// organization's activities to its intended outcomes.
+type THYDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewTHYDocument creates a new THY document with defaults
+func NewTHYDocument(id, title, owner string) *THYDocument {
+	return &THYDocument{BaseBSpecDocument: *NewDocument(DocumentTypeTHY, id, title, owner)}
+}
+
+// Validate validates the THY document and returns the validation issues
+func (d *THYDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeTHY)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *THYDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// VALDocument is a typed Organizational Values (VAL) document of the Strategic
+// Foundation domain
+//
+// The Values document defines the guiding principles that shape culture, guide
+// decisions, and determine how the organization behaves.
+type VALDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+
+	// Strategic foundation documents are required for all conformance levels
+	StrategicFoundation bool `json:"strategic_foundation" yaml:"strategic_foundation"`
+}
+
+// NewVALDocument creates a new VAL document with defaults
+func NewVALDocument(id, title, owner string) *VALDocument {
+	doc := &VALDocument{BaseBSpecDocument: *NewDocument(DocumentTypeVAL, id, title, owner)}
+	doc.StrategicFoundation = true
+	return doc
+}
+
+// Validate validates the VAL document and returns the validation issues
+func (d *VALDocument) Validate() ValidationIssues {
+	issues := d.validateType(DocumentTypeVAL)
+
+	// Strategic foundation documents require success criteria
+	if len(d.SuccessCriteria) == 0 {
+		issues = append(issues, newIssue(SeverityError, CodeMissingSuccessCriteria, "success_criteria", "Strategic foundation documents must have success_criteria defined").
+			withFix("list measurable success_criteria"))
+	}
+	return issues
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *VALDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// VSNDocument is a typed Vision Statement (VSN) document of the Strategic
+// Foundation domain
+//
+// The Vision document articulates the future state the organization aims to
+// create—both for itself and the world.
+type VSNDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+
+	// Strategic foundation documents are required for all conformance levels
+	StrategicFoundation bool `json:"strategic_foundation" yaml:"strategic_foundation"`
+}
+
+// NewVSNDocument creates a new VSN document with defaults
+func NewVSNDocument(id, title, owner string) *VSNDocument {
+	doc := &VSNDocument{BaseBSpecDocument: *NewDocument(DocumentTypeVSN, id, title, owner)}
+	doc.StrategicFoundation = true
+	return doc
+}
+
+// Validate validates the VSN document and returns the validation issues
+func (d *VSNDocument) Validate() ValidationIssues {
+	issues := d.validateType(DocumentTypeVSN)
+
+	// Strategic foundation documents require success criteria
+	if len(d.SuccessCriteria) == 0 {
+		issues = append(issues, newIssue(SeverityError, CodeMissingSuccessCriteria, "success_criteria", "Strategic foundation documents must have success_criteria defined").
+			withFix("list measurable success_criteria"))
+	}
+	return issues
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *VSNDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// CMPDocument is a typed Competitive Analysis (CMP) document of the Market &
+// Environment domain
+//
+// The Competitive Analysis document maps the competitive landscape, analyzes
+// key competitors, and identifies competitive threats and opportunities.
+type CMPDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewCMPDocument creates a new CMP document with defaults
+func NewCMPDocument(id, title, owner string) *CMPDocument {
+	return &CMPDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCMP, id, title, owner)}
+}
+
+// Validate validates the CMP document and returns the validation issues
+func (d *CMPDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeCMP)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *CMPDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// ECODocument is a typed Ecosystem (ECO) document of the Market & Environment
+// domain
+//
+// The Ecosystem document maps the network of partners, suppliers, distributors,
+// and other stakeholders that create value around the organization.
+type ECODocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewECODocument creates a new ECO document with defaults
+func NewECODocument(id, title, owner string) *ECODocument {
+	return &ECODocument{BaseBSpecDocument: *NewDocument(DocumentTypeECO, id, title, owner)}
+}
+
+// Validate validates the ECO document and returns the validation issues
+func (d *ECODocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeECO)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *ECODocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// MACDocument is a typed Macro Environment (MAC) document of the Market &
+// Environment domain
+//
+// The Macro Environment document analyzes broad economic, political, social,
+// and technological factors that influence the business environment.
+type MACDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewMACDocument creates a new MAC document with defaults
+func NewMACDocument(id, title, owner string) *MACDocument {
+	return &MACDocument{BaseBSpecDocument: *NewDocument(DocumentTypeMAC, id, title, owner)}
+}
+
+// Validate validates the MAC document and returns the validation issues
+func (d *MACDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeMAC)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *MACDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// MKTDocument is a typed Market Definition (MKT) document of the Market &
+// Environment domain
+//
+// The Market Definition document establishes the boundaries, size, and
+// characteristics of the addressable market.
+type MKTDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewMKTDocument creates a new MKT document with defaults
+func NewMKTDocument(id, title, owner string) *MKTDocument {
+	return &MKTDocument{BaseBSpecDocument: *NewDocument(DocumentTypeMKT, id, title, owner)}
+}
+
+// Validate validates the MKT document and returns the validation issues
+func (d *MKTDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeMKT)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *MKTDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// OPPDocument is a typed Opportunities (OPP) document of the Market &
+// Environment domain
+//
+// The Opportunities document identifies market gaps, growth potential, and
+// strategic opportunities available to the organization.
+type OPPDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewOPPDocument creates a new OPP document with defaults
+func NewOPPDocument(id, title, owner string) *OPPDocument {
+	return &OPPDocument{BaseBSpecDocument: *NewDocument(DocumentTypeOPP, id, title, owner)}
+}
+
+// Validate validates the OPP document and returns the validation issues
+func (d *OPPDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeOPP)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *OPPDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// PFODocument is a typed Porter's Five Forces (PFO) document of the Market &
+// Environment domain
+//
+// Use this template to provide a defensible competitive-pressure assessment
+// that supports strategic choices.
+type PFODocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewPFODocument creates a new PFO document with defaults
+func NewPFODocument(id, title, owner string) *PFODocument {
+	return &PFODocument{BaseBSpecDocument: *NewDocument(DocumentTypePFO, id, title, owner)}
+}
+
+// Validate validates the PFO document and returns the validation issues
+func (d *PFODocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypePFO)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *PFODocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// POSDocument is a typed Positioning (POS) document of the Market & Environment
+// domain
+//
+// The Positioning document defines how the organization wants to be perceived
+// in the market relative to competitors and alternatives.
+type POSDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewPOSDocument creates a new POS document with defaults
+func NewPOSDocument(id, title, owner string) *POSDocument {
+	return &POSDocument{BaseBSpecDocument: *NewDocument(DocumentTypePOS, id, title, owner)}
+}
+
+// Validate validates the POS document and returns the validation issues
+func (d *POSDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypePOS)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *POSDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// REGDocument is a typed Regulatory Environment (REG) document of the Market &
+// Environment domain
+//
+// The Regulatory Environment document analyzes laws, regulations, and
+// compliance requirements affecting the business.
+type REGDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewREGDocument creates a new REG document with defaults
+func NewREGDocument(id, title, owner string) *REGDocument {
+	return &REGDocument{BaseBSpecDocument: *NewDocument(DocumentTypeREG, id, title, owner)}
+}
+
+// Validate validates the REG document and returns the validation issues
+func (d *REGDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeREG)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *REGDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// SEGDocument is a typed Market Segments (SEG) document of the Market &
+// Environment domain
+//
+// The Market Segments document identifies and analyzes distinct customer groups
+// within the broader market.
+type SEGDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSEGDocument creates a new SEG document with defaults
+func NewSEGDocument(id, title, owner string) *SEGDocument {
+	return &SEGDocument{BaseBSpecDocument: *NewDocument(DocumentTypeSEG, id, title, owner)}
+}
+
+// Validate validates the SEG document and returns the validation issues
+func (d *SEGDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSEG)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *SEGDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// SWODocument is a typed SWOT Synthesis (SWO) document of the Market &
+// Environment domain
+//
+// SWOT Synthesis converts Opportunity and Threat inputs into consolidated
+// strategic implications and option sets, and connects them to strategy and
+// action priorities.
+type SWODocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSWODocument creates a new SWO document with defaults
+func NewSWODocument(id, title, owner string) *SWODocument {
+	return &SWODocument{BaseBSpecDocument: *NewDocument(DocumentTypeSWO, id, title, owner)}
+}
+
+// Validate validates the SWO document and returns the validation issues
+func (d *SWODocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSWO)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *SWODocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// THRDocument is a typed Threats (THR) document of the Market & Environment
+// domain
+//
+// The Threats document identifies external risks to market position and
+// business model.
+type THRDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewTHRDocument creates a new THR document with defaults
+func NewTHRDocument(id, title, owner string) *THRDocument {
+	return &THRDocument{BaseBSpecDocument: *NewDocument(DocumentTypeTHR, id, title, owner)}
+}
+
+// Validate validates the THR document and returns the validation issues
+func (d *THRDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeTHR)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *THRDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// TRNDocument is a typed Trends (TRN) document of the Market & Environment
+// domain
+//
+// The Trends document identifies and analyzes market forces and changes shaping
+// the industry.
+type TRNDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewTRNDocument creates a new TRN document with defaults
+func NewTRNDocument(id, title, owner string) *TRNDocument {
+	return &TRNDocument{BaseBSpecDocument: *NewDocument(DocumentTypeTRN, id, title, owner)}
+}
+
+// Validate validates the TRN document and returns the validation issues
+func (d *TRNDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeTRN)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *TRNDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// VCHDocument is a typed Value Chain Analysis (VCH) document of the Market &
+// Environment domain
+//
+// Use this template to produce a repeatable map of value-driving activities,
+// dependencies, and optimization opportunities across inbound logistics,
+// operations, and customer-facing delivery.
+type VCHDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewVCHDocument creates a new VCH document with defaults
+func NewVCHDocument(id, title, owner string) *VCHDocument {
+	return &VCHDocument{BaseBSpecDocument: *NewDocument(DocumentTypeVCH, id, title, owner)}
+}
+
+// Validate validates the VCH document and returns the validation issues
+func (d *VCHDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeVCH)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *VCHDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// WRDDocument is a typed Wardley Mapping (WRD) document of the Market &
+// Environment domain
+//
+// Use this template to represent value components, user needs, and evolutionary
+// movement in a way that informs investment and operational decisions.
+type WRDDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewWRDDocument creates a new WRD document with defaults
+func NewWRDDocument(id, title, owner string) *WRDDocument {
+	return &WRDDocument{BaseBSpecDocument: *NewDocument(DocumentTypeWRD, id, title, owner)}
+}
+
+// Validate validates the WRD document and returns the validation issues
+func (d *WRDDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeWRD)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *WRDDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// BEHDocument is a typed Behaviors (BEH) document of the Customer & Value
+// domain
+//
+// The Behaviors document analyzes customer usage patterns, behavioral data, and
+// interaction analytics to understand how customers actually use products and
+// services, revealing gaps between stated preferences and actual behavior.
+type BEHDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewBEHDocument creates a new BEH document with defaults
+func NewBEHDocument(id, title, owner string) *BEHDocument {
+	return &BEHDocument{BaseBSpecDocument: *NewDocument(DocumentTypeBEH, id, title, owner)}
+}
+
+// Validate validates the BEH document and returns the validation issues
+func (d *BEHDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeBEH)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *BEHDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// CINDocument is a typed Interviews (CIN) document of the Customer & Value
+// domain
+//
+// The Interviews document captures structured customer research conversations
+// that provide deep insights into needs, behaviors, motivations, and
+// experiences.
+type CINDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewCINDocument creates a new CIN document with defaults
+func NewCINDocument(id, title, owner string) *CINDocument {
+	return &CINDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCIN, id, title, owner)}
+}
+
+// Validate validates the CIN document and returns the validation issues
+func (d *CINDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeCIN)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *CINDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// CJMDocument is a typed Customer Journey Map (CJM) document of the Customer &
+// Value domain
+//
+// The Customer Journey Map document visualizes the end-to-end customer
+// experience from awareness to advocacy.
+type CJMDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewCJMDocument creates a new CJM document with defaults
+func NewCJMDocument(id, title, owner string) *CJMDocument {
+	return &CJMDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCJM, id, title, owner)}
+}
+
+// Validate validates the CJM document and returns the validation issues
+func (d *CJMDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeCJM)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *CJMDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// CSUDocument is a typed Customer Success (CSU) document of the Customer &
+// Value domain
+//
+// Customer Success defines how the organization drives customer value
+// realization and long-term retention after purchase.
+type CSUDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewCSUDocument creates a new CSU document with defaults
+func NewCSUDocument(id, title, owner string) *CSUDocument {
+	return &CSUDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCSU, id, title, owner)}
+}
+
+// Validate validates the CSU document and returns the validation issues
+func (d *CSUDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeCSU)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *CSUDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// EMPDocument is a typed Empathy Maps (EMP) document of the Customer & Value
+// domain
+//
+// The Empathy Maps document captures deep understanding of customer thoughts,
+// feelings, behaviors, and environment.
+type EMPDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewEMPDocument creates a new EMP document with defaults
+func NewEMPDocument(id, title, owner string) *EMPDocument {
+	return &EMPDocument{BaseBSpecDocument: *NewDocument(DocumentTypeEMP, id, title, owner)}
+}
+
+// Validate validates the EMP document and returns the validation issues
+func (d *EMPDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeEMP)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *EMPDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// FEEDocument is a typed Feedback (FEE) document of the Customer & Value domain
+//
+// The Feedback document captures, analyzes, and manages customer input,
+// reviews, and satisfaction data.
+type FEEDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewFEEDocument creates a new FEE document with defaults
+func NewFEEDocument(id, title, owner string) *FEEDocument {
+	return &FEEDocument{BaseBSpecDocument: *NewDocument(DocumentTypeFEE, id, title, owner)}
+}
+
+// Validate validates the FEE document and returns the validation issues
+func (d *FEEDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeFEE)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *FEEDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// GAIDocument is a typed Gains (GAI) document of the Customer & Value domain
+//
+// The Gains document identifies and analyzes the positive outcomes, benefits,
+// and value that customers achieve or seek.
+type GAIDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewGAIDocument creates a new GAI document with defaults
+func NewGAIDocument(id, title, owner string) *GAIDocument {
+	return &GAIDocument{BaseBSpecDocument: *NewDocument(DocumentTypeGAI, id, title, owner)}
+}
+
+// Validate validates the GAI document and returns the validation issues
+func (d *GAIDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeGAI)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *GAIDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// JTBDocument is a typed Jobs-to-be-Done (JTBD) (JTB) document of the Customer
+// & Value domain
+//
+// The Jobs-to-be-Done document defines the specific outcomes customers hire
+// products or services to achieve.
+type JTBDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+
+	// Customer understanding documents require specific validation
+	CustomerFocused bool `json:"customer_focused" yaml:"customer_focused"`
+}
+
+// NewJTBDocument creates a new JTB document with defaults
+func NewJTBDocument(id, title, owner string) *JTBDocument {
+	doc := &JTBDocument{BaseBSpecDocument: *NewDocument(DocumentTypeJTB, id, title, owner)}
+	doc.CustomerFocused = true
+	return doc
+}
+
+// Validate validates the JTB document and returns the validation issues
+func (d *JTBDocument) Validate() ValidationIssues {
+	issues := d.validateType(DocumentTypeJTB)
+
+	// Customer understanding documents should have related documents
+	if len(d.Related) == 0 {
+		issues = append(issues, newIssue(SeverityWarning, CodeMissingRelated, "related", "Customer understanding documents should reference related personas or jobs-to-be-done").
+			withFix("add the related PER or JTB documents to related"))
+	}
+	return issues
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *JTBDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// PAIDocument is a typed Pain Points (PAI) document of the Customer & Value
+// domain
+//
+// The Pain Points document identifies and analyzes customer problems,
+// frustrations, and obstacles.
+type PAIDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewPAIDocument creates a new PAI document with defaults
+func NewPAIDocument(id, title, owner string) *PAIDocument {
+	return &PAIDocument{BaseBSpecDocument: *NewDocument(DocumentTypePAI, id, title, owner)}
+}
+
+// Validate validates the PAI document and returns the validation issues
+func (d *PAIDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypePAI)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *PAIDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// PERDocument is a typed Personas (PER) document of the Customer & Value domain
+//
+// The Personas document creates detailed archetypal representations of key
+// customer segments.
+type PERDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+
+	// Customer understanding documents require specific validation
+	CustomerFocused bool `json:"customer_focused" yaml:"customer_focused"`
+
+	Demographics *Demographics `json:"demographics,omitempty" yaml:"demographics,omitempty"` // Who the persona is
+	Goals        []string      `json:"goals,omitempty" yaml:"goals,omitempty"`               // What the persona wants to achieve
+}
+
+// NewPERDocument creates a new PER document with defaults
+func NewPERDocument(id, title, owner string) *PERDocument {
+	doc := &PERDocument{BaseBSpecDocument: *NewDocument(DocumentTypePER, id, title, owner)}
+	doc.CustomerFocused = true
+	return doc
+}
+
+// Validate validates the PER document and returns the validation issues
+func (d *PERDocument) Validate() ValidationIssues {
+	issues := d.validateType(DocumentTypePER)
+	issues = append(issues, d.validateFields()...)
+
+	// Customer understanding documents should have related documents
+	if len(d.Related) == 0 {
+		issues = append(issues, newIssue(SeverityWarning, CodeMissingRelated, "related", "Customer understanding documents should reference related personas or jobs-to-be-done").
+			withFix("add the related PER or JTB documents to related"))
+	}
+	return issues
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *PERDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// STODocument is a typed Stories (User Stories) (STO) document of the Customer
+// & Value domain
+//
+// The User Stories (STO) document captures implementation-sized requirements
+// from the user perspective using the standard "As a...
+type STODocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSTODocument creates a new STO document with defaults
+func NewSTODocument(id, title, owner string) *STODocument {
+	return &STODocument{BaseBSpecDocument: *NewDocument(DocumentTypeSTO, id, title, owner)}
+}
+
+// Validate validates the STO document and returns the validation issues
+func (d *STODocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSTO)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *STODocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// SURDocument is a typed Surveys (SUR) document of the Customer & Value domain
+//
+// The Surveys document captures quantitative customer research through
+// structured questionnaires that provide statistical insights into customer
+// attitudes, behaviors, preferences, and satisfaction levels.
+type SURDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSURDocument creates a new SUR document with defaults
+func NewSURDocument(id, title, owner string) *SURDocument {
+	return &SURDocument{BaseBSpecDocument: *NewDocument(DocumentTypeSUR, id, title, owner)}
+}
+
+// Validate validates the SUR document and returns the validation issues
+func (d *SURDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSUR)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *SURDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// USEDocument is a typed Use Cases (USE) document of the Customer & Value
+// domain
+//
+// The Use Cases document describes customer workflow scenarios using the
+// solution in context.
+type USEDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewUSEDocument creates a new USE document with defaults
+func NewUSEDocument(id, title, owner string) *USEDocument {
+	return &USEDocument{BaseBSpecDocument: *NewDocument(DocumentTypeUSE, id, title, owner)}
+}
+
+// Validate validates the USE document and returns the validation issues
+func (d *USEDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeUSE)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *USEDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// VPRDocument is a typed Value Proposition (VPR) document of the Customer &
+// Value domain
+//
+// The Value Proposition document converts customer insight into a clearly
+// testable proposition statement.
+type VPRDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewVPRDocument creates a new VPR document with defaults
+func NewVPRDocument(id, title, owner string) *VPRDocument {
+	return &VPRDocument{BaseBSpecDocument: *NewDocument(DocumentTypeVPR, id, title, owner)}
+}
+
+// Validate validates the VPR document and returns the validation issues
+func (d *VPRDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeVPR)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *VPRDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// EOLDocument is a typed End-of-Life and Retirement (EOL) document of the
+// Product & Service domain
+//
+// End-of-Life and Retirement manages planned retirement of products/services,
+// including customer communication, migration strategy, and sunset operations
+// across support, compliance, and security.
+type EOLDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewEOLDocument creates a new EOL document with defaults
+func NewEOLDocument(id, title, owner string) *EOLDocument {
+	return &EOLDocument{BaseBSpecDocument: *NewDocument(DocumentTypeEOL, id, title, owner)}
+}
+
+// Validate validates the EOL document and returns the validation issues
+func (d *EOLDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeEOL)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *EOLDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// FEADocument is a typed Feature Specification (FEA) document of the Product &
+// Service domain
+//
+// The Feature Specification defines detailed, feature-level requirements for
+// specific product capabilities.
+type FEADocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewFEADocument creates a new FEA document with defaults
+func NewFEADocument(id, title, owner string) *FEADocument {
+	return &FEADocument{BaseBSpecDocument: *NewDocument(DocumentTypeFEA, id, title, owner)}
+}
+
+// Validate validates the FEA document and returns the validation issues
+func (d *FEADocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeFEA)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *FEADocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// INTDocument is a typed Integration Specification (INT) document of the
+// Product & Service domain
+//
+// The Integration Specification defines detailed technical and business
+// requirements for connecting systems, applications, and services.
+type INTDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewINTDocument creates a new INT document with defaults
+func NewINTDocument(id, title, owner string) *INTDocument {
+	return &INTDocument{BaseBSpecDocument: *NewDocument(DocumentTypeINT, id, title, owner)}
+}
+
+// Validate validates the INT document and returns the validation issues
+func (d *INTDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeINT)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *INTDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// PRDDocument is a typed Product Requirements Document (PRD) document of the
+// Product & Service domain
+//
+// The Product Requirements Document defines product-level requirements,
+// capturing the outcome-oriented problem, value proposition, and strategic fit
+// of a product initiative.
+type PRDDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewPRDDocument creates a new PRD document with defaults
+func NewPRDDocument(id, title, owner string) *PRDDocument {
+	return &PRDDocument{BaseBSpecDocument: *NewDocument(DocumentTypePRD, id, title, owner)}
+}
+
+// Validate validates the PRD document and returns the validation issues
+func (d *PRDDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypePRD)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *PRDDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// PSPDocument is a typed Performance Specification (PSP) document of the
+// Product & Service domain
+//
+// The Performance Specification defines comprehensive performance requirements,
+// targets, and measurement frameworks for systems, applications, and services.
+type PSPDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewPSPDocument creates a new PSP document with defaults
+func NewPSPDocument(id, title, owner string) *PSPDocument {
+	return &PSPDocument{BaseBSpecDocument: *NewDocument(DocumentTypePSP, id, title, owner)}
+}
+
+// Validate validates the PSP document and returns the validation issues
+func (d *PSPDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypePSP)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *PSPDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// QUADocument is a typed Quality Specification (QUA) document of the Product &
+// Service domain
+//
+// The Quality Specification defines comprehensive quality standards, metrics,
+// and assurance processes for products, services, and systems.
+type QUADocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewQUADocument creates a new QUA document with defaults
+func NewQUADocument(id, title, owner string) *QUADocument {
+	return &QUADocument{BaseBSpecDocument: *NewDocument(DocumentTypeQUA, id, title, owner)}
+}
+
+// Validate validates the QUA document and returns the validation issues
+func (d *QUADocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeQUA)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *QUADocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// REQDocument is a typed Requirements Specification (REQ) document of the
+// Product & Service domain
+//
+// The Requirements Specification defines comprehensive, system-level functional
+// and non-functional requirements with traceability and testability as
+// first-class constraints.
+type REQDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewREQDocument creates a new REQ document with defaults
+func NewREQDocument(id, title, owner string) *REQDocument {
+	return &REQDocument{BaseBSpecDocument: *NewDocument(DocumentTypeREQ, id, title, owner)}
+}
+
+// Validate validates the REQ document and returns the validation issues
+func (d *REQDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeREQ)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *REQDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// RODDocument is a typed Roadmap (ROD) document of the Product & Service domain
+//
+// The Roadmap document defines strategic product and technology direction over
+// multiple time horizons, aligning development efforts with business objectives
+// while managing resource constraints and market dynamics.
+type RODDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewRODDocument creates a new ROD document with defaults
+func NewRODDocument(id, title, owner string) *RODDocument {
+	return &RODDocument{BaseBSpecDocument: *NewDocument(DocumentTypeROD, id, title, owner)}
+}
+
+// Validate validates the ROD document and returns the validation issues
+func (d *RODDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeROD)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *RODDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// SUPDocument is a typed Support Specification (SUP) document of the Product &
+// Service domain
+//
+// The Support Specification defines comprehensive customer support strategies,
+// processes, and service standards that ensure exceptional customer experience
+// throughout the product lifecycle.
+type SUPDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSUPDocument creates a new SUP document with defaults
+func NewSUPDocument(id, title, owner string) *SUPDocument {
+	return &SUPDocument{BaseBSpecDocument: *NewDocument(DocumentTypeSUP, id, title, owner)}
+}
+
+// Validate validates the SUP document and returns the validation issues
+func (d *SUPDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSUP)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *SUPDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// SVCDocument is a typed Service Specification (SVC) document of the Product &
+// Service domain
+//
+// The Service Specification defines comprehensive requirements for services,
+// including delivery models, quality standards, and operational requirements.
+type SVCDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSVCDocument creates a new SVC document with defaults
+func NewSVCDocument(id, title, owner string) *SVCDocument {
+	return &SVCDocument{BaseBSpecDocument: *NewDocument(DocumentTypeSVC, id, title, owner)}
+}
+
+// Validate validates the SVC document and returns the validation issues
+func (d *SVCDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSVC)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *SVCDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// UXDDocument is a typed User Experience Design (UXD) document of the Product &
+// Service domain
+//
+// The User Experience Design document defines comprehensive user experience
+// strategies, interaction patterns, and design specifications that ensure
+// products and services deliver intuitive, accessible, and delightful user
+// experiences aligned with user needs and business objectives.
+type UXDDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewUXDDocument creates a new UXD document with defaults
+func NewUXDDocument(id, title, owner string) *UXDDocument {
+	return &UXDDocument{BaseBSpecDocument: *NewDocument(DocumentTypeUXD, id, title, owner)}
+}
+
+// Validate validates the UXD document and returns the validation issues
+func (d *UXDDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeUXD)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *UXDDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// CHNDocument is a typed Channel Strategy (CHN) document of the Business Model
+// domain
+//
+// The Channel Strategy defines sales and distribution channels for moving value
+// to market and fulfilling customer transactions.
+type CHNDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewCHNDocument creates a new CHN document with defaults
+func NewCHNDocument(id, title, owner string) *CHNDocument {
+	return &CHNDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCHN, id, title, owner)}
+}
+
+// Validate validates the CHN document and returns the validation issues
+func (d *CHNDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeCHN)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *CHNDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// CSTDocument is a typed Cost Structure (CST) document of the Business Model
+// domain
+//
+// The Cost Structure defines systematic analysis and optimization of
+// organizational costs to support business strategy and profitability.
+type CSTDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+
+	// Business model documents are critical for financial validation
+	BusinessModelCore bool `json:"business_model_core" yaml:"business_model_core"`
+}
+
+// NewCSTDocument creates a new CST document with defaults
+func NewCSTDocument(id, title, owner string) *CSTDocument {
+	doc := &CSTDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCST, id, title, owner)}
+	doc.BusinessModelCore = true
+	return doc
+}
+
+// Validate validates the CST document and returns the validation issues
+func (d *CSTDocument) Validate() ValidationIssues {
+	issues := d.validateType(DocumentTypeCST)
+
+	// Business model documents require metrics
+	if len(d.Metrics) == 0 {
+		issues = append(issues, newIssue(SeverityError, CodeMissingMetrics, "metrics", "Business model documents must have metrics defined for measurement").
+			withFix("list the MET documents that measure it in metrics"))
+	}
+	return issues
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *CSTDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// CUSDocument is a typed Customer Relationships (CUS) document of the Business
+// Model domain
+//
+// The Customer Relationships defines systematic approaches to building,
+// managing, and optimizing customer relationships that drive acquisition,
+// retention, growth, and advocacy.
+type CUSDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewCUSDocument creates a new CUS document with defaults
+func NewCUSDocument(id, title, owner string) *CUSDocument {
+	return &CUSDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCUS, id, title, owner)}
+}
+
+// Validate validates the CUS document and returns the validation issues
+func (d *CUSDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeCUS)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *CUSDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// KACDocument is a typed Key Activities (KAC) document of the Business Model
+// domain
+//
+// The Key Activities defines systematic approaches to critical business
+// activities that create customer value, drive competitive advantage, and
+// enable business model execution.
+type KACDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewKACDocument creates a new KAC document with defaults
+func NewKACDocument(id, title, owner string) *KACDocument {
+	return &KACDocument{BaseBSpecDocument: *NewDocument(DocumentTypeKAC, id, title, owner)}
+}
+
+// Validate validates the KAC document and returns the validation issues
+func (d *KACDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeKAC)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *KACDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// KPTDocument is a typed Key Partnerships (KPT) document of the Business Model
+// domain
+//
+// The Key Partnerships defines systematic approaches to strategic alliances and
+// partnerships that create mutual value and competitive advantages.
+type KPTDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewKPTDocument creates a new KPT document with defaults
+func NewKPTDocument(id, title, owner string) *KPTDocument {
+	return &KPTDocument{BaseBSpecDocument: *NewDocument(DocumentTypeKPT, id, title, owner)}
+}
+
+// Validate validates the KPT document and returns the validation issues
+func (d *KPTDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeKPT)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *KPTDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// KRSDocument is a typed Key Resources (KRS) document of the Business Model
+// domain
+//
+// The Key Resources defines systematic identification, development, and
+// management of critical organizational assets that create competitive
+// advantage and enable business strategy execution.
+type KRSDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewKRSDocument creates a new KRS document with defaults
+func NewKRSDocument(id, title, owner string) *KRSDocument {
+	return &KRSDocument{BaseBSpecDocument: *NewDocument(DocumentTypeKRS, id, title, owner)}
+}
+
+// Validate validates the KRS document and returns the validation issues
+func (d *KRSDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeKRS)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *KRSDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// PRIDocument is a typed Pricing Strategy (PRI) document of the Business Model
+// domain
+//
+// The Pricing Strategy defines systematic approaches to product and service
+// pricing that optimize value capture while supporting competitive positioning
+// and business objectives.
+type PRIDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewPRIDocument creates a new PRI document with defaults
+func NewPRIDocument(id, title, owner string) *PRIDocument {
+	return &PRIDocument{BaseBSpecDocument: *NewDocument(DocumentTypePRI, id, title, owner)}
+}
+
+// Validate validates the PRI document and returns the validation issues
+func (d *PRIDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypePRI)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *PRIDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// REVDocument is a typed Revenue Model (REV) document of the Business Model
+// domain
+//
+// The Revenue Model defines how organizations create, capture, and optimize
+// revenue streams.
+type REVDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+
+	// Business model documents are critical for financial validation
+	BusinessModelCore bool `json:"business_model_core" yaml:"business_model_core"`
+}
+
+// NewREVDocument creates a new REV document with defaults
+func NewREVDocument(id, title, owner string) *REVDocument {
+	doc := &REVDocument{BaseBSpecDocument: *NewDocument(DocumentTypeREV, id, title, owner)}
+	doc.BusinessModelCore = true
+	return doc
+}
+
+// Validate validates the REV document and returns the validation issues
+func (d *REVDocument) Validate() ValidationIssues {
+	issues := d.validateType(DocumentTypeREV)
+
+	// Business model documents require metrics
+	if len(d.Metrics) == 0 {
+		issues = append(issues, newIssue(SeverityError, CodeMissingMetrics, "metrics", "Business model documents must have metrics defined for measurement").
+			withFix("list the MET documents that measure it in metrics"))
+	}
+	return issues
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *REVDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// VSTDocument is a typed Value Stream (VST) document of the Business Model
+// domain
+//
+// The Value Stream defines systematic analysis and optimization of end-to-end
+// value creation processes that deliver customer value.
+type VSTDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewVSTDocument creates a new VST document with defaults
+func NewVSTDocument(id, title, owner string) *VSTDocument {
+	return &VSTDocument{BaseBSpecDocument: *NewDocument(DocumentTypeVST, id, title, owner)}
+}
+
+// Validate validates the VST document and returns the validation issues
+func (d *VSTDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeVST)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *VSTDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// CAPDocument is a typed Capability Specification (CAP) document of the
+// Operations & Execution domain
+//
+// The Capability Specification defines systematic approaches to building and
+// managing organizational capabilities that create competitive advantage and
+// enable business strategy execution.
+type CAPDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewCAPDocument creates a new CAP document with defaults
+func NewCAPDocument(id, title, owner string) *CAPDocument {
+	return &CAPDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCAP, id, title, owner)}
+}
+
+// Validate validates the CAP document and returns the validation issues
+func (d *CAPDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeCAP)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *CAPDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// FACDocument is a typed Facilities Management (FAC) document of the Operations
+// & Execution domain
+//
+// The Facilities Management document defines systematic approaches to planning,
+// operating, and maintaining physical facilities that support business
+// operations through efficient space utilization, operational excellence, and
+// employee productivity.
+type FACDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewFACDocument creates a new FAC document with defaults
+func NewFACDocument(id, title, owner string) *FACDocument {
+	return &FACDocument{BaseBSpecDocument: *NewDocument(DocumentTypeFAC, id, title, owner)}
+}
+
+// Validate validates the FAC document and returns the validation issues
+func (d *FACDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeFAC)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *FACDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// OPSDocument is a typed Operations Manual (OPS) document of the Operations &
+// Execution domain
+//
+// The Operations Manual defines systematic approaches to day-to-day operational
+// management that ensure consistent service delivery, efficient resource
+// utilization, and continuous operational excellence.
+type OPSDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewOPSDocument creates a new OPS document with defaults
+func NewOPSDocument(id, title, owner string) *OPSDocument {
+	return &OPSDocument{BaseBSpecDocument: *NewDocument(DocumentTypeOPS, id, title, owner)}
+}
+
+// Validate validates the OPS document and returns the validation issues
+func (d *OPSDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeOPS)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *OPSDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// ORGDocument is a typed Organization Structure (ORG) document of the
+// Operations & Execution domain
+//
+// The Organization Structure defines systematic approaches to designing and
+// managing organizational hierarchies, reporting relationships, and team
+// structures that enable effective execution and coordination.
+type ORGDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewORGDocument creates a new ORG document with defaults
+func NewORGDocument(id, title, owner string) *ORGDocument {
+	return &ORGDocument{BaseBSpecDocument: *NewDocument(DocumentTypeORG, id, title, owner)}
+}
+
+// Validate validates the ORG document and returns the validation issues
+func (d *ORGDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeORG)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *ORGDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// POLDocument is a typed Policies (POL) document of the Operations & Execution
+// domain
+//
+// The Policies document defines systematic approaches to establishing,
+// implementing, and managing organizational policies that guide behavior,
+// ensure compliance, and mitigate risks.
+type POLDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewPOLDocument creates a new POL document with defaults
+func NewPOLDocument(id, title, owner string) *POLDocument {
+	return &POLDocument{BaseBSpecDocument: *NewDocument(DocumentTypePOL, id, title, owner)}
+}
+
+// Validate validates the POL document and returns the validation issues
+func (d *POLDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypePOL)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *POLDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// PPLDocument is a typed People Strategy (PPL) document of the Operations &
+// Execution domain
+//
+// People Strategy governs how the organization attracts, develops, rewards,
+// evaluates, and retains talent across functions.
+type PPLDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewPPLDocument creates a new PPL document with defaults
+func NewPPLDocument(id, title, owner string) *PPLDocument {
+	return &PPLDocument{BaseBSpecDocument: *NewDocument(DocumentTypePPL, id, title, owner)}
+}
+
+// Validate validates the PPL document and returns the validation issues
+func (d *PPLDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypePPL)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *PPLDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// PRODocument is a typed Process Specification (PRO) document of the Operations
+// & Execution domain
+//
+// The Process Specification defines systematic approaches to executing business
+// activities through documented, repeatable, and optimized processes.
+type PRODocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewPRODocument creates a new PRO document with defaults
+func NewPRODocument(id, title, owner string) *PRODocument {
+	return &PRODocument{BaseBSpecDocument: *NewDocument(DocumentTypePRO, id, title, owner)}
+}
+
+// Validate validates the PRO document and returns the validation issues
+func (d *PRODocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypePRO)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *PRODocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// ROLDocument is a typed Role Definition (ROL) document of the Operations &
+// Execution domain
+//
+// The Role Definition defines systematic approaches to designing and
+// documenting organizational roles that clarify responsibilities, authorities,
+// and requirements.
+type ROLDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewROLDocument creates a new ROL document with defaults
+func NewROLDocument(id, title, owner string) *ROLDocument {
+	return &ROLDocument{BaseBSpecDocument: *NewDocument(DocumentTypeROL, id, title, owner)}
+}
+
+// Validate validates the ROL document and returns the validation issues
+func (d *ROLDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeROL)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *ROLDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// SKIDocument is a typed Skills Framework (SKI) document of the Operations &
+// Execution domain
+//
+// The Skills Framework defines systematic approaches to identifying, assessing,
+// and developing organizational skills and competencies that enable strategic
+// execution and competitive advantage.
+type SKIDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSKIDocument creates a new SKI document with defaults
+func NewSKIDocument(id, title, owner string) *SKIDocument {
+	return &SKIDocument{BaseBSpecDocument: *NewDocument(DocumentTypeSKI, id, title, owner)}
+}
+
+// Validate validates the SKI document and returns the validation issues
+func (d *SKIDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSKI)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *SKIDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// SLADocument is a typed Service Level Agreement (SLA) document of the
+// Operations & Execution domain
+//
+// The Service Level Agreement defines systematic approaches to establishing,
+// measuring, and managing service level commitments that ensure consistent
+// service delivery and customer satisfaction.
+type SLADocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSLADocument creates a new SLA document with defaults
+func NewSLADocument(id, title, owner string) *SLADocument {
+	return &SLADocument{BaseBSpecDocument: *NewDocument(DocumentTypeSLA, id, title, owner)}
+}
+
+// Validate validates the SLA document and returns the validation issues
+func (d *SLADocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSLA)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *SLADocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// TEADocument is a typed Team Structure (TEA) document of the Operations &
+// Execution domain
+//
+// The Team Structure defines systematic approaches to organizing and managing
+// teams that deliver business outcomes through effective collaboration, clear
+// accountability, and continuous improvement.
+type TEADocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewTEADocument creates a new TEA document with defaults
+func NewTEADocument(id, title, owner string) *TEADocument {
+	return &TEADocument{BaseBSpecDocument: *NewDocument(DocumentTypeTEA, id, title, owner)}
+}
+
+// Validate validates the TEA document and returns the validation issues
+func (d *TEADocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeTEA)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *TEADocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// VNDDocument is a typed Vendor Management (VND) document of the Operations &
+// Execution domain
+//
+// The Vendor Management document defines systematic approaches to selecting,
+// managing, and optimizing vendor relationships that deliver business value
+// through effective partnership, performance management, and risk mitigation.
+type VNDDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewVNDDocument creates a new VND document with defaults
+func NewVNDDocument(id, title, owner string) *VNDDocument {
+	return &VNDDocument{BaseBSpecDocument: *NewDocument(DocumentTypeVND, id, title, owner)}
+}
+
+// Validate validates the VND document and returns the validation issues
+func (d *VNDDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeVND)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *VNDDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// WFLDocument is a typed Workflow Specification (WFL) document of the
+// Operations & Execution domain
+//
+// The Workflow Specification defines systematic approaches to designing,
+// implementing, and managing business workflows that automate and optimize
+// operational processes.
+type WFLDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewWFLDocument creates a new WFL document with defaults
+func NewWFLDocument(id, title, owner string) *WFLDocument {
+	return &WFLDocument{BaseBSpecDocument: *NewDocument(DocumentTypeWFL, id, title, owner)}
+}
+
+// Validate validates the WFL document and returns the validation issues
+func (d *WFLDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeWFL)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *WFLDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// ANADocument is a typed Analytics (ANA) document of the Technology & Data
+// domain
+//
+// The Analytics document defines systematic approaches to designing,
+// implementing, and managing analytics capabilities that enable data-driven
+// decision making through business intelligence, advanced analytics, and
+// strategic insights.
+type ANADocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewANADocument creates a new ANA document with defaults
+func NewANADocument(id, title, owner string) *ANADocument {
+	return &ANADocument{BaseBSpecDocument: *NewDocument(DocumentTypeANA, id, title, owner)}
+}
+
+// Validate validates the ANA document and returns the validation issues
+func (d *ANADocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeANA)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *ANADocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// APIDocument is a typed APIs (API) document of the Technology & Data domain
+//
+// The APIs document defines systematic approaches to designing, implementing,
+// and managing application programming interfaces that enable business
+// capabilities through effective integration, developer experience, and
+// scalable service delivery.
+type APIDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewAPIDocument creates a new API document with defaults
+func NewAPIDocument(id, title, owner string) *APIDocument {
+	return &APIDocument{BaseBSpecDocument: *NewDocument(DocumentTypeAPI, id, title, owner)}
+}
+
+// Validate validates the API document and returns the validation issues
+func (d *APIDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeAPI)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *APIDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// ARCDocument is a typed Architecture (ARC) document of the Technology & Data
+// domain
+//
+// The Architecture document defines systematic approaches to designing and
+// documenting technical architecture that enables business capabilities through
+// coherent technology decisions, quality attribute optimization, and strategic
+// alignment.
+type ARCDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewARCDocument creates a new ARC document with defaults
+func NewARCDocument(id, title, owner string) *ARCDocument {
+	return &ARCDocument{BaseBSpecDocument: *NewDocument(DocumentTypeARC, id, title, owner)}
+}
+
+// Validate validates the ARC document and returns the validation issues
+func (d *ARCDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeARC)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *ARCDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// DATDocument is a typed Data Models (DAT) document of the Technology & Data
+// domain
+//
+// The Data Models document defines systematic approaches to designing,
+// governing, and managing data structures that support business capabilities
+// through coherent data architecture, quality assurance, and strategic data
+// management.
+type DATDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewDATDocument creates a new DAT document with defaults
+func NewDATDocument(id, title, owner string) *DATDocument {
+	return &DATDocument{BaseBSpecDocument: *NewDocument(DocumentTypeDAT, id, title, owner)}
+}
+
+// Validate validates the DAT document and returns the validation issues
+func (d *DATDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeDAT)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *DATDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// DEVDocument is a typed Development (DEV) document of the Technology & Data
+// domain
+//
+// The Development document defines software development practices,
+// methodologies, and team processes that enable efficient delivery of
+// high-quality software solutions.
+type DEVDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewDEVDocument creates a new DEV document with defaults
+func NewDEVDocument(id, title, owner string) *DEVDocument {
+	return &DEVDocument{BaseBSpecDocument: *NewDocument(DocumentTypeDEV, id, title, owner)}
+}
+
+// Validate validates the DEV document and returns the validation issues
+func (d *DEVDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeDEV)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *DEVDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// INFDocument is a typed Infrastructure (INF) document of the Technology & Data
+// domain
+//
+// The Infrastructure document defines systematic approaches to designing,
+// deploying, and managing technology infrastructure that supports business
+// operations through reliable, secure, and scalable platforms.
+type INFDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewINFDocument creates a new INF document with defaults
+func NewINFDocument(id, title, owner string) *INFDocument {
+	return &INFDocument{BaseBSpecDocument: *NewDocument(DocumentTypeINF, id, title, owner)}
+}
+
+// Validate validates the INF document and returns the validation issues
+func (d *INFDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeINF)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *INFDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// SECDocument is a typed Security (SEC) document of the Technology & Data
+// domain
+//
+// The Security document defines systematic approaches to designing,
+// implementing, and managing security controls that protect business assets
+// through comprehensive risk management, compliance adherence, and threat
+// mitigation.
+type SECDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSECDocument creates a new SEC document with defaults
+func NewSECDocument(id, title, owner string) *SECDocument {
+	return &SECDocument{BaseBSpecDocument: *NewDocument(DocumentTypeSEC, id, title, owner)}
+}
+
+// Validate validates the SEC document and returns the validation issues
+func (d *SECDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSEC)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *SECDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// SYSDocument is a typed Systems (SYS) document of the Technology & Data domain
+//
+// The Systems document defines systematic approaches to designing,
+// implementing, and managing technology systems that deliver business
+// capabilities through functional features, technical architecture, and
+// operational excellence.
+type SYSDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSYSDocument creates a new SYS document with defaults
+func NewSYSDocument(id, title, owner string) *SYSDocument {
+	return &SYSDocument{BaseBSpecDocument: *NewDocument(DocumentTypeSYS, id, title, owner)}
+}
+
+// Validate validates the SYS document and returns the validation issues
+func (d *SYSDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSYS)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *SYSDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// AUDDocument is a typed Audit (AUD) document of the Financial & Investment
+// domain
+//
+// The Audit document defines systematic approaches to internal and external
+// audit processes that provide independent assurance on financial reporting,
+// internal controls, and operational effectiveness.
+type AUDDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewAUDDocument creates a new AUD document with defaults
+func NewAUDDocument(id, title, owner string) *AUDDocument {
+	return &AUDDocument{BaseBSpecDocument: *NewDocument(DocumentTypeAUD, id, title, owner)}
+}
+
+// Validate validates the AUD document and returns the validation issues
+func (d *AUDDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeAUD)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *AUDDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// BUDDocument is a typed Budget (BUD) document of the Financial & Investment
+// domain
+//
+// The Budget document defines systematic resource allocation and spending plans
+// that translate strategic objectives into financial commitments.
+type BUDDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+
+	Currency  string           `json:"currency,omitempty" yaml:"currency,omitempty"`     // ISO 4217 currency code of the amounts
+	LineItems []BudgetLineItem `json:"line_items,omitempty" yaml:"line_items,omitempty"` // Planned amounts by category
+}
+
+// NewBUDDocument creates a new BUD document with defaults
+func NewBUDDocument(id, title, owner string) *BUDDocument {
+	return &BUDDocument{BaseBSpecDocument: *NewDocument(DocumentTypeBUD, id, title, owner)}
+}
+
+// Validate validates the BUD document and returns the validation issues
+func (d *BUDDocument) Validate() ValidationIssues {
+	issues := d.validateType(DocumentTypeBUD)
+	issues = append(issues, d.validateFields()...)
+	return issues
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *BUDDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// FINDocument is a typed Financial Model (FIN) document of the Financial &
+// Investment domain
+//
+// The Financial Model document defines comprehensive financial projections and
+// planning models that forecast business performance through detailed P&L,
+// balance sheet, and cash flow analysis.
+type FINDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewFINDocument creates a new FIN document with defaults
+func NewFINDocument(id, title, owner string) *FINDocument {
+	return &FINDocument{BaseBSpecDocument: *NewDocument(DocumentTypeFIN, id, title, owner)}
+}
+
+// Validate validates the FIN document and returns the validation issues
+func (d *FINDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeFIN)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *FINDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// FNDDocument is a typed Funding (FND) document of the Financial & Investment
+// domain
+//
+// The Funding document defines systematic approaches to raising capital and
+// securing financial resources to support business operations, growth
+// initiatives, and strategic investments.
+type FNDDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewFNDDocument creates a new FND document with defaults
+func NewFNDDocument(id, title, owner string) *FNDDocument {
+	return &FNDDocument{BaseBSpecDocument: *NewDocument(DocumentTypeFND, id, title, owner)}
+}
+
+// Validate validates the FND document and returns the validation issues
+func (d *FNDDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeFND)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *FNDDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// FORDocument is a typed Forecasts (FOR) document of the Financial & Investment
+// domain
+//
+// The Forecasts document defines forward-looking financial predictions and
+// scenarios that anticipate future business performance through analytical
+// modeling and trend analysis.
+type FORDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewFORDocument creates a new FOR document with defaults
+func NewFORDocument(id, title, owner string) *FORDocument {
+	return &FORDocument{BaseBSpecDocument: *NewDocument(DocumentTypeFOR, id, title, owner)}
+}
+
+// Validate validates the FOR document and returns the validation issues
+func (d *FORDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeFOR)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *FORDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// INVDocument is a typed Investment (INV) document of the Financial &
+// Investment domain
+//
+// The Investment document defines systematic approaches to capital allocation
+// and investment decisions that optimize return on investment while managing
+// risk and supporting strategic business objectives.
+type INVDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewINVDocument creates a new INV document with defaults
+func NewINVDocument(id, title, owner string) *INVDocument {
+	return &INVDocument{BaseBSpecDocument: *NewDocument(DocumentTypeINV, id, title, owner)}
+}
+
+// Validate validates the INV document and returns the validation issues
+func (d *INVDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeINV)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *INVDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// METDocument is a typed Metrics (MET) document of the Financial & Investment
+// domain
+//
+// The Metrics document defines systematic approaches to measuring, monitoring,
+// and managing business performance through key performance indicators,
+// financial metrics, and operational measures.
+type METDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+
+	Formula  string   `json:"formula,omitempty" yaml:"formula,omitempty"`   // How the metric is calculated
+	Unit     string   `json:"unit,omitempty" yaml:"unit,omitempty"`         // Unit of the baseline and target, e.g. "%" or "USD"
+	Baseline *float64 `json:"baseline,omitempty" yaml:"baseline,omitempty"` // Value when measurement started
+	Target   *float64 `json:"target,omitempty" yaml:"target,omitempty"`     // Value to reach
+}
+
+// NewMETDocument creates a new MET document with defaults
+func NewMETDocument(id, title, owner string) *METDocument {
+	return &METDocument{BaseBSpecDocument: *NewDocument(DocumentTypeMET, id, title, owner)}
+}
+
+// Validate validates the MET document and returns the validation issues
+func (d *METDocument) Validate() ValidationIssues {
+	issues := d.validateType(DocumentTypeMET)
+	issues = append(issues, d.validateFields()...)
+	return issues
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *METDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// REPDocument is a typed Reporting (REP) document of the Financial & Investment
+// domain
+//
+// The Reporting document defines systematic approaches to financial and
+// business reporting that provide stakeholders with accurate, timely, and
+// relevant information for decision making.
+type REPDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewREPDocument creates a new REP document with defaults
+func NewREPDocument(id, title, owner string) *REPDocument {
+	return &REPDocument{BaseBSpecDocument: *NewDocument(DocumentTypeREP, id, title, owner)}
+}
+
+// Validate validates the REP document and returns the validation issues
+func (d *REPDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeREP)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *REPDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// TAXDocument is a typed Tax Strategy (TAX) document of the Financial &
+// Investment domain
+//
+// The Tax Strategy document defines systematic approaches to tax planning,
+// compliance, and optimization that minimize tax liability while ensuring full
+// compliance with tax laws and regulations.
+type TAXDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewTAXDocument creates a new TAX document with defaults
+func NewTAXDocument(id, title, owner string) *TAXDocument {
+	return &TAXDocument{BaseBSpecDocument: *NewDocument(DocumentTypeTAX, id, title, owner)}
+}
+
+// Validate validates the TAX document and returns the validation issues
+func (d *TAXDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeTAX)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *TAXDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// VLUDocument is a typed Valuation (VLU) document of the Financial & Investment
+// domain
+//
+// The Valuation document defines systematic approaches to determining business
+// value, asset worth, and enterprise valuation through multiple methodologies
+// and analytical frameworks.
+type VLUDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewVLUDocument creates a new VLU document with defaults
+func NewVLUDocument(id, title, owner string) *VLUDocument {
+	return &VLUDocument{BaseBSpecDocument: *NewDocument(DocumentTypeVLU, id, title, owner)}
+}
+
+// Validate validates the VLU document and returns the validation issues
+func (d *VLUDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeVLU)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *VLUDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// BCRDocument is a typed Business Continuity and Recovery (BCR) document of the
+// Risk & Governance domain
+//
+// Business Continuity and Recovery focuses on maintaining essential services
+// during and after disruption events.
+type BCRDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewBCRDocument creates a new BCR document with defaults
+func NewBCRDocument(id, title, owner string) *BCRDocument {
+	return &BCRDocument{BaseBSpecDocument: *NewDocument(DocumentTypeBCR, id, title, owner)}
+}
+
+// Validate validates the BCR document and returns the validation issues
+func (d *BCRDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeBCR)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *BCRDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// COMDocument is a typed Compliance (COM) document of the Risk & Governance
+// domain
+//
+// The Compliance document defines systematic approaches to ensuring adherence
+// to laws, regulations, policies, and standards that govern business
+// operations.
+type COMDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewCOMDocument creates a new COM document with defaults
+func NewCOMDocument(id, title, owner string) *COMDocument {
+	return &COMDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCOM, id, title, owner)}
+}
+
+// Validate validates the COM document and returns the validation issues
+func (d *COMDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeCOM)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *COMDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// COSDocument is a typed COSO Enterprise Risk Management (COS) document of the
+// Risk & Governance domain
+//
+// Use this document when an organization needs a structured ERM framing across
+// governance bodies, risk appetite, and control assurance.
+type COSDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewCOSDocument creates a new COS document with defaults
+func NewCOSDocument(id, title, owner string) *COSDocument {
+	return &COSDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCOS, id, title, owner)}
+}
+
+// Validate validates the COS document and returns the validation issues
+func (d *COSDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeCOS)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *COSDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// CRIDocument is a typed Crisis Management (CRI) document of the Risk &
+// Governance domain
+//
+// Crisis Management defines severity-classified response playbooks, executive
+// communication, and command structure for high-impact disruptions that go
+// beyond routine incidents.
+type CRIDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewCRIDocument creates a new CRI document with defaults
+func NewCRIDocument(id, title, owner string) *CRIDocument {
+	return &CRIDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCRI, id, title, owner)}
+}
+
+// Validate validates the CRI document and returns the validation issues
+func (d *CRIDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeCRI)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *CRIDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// CTLDocument is a typed Controls (CTL) document of the Risk & Governance
+// domain
+//
+// The Controls document defines systematic approaches to designing,
+// implementing, and operating internal controls that mitigate business risks,
+// ensure compliance, and support reliable business operations.
+type CTLDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewCTLDocument creates a new CTL document with defaults
+func NewCTLDocument(id, title, owner string) *CTLDocument {
+	return &CTLDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCTL, id, title, owner)}
+}
+
+// Validate validates the CTL document and returns the validation issues
+func (d *CTLDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeCTL)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *CTLDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// ETHDocument is a typed Ethics (ETH) document of the Risk & Governance domain
+//
+// The Ethics document defines systematic approaches to promoting ethical
+// behavior, integrity, and moral standards throughout the organization.
+type ETHDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewETHDocument creates a new ETH document with defaults
+func NewETHDocument(id, title, owner string) *ETHDocument {
+	return &ETHDocument{BaseBSpecDocument: *NewDocument(DocumentTypeETH, id, title, owner)}
+}
+
+// Validate validates the ETH document and returns the validation issues
+func (d *ETHDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeETH)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *ETHDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// GOVDocument is a typed Governance (GOV) document of the Risk & Governance
+// domain
+//
+// The Governance document defines systematic approaches to corporate governance
+// that ensure effective oversight, accountability, and decision-making
+// throughout the organization.
+type GOVDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewGOVDocument creates a new GOV document with defaults
+func NewGOVDocument(id, title, owner string) *GOVDocument {
+	return &GOVDocument{BaseBSpecDocument: *NewDocument(DocumentTypeGOV, id, title, owner)}
+}
+
+// Validate validates the GOV document and returns the validation issues
+func (d *GOVDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeGOV)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *GOVDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// INCDocument is a typed Incidents (INC) document of the Risk & Governance
+// domain
+//
+// The Incidents document defines systematic approaches to identifying,
+// responding to, and managing incidents that disrupt business operations,
+// threaten stakeholder safety, or impact organizational objectives.
+type INCDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewINCDocument creates a new INC document with defaults
+func NewINCDocument(id, title, owner string) *INCDocument {
+	return &INCDocument{BaseBSpecDocument: *NewDocument(DocumentTypeINC, id, title, owner)}
+}
+
+// Validate validates the INC document and returns the validation issues
+func (d *INCDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeINC)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *INCDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// INSDocument is a typed Insurance (INS) document of the Risk & Governance
+// domain
+//
+// The Insurance document defines systematic approaches to managing insurance
+// programs that transfer financial risks and protect organizational assets,
+// operations, and stakeholders.
+type INSDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewINSDocument creates a new INS document with defaults
+func NewINSDocument(id, title, owner string) *INSDocument {
+	return &INSDocument{BaseBSpecDocument: *NewDocument(DocumentTypeINS, id, title, owner)}
+}
+
+// Validate validates the INS document and returns the validation issues
+func (d *INSDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeINS)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *INSDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// LEGDocument is a typed Legal (LEG) document of the Risk & Governance domain
+//
+// The Legal document defines systematic approaches to managing legal affairs,
+// protecting legal interests, and ensuring compliance with applicable laws and
+// regulations.
+type LEGDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewLEGDocument creates a new LEG document with defaults
+func NewLEGDocument(id, title, owner string) *LEGDocument {
+	return &LEGDocument{BaseBSpecDocument: *NewDocument(DocumentTypeLEG, id, title, owner)}
+}
+
+// Validate validates the LEG document and returns the validation issues
+func (d *LEGDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeLEG)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *LEGDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// MNADocument is a typed M&A and Corporate Development (MNA) document of the
+// Risk & Governance domain
+//
+// M&A Strategy defines how the organization evaluates acquisition and strategic
+// transaction opportunities, manages diligence, governs execution risk, and
+// integrates outcomes into operating model and reporting.
+type MNADocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewMNADocument creates a new MNA document with defaults
+func NewMNADocument(id, title, owner string) *MNADocument {
+	return &MNADocument{BaseBSpecDocument: *NewDocument(DocumentTypeMNA, id, title, owner)}
+}
+
+// Validate validates the MNA document and returns the validation issues
+func (d *MNADocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeMNA)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *MNADocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// PRVDocument is a typed Privacy Program (PRV) document of the Risk &
+// Governance domain
+//
+// Privacy Program defines lawful data processing practices, privacy rights
+// management, and regulator-facing controls for privacy obligations.
+type PRVDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewPRVDocument creates a new PRV document with defaults
+func NewPRVDocument(id, title, owner string) *PRVDocument {
+	return &PRVDocument{BaseBSpecDocument: *NewDocument(DocumentTypePRV, id, title, owner)}
+}
+
+// Validate validates the PRV document and returns the validation issues
+func (d *PRVDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypePRV)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *PRVDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// RSKDocument is a typed Risks (RSK) document of the Risk & Governance domain
+//
+// The Risks document defines systematic approaches to identifying, assessing,
+// and managing business risks that could impact organizational objectives,
+// operations, and stakeholder value.
+type RSKDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+
+	// Risk management documents require mitigation planning
+	RiskManagement bool `json:"risk_management" yaml:"risk_management"`
+
+	Likelihood *RiskLevel `json:"likelihood,omitempty" yaml:"likelihood,omitempty"` // How likely the risk is to occur
+	Impact     *RiskLevel `json:"impact,omitempty" yaml:"impact,omitempty"`         // How severe the consequences are
+}
+
+// NewRSKDocument creates a new RSK document with defaults
+func NewRSKDocument(id, title, owner string) *RSKDocument {
+	doc := &RSKDocument{BaseBSpecDocument: *NewDocument(DocumentTypeRSK, id, title, owner)}
+	doc.RiskManagement = true
+	return doc
+}
+
+// Validate validates the RSK document and returns the validation issues
+func (d *RSKDocument) Validate() ValidationIssues {
+	issues := d.validateType(DocumentTypeRSK)
+	issues = append(issues, d.validateFields()...)
+
+	// Risk management documents should reference each other
+	if !hasEntry(d.Related, "MIT-") {
+		issues = append(issues, newIssue(SeverityWarning, CodeMissingMitigationReference, "related", "Risk documents should reference corresponding mitigation documents").
+			withFix("add the MIT documents that mitigate the risk to related"))
+	}
+	return issues
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *RSKDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// STADocument is a typed Stakeholder Map (STA) document of the Risk &
+// Governance domain
+//
+// Stakeholder Map establishes who can materially influence outcomes,
+// operations, compliance posture, funding outcomes, and execution feasibility.
+type STADocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSTADocument creates a new STA document with defaults
+func NewSTADocument(id, title, owner string) *STADocument {
+	return &STADocument{BaseBSpecDocument: *NewDocument(DocumentTypeSTA, id, title, owner)}
+}
+
+// Validate validates the STA document and returns the validation issues
+func (d *STADocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSTA)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *STADocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// ADTDocument is a typed Adaptation and Agility (ADT) document of the Growth &
+// Innovation domain
+//
+// The Adaptation and Agility document defines systematic approaches to building
+// organizational capabilities that enable rapid response to changing conditions
+// and emerging opportunities.
+type ADTDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewADTDocument creates a new ADT document with defaults
+func NewADTDocument(id, title, owner string) *ADTDocument {
+	return &ADTDocument{BaseBSpecDocument: *NewDocument(DocumentTypeADT, id, title, owner)}
+}
+
+// Validate validates the ADT document and returns the validation issues
+func (d *ADTDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeADT)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *ADTDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// EXPDocument is a typed Experimentation (EXP) document of the Growth &
+// Innovation domain
+//
+// The Experimentation document governs controlled validation of priority
+// hypotheses before deeper productization.
+type EXPDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewEXPDocument creates a new EXP document with defaults
+func NewEXPDocument(id, title, owner string) *EXPDocument {
+	return &EXPDocument{BaseBSpecDocument: *NewDocument(DocumentTypeEXP, id, title, owner)}
+}
+
+// Validate validates the EXP document and returns the validation issues
+func (d *EXPDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeEXP)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *EXPDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// FUTDocument is a typed Future Planning (FUT) document of the Growth &
+// Innovation domain
+//
+// The Future Planning document defines systematic approaches to understanding
+// and preparing for future possibilities through scenario development and
+// strategic planning.
+type FUTDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewFUTDocument creates a new FUT document with defaults
+func NewFUTDocument(id, title, owner string) *FUTDocument {
+	return &FUTDocument{BaseBSpecDocument: *NewDocument(DocumentTypeFUT, id, title, owner)}
+}
+
+// Validate validates the FUT document and returns the validation issues
+func (d *FUTDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeFUT)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *FUTDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// IGNDocument is a typed Insight Generation (IGN) document of the Growth &
+// Innovation domain
+//
+// The Insight Generation document defines the synthesis layer that integrates
+// evidence from `EXP`, market signals, and operational learning.
+type IGNDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewIGNDocument creates a new IGN document with defaults
+func NewIGNDocument(id, title, owner string) *IGNDocument {
+	return &IGNDocument{BaseBSpecDocument: *NewDocument(DocumentTypeIGN, id, title, owner)}
+}
+
+// Validate validates the IGN document and returns the validation issues
+func (d *IGNDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeIGN)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *IGNDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// INNDocument is a typed Innovation Strategy (INN) document of the Growth &
+// Innovation domain
+//
+// The Innovation Strategy document defines portfolio-level choices: which
+// innovation bets to pursue and why.
+type INNDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewINNDocument creates a new INN document with defaults
+func NewINNDocument(id, title, owner string) *INNDocument {
+	return &INNDocument{BaseBSpecDocument: *NewDocument(DocumentTypeINN, id, title, owner)}
+}
+
+// Validate validates the INN document and returns the validation issues
+func (d *INNDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeINN)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *INNDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// LEADocument is a typed Learning Organization (LEA) document of the Growth &
+// Innovation domain
+//
+// The Learning Organization document defines systematic approaches to building
+// organizational capabilities for continuous learning and adaptation.
+type LEADocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewLEADocument creates a new LEA document with defaults
+func NewLEADocument(id, title, owner string) *LEADocument {
+	return &LEADocument{BaseBSpecDocument: *NewDocument(DocumentTypeLEA, id, title, owner)}
+}
+
+// Validate validates the LEA document and returns the validation issues
+func (d *LEADocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeLEA)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *LEADocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// RNDDocument is a typed Research and Development (RND) document of the Growth
+// & Innovation domain
+//
+// The Research and Development document governs long-cycle technical
+// investigation and capability creation behind innovation bets.
+type RNDDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewRNDDocument creates a new RND document with defaults
+func NewRNDDocument(id, title, owner string) *RNDDocument {
+	return &RNDDocument{BaseBSpecDocument: *NewDocument(DocumentTypeRND, id, title, owner)}
+}
+
+// Validate validates the RND document and returns the validation issues
+func (d *RNDDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeRND)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *RNDDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// DECDocument is a typed Decision Records (DEC) document of the Learning &
+// Decisions domain
+//
+// The Decision Records document captures strategic and operational decisions
+// made within the organization, including context, rationale, alternatives
+// considered, and outcomes.
+type DECDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewDECDocument creates a new DEC document with defaults
+func NewDECDocument(id, title, owner string) *DECDocument {
+	return &DECDocument{BaseBSpecDocument: *NewDocument(DocumentTypeDEC, id, title, owner)}
+}
+
+// Validate validates the DEC document and returns the validation issues
+func (d *DECDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeDEC)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *DECDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// HYPDocument is a typed Hypothesis Management (HYP) document of the Learning &
+// Decisions domain
+//
+// The Hypothesis Management document captures testable assumptions and beliefs
+// about business, customers, markets, and solutions that guide organizational
+// decision-making and learning.
+type HYPDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewHYPDocument creates a new HYP document with defaults
+func NewHYPDocument(id, title, owner string) *HYPDocument {
+	return &HYPDocument{BaseBSpecDocument: *NewDocument(DocumentTypeHYP, id, title, owner)}
+}
+
+// Validate validates the HYP document and returns the validation issues
+func (d *HYPDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeHYP)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *HYPDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// KNODocument is a typed Knowledge Management (KNO) document of the Learning &
+// Decisions domain
+//
+// The Knowledge Management document defines systematic approaches to capturing,
+// organizing, sharing, and leveraging organizational knowledge assets.
+type KNODocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewKNODocument creates a new KNO document with defaults
+func NewKNODocument(id, title, owner string) *KNODocument {
+	return &KNODocument{BaseBSpecDocument: *NewDocument(DocumentTypeKNO, id, title, owner)}
+}
+
+// Validate validates the KNO document and returns the validation issues
+func (d *KNODocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeKNO)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *KNODocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// LRNDocument is a typed Learning Records (LRN) document of the Learning &
+// Decisions domain
+//
+// The Learning Records document captures key discoveries, insights, and
+// knowledge gained through organizational activities, experiments, and
+// experiences.
+type LRNDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewLRNDocument creates a new LRN document with defaults
+func NewLRNDocument(id, title, owner string) *LRNDocument {
+	return &LRNDocument{BaseBSpecDocument: *NewDocument(DocumentTypeLRN, id, title, owner)}
+}
+
+// Validate validates the LRN document and returns the validation issues
+func (d *LRNDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeLRN)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *LRNDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// RETDocument is a typed Retrospective Analysis (RET) document of the Learning
+// & Decisions domain
+//
+// The Retrospective Analysis document captures structured reflection on
+// completed projects, initiatives, or time periods to identify successes,
+// failures, lessons learned, and improvement opportunities.
+type RETDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewRETDocument creates a new RET document with defaults
+func NewRETDocument(id, title, owner string) *RETDocument {
+	return &RETDocument{BaseBSpecDocument: *NewDocument(DocumentTypeRET, id, title, owner)}
+}
+
+// Validate validates the RET document and returns the validation issues
+func (d *RETDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeRET)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *RETDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// WISDocument is a typed Wisdom Synthesis (WIS) document of the Learning &
+// Decisions domain
+//
+// The Wisdom Synthesis document captures the practical wisdom, judgment
+// principles, and synthesized insights that guide organizational
+// decision-making and action.
+type WISDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewWISDocument creates a new WIS document with defaults
+func NewWISDocument(id, title, owner string) *WISDocument {
+	return &WISDocument{BaseBSpecDocument: *NewDocument(DocumentTypeWIS, id, title, owner)}
+}
+
+// Validate validates the WIS document and returns the validation issues
+func (d *WISDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeWIS)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *WISDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// BPODocument is a typed Brand Positioning (BPO) document of the Brand &
+// Marketing domain
+//
+// The Brand Positioning document defines how the brand occupies a distinctive
+// position in customers' minds relative to competitors.
+type BPODocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewBPODocument creates a new BPO document with defaults
+func NewBPODocument(id, title, owner string) *BPODocument {
+	return &BPODocument{BaseBSpecDocument: *NewDocument(DocumentTypeBPO, id, title, owner)}
+}
+
+// Validate validates the BPO document and returns the validation issues
+func (d *BPODocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeBPO)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *BPODocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// BRDDocument is a typed Brand Strategy (BRD) document of the Brand & Marketing
+// domain
+//
+// The Brand Strategy document defines the foundational elements that shape how
+// the brand is perceived and experienced by customers.
+type BRDDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewBRDDocument creates a new BRD document with defaults
+func NewBRDDocument(id, title, owner string) *BRDDocument {
+	return &BRDDocument{BaseBSpecDocument: *NewDocument(DocumentTypeBRD, id, title, owner)}
+}
+
+// Validate validates the BRD document and returns the validation issues
+func (d *BRDDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeBRD)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *BRDDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// CAMDocument is a typed Marketing Campaign (CAM) document of the Brand &
+// Marketing domain
+//
+// The Marketing Campaign document defines integrated marketing initiatives that
+// achieve specific business objectives through coordinated messaging, creative
+// execution, and multi-channel activation.
+type CAMDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewCAMDocument creates a new CAM document with defaults
+func NewCAMDocument(id, title, owner string) *CAMDocument {
+	return &CAMDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCAM, id, title, owner)}
+}
+
+// Validate validates the CAM document and returns the validation issues
+func (d *CAMDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeCAM)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *CAMDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// CNTDocument is a typed Content Strategy (CNT) document of the Brand &
+// Marketing domain
+//
+// The Content Strategy document defines what content will be created, why, and
+// how it supports business objectives through strategic content planning and
+// execution.
+type CNTDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewCNTDocument creates a new CNT document with defaults
+func NewCNTDocument(id, title, owner string) *CNTDocument {
+	return &CNTDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCNT, id, title, owner)}
+}
+
+// Validate validates the CNT document and returns the validation issues
+func (d *CNTDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeCNT)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *CNTDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// IFLDocument is a typed Influencer Marketing (IFL) document of the Brand &
+// Marketing domain
+//
+// The Influencer Marketing document defines strategies for partnering with
+// influencers to amplify brand messaging, build credibility, and reach target
+// audiences through authentic endorsements.
+type IFLDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewIFLDocument creates a new IFL document with defaults
+func NewIFLDocument(id, title, owner string) *IFLDocument {
+	return &IFLDocument{BaseBSpecDocument: *NewDocument(DocumentTypeIFL, id, title, owner)}
+}
+
+// Validate validates the IFL document and returns the validation issues
+func (d *IFLDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeIFL)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *IFLDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// MCHDocument is a typed Marketing Channel Strategy (MCH) document of the Brand
+// & Marketing domain
+//
+// The Marketing Channel Strategy document defines how the brand reaches and
+// engages target audiences through marketing, social, and owned/earned paid
+// content channels.
+type MCHDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewMCHDocument creates a new MCH document with defaults
+func NewMCHDocument(id, title, owner string) *MCHDocument {
+	return &MCHDocument{BaseBSpecDocument: *NewDocument(DocumentTypeMCH, id, title, owner)}
+}
+
+// Validate validates the MCH document and returns the validation issues
+func (d *MCHDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeMCH)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *MCHDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// MSGDocument is a typed Messaging Framework (MSG) document of the Brand &
+// Marketing domain
+//
+// The Messaging Framework document defines what the brand says and how it says
+// it to different audiences across various touchpoints.
+type MSGDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewMSGDocument creates a new MSG document with defaults
+func NewMSGDocument(id, title, owner string) *MSGDocument {
+	return &MSGDocument{BaseBSpecDocument: *NewDocument(DocumentTypeMSG, id, title, owner)}
+}
+
+// Validate validates the MSG document and returns the validation issues
+func (d *MSGDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeMSG)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *MSGDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// PRFDocument is a typed Performance Marketing (PRF) document of the Brand &
+// Marketing domain
+//
+// The Performance Marketing document defines data-driven marketing strategies
+// focused on measurable outcomes and return on investment.
+type PRFDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewPRFDocument creates a new PRF document with defaults
+func NewPRFDocument(id, title, owner string) *PRFDocument {
+	return &PRFDocument{BaseBSpecDocument: *NewDocument(DocumentTypePRF, id, title, owner)}
+}
+
+// Validate validates the PRF document and returns the validation issues
+func (d *PRFDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypePRF)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *PRFDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// SALDocument is a typed Sales Strategy (SAL) document of the Brand & Marketing
+// domain
+//
+// The Sales Strategy document defines how the organization acquires, converts,
+// and retains customers through repeatable sales motions.
+type SALDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSALDocument creates a new SAL document with defaults
+func NewSALDocument(id, title, owner string) *SALDocument {
+	return &SALDocument{BaseBSpecDocument: *NewDocument(DocumentTypeSAL, id, title, owner)}
+}
+
+// Validate validates the SAL document and returns the validation issues
+func (d *SALDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSAL)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *SALDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// SEODocument is a typed Search Engine Optimization (SEO) document of the Brand
+// & Marketing domain
+//
+// The Search Engine Optimization document defines strategies for improving
+// organic search visibility and traffic through technical optimization, content
+// strategy, and authority building.
+type SEODocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSEODocument creates a new SEO document with defaults
+func NewSEODocument(id, title, owner string) *SEODocument {
+	return &SEODocument{BaseBSpecDocument: *NewDocument(DocumentTypeSEO, id, title, owner)}
+}
+
+// Validate validates the SEO document and returns the validation issues
+func (d *SEODocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSEO)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *SEODocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// SOCDocument is a typed Social Media Strategy (SOC) document of the Brand &
+// Marketing domain
+//
+// The Social Media Strategy document defines how the brand will engage with
+// audiences across social platforms to build community, drive engagement, and
+// support business objectives.
+type SOCDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSOCDocument creates a new SOC document with defaults
+func NewSOCDocument(id, title, owner string) *SOCDocument {
+	return &SOCDocument{BaseBSpecDocument: *NewDocument(DocumentTypeSOC, id, title, owner)}
+}
+
+// Validate validates the SOC document and returns the validation issues
+func (d *SOCDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSOC)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *SOCDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// TONDocument is a typed Tone of Voice (TON) document of the Brand & Marketing
+// domain
+//
+// The Tone of Voice document defines how the brand sounds in all
+// communications, establishing voice characteristics that express brand
+// personality and create consistent customer experiences.
+type TONDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewTONDocument creates a new TON document with defaults
+func NewTONDocument(id, title, owner string) *TONDocument {
+	return &TONDocument{BaseBSpecDocument: *NewDocument(DocumentTypeTON, id, title, owner)}
+}
+
+// Validate validates the TON document and returns the validation issues
+func (d *TONDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeTON)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *TONDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// VIDDocument is a typed Visual Identity (VID) document of the Brand &
+// Marketing domain
+//
+// The Visual Identity document defines the visual language that expresses brand
+// personality and creates recognition across all customer touchpoints.
+type VIDDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewVIDDocument creates a new VID document with defaults
+func NewVIDDocument(id, title, owner string) *VIDDocument {
+	return &VIDDocument{BaseBSpecDocument: *NewDocument(DocumentTypeVID, id, title, owner)}
+}
+
+// Validate validates the VID document and returns the validation issues
+func (d *VIDDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeVID)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *VIDDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// MITDocument is a typed Mitigations (MIT) document of the Risk & Governance
+// domain
+//
+// Risk response strategies and controls.
+//
+// The conformance levels of the README require it; spec/v1 does not define it.
+type MITDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+
+	// Risk management documents require mitigation planning
+	RiskManagement bool `json:"risk_management" yaml:"risk_management"`
+}
+
+// NewMITDocument creates a new MIT document with defaults
+func NewMITDocument(id, title, owner string) *MITDocument {
+	doc := &MITDocument{BaseBSpecDocument: *NewDocument(DocumentTypeMIT, id, title, owner)}
+	doc.RiskManagement = true
+	return doc
+}
+
+// Validate validates the MIT document and returns the validation issues
+func (d *MITDocument) Validate() ValidationIssues {
+	issues := d.validateType(DocumentTypeMIT)
+
+	// Risk management documents should reference each other
+	if !hasEntry(d.Related, "RSK-") {
+		issues = append(issues, newIssue(SeverityWarning, CodeMissingRiskReference, "related", "Mitigation documents should reference corresponding risk documents").
+			withFix("add the RSK documents it mitigates to related"))
+	}
+	return issues
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *MITDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// ACQDocument is a typed Acquisitions (ACQ) document of the Growth & Innovation
+// domain
+//
+// M&A strategy and integration planning.
+//
+// Deprecated: ACQ is not defined by the specification.
+type ACQDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewACQDocument creates a new ACQ document with defaults
+func NewACQDocument(id, title, owner string) *ACQDocument {
+	return &ACQDocument{BaseBSpecDocument: *NewDocument(DocumentTypeACQ, id, title, owner)}
+}
+
+// Validate validates the ACQ document and returns the validation issues
+func (d *ACQDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeACQ)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *ACQDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// ACTDocument is a typed Key Activities (ACT) document of the Business Model
+// domain
+//
+// Essential processes for value creation.
+//
+// Deprecated: ACT is not defined by the specification; use KACDocument.
+type ACTDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewACTDocument creates a new ACT document with defaults
+func NewACTDocument(id, title, owner string) *ACTDocument {
+	return &ACTDocument{BaseBSpecDocument: *NewDocument(DocumentTypeACT, id, title, owner)}
+}
+
+// Validate validates the ACT document and returns the validation issues
+func (d *ACTDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeACT)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *ACTDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// BMCDocument is a typed Business Model Canvas (BMC) document of the Business
+// Model domain
+//
+// Complete business model overview.
+//
+// Deprecated: BMC is not defined by the specification.
+type BMCDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewBMCDocument creates a new BMC document with defaults
+func NewBMCDocument(id, title, owner string) *BMCDocument {
+	return &BMCDocument{BaseBSpecDocument: *NewDocument(DocumentTypeBMC, id, title, owner)}
+}
+
+// Validate validates the BMC document and returns the validation issues
+func (d *BMCDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeBMC)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *BMCDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// CACDocument is a typed Customer Acquisition (CAC) document of the Business
+// Model domain
+//
+// Customer acquisition cost and strategies.
+//
+// Deprecated: CAC is not defined by the specification.
+type CACDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewCACDocument creates a new CAC document with defaults
+func NewCACDocument(id, title, owner string) *CACDocument {
+	return &CACDocument{BaseBSpecDocument: *NewDocument(DocumentTypeCAC, id, title, owner)}
+}
+
+// Validate validates the CAC document and returns the validation issues
+func (d *CACDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeCAC)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *CACDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// GRWDocument is a typed Growth Model (GRW) document of the Growth & Innovation
+// domain
+//
+// How the business scales customers and revenue.
+//
+// Deprecated: GRW is not defined by the specification.
+type GRWDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewGRWDocument creates a new GRW document with defaults
+func NewGRWDocument(id, title, owner string) *GRWDocument {
+	return &GRWDocument{BaseBSpecDocument: *NewDocument(DocumentTypeGRW, id, title, owner)}
+}
+
+// Validate validates the GRW document and returns the validation issues
+func (d *GRWDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeGRW)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *GRWDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// GTMDocument is a typed Go-to-Market (GTM) document of the Growth & Innovation
+// domain
+//
+// Launch and customer acquisition strategy.
+//
+// Deprecated: GTM is not defined by the specification.
+type GTMDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewGTMDocument creates a new GTM document with defaults
+func NewGTMDocument(id, title, owner string) *GTMDocument {
+	return &GTMDocument{BaseBSpecDocument: *NewDocument(DocumentTypeGTM, id, title, owner)}
+}
+
+// Validate validates the GTM document and returns the validation issues
+func (d *GTMDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeGTM)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *GTMDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// GVNDocument is a typed Governance (GVN) document of the Risk & Governance
+// domain
+//
+// Decision-making structure and oversight.
+//
+// Deprecated: GVN is not defined by the specification; use GOVDocument.
+type GVNDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewGVNDocument creates a new GVN document with defaults
+func NewGVNDocument(id, title, owner string) *GVNDocument {
+	return &GVNDocument{BaseBSpecDocument: *NewDocument(DocumentTypeGVN, id, title, owner)}
+}
+
+// Validate validates the GVN document and returns the validation issues
+func (d *GVNDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeGVN)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *GVNDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// LTVDocument is a typed Lifetime Value (LTV) document of the Business Model
+// domain
+//
+// Customer lifetime value models and drivers.
+//
+// Deprecated: LTV is not defined by the specification.
+type LTVDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewLTVDocument creates a new LTV document with defaults
+func NewLTVDocument(id, title, owner string) *LTVDocument {
+	return &LTVDocument{BaseBSpecDocument: *NewDocument(DocumentTypeLTV, id, title, owner)}
+}
+
+// Validate validates the LTV document and returns the validation issues
+func (d *LTVDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeLTV)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *LTVDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// PRCDocument is a typed Processes (PRC) document of the Operations & Execution
+// domain
+//
+// Repeatable workflows that drive business outcomes.
+//
+// Deprecated: PRC is not defined by the specification; use PRODocument.
+type PRCDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewPRCDocument creates a new PRC document with defaults
+func NewPRCDocument(id, title, owner string) *PRCDocument {
+	return &PRCDocument{BaseBSpecDocument: *NewDocument(DocumentTypePRC, id, title, owner)}
+}
+
+// Validate validates the PRC document and returns the validation issues
+func (d *PRCDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypePRC)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *PRCDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// PRTDocument is a typed Key Partnerships (PRT) document of the Business Model
+// domain
+//
+// Strategic alliances and supplier relationships.
+//
+// Deprecated: PRT is not defined by the specification; use KPTDocument.
+type PRTDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewPRTDocument creates a new PRT document with defaults
+func NewPRTDocument(id, title, owner string) *PRTDocument {
+	return &PRTDocument{BaseBSpecDocument: *NewDocument(DocumentTypePRT, id, title, owner)}
+}
+
+// Validate validates the PRT document and returns the validation issues
+func (d *PRTDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypePRT)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *PRTDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// RELDocument is a typed Customer Relationships (REL) document of the Business
+// Model domain
+//
+// How relationships are built and maintained.
+//
+// Deprecated: REL is not defined by the specification.
+type RELDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewRELDocument creates a new REL document with defaults
+func NewRELDocument(id, title, owner string) *RELDocument {
+	return &RELDocument{BaseBSpecDocument: *NewDocument(DocumentTypeREL, id, title, owner)}
+}
+
+// Validate validates the REL document and returns the validation issues
+func (d *RELDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeREL)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *RELDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// RESDocument is a typed Key Resources (RES) document of the Business Model
+// domain
+//
+// Critical assets required for the business model.
+//
+// Deprecated: RES is not defined by the specification; use KRSDocument.
+type RESDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewRESDocument creates a new RES document with defaults
+func NewRESDocument(id, title, owner string) *RESDocument {
+	return &RESDocument{BaseBSpecDocument: *NewDocument(DocumentTypeRES, id, title, owner)}
+}
+
+// Validate validates the RES document and returns the validation issues
+func (d *RESDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeRES)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *RESDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// SCLDocument is a typed Scaling (SCL) document of the Growth & Innovation
+// domain
+//
+// Operational scaling approach and constraints.
+//
+// Deprecated: SCL is not defined by the specification.
+type SCLDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewSCLDocument creates a new SCL document with defaults
+func NewSCLDocument(id, title, owner string) *SCLDocument {
+	return &SCLDocument{BaseBSpecDocument: *NewDocument(DocumentTypeSCL, id, title, owner)}
+}
+
+// Validate validates the SCL document and returns the validation issues
+func (d *SCLDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeSCL)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *SCLDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// TOODocument is a typed Tools (TOO) document of the Operations & Execution
+// domain
+//
+// Software, systems, and operational tools.
+//
+// Deprecated: TOO is not defined by the specification.
+type TOODocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewTOODocument creates a new TOO document with defaults
+func NewTOODocument(id, title, owner string) *TOODocument {
+	return &TOODocument{BaseBSpecDocument: *NewDocument(DocumentTypeTOO, id, title, owner)}
+}
+
+// Validate validates the TOO document and returns the validation issues
+func (d *TOODocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeTOO)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *TOODocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+
+// UNTDocument is a typed Unit Economics (UNT) document of the Business Model
+// domain
+//
+// Per-customer or per-unit financial metrics.
+//
+// Deprecated: UNT is not defined by the specification.
+type UNTDocument struct {
+	BaseBSpecDocument `yaml:",inline"`
+}
+
+// NewUNTDocument creates a new UNT document with defaults
+func NewUNTDocument(id, title, owner string) *UNTDocument {
+	return &UNTDocument{BaseBSpecDocument: *NewDocument(DocumentTypeUNT, id, title, owner)}
+}
+
+// Validate validates the UNT document and returns the validation issues
+func (d *UNTDocument) Validate() ValidationIssues {
+	return d.validateType(DocumentTypeUNT)
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *UNTDocument) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
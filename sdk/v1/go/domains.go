@@ -0,0 +1,76 @@
+package bspec
+
+import (
+	"strings"
+)
+
+// DomainInfo describes how a business domain is presented and organized
+type DomainInfo struct {
+	Domain        BusinessDomain `json:"domain"`
+	Order         int            `json:"order"`
+	Folder        string         `json:"folder"`         // Folder under documents/ in a .bspec archive, e.g. "01-strategic"; empty if the format defines none
+	SpecDirectory string         `json:"spec_directory"` // Directory under spec/v1, e.g. "strategic-foundation"
+	DisplayName   string         `json:"display_name"`
+	Emoji         string         `json:"emoji"`
+}
+
+// domainCatalog lists the business domains in archive folder order. The
+// folders are those of spec/v1/format.md, which defines 01-strategic through
+// 11-learning and no folder for brand documents.
+var domainCatalog = []DomainInfo{
+	{BusinessDomainStrategic, 1, "01-strategic", "strategic-foundation", "Strategic Foundation", "🎯"},
+	{BusinessDomainMarket, 2, "02-market", "market-environment", "Market & Environment", "🌍"},
+	{BusinessDomainCustomer, 3, "03-customer", "customer-value", "Customer & Value", "👥"},
+	{BusinessDomainProduct, 4, "04-product", "product-service", "Product & Service", "📦"},
+	{BusinessDomainBusinessModel, 5, "05-business-model", "business-model", "Business Model", "💰"},
+	{BusinessDomainOperations, 6, "06-operations", "operations-execution", "Operations & Execution", "⚙️"},
+	{BusinessDomainTechnology, 7, "07-technology", "technology-data", "Technology & Data", "🔧"},
+	{BusinessDomainFinancial, 8, "08-financial", "financial-investment", "Financial & Investment", "📊"},
+	{BusinessDomainRisk, 9, "09-risk", "risk-governance", "Risk & Governance", "⚠️"},
+	{BusinessDomainGrowth, 10, "10-growth", "growth-innovation", "Growth & Innovation", "📈"},
+	{BusinessDomainLearning, 11, "11-learning", "learning-decisions", "Learning & Decisions", "🧠"},
+	{BusinessDomainBrand, 12, "", "brand-marketing", "Brand & Marketing", "📢"},
+}
+
+// Domains returns all business domains in archive folder order
+func Domains() []DomainInfo {
+	domains := make([]DomainInfo, len(domainCatalog))
+	copy(domains, domainCatalog)
+	return domains
+}
+
+// LookupDomain returns the presentation details of a business domain
+func LookupDomain(domain BusinessDomain) (DomainInfo, bool) {
+	for _, info := range domainCatalog {
+		if info.Domain == domain {
+			return info, true
+		}
+	}
+	return DomainInfo{}, false
+}
+
+// ParseBusinessDomain resolves a domain from its name, archive folder,
+// spec directory or display name, e.g. "strategic", "01-strategic",
+// "strategic-foundation" or "Strategic Foundation"
+func ParseBusinessDomain(value string) (BusinessDomain, bool) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	if normalized == "" {
+		return "", false
+	}
+
+	for _, info := range domainCatalog {
+		if normalized == string(info.Domain) ||
+			normalized == info.Folder ||
+			normalized == info.SpecDirectory ||
+			normalized == strings.ToLower(info.DisplayName) {
+			return info.Domain, true
+		}
+	}
+
+	// Accept numbered folders with a different prefix, e.g. "1-strategic"
+	if i := strings.Index(normalized, "-"); i > 0 && strings.Trim(normalized[:i], "0123456789") == "" {
+		return ParseBusinessDomain(normalized[i+1:])
+	}
+
+	return "", false
+}
@@ -1,60 +0,0 @@
-// Package bspec provides EMP document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.610110
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// EMPDocument represents a Empathy Maps (EMP) document
-// Domain: customer
-//
-// Deep understanding of customer thoughts and feelings
-type EMPDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewEMPDocument creates a new EMP document with defaults
-func NewEMPDocument(id, title, owner string) *EMPDocument {
-	doc := &EMPDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeEMP,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainCustomer
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the EMP document and returns any validation errors
-func (d *EMPDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeEMP {
-		errors = append(errors, "document type must be EMP")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
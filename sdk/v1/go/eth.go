@@ -1,60 +0,0 @@
-// Package bspec provides ETH document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.617271
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// ETHDocument represents a Ethics (ETH) document
-// Domain: risk
-//
-// Ethical guidelines and moral standards
-type ETHDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewETHDocument creates a new ETH document with defaults
-func NewETHDocument(id, title, owner string) *ETHDocument {
-	doc := &ETHDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeETH,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainRisk
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the ETH document and returns any validation errors
-func (d *ETHDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeETH {
-		errors = append(errors, "document type must be ETH")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides FAC document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.614661
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// FACDocument represents a Facilities (FAC) document
-// Domain: operations
-//
-// Physical spaces, equipment, and infrastructure
-type FACDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewFACDocument creates a new FAC document with defaults
-func NewFACDocument(id, title, owner string) *FACDocument {
-	doc := &FACDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeFAC,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainOperations
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the FAC document and returns any validation errors
-func (d *FACDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeFAC {
-		errors = append(errors, "document type must be FAC")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides FEE document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.610209
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// FEEDocument represents a Feedback (FEE) document
-// Domain: customer
-//
-// Customer input, reviews, and satisfaction data
-type FEEDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewFEEDocument creates a new FEE document with defaults
-func NewFEEDocument(id, title, owner string) *FEEDocument {
-	doc := &FEEDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeFEE,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainCustomer
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the FEE document and returns any validation errors
-func (d *FEEDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeFEE {
-		errors = append(errors, "document type must be FEE")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -0,0 +1,245 @@
+package bspec
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// RiskLevel rates the likelihood or the impact of a risk
+type RiskLevel string
+
+const (
+	RiskLevelVeryLow  RiskLevel = "very_low"
+	RiskLevelLow      RiskLevel = "low"
+	RiskLevelMedium   RiskLevel = "medium"
+	RiskLevelHigh     RiskLevel = "high"
+	RiskLevelVeryHigh RiskLevel = "very_high"
+)
+
+// riskLevels is the likelihood scale of the RSK specification, lowest first
+var riskLevels = []RiskLevel{RiskLevelVeryLow, RiskLevelLow, RiskLevelMedium, RiskLevelHigh, RiskLevelVeryHigh}
+
+var (
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	ageRangePattern = regexp.MustCompile(`^(\d+)(?:-(\d+)|\+)$`)
+)
+
+// Value returns the position of a level on a 1 to 5 scale, or 0 if the level
+// is unknown
+func (l RiskLevel) Value() int {
+	for i, level := range riskLevels {
+		if level == l {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// KeyResult is a measurable result of an objective
+type KeyResult struct {
+	Description string   `json:"description" yaml:"description"`
+	Metric      string   `json:"metric,omitempty" yaml:"metric,omitempty"` // MET document that measures it
+	Unit        string   `json:"unit,omitempty" yaml:"unit,omitempty"`
+	Baseline    *float64 `json:"baseline,omitempty" yaml:"baseline,omitempty"`
+	Target      *float64 `json:"target,omitempty" yaml:"target,omitempty"`
+	Current     *float64 `json:"current,omitempty" yaml:"current,omitempty"`
+	Owner       string   `json:"owner,omitempty" yaml:"owner,omitempty"`
+}
+
+// Progress returns how far the current value has moved from the baseline to
+// the target, where 1 means the target is reached
+func (k KeyResult) Progress() (float64, bool) {
+	if k.Current == nil {
+		return 0, false
+	}
+	return progress(k.Baseline, k.Target, *k.Current)
+}
+
+// BudgetLineItem is a planned amount of a budget
+type BudgetLineItem struct {
+	Category    string  `json:"category" yaml:"category"`
+	Description string  `json:"description,omitempty" yaml:"description,omitempty"`
+	Amount      float64 `json:"amount" yaml:"amount"`
+	Owner       string  `json:"owner,omitempty" yaml:"owner,omitempty"`
+}
+
+// Demographics describes who a persona is
+type Demographics struct {
+	AgeRange     string `json:"age_range,omitempty" yaml:"age_range,omitempty"` // e.g. "25-34" or "65+"
+	Location     string `json:"location,omitempty" yaml:"location,omitempty"`
+	Role         string `json:"role,omitempty" yaml:"role,omitempty"`
+	Organization string `json:"organization,omitempty" yaml:"organization,omitempty"`
+	Education    string `json:"education,omitempty" yaml:"education,omitempty"`
+	Income       string `json:"income,omitempty" yaml:"income,omitempty"`
+}
+
+// Score returns likelihood times impact on a 1 to 25 scale, or 0 if the risk
+// is not rated
+func (d *RSKDocument) Score() int {
+	if d.Likelihood == nil || d.Impact == nil {
+		return 0
+	}
+	return d.Likelihood.Value() * d.Impact.Value()
+}
+
+// validateFields validates the likelihood and impact of a risk
+func (d *RSKDocument) validateFields() ValidationIssues {
+	var issues ValidationIssues
+	for _, field := range []struct {
+		key   string
+		level *RiskLevel
+	}{{"likelihood", d.Likelihood}, {"impact", d.Impact}} {
+		if field.level != nil && field.level.Value() == 0 {
+			issues = append(issues, newIssue(SeverityError, CodeInvalidValue, field.key, fmt.Sprintf("%s must be one of %s", field.key, joinLevels())))
+		}
+	}
+	if d.Likelihood != nil && d.Impact == nil {
+		issues = append(issues, newIssue(SeverityError, CodeRequiredField, "impact", "likelihood and impact must be rated together"))
+	}
+	if d.Impact != nil && d.Likelihood == nil {
+		issues = append(issues, newIssue(SeverityError, CodeRequiredField, "likelihood", "likelihood and impact must be rated together"))
+	}
+	return issues
+}
+
+// Progress returns how far a value has moved from the baseline to the target
+// of a metric, where 1 means the target is reached
+func (d *METDocument) Progress(current float64) (float64, bool) {
+	return progress(d.Baseline, d.Target, current)
+}
+
+// validateFields validates the unit, baseline and target of a metric
+func (d *METDocument) validateFields() ValidationIssues {
+	var issues ValidationIssues
+	if (d.Baseline != nil || d.Target != nil) && strings.TrimSpace(d.Unit) == "" {
+		issues = append(issues, newIssue(SeverityError, CodeRequiredField, "unit", "unit is required when baseline or target is set"))
+	}
+	if d.Baseline != nil && d.Target != nil && *d.Baseline == *d.Target {
+		issues = append(issues, newIssue(SeverityError, CodeInvalidValue, "target", "target must differ from baseline"))
+	}
+	return issues
+}
+
+// Progress returns the average progress of the key results that have a
+// baseline, a target and a current value
+func (d *OBJDocument) Progress() (float64, bool) {
+	var total float64
+	var count int
+	for _, result := range d.KeyResults {
+		if value, ok := result.Progress(); ok {
+			total += value
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, false
+	}
+	return total / float64(count), true
+}
+
+// validateFields validates the key results of an objective
+func (d *OBJDocument) validateFields() ValidationIssues {
+	var issues ValidationIssues
+	for i, result := range d.KeyResults {
+		field := fmt.Sprintf("key_results[%d]", i)
+		if strings.TrimSpace(result.Description) == "" {
+			issues = append(issues, newIssue(SeverityError, CodeRequiredField, field+".description", field+".description is required"))
+		}
+		if result.Target == nil {
+			issues = append(issues, newIssue(SeverityError, CodeRequiredField, field+".target", field+".target is required"))
+		}
+		if result.Metric != "" {
+			if err := ValidateDocumentID(result.Metric, DocumentTypeMET); err != nil {
+				issues = append(issues, newIssue(SeverityError, CodeInvalidReference, field+".metric", fmt.Sprintf("%s.metric: %v", field, err)))
+			}
+		}
+		if result.Baseline != nil && result.Target != nil && *result.Baseline == *result.Target {
+			issues = append(issues, newIssue(SeverityError, CodeInvalidValue, field+".target", field+".target must differ from baseline"))
+		}
+	}
+	return issues
+}
+
+// Total returns the sum of the line items of a budget
+func (d *BUDDocument) Total() float64 {
+	var total float64
+	for _, item := range d.LineItems {
+		total += item.Amount
+	}
+	return total
+}
+
+// TotalByCategory returns the sum of the line items of a budget per category
+func (d *BUDDocument) TotalByCategory() map[string]float64 {
+	totals := make(map[string]float64)
+	for _, item := range d.LineItems {
+		totals[item.Category] += item.Amount
+	}
+	return totals
+}
+
+// validateFields validates the currency and line items of a budget
+func (d *BUDDocument) validateFields() ValidationIssues {
+	var issues ValidationIssues
+	if d.Currency != "" && !currencyPattern.MatchString(d.Currency) {
+		issues = append(issues, newIssue(SeverityError, CodeInvalidValue, "currency", "currency must be an ISO 4217 code, e.g. 'USD'").
+			withFix(fmt.Sprintf("set currency to %s", strings.ToUpper(d.Currency))))
+	}
+	if d.Currency == "" && len(d.LineItems) > 0 {
+		issues = append(issues, newIssue(SeverityError, CodeRequiredField, "currency", "currency is required when line_items are listed"))
+	}
+	for i, item := range d.LineItems {
+		field := fmt.Sprintf("line_items[%d]", i)
+		if strings.TrimSpace(item.Category) == "" {
+			issues = append(issues, newIssue(SeverityError, CodeRequiredField, field+".category", field+".category is required"))
+		}
+		if item.Amount < 0 {
+			issues = append(issues, newIssue(SeverityError, CodeInvalidValue, field+".amount", field+".amount must not be negative"))
+		}
+	}
+	return issues
+}
+
+// validateFields validates the demographics and goals of a persona
+func (d *PERDocument) validateFields() ValidationIssues {
+	var issues ValidationIssues
+	if d.Demographics != nil && d.Demographics.AgeRange != "" {
+		match := ageRangePattern.FindStringSubmatch(d.Demographics.AgeRange)
+		if match == nil {
+			issues = append(issues, newIssue(SeverityError, CodeInvalidValue, "demographics.age_range", "demographics.age_range must be a range such as '25-34' or '65+'"))
+		} else if match[2] != "" {
+			low, _ := strconv.Atoi(match[1])
+			high, _ := strconv.Atoi(match[2])
+			if low > high {
+				issues = append(issues, newIssue(SeverityError, CodeInvalidValue, "demographics.age_range", "demographics.age_range must start with the lower age").
+					withFix(fmt.Sprintf("write the range as %d-%d", high, low)))
+			}
+		}
+	}
+	for i, goal := range d.Goals {
+		if strings.TrimSpace(goal) == "" {
+			field := fmt.Sprintf("goals[%d]", i)
+			issues = append(issues, newIssue(SeverityWarning, CodeInvalidValue, field, field+" must not be empty").
+				withFix("remove the empty goal"))
+		}
+	}
+	return issues
+}
+
+// progress returns where a value lies between a baseline and a target
+func progress(baseline, target *float64, current float64) (float64, bool) {
+	if baseline == nil || target == nil || *baseline == *target {
+		return 0, false
+	}
+	return (current - *baseline) / (*target - *baseline), true
+}
+
+func joinLevels() string {
+	names := make([]string, len(riskLevels))
+	for i, level := range riskLevels {
+		names[i] = string(level)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,134 @@
+package bspec
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func hasIssue(issues ValidationIssues, text string) bool {
+	for _, issue := range issues {
+		if strings.Contains(issue.Message, text) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRSKFields(t *testing.T) {
+	doc := NewRSKDocument("RSK-vendor-lock-in", "Vendor lock-in", "cro")
+	data := []byte(`{"id":"RSK-vendor-lock-in","title":"Vendor lock-in","type":"RSK","owner":"cro","created":"2024-01-01","updated":"2024-01-01","likelihood":"high","impact":"very_high","related":["MIT-exit-plan"]}`)
+	if err := json.Unmarshal(data, doc); err != nil {
+		t.Fatalf("Failed to decode: %v", err)
+	}
+	if score := doc.Score(); score != 20 {
+		t.Errorf("Expected score 20, got %d", score)
+	}
+	if issues := doc.Validate(); len(issues) != 0 {
+		t.Errorf("Expected no issues, got %v", issues)
+	}
+
+	unknown := RiskLevel("extreme")
+	doc.Impact = &unknown
+	if issues := doc.Validate(); !hasIssue(issues, "impact must be one of very_low") {
+		t.Errorf("Expected unknown impact error, got %v", issues)
+	}
+	doc.Impact = nil
+	if issues := doc.Validate(); !hasIssue(issues, "rated together") {
+		t.Errorf("Expected likelihood without impact error, got %v", issues)
+	}
+	if score := doc.Score(); score != 0 {
+		t.Errorf("Expected no score without impact, got %d", score)
+	}
+}
+
+func TestMETFields(t *testing.T) {
+	baseline, target := 10.0, 20.0
+	doc := NewMETDocument("MET-nps", "Net promoter score", "cfo")
+	doc.Formula = "promoters - detractors"
+	doc.Baseline, doc.Target = &baseline, &target
+
+	if issues := doc.Validate(); !hasIssue(issues, "unit is required") {
+		t.Errorf("Expected unit error, got %v", issues)
+	}
+	doc.Unit = "points"
+	if issues := doc.Validate(); hasIssue(issues, "unit") || hasIssue(issues, "baseline") {
+		t.Errorf("Expected no field issues, got %v", issues)
+	}
+	if progress, ok := doc.Progress(15); !ok || progress != 0.5 {
+		t.Errorf("Expected progress 0.5, got %v %t", progress, ok)
+	}
+}
+
+func TestOBJFields(t *testing.T) {
+	baseline, target, current := 100.0, 200.0, 175.0
+	doc := NewOBJDocument("OBJ-2025", "2025 objectives", "ceo")
+	doc.KeyResults = []KeyResult{
+		{Description: "Grow customers", Metric: "MET-customers", Baseline: &baseline, Target: &target, Current: &current},
+		{Metric: "customers"},
+	}
+
+	issues := doc.Validate()
+	for _, expected := range []string{"key_results[1].description is required", "key_results[1].target is required", "key_results[1].metric"} {
+		if !hasIssue(issues, expected) {
+			t.Errorf("Expected %q, got %v", expected, issues)
+		}
+	}
+	if hasIssue(issues, "key_results[0]") {
+		t.Errorf("Expected the first key result to be valid, got %v", issues)
+	}
+	if progress, ok := doc.Progress(); !ok || progress != 0.75 {
+		t.Errorf("Expected progress 0.75, got %v %t", progress, ok)
+	}
+}
+
+func TestBUDFields(t *testing.T) {
+	doc := NewBUDDocument("BUD-2025", "2025 budget", "cfo")
+	doc.LineItems = []BudgetLineItem{
+		{Category: "engineering", Amount: 1000},
+		{Category: "engineering", Amount: 500},
+		{Category: "marketing", Amount: 250},
+		{Amount: -10},
+	}
+
+	issues := doc.Validate()
+	for _, expected := range []string{"currency is required", "line_items[3].category is required", "line_items[3].amount must not be negative"} {
+		if !hasIssue(issues, expected) {
+			t.Errorf("Expected %q, got %v", expected, issues)
+		}
+	}
+	doc.Currency = "usd"
+	if issues := doc.Validate(); !hasIssue(issues, "ISO 4217") {
+		t.Errorf("Expected currency format error, got %v", issues)
+	}
+
+	if total := doc.Total(); total != 1740 {
+		t.Errorf("Expected total 1740, got %v", total)
+	}
+	if totals := doc.TotalByCategory(); totals["engineering"] != 1500 || totals["marketing"] != 250 {
+		t.Errorf("Unexpected totals by category: %v", totals)
+	}
+}
+
+func TestPERFields(t *testing.T) {
+	doc := NewPERDocument("PER-ops-lead", "Operations lead", "pm")
+	doc.Related = []string{"JTB-plan-shifts"}
+	doc.Demographics = &Demographics{AgeRange: "35-44", Role: "Operations lead"}
+	doc.Goals = []string{"Plan shifts in minutes"}
+	if issues := doc.Validate(); hasIssue(issues, "demographics") || hasIssue(issues, "goals") {
+		t.Errorf("Expected no field issues, got %v", issues)
+	}
+
+	for _, ageRange := range []string{"44-35", "thirties"} {
+		doc.Demographics.AgeRange = ageRange
+		if issues := doc.Validate(); !hasIssue(issues, "demographics.age_range") {
+			t.Errorf("Expected age range error for %q, got %v", ageRange, issues)
+		}
+	}
+	doc.Demographics.AgeRange = "65+"
+	doc.Goals = append(doc.Goals, " ")
+	issues := doc.Validate()
+	if hasIssue(issues, "demographics") || !hasIssue(issues, "goals[1] must not be empty") {
+		t.Errorf("Expected only the empty goal error, got %v", issues)
+	}
+}
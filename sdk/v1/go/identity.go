@@ -0,0 +1,67 @@
+package bspec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidateDocumentID checks that an ID starts with its document type code
+// followed by a dash and can be used in a file name
+func ValidateDocumentID(id string, docType DocumentType) error {
+	if id == "" {
+		return fmt.Errorf("id is required")
+	}
+	if !strings.HasPrefix(id, string(docType)+"-") || len(id) == len(docType)+1 {
+		return fmt.Errorf("id must start with document type '%s-'", docType)
+	}
+	if strings.ContainsAny(id, " \t\n/\\") {
+		return fmt.Errorf("id must not contain whitespace or path separators")
+	}
+	return nil
+}
+
+// DocumentID builds an ID of the form {TYPE}-{slug} from a title, e.g.
+// "RSK-vendor-lock-in" for an RSK document titled "Vendor lock-in"
+func DocumentID(docType DocumentType, title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		default:
+			dash = true
+		}
+	}
+	slug := b.String()
+	if slug == "" {
+		slug = "document"
+	}
+	return string(docType) + "-" + slug
+}
+
+// DocumentFileName returns the file name of a document version, e.g.
+// "RSK-vendor-lock-in-v1.0.0.md"
+func DocumentFileName(id, version string) string {
+	return fmt.Sprintf("%s-v%s.md", id, version)
+}
+
+// NewDocument creates a document of any type with defaults set and the
+// domain of its type
+func NewDocument(docType DocumentType, id, title, owner string) *BaseBSpecDocument {
+	doc := &BaseBSpecDocument{
+		ID:    id,
+		Title: title,
+		Type:  docType,
+		Owner: owner,
+	}
+	doc.SetDefaults()
+	if domain, ok := DomainForType(docType); ok {
+		doc.Domain = &domain
+	}
+	return doc
+}
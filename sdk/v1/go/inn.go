@@ -1,60 +0,0 @@
-// Package bspec provides INN document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.617831
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// INNDocument represents a Innovation (INN) document
-// Domain: growth
-//
-// Future product and service development pipeline
-type INNDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewINNDocument creates a new INN document with defaults
-func NewINNDocument(id, title, owner string) *INNDocument {
-	doc := &INNDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeINN,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainGrowth
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the INN document and returns any validation errors
-func (d *INNDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeINN {
-		errors = append(errors, "document type must be INN")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
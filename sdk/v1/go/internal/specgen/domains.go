@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+)
+
+// Domain is an entry of the SDK's domainCatalog
+type Domain struct {
+	Constant      string // BusinessDomain constant, e.g. "BusinessDomainRisk"
+	SpecDirectory string // Directory under spec/v1, e.g. "risk-governance"
+	DisplayName   string // e.g. "Risk & Governance"
+	Emoji         string
+}
+
+// ReadDomains reads the domainCatalog declared in the SDK source file path,
+// so that the generator and the SDK share one list of domains
+func ReadDomains(path string) ([]Domain, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			if len(value.Names) != 1 || value.Names[0].Name != "domainCatalog" || len(value.Values) != 1 {
+				continue
+			}
+			list, ok := value.Values[0].(*ast.CompositeLit)
+			if !ok {
+				return nil, fmt.Errorf("%s: domainCatalog is not a composite literal", path)
+			}
+			var domains []Domain
+			for _, elt := range list.Elts {
+				domain, err := parseDomain(elt)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", path, err)
+				}
+				domains = append(domains, domain)
+			}
+			return domains, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: domainCatalog not found", path)
+}
+
+// parseDomain reads a positional DomainInfo literal:
+// {Domain, Order, Folder, SpecDirectory, DisplayName, Emoji}
+func parseDomain(expr ast.Expr) (Domain, error) {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok || len(lit.Elts) != 6 {
+		return Domain{}, fmt.Errorf("domainCatalog entries must list the six DomainInfo fields in order")
+	}
+	constant, ok := lit.Elts[0].(*ast.Ident)
+	if !ok {
+		return Domain{}, fmt.Errorf("domainCatalog entries must start with a BusinessDomain constant")
+	}
+
+	var values []string
+	for _, elt := range lit.Elts[2:] {
+		basic, ok := elt.(*ast.BasicLit)
+		if !ok || basic.Kind != token.STRING {
+			return Domain{}, fmt.Errorf("domain %s: expected string literals", constant.Name)
+		}
+		value, err := strconv.Unquote(basic.Value)
+		if err != nil {
+			return Domain{}, fmt.Errorf("domain %s: %w", constant.Name, err)
+		}
+		values = append(values, value)
+	}
+	return Domain{
+		Constant:      constant.Name,
+		SpecDirectory: values[1],
+		DisplayName:   values[2],
+		Emoji:         values[3],
+	}, nil
+}
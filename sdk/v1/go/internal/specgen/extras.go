@@ -0,0 +1,167 @@
+package main
+
+import "strings"
+
+// Flag is a boolean frontmatter field that marks a group of document types
+type Flag struct {
+	Field   string // Go field name
+	Key     string // Frontmatter key
+	Comment string
+}
+
+// Rule requires a list field of the base document to contain an entry
+// starting with Prefix, or any entry if Prefix is empty
+type Rule struct {
+	Comment  string
+	Field    string // Go field name of a []string field of BaseBSpecDocument
+	Key      string // Frontmatter key of the field
+	Prefix   string
+	Code     string // Code constant of the issue
+	Severity string // Severity constant of the issue
+	Message  string
+	Fix      string
+}
+
+// ReferenceType returns the document type a rule expects the field to
+// reference, or "" if the rule does not require a type
+func (r Rule) ReferenceType() string {
+	return strings.TrimSuffix(r.Prefix, "-")
+}
+
+// References returns the rules of an extra that require a reference to a
+// document type
+func (e Extra) References() []Rule {
+	var rules []Rule
+	for _, rule := range e.Rules {
+		if rule.ReferenceType() != "" {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// Field is a typed frontmatter field of a document type. Its Go type is
+// declared in the SDK, and types with fields implement validateFields there.
+type Field struct {
+	Name    string // Go field name
+	Type    string // Go type
+	Key     string // Frontmatter key
+	Comment string
+}
+
+// Extra is what a typed document adds to the base document
+type Extra struct {
+	Flag   *Flag
+	Fields []Field
+	Rules  []Rule
+}
+
+// LegacyType is a document type of an earlier draft of the specification
+// that the SDK keeps for compatibility
+type LegacyType struct {
+	Code      string
+	Name      string
+	Domain    string // BusinessDomain constant
+	Summary   string
+	Successor string // Specification type that replaces it, if any
+}
+
+// ReadmeType is a document type that a conformance level of the README
+// requires but spec/v1 does not define
+type ReadmeType struct {
+	Code    string
+	Name    string
+	Domain  string // BusinessDomain constant
+	Summary string
+}
+
+var (
+	strategicFoundation = &Flag{"StrategicFoundation", "strategic_foundation", "Strategic foundation documents are required for all conformance levels"}
+	customerFocused     = &Flag{"CustomerFocused", "customer_focused", "Customer understanding documents require specific validation"}
+	businessModelCore   = &Flag{"BusinessModelCore", "business_model_core", "Business model documents are critical for financial validation"}
+	riskManagement      = &Flag{"RiskManagement", "risk_management", "Risk management documents require mitigation planning"}
+
+	successCriteriaRule = Rule{
+		Comment: "Strategic foundation documents require success criteria", Field: "SuccessCriteria", Key: "success_criteria",
+		Code: "CodeMissingSuccessCriteria", Severity: "SeverityError",
+		Message: "Strategic foundation documents must have success_criteria defined", Fix: "list measurable success_criteria",
+	}
+	relatedRule = Rule{
+		Comment: "Customer understanding documents should have related documents", Field: "Related", Key: "related",
+		Code: "CodeMissingRelated", Severity: "SeverityWarning",
+		Message: "Customer understanding documents should reference related personas or jobs-to-be-done", Fix: "add the related PER or JTB documents to related",
+	}
+	metricsRule = Rule{
+		Comment: "Business model documents require metrics", Field: "Metrics", Key: "metrics",
+		Code: "CodeMissingMetrics", Severity: "SeverityError",
+		Message: "Business model documents must have metrics defined for measurement", Fix: "list the MET documents that measure it in metrics",
+	}
+	mitigationRule = Rule{
+		Comment: "Risk management documents should reference each other", Field: "Related", Key: "related", Prefix: "MIT-",
+		Code: "CodeMissingMitigationReference", Severity: "SeverityWarning",
+		Message: "Risk documents should reference corresponding mitigation documents", Fix: "add the MIT documents that mitigate the risk to related",
+	}
+	riskRule = Rule{
+		Comment: "Risk management documents should reference each other", Field: "Related", Key: "related", Prefix: "RSK-",
+		Code: "CodeMissingRiskReference", Severity: "SeverityWarning",
+		Message: "Mitigation documents should reference corresponding risk documents", Fix: "add the RSK documents it mitigates to related",
+	}
+)
+
+// extras lists the fields and validation rules that the markdown does not
+// express, by document type code
+var extras = map[string]Extra{
+	"MSN": {Flag: strategicFoundation, Rules: []Rule{successCriteriaRule}},
+	"VSN": {Flag: strategicFoundation, Rules: []Rule{successCriteriaRule}},
+	"VAL": {Flag: strategicFoundation, Rules: []Rule{successCriteriaRule}},
+	"OBJ": {Fields: []Field{
+		{"KeyResults", "[]KeyResult", "key_results", "Measurable results of the objectives"},
+	}},
+	"PER": {Flag: customerFocused, Rules: []Rule{relatedRule}, Fields: []Field{
+		{"Demographics", "*Demographics", "demographics", "Who the persona is"},
+		{"Goals", "[]string", "goals", "What the persona wants to achieve"},
+	}},
+	"JTB": {Flag: customerFocused, Rules: []Rule{relatedRule}},
+	"CST": {Flag: businessModelCore, Rules: []Rule{metricsRule}},
+	"REV": {Flag: businessModelCore, Rules: []Rule{metricsRule}},
+	"MET": {Fields: []Field{
+		{"Formula", "string", "formula", "How the metric is calculated"},
+		{"Unit", "string", "unit", "Unit of the baseline and target, e.g. \"%\" or \"USD\""},
+		{"Baseline", "*float64", "baseline", "Value when measurement started"},
+		{"Target", "*float64", "target", "Value to reach"},
+	}},
+	"BUD": {Fields: []Field{
+		{"Currency", "string", "currency", "ISO 4217 currency code of the amounts"},
+		{"LineItems", "[]BudgetLineItem", "line_items", "Planned amounts by category"},
+	}},
+	"RSK": {Flag: riskManagement, Rules: []Rule{mitigationRule}, Fields: []Field{
+		{"Likelihood", "*RiskLevel", "likelihood", "How likely the risk is to occur"},
+		{"Impact", "*RiskLevel", "impact", "How severe the consequences are"},
+	}},
+	"MIT": {Flag: riskManagement, Rules: []Rule{riskRule}},
+}
+
+// readmeTypes lists the document types the README requires beyond spec/v1:
+// Bronze asks for "2+ RSK with MIT"
+var readmeTypes = []ReadmeType{
+	{"MIT", "Mitigations", "BusinessDomainRisk", "Risk response strategies and controls"},
+}
+
+// legacyTypes lists the document types of earlier drafts of the specification
+var legacyTypes = []LegacyType{
+	{"ACQ", "Acquisitions", "BusinessDomainGrowth", "M&A strategy and integration planning", ""},
+	{"ACT", "Key Activities", "BusinessDomainBusinessModel", "Essential processes for value creation", "KAC"},
+	{"BMC", "Business Model Canvas", "BusinessDomainBusinessModel", "Complete business model overview", ""},
+	{"CAC", "Customer Acquisition", "BusinessDomainBusinessModel", "Customer acquisition cost and strategies", ""},
+	{"GRW", "Growth Model", "BusinessDomainGrowth", "How the business scales customers and revenue", ""},
+	{"GTM", "Go-to-Market", "BusinessDomainGrowth", "Launch and customer acquisition strategy", ""},
+	{"GVN", "Governance", "BusinessDomainRisk", "Decision-making structure and oversight", "GOV"},
+	{"LTV", "Lifetime Value", "BusinessDomainBusinessModel", "Customer lifetime value models and drivers", ""},
+	{"PRC", "Processes", "BusinessDomainOperations", "Repeatable workflows that drive business outcomes", "PRO"},
+	{"PRT", "Key Partnerships", "BusinessDomainBusinessModel", "Strategic alliances and supplier relationships", "KPT"},
+	{"REL", "Customer Relationships", "BusinessDomainBusinessModel", "How relationships are built and maintained", ""},
+	{"RES", "Key Resources", "BusinessDomainBusinessModel", "Critical assets required for the business model", "KRS"},
+	{"SCL", "Scaling", "BusinessDomainGrowth", "Operational scaling approach and constraints", ""},
+	{"TOO", "Tools", "BusinessDomainOperations", "Software, systems, and operational tools", ""},
+	{"UNT", "Unit Economics", "BusinessDomainBusinessModel", "Per-customer or per-unit financial metrics", ""},
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// The JSON catalog has the shape of the SDK's BSpec struct, so that it can be
+// read with LoadFromJSON
+
+type catalogJSON struct {
+	Metadata      metadataJSON   `json:"metadata"`
+	Statistics    statisticsJSON `json:"statistics"`
+	Domains       []domainJSON   `json:"domains"`
+	DocumentTypes []typeJSON     `json:"document_types"`
+}
+
+type metadataJSON struct {
+	BSpecVersion string `json:"bspec_version"`
+	Generator    string `json:"generator"`
+	SourceSpec   string `json:"source_spec"`
+}
+
+type statisticsJSON struct {
+	TotalDocumentTypes int `json:"total_document_types"`
+	TotalDomains       int `json:"total_domains"`
+}
+
+type domainJSON struct {
+	Name          string   `json:"name"`
+	DisplayName   string   `json:"display_name"`
+	Emoji         string   `json:"emoji"`
+	DocumentTypes []string `json:"document_types"`
+	DocumentCount int      `json:"document_count"`
+}
+
+type typeJSON struct {
+	Code         string        `json:"code"`
+	Name         string        `json:"name"`
+	Purpose      string        `json:"purpose"`
+	Domain       string        `json:"domain"`
+	Dependencies []string      `json:"dependencies,omitempty"`
+	Enablements  []string      `json:"enablements,omitempty"`
+	Sections     []sectionJSON `json:"sections,omitempty"`
+	Checklist    []string      `json:"checklist,omitempty"`
+	Quality      []qualityJSON `json:"quality_standards,omitempty"`
+}
+
+type qualityJSON struct {
+	Level string   `json:"level"`
+	Title string   `json:"title"`
+	Items []string `json:"items"`
+}
+
+type sectionJSON struct {
+	Level int    `json:"level"`
+	Title string `json:"title"`
+}
+
+// jsonCatalog renders the specification types and domains as indented JSON
+func jsonCatalog(model Model, domains []Domain, version string) ([]byte, error) {
+	catalog := catalogJSON{
+		Metadata: metadataJSON{BSpecVersion: version, Generator: "specgen", SourceSpec: "spec/v1"},
+		Statistics: statisticsJSON{
+			TotalDocumentTypes: len(model.Specs),
+			TotalDomains:       len(domains),
+		},
+		Domains:       []domainJSON{},
+		DocumentTypes: []typeJSON{},
+	}
+
+	for _, domain := range domains {
+		entry := domainJSON{Name: domain.DisplayName, DisplayName: domain.DisplayName, Emoji: domain.Emoji, DocumentTypes: []string{}}
+		for _, spec := range model.Specs {
+			if spec.Domain == domain.DisplayName {
+				entry.DocumentTypes = append(entry.DocumentTypes, spec.Code)
+			}
+		}
+		entry.DocumentCount = len(entry.DocumentTypes)
+		catalog.Domains = append(catalog.Domains, entry)
+	}
+
+	for _, spec := range model.Specs {
+		entry := typeJSON{
+			Code:         spec.Code,
+			Name:         spec.Name,
+			Purpose:      spec.Purpose,
+			Domain:       spec.Domain,
+			Dependencies: spec.Dependencies,
+			Enablements:  spec.Enablements,
+			Checklist:    spec.Checklist,
+		}
+		for _, section := range spec.Sections {
+			entry.Sections = append(entry.Sections, sectionJSON{Level: section.Level, Title: section.Title})
+		}
+		for _, quality := range spec.Quality {
+			entry.Quality = append(entry.Quality, qualityJSON{Level: quality.Level, Title: quality.Title, Items: quality.Items})
+		}
+		catalog.DocumentTypes = append(catalog.DocumentTypes, entry)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(catalog); err != nil {
+		return nil, fmt.Errorf("failed to encode JSON catalog: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,110 @@
+// Command specgen generates SDK sources from the spec/v1 markdown files: the
+// document type constants and their domains, the typed documents with their
+// validation rules, the catalog, in Go and as JSON, and the writing standards.
+//
+// It is run through go generate from the SDK root:
+//
+//	go generate ./...
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/template"
+)
+
+// sources are the generated Go files and their templates
+var sources = map[string]*template.Template{
+	"catalog_gen.go":   catalogTemplate,
+	"types_gen.go":     typesTemplate,
+	"documents_gen.go": documentsTemplate,
+	"writing_gen.go":   writingTemplate,
+}
+
+func main() {
+	specDir := flag.String("spec", "../../../spec/v1", "Directory containing the spec markdown files")
+	domains := flag.String("domains", "domains.go", "SDK source file declaring domainCatalog")
+	dir := flag.String("dir", ".", "Output directory of the Go files")
+	jsonOut := flag.String("json", "", "Output file of the JSON catalog")
+	flag.Parse()
+
+	if err := run(*specDir, *domains, *dir, *jsonOut); err != nil {
+		fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(specDir, domainsPath, dir, jsonOut string) error {
+	files, catalog, err := generate(specDir, domainsPath)
+	if err != nil {
+		return err
+	}
+	for _, name := range sortedNames(files) {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, files[name], 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+	if jsonOut != "" {
+		if err := os.WriteFile(jsonOut, catalog, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", jsonOut, err)
+		}
+	}
+	return nil
+}
+
+// generate renders the Go files, by name, and the JSON catalog
+func generate(specDir, domainsPath string) (map[string][]byte, []byte, error) {
+	specs, err := ParseSpecDirectory(specDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	domains, err := ReadDomains(domainsPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	model, err := NewModel(specs, domains)
+	if err != nil {
+		return nil, nil, err
+	}
+	if model.Standards, err = ParseStandardsFile(filepath.Join(specDir, StandardsFile)); err != nil {
+		return nil, nil, err
+	}
+
+	files := make(map[string][]byte)
+	for name, tmpl := range sources {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, model); err != nil {
+			return nil, nil, fmt.Errorf("failed to render %s: %w", name, err)
+		}
+		source, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to format %s: %w", name, err)
+		}
+		files[name] = source
+	}
+
+	version, err := os.ReadFile(filepath.Join(specDir, "version.txt"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read spec version: %w", err)
+	}
+	catalog, err := jsonCatalog(model, domains, string(bytes.TrimSpace(version)))
+	if err != nil {
+		return nil, nil, err
+	}
+	return files, catalog, nil
+}
+
+func sortedNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// sdkDir is the SDK root, where go generate writes the Go files
+const sdkDir = "../.."
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	files, catalog, err := generate(filepath.Join(sdkDir, "../../../spec/v1"), filepath.Join(sdkDir, "domains.go"))
+	if err != nil {
+		t.Fatalf("Failed to generate: %v", err)
+	}
+
+	files["../json/catalog.json"] = catalog
+	for name, generated := range files {
+		committed, err := os.ReadFile(filepath.Join(sdkDir, name))
+		if err != nil {
+			t.Errorf("Failed to read %s: %v; run go generate", name, err)
+			continue
+		}
+		if !bytes.Equal(committed, generated) {
+			t.Errorf("%s is out of date; run go generate", name)
+		}
+	}
+}
+
+func TestGenerateDeterministic(t *testing.T) {
+	specDir := filepath.Join(sdkDir, "../../../spec/v1")
+	domains := filepath.Join(sdkDir, "domains.go")
+	first, firstCatalog, err := generate(specDir, domains)
+	if err != nil {
+		t.Fatalf("Failed to generate: %v", err)
+	}
+	second, secondCatalog, err := generate(specDir, domains)
+	if err != nil {
+		t.Fatalf("Failed to generate: %v", err)
+	}
+	for name := range first {
+		if !bytes.Equal(first[name], second[name]) {
+			t.Errorf("%s differs between runs", name)
+		}
+	}
+	if !bytes.Equal(firstCatalog, secondCatalog) {
+		t.Error("JSON catalog differs between runs")
+	}
+}
+
+func TestParseSpecFileChecklist(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "risk-governance")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "RSK-spec.md")
+	content := strings.Join([]string{
+		"# RSK: Risks Specification",
+		"",
+		"**Document Type Code:** RSK",
+		"**Document Type Name:** Risks",
+		"**Domain:** Risk & Governance",
+		"",
+		"## Purpose and Scope",
+		"",
+		"Risks are tracked.",
+		"",
+		"## Relationship Guidelines",
+		"",
+		"### Typical Dependencies",
+		"- **STR** (Strategy): context",
+		"",
+		"## Quality Standards",
+		"",
+		"### Bronze Level (Minimum Viable)",
+		"- [ ] Risk register",
+		"### Internal Quality Standards",
+		"- [ ] Not a level",
+		"",
+		"## Validation Checklist",
+		"",
+		"- [ ] Risk register with owners",
+		"- [x] Mitigation plans",
+		"- Not a checklist item",
+	}, "\n")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	spec, err := ParseSpecFile(path)
+	if err != nil {
+		t.Fatalf("ParseSpecFile failed: %v", err)
+	}
+	if spec.Code != "RSK" || spec.Name != "Risks" || spec.Domain != "Risk & Governance" {
+		t.Errorf("Unexpected header: %+v", spec)
+	}
+	if len(spec.Dependencies) != 1 || spec.Dependencies[0] != "STR" {
+		t.Errorf("Expected dependency STR, got %v", spec.Dependencies)
+	}
+	if len(spec.Checklist) != 2 || spec.Checklist[0] != "Risk register with owners" || spec.Checklist[1] != "Mitigation plans" {
+		t.Errorf("Unexpected checklist: %v", spec.Checklist)
+	}
+	if len(spec.Quality) != 1 || spec.Quality[0].Level != "bronze" || spec.Quality[0].Title != "Bronze Level (Minimum Viable)" || len(spec.Quality[0].Items) != 1 {
+		t.Errorf("Unexpected quality standards: %+v", spec.Quality)
+	}
+}
+
+func TestNewModelRejectsUnknownDomain(t *testing.T) {
+	specs := []TypeSpec{{Code: "XYZ", Name: "Unknown", Domain: "Nowhere", SpecDirectory: "nowhere"}}
+	domains := []Domain{{Constant: "BusinessDomainRisk", SpecDirectory: "risk-governance", DisplayName: "Risk & Governance"}}
+	if _, err := NewModel(specs, domains); err == nil || !strings.Contains(err.Error(), "not in domainCatalog") {
+		t.Errorf("Expected unknown directory error, got %v", err)
+	}
+}
+
+func TestParseStandardsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StandardsFile)
+	content := strings.Join([]string{
+		"## Document Structure Standards",
+		"",
+		"### Required Sections (in order)",
+		"",
+		"1. **Document Header**",
+		"   ```markdown",
+		"   # {CODE}: {Full Name} Specification",
+		"   **Document Type Code:** {CODE}",
+		"   **Status:** Draft",
+		"   ```",
+		"",
+		"2. **Abstract** (NEW - add to all specs)",
+		"   ```markdown",
+		"   ## Abstract",
+		"   ```",
+		"",
+		"3. **Purpose and Scope**",
+		"",
+		"### 2. Normative Language (RFC 2119 Style)",
+		"- **MUST/SHALL**: Absolute requirement",
+		"- **MAY/OPTIONAL**: Truly optional",
+		"",
+		"### Professional Vocabulary",
+		"",
+		"| Instead Of | Use |",
+		"|------------|-----|",
+		`| "make sure" | "ensure", "verify" |`,
+		"",
+		"### Sentence Structure",
+		"- **Length**: 10-20 words per sentence average",
+		"",
+		"### Completeness Requirements",
+		`- No placeholder text (e.g., "TBD")`,
+	}, "\n")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	standards, err := ParseStandardsFile(path)
+	if err != nil {
+		t.Fatalf("ParseStandardsFile failed: %v", err)
+	}
+	if standards.Title != "# {CODE}: {Full Name} Specification" || strings.Join(standards.HeaderFields, ",") != "Document Type Code,Status" {
+		t.Errorf("Unexpected header: %q %v", standards.Title, standards.HeaderFields)
+	}
+	if strings.Join(standards.Sections, ",") != "Abstract,Purpose and Scope" {
+		t.Errorf("Unexpected sections: %v", standards.Sections)
+	}
+	if strings.Join(standards.NormativeKeywords, ",") != "MUST,SHALL,MAY,OPTIONAL" {
+		t.Errorf("Unexpected keywords: %v", standards.NormativeKeywords)
+	}
+	if len(standards.Vocabulary) != 1 || standards.Vocabulary[0].Avoid != "make sure" || len(standards.Vocabulary[0].Use) != 2 {
+		t.Errorf("Unexpected vocabulary: %+v", standards.Vocabulary)
+	}
+	if standards.MinSentenceWords != 10 || standards.MaxSentenceWords != 20 || len(standards.Placeholders) != 1 {
+		t.Errorf("Unexpected sentence length or placeholders: %+v", standards)
+	}
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Model is the input of the templates
+type Model struct {
+	Specs  []TypeSpec  // Specification types, sorted by code
+	Types  []TypeModel // Specification types, in domain order and by code
+	Readme []TypeModel // Types the README requires beyond the specification, by code
+	Legacy []TypeModel // Legacy types, by code
+
+	Standards Standards // Writing standards
+}
+
+// TypeModel is a document type the SDK declares
+type TypeModel struct {
+	Code        string
+	Doc         string // Doc comment of the typed document
+	Domain      Domain
+	DomainStart bool   // First type of its domain
+	Successor   string // Specification type that replaces a legacy type
+	Name        string // Name of a type the specification does not define
+	Summary     string // Summary of a type the specification does not define
+	Extra
+}
+
+// All returns the specification types followed by the README and legacy
+// types
+func (m Model) All() []TypeModel {
+	return append(append(append([]TypeModel{}, m.Types...), m.Readme...), m.Legacy...)
+}
+
+// NewModel resolves the domains of the specification, README and legacy
+// types and
+// checks that the generator tables agree with the specification
+func NewModel(specs []TypeSpec, domains []Domain) (Model, error) {
+	model := Model{Specs: specs}
+
+	order := make(map[string]int, len(domains))
+	byDirectory := make(map[string]Domain, len(domains))
+	byConstant := make(map[string]Domain, len(domains))
+	for i, domain := range domains {
+		order[domain.Constant] = i
+		byDirectory[domain.SpecDirectory] = domain
+		byConstant[domain.Constant] = domain
+	}
+
+	codes := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		domain, ok := byDirectory[spec.SpecDirectory]
+		if !ok {
+			return Model{}, fmt.Errorf("%s: directory %s is not in domainCatalog", spec.Code, spec.SpecDirectory)
+		}
+		if spec.Domain != domain.DisplayName {
+			return Model{}, fmt.Errorf("%s: domain %q does not match directory %s (%s)", spec.Code, spec.Domain, spec.SpecDirectory, domain.DisplayName)
+		}
+		codes[spec.Code] = true
+
+		summary := firstSentence(spec.Purpose)
+		model.Types = append(model.Types, TypeModel{
+			Code:   spec.Code,
+			Doc:    comment(fmt.Sprintf("%sDocument is a typed %s (%s) document of the %s domain", spec.Code, spec.Name, spec.Code, domain.DisplayName), summary),
+			Domain: domain,
+			Extra:  extras[spec.Code],
+		})
+	}
+	sort.SliceStable(model.Types, func(i, j int) bool {
+		a, b := model.Types[i], model.Types[j]
+		if a.Domain.Constant != b.Domain.Constant {
+			return order[a.Domain.Constant] < order[b.Domain.Constant]
+		}
+		return a.Code < b.Code
+	})
+	for i := range model.Types {
+		model.Types[i].DomainStart = i == 0 || model.Types[i].Domain.Constant != model.Types[i-1].Domain.Constant
+	}
+
+	for _, readme := range readmeTypes {
+		if codes[readme.Code] {
+			return Model{}, fmt.Errorf("README type %s is defined by the specification", readme.Code)
+		}
+		domain, ok := byConstant[readme.Domain]
+		if !ok {
+			return Model{}, fmt.Errorf("README type %s: unknown domain %s", readme.Code, readme.Domain)
+		}
+		codes[readme.Code] = true
+
+		model.Readme = append(model.Readme, TypeModel{
+			Code:    readme.Code,
+			Doc:     comment(fmt.Sprintf("%sDocument is a typed %s (%s) document of the %s domain", readme.Code, readme.Name, readme.Code, domain.DisplayName), sentence(readme.Summary), "The conformance levels of the README require it; spec/v1 does not define it."),
+			Domain:  domain,
+			Name:    readme.Name,
+			Summary: sentence(readme.Summary),
+			Extra:   extras[readme.Code],
+		})
+	}
+	sort.Slice(model.Readme, func(i, j int) bool { return model.Readme[i].Code < model.Readme[j].Code })
+
+	for _, legacy := range legacyTypes {
+		if codes[legacy.Code] {
+			return Model{}, fmt.Errorf("legacy type %s is defined by the specification", legacy.Code)
+		}
+		domain, ok := byConstant[legacy.Domain]
+		if !ok {
+			return Model{}, fmt.Errorf("legacy type %s: unknown domain %s", legacy.Code, legacy.Domain)
+		}
+		deprecated := fmt.Sprintf("Deprecated: %s is not defined by the specification.", legacy.Code)
+		if legacy.Successor != "" {
+			if !codes[legacy.Successor] {
+				return Model{}, fmt.Errorf("legacy type %s: successor %s is not defined by the specification", legacy.Code, legacy.Successor)
+			}
+			deprecated = fmt.Sprintf("Deprecated: %s is not defined by the specification; use %sDocument.", legacy.Code, legacy.Successor)
+		}
+		codes[legacy.Code] = true
+
+		model.Legacy = append(model.Legacy, TypeModel{
+			Code:      legacy.Code,
+			Doc:       comment(fmt.Sprintf("%sDocument is a typed %s (%s) document of the %s domain", legacy.Code, legacy.Name, legacy.Code, domain.DisplayName), sentence(legacy.Summary), deprecated),
+			Domain:    domain,
+			Successor: legacy.Successor,
+			Name:      legacy.Name,
+			Summary:   sentence(legacy.Summary),
+			Extra:     extras[legacy.Code],
+		})
+	}
+	sort.Slice(model.Legacy, func(i, j int) bool { return model.Legacy[i].Code < model.Legacy[j].Code })
+
+	for code := range extras {
+		if !codes[code] {
+			return Model{}, fmt.Errorf("extras: unknown document type %s", code)
+		}
+	}
+	return model, nil
+}
+
+// firstSentence returns the first sentence of a text, ending with a period
+func firstSentence(text string) string {
+	if i := strings.Index(text, ". "); i >= 0 {
+		return text[:i+1]
+	}
+	return sentence(text)
+}
+
+// sentence ends a text with a period, so that gofmt does not take a one-line
+// paragraph for a heading
+func sentence(text string) string {
+	if text == "" || strings.HasSuffix(text, ".") {
+		return text
+	}
+	return text + "."
+}
+
+// comment renders paragraphs as a doc comment wrapped at 80 columns
+func comment(paragraphs ...string) string {
+	var lines []string
+	for _, paragraph := range paragraphs {
+		if paragraph == "" {
+			continue
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "//")
+		}
+		line := "//"
+		for _, word := range strings.Fields(paragraph) {
+			if len(line)+1+len(word) > 80 && line != "//" {
+				lines = append(lines, line)
+				line = "//"
+			}
+			line += " " + word
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,315 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// TypeSpec is the information read from one *-spec.md file
+type TypeSpec struct {
+	Code          string
+	Name          string
+	Domain        string // Domain display name, e.g. "Risk & Governance"
+	SpecDirectory string // Directory under spec/v1, e.g. "risk-governance"
+	Purpose       string
+	Dependencies  []string
+	Enablements   []string
+	Sections      []Section // Section headings of the content template
+	Checklist     []string  // Items of the validation checklist
+	Quality       []Quality // Quality Standards checklists, by level
+}
+
+// Quality is the Quality Standards checklist of a conformance level
+type Quality struct {
+	Level string // e.g. "bronze"
+	Title string // e.g. "Bronze Level (Minimum Viable)"
+	Items []string
+}
+
+// Section is a heading of the content template
+type Section struct {
+	Level int
+	Title string
+}
+
+// specSections are the sections of a spec file itself, as opposed to the
+// sections of the document it specifies
+var specSections = map[string]bool{
+	"Abstract":                   true,
+	"Framework and Attribution":  true,
+	"Purpose and Scope":          true,
+	"Document Metadata Schema":   true,
+	"Content Structure Template": true,
+	"Validation":                 true,
+	"Quality Standards":          true,
+	"Common Pitfalls":            true,
+	"Success Patterns":           true,
+	"Relationship Guidelines":    true,
+	"Document Relationships":     true,
+	"Validation Checklist":       true,
+}
+
+// qualityLevels are the conformance levels of the Quality Standards
+// subsections, which start with the level name
+var qualityLevels = map[string]bool{"bronze": true, "silver": true, "gold": true}
+
+// templateEnd lists the spec sections that follow the content template
+var templateEnd = map[string]bool{
+	"Quality Standards":    true,
+	"Validation Checklist": true,
+}
+
+var (
+	sectionPattern   = regexp.MustCompile(`^(#{2,3})\s+(.+?)\s*$`)
+	headerPattern    = regexp.MustCompile(`^\*\*([^*]+):\*\*\s*(.*)$`)
+	titlePattern     = regexp.MustCompile(`^#\s+([A-Z]{3}):\s*(.*?)\s*(Document Type )?Specification\s*$`)
+	guidelinePattern = regexp.MustCompile(`^[-*]\s+\*\*([A-Z]{3})\b`)
+	summaryPattern   = regexp.MustCompile(`^[-*]\s+\*\*(Depends on|Enables)\*\*:\s*(.*)$`)
+	codePattern      = regexp.MustCompile(`\b([A-Z]{3})\b`)
+	checklistPattern = regexp.MustCompile(`^[-*]\s+\[[ xX]\]\s+(.+)$`)
+)
+
+// ParseSpecDirectory reads every */*-spec.md file below dir, sorted by code
+func ParseSpecDirectory(dir string) ([]TypeSpec, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*", "*-spec.md"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list spec files: %w", err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no *-spec.md files found in %s", dir)
+	}
+
+	var specs []TypeSpec
+	seen := make(map[string]string)
+	for _, path := range paths {
+		spec, err := ParseSpecFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := seen[spec.Code]; ok {
+			return nil, fmt.Errorf("document type %s is defined in both %s and %s", spec.Code, other, path)
+		}
+		seen[spec.Code] = path
+		specs = append(specs, spec)
+	}
+
+	sort.Slice(specs, func(i, j int) bool { return specs[i].Code < specs[j].Code })
+	return specs, nil
+}
+
+// ParseSpecFile reads the header, purpose, relationship guidelines, quality
+// standards and validation checklist of a spec file
+func ParseSpecFile(path string) (TypeSpec, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return TypeSpec{}, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	spec := TypeSpec{SpecDirectory: filepath.Base(filepath.Dir(path))}
+
+	var (
+		section    string // Current "## " section
+		subsection string // Current "### " section
+		inHeader   = true
+		purpose    paragraph
+		abstract   paragraph
+
+		// Relationships from the guideline and summary sections
+		dependencies, enablements               []string
+		summaryDependencies, summaryEnablements []string
+		checklist                               []string
+		quality                                 []Quality
+
+		// Content template; specs without one describe the document's
+		// sections as their own sections
+		inTemplate, templateOpened, templateFence bool
+		templateSections, ownSections             []Section
+	)
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t")
+		trimmed := strings.TrimSpace(line)
+
+		if inTemplate {
+			if strings.HasPrefix(line, "## ") && templateEnd[strings.TrimSpace(strings.TrimPrefix(line, "## "))] {
+				inTemplate = false
+			} else {
+				switch {
+				case strings.HasPrefix(trimmed, "```") && !templateOpened:
+					templateOpened = true
+				case strings.HasPrefix(trimmed, "```"):
+					templateFence = !templateFence
+				case !templateFence:
+					if match := sectionPattern.FindStringSubmatch(line); match != nil {
+						templateSections = append(templateSections, Section{Level: len(match[1]), Title: match[2]})
+					}
+				}
+				continue
+			}
+		}
+
+		switch {
+		case strings.HasPrefix(line, "# "):
+			if match := titlePattern.FindStringSubmatch(line); match != nil && spec.Code == "" {
+				spec.Code, spec.Name = match[1], match[2]
+			}
+			continue
+		case strings.HasPrefix(line, "## "):
+			inHeader = false
+			section = strings.TrimSpace(strings.TrimPrefix(line, "## "))
+			subsection = ""
+			// Content templates repeat these headings inside code fences, which
+			// are not reliably closed; the real section comes last and wins
+			switch section {
+			case "Relationship Guidelines":
+				dependencies, enablements = nil, nil
+			case "Document Relationships":
+				summaryDependencies, summaryEnablements = nil, nil
+			case "Validation Checklist":
+				checklist = nil
+			case "Quality Standards":
+				quality = nil
+			case "Content Structure Template":
+				inTemplate, templateOpened, templateFence = true, false, false
+				templateSections = nil
+			}
+			if !specSections[section] {
+				ownSections = append(ownSections, Section{Level: 2, Title: section})
+			}
+			continue
+		case strings.HasPrefix(line, "### "):
+			subsection = strings.TrimSpace(strings.TrimPrefix(line, "### "))
+			if !specSections[section] {
+				ownSections = append(ownSections, Section{Level: 3, Title: subsection})
+			}
+			continue
+		}
+
+		if inHeader {
+			if match := headerPattern.FindStringSubmatch(trimmed); match != nil {
+				switch match[1] {
+				case "Document Type Code":
+					spec.Code = strings.TrimSpace(match[2])
+				case "Document Type Name":
+					spec.Name = strings.TrimSpace(match[2])
+				case "Domain":
+					spec.Domain = strings.TrimSpace(match[2])
+				}
+			}
+			continue
+		}
+
+		switch section {
+		case "Purpose and Scope":
+			purpose.add(trimmed)
+		case "Abstract":
+			abstract.add(trimmed)
+		case "Relationship Guidelines":
+			match := guidelinePattern.FindStringSubmatch(trimmed)
+			if match == nil {
+				continue
+			}
+			switch subsection {
+			case "Typical Dependencies":
+				dependencies = append(dependencies, match[1])
+			case "Typical Enablements":
+				enablements = append(enablements, match[1])
+			}
+		case "Document Relationships":
+			match := summaryPattern.FindStringSubmatch(trimmed)
+			if match == nil {
+				continue
+			}
+			for _, code := range codePattern.FindAllStringSubmatch(match[2], -1) {
+				if match[1] == "Depends on" {
+					summaryDependencies = append(summaryDependencies, code[1])
+				} else {
+					summaryEnablements = append(summaryEnablements, code[1])
+				}
+			}
+		case "Validation Checklist":
+			if match := checklistPattern.FindStringSubmatch(trimmed); match != nil {
+				checklist = append(checklist, match[1])
+			}
+		case "Quality Standards":
+			match := checklistPattern.FindStringSubmatch(trimmed)
+			level := strings.ToLower(strings.SplitN(subsection, " ", 2)[0])
+			if match == nil || !qualityLevels[level] {
+				continue
+			}
+			if len(quality) == 0 || quality[len(quality)-1].Title != subsection {
+				quality = append(quality, Quality{Level: level, Title: subsection})
+			}
+			quality[len(quality)-1].Items = append(quality[len(quality)-1].Items, match[1])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return TypeSpec{}, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	if spec.Code == "" {
+		return TypeSpec{}, fmt.Errorf("%s: missing document type code", path)
+	}
+	if spec.Domain == "" {
+		return TypeSpec{}, fmt.Errorf("%s: missing domain", path)
+	}
+
+	spec.Dependencies = uniqueCodes(spec.Code, dependencies, summaryDependencies)
+	spec.Enablements = uniqueCodes(spec.Code, enablements, summaryEnablements)
+	spec.Checklist = checklist
+	spec.Quality = quality
+	spec.Sections = templateSections
+	if len(spec.Sections) == 0 {
+		spec.Sections = ownSections
+	}
+	spec.Purpose = purpose.String()
+	if spec.Purpose == "" {
+		spec.Purpose = abstract.String()
+	}
+	return spec, nil
+}
+
+// paragraph collects the first paragraph of a section
+type paragraph struct {
+	lines []string
+	done  bool
+}
+
+// add appends a line until the first blank line after some text
+func (p *paragraph) add(line string) {
+	switch {
+	case p.done:
+	case line == "":
+		p.done = len(p.lines) > 0
+	default:
+		p.lines = append(p.lines, line)
+	}
+}
+
+func (p *paragraph) String() string {
+	return strings.Join(p.lines, " ")
+}
+
+// uniqueCodes merges lists of document type codes in order, dropping
+// duplicates and the type itself
+func uniqueCodes(self string, lists ...[]string) []string {
+	var codes []string
+	seen := map[string]bool{self: true}
+	for _, list := range lists {
+		for _, code := range list {
+			if !seen[code] {
+				seen[code] = true
+				codes = append(codes, code)
+			}
+		}
+	}
+	return codes
+}
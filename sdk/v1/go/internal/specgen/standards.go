@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// StandardsFile is the writing standards file in the spec directory
+const StandardsFile = "WRITING_STANDARDS.md"
+
+// Standards is the information read from the writing standards
+type Standards struct {
+	Title             string
+	HeaderFields      []string
+	Sections          []string
+	OptionalSections  []string
+	NormativeKeywords []string
+	Vocabulary        []Vocabulary
+	MinSentenceWords  int
+	MaxSentenceWords  int
+	Placeholders      []string
+}
+
+// Vocabulary is a row of the professional vocabulary table
+type Vocabulary struct {
+	Avoid string
+	Use   []string
+}
+
+// headerSection is the required section that describes the header rather
+// than a "##" section
+const headerSection = "Document Header"
+
+var (
+	numberedPattern = regexp.MustCompile(`^\d+\.\s+\*\*(.+?)\*\*`)
+	keywordPattern  = regexp.MustCompile(`^[-*]\s+\*\*([A-Z /]+)\*\*:`)
+	vocabPattern    = regexp.MustCompile(`^\|\s*"([^"]+)"\s*\|(.*)\|$`)
+	quotedPattern   = regexp.MustCompile(`"([^"]+)"`)
+	sentencePattern = regexp.MustCompile(`(\d+)-(\d+) words per sentence`)
+	fieldPattern    = regexp.MustCompile(`^\*\*([^*]+):\*\*`)
+	placeholderLine = regexp.MustCompile(`(?i)placeholder text`)
+)
+
+// ParseStandardsFile reads the required sections, header, normative
+// keywords, vocabulary, sentence length and placeholders of the writing
+// standards
+func ParseStandardsFile(path string) (Standards, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Standards{}, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var (
+		standards  Standards
+		subsection string // Current "### " section
+		item       string // Current numbered item
+	)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		// Headings of the examples are indented under their list item
+		if strings.HasPrefix(line, "## ") || strings.HasPrefix(line, "### ") {
+			subsection = strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
+			item = ""
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(subsection, "Required Sections"):
+			if match := numberedPattern.FindStringSubmatch(trimmed); match != nil {
+				item = match[1]
+				if item != headerSection {
+					standards.Sections = append(standards.Sections, item)
+				}
+			} else if item == headerSection {
+				if match := fieldPattern.FindStringSubmatch(trimmed); match != nil {
+					standards.HeaderFields = append(standards.HeaderFields, match[1])
+				} else if strings.HasPrefix(trimmed, "# ") {
+					standards.Title = trimmed
+				}
+			}
+		case strings.HasPrefix(subsection, "Optional but Recommended Sections"):
+			if match := numberedPattern.FindStringSubmatch(trimmed); match != nil {
+				standards.OptionalSections = append(standards.OptionalSections, match[1])
+			}
+		case strings.Contains(subsection, "Normative Language"):
+			if match := keywordPattern.FindStringSubmatch(trimmed); match != nil {
+				for _, keyword := range strings.Split(match[1], "/") {
+					standards.NormativeKeywords = append(standards.NormativeKeywords, strings.TrimSpace(keyword))
+				}
+			}
+		case subsection == "Professional Vocabulary":
+			if match := vocabPattern.FindStringSubmatch(trimmed); match != nil {
+				rule := Vocabulary{Avoid: match[1]}
+				for _, use := range quotedPattern.FindAllStringSubmatch(match[2], -1) {
+					rule.Use = append(rule.Use, use[1])
+				}
+				standards.Vocabulary = append(standards.Vocabulary, rule)
+			}
+		case subsection == "Sentence Structure":
+			if match := sentencePattern.FindStringSubmatch(trimmed); match != nil {
+				standards.MinSentenceWords, _ = strconv.Atoi(match[1])
+				standards.MaxSentenceWords, _ = strconv.Atoi(match[2])
+			}
+		case subsection == "Completeness Requirements":
+			if placeholderLine.MatchString(trimmed) {
+				for _, placeholder := range quotedPattern.FindAllStringSubmatch(trimmed, -1) {
+					standards.Placeholders = append(standards.Placeholders, placeholder[1])
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Standards{}, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	for _, check := range []struct {
+		name    string
+		missing bool
+	}{
+		{"title", standards.Title == ""},
+		{"header fields", len(standards.HeaderFields) == 0},
+		{"required sections", len(standards.Sections) == 0},
+		{"normative keywords", len(standards.NormativeKeywords) == 0},
+		{"vocabulary", len(standards.Vocabulary) == 0},
+		{"sentence length", standards.MaxSentenceWords == 0},
+		{"placeholders", len(standards.Placeholders) == 0},
+	} {
+		if check.missing {
+			return Standards{}, fmt.Errorf("%s: no %s found", path, check.name)
+		}
+	}
+	return standards, nil
+}
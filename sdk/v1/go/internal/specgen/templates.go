@@ -0,0 +1,238 @@
+package main
+
+import "text/template"
+
+var catalogTemplate = template.Must(template.New("catalog").Parse(`// Code generated by specgen from spec/v1; DO NOT EDIT.
+
+package bspec
+
+// catalogDocumentTypes lists the document types defined in spec/v1
+var catalogDocumentTypes = []DocumentTypeInfo{
+{{- range .Specs }}
+	{
+		Code:    {{ printf "%q" .Code }},
+		Name:    {{ printf "%q" .Name }},
+		Purpose: {{ printf "%q" .Purpose }},
+		Domain:  {{ printf "%q" .Domain }},
+		{{- if .Dependencies }}
+		Dependencies: []string{ {{- range $i, $code := .Dependencies }}{{ if $i }}, {{ end }}{{ printf "%q" $code }}{{ end -}} },
+		{{- end }}
+		{{- if .Enablements }}
+		Enablements: []string{ {{- range $i, $code := .Enablements }}{{ if $i }}, {{ end }}{{ printf "%q" $code }}{{ end -}} },
+		{{- end }}
+		{{- if .Sections }}
+		Sections: []SectionTemplate{
+		{{- range .Sections }}
+			{Level: {{ .Level }}, Title: {{ printf "%q" .Title }}},
+		{{- end }}
+		},
+		{{- end }}
+		{{- if .Checklist }}
+		Checklist: []string{
+		{{- range .Checklist }}
+			{{ printf "%q" . }},
+		{{- end }}
+		},
+		{{- end }}
+		{{- if .Quality }}
+		QualityStandards: []QualityStandard{
+		{{- range .Quality }}
+			{Level: {{ printf "%q" .Level }}, Title: {{ printf "%q" .Title }}, Items: []string{
+			{{- range .Items }}
+				{{ printf "%q" . }},
+			{{- end }}
+			}},
+		{{- end }}
+		},
+		{{- end }}
+	},
+{{- end }}
+}
+
+// readmeDocumentTypes lists the document types that the conformance levels of
+// the README require and spec/v1 does not define, without the template of a
+// specification
+var readmeDocumentTypes = []DocumentTypeInfo{
+{{- range .Readme }}
+	{Code: {{ printf "%q" .Code }}, Name: {{ printf "%q" .Name }}, Purpose: {{ printf "%q" .Summary }}, Domain: {{ printf "%q" .Domain.DisplayName }}},
+{{- end }}
+}
+`))
+
+var typesTemplate = template.Must(template.New("types").Parse(`// Code generated by specgen from spec/v1; DO NOT EDIT.
+
+package bspec
+
+import "fmt"
+
+// Document types defined by the specification
+const (
+{{- range $i, $type := .Types }}
+{{- if $type.DomainStart }}
+{{- if $i }}
+{{ end }}
+	// {{ $type.Domain.DisplayName }}
+{{- end }}
+	DocumentType{{ $type.Code }} DocumentType = {{ printf "%q" $type.Code }}
+{{- end }}
+)
+
+// Document types that the README requires and spec/v1 does not define
+const (
+{{- range .Readme }}
+	DocumentType{{ .Code }} DocumentType = {{ printf "%q" .Code }}
+{{- end }}
+)
+
+// Document types of earlier drafts of the specification
+const (
+{{- range .Legacy }}
+	DocumentType{{ .Code }} DocumentType = {{ printf "%q" .Code }}
+{{- end }}
+)
+
+// documentTypeDomains maps the document types to their business domain
+var documentTypeDomains = map[DocumentType]BusinessDomain{
+{{- range .All }}
+	DocumentType{{ .Code }}: {{ .Domain.Constant }},
+{{- end }}
+}
+
+// legacyDocumentTypes lists the legacy document types with their successor
+// in the specification where there is one
+var legacyDocumentTypes = map[DocumentType]DocumentType{
+{{- range .Legacy }}
+	DocumentType{{ .Code }}: {{ if .Successor }}DocumentType{{ .Successor }}{{ else }}""{{ end }},
+{{- end }}
+}
+
+// legacyCatalog describes the legacy document types, without the template
+// of a specification
+var legacyCatalog = []DocumentTypeInfo{
+{{- range .Legacy }}
+	{Code: {{ printf "%q" .Code }}, Name: {{ printf "%q" .Name }}, Purpose: {{ printf "%q" .Summary }}, Domain: {{ printf "%q" .Domain.DisplayName }}},
+{{- end }}
+}
+
+// expectedReferences lists the document types the validation of a type
+// expects its documents to reference
+var expectedReferences = map[DocumentType][]ExpectedReference{
+{{- range .All }}
+{{- if .References }}
+	DocumentType{{ .Code }}: {
+	{{- range .References }}
+		{Key: {{ printf "%q" .Key }}, Type: DocumentType{{ .ReferenceType }}, Code: {{ .Code }}},
+	{{- end }}
+	},
+{{- end }}
+{{- end }}
+}
+
+// NewTypedDocument creates the typed document of a document type with the
+// defaults of its type
+func NewTypedDocument(docType DocumentType, id, title, owner string) (Document, error) {
+	switch docType {
+{{- range .All }}
+	case DocumentType{{ .Code }}:
+		return New{{ .Code }}Document(id, title, owner), nil
+{{- end }}
+	}
+	return nil, fmt.Errorf("unknown document type: %s", docType)
+}
+`))
+
+var documentsTemplate = template.Must(template.New("documents").Parse(`// Code generated by specgen from spec/v1; DO NOT EDIT.
+
+package bspec
+{{ range .All }}
+{{ .Doc }}
+type {{ .Code }}Document struct {
+	BaseBSpecDocument ` + "`" + `yaml:",inline"` + "`" + `
+{{- with .Flag }}
+
+	// {{ .Comment }}
+	{{ .Field }} bool ` + "`" + `json:"{{ .Key }}" yaml:"{{ .Key }}"` + "`" + `
+{{- end }}
+{{- if .Fields }}
+{{ range .Fields }}
+	{{ .Name }} {{ .Type }} ` + "`" + `json:"{{ .Key }},omitempty" yaml:"{{ .Key }},omitempty"` + "`" + ` // {{ .Comment }}
+{{- end }}
+{{- end }}
+}
+
+// New{{ .Code }}Document creates a new {{ .Code }} document with defaults
+func New{{ .Code }}Document(id, title, owner string) *{{ .Code }}Document {
+{{- if .Flag }}
+	doc := &{{ .Code }}Document{BaseBSpecDocument: *NewDocument(DocumentType{{ .Code }}, id, title, owner)}
+	doc.{{ .Flag.Field }} = true
+	return doc
+{{- else }}
+	return &{{ .Code }}Document{BaseBSpecDocument: *NewDocument(DocumentType{{ .Code }}, id, title, owner)}
+{{- end }}
+}
+
+// Validate validates the {{ .Code }} document and returns the validation issues
+func (d *{{ .Code }}Document) Validate() ValidationIssues {
+{{- if or .Rules .Fields }}
+	issues := d.validateType(DocumentType{{ .Code }})
+{{- if .Fields }}
+	issues = append(issues, d.validateFields()...)
+{{- end }}
+{{- range .Rules }}
+
+	// {{ .Comment }}
+	{{- if .Prefix }}
+	if !hasEntry(d.{{ .Field }}, {{ printf "%q" .Prefix }}) {
+	{{- else }}
+	if len(d.{{ .Field }}) == 0 {
+	{{- end }}
+		issues = append(issues, newIssue({{ .Severity }}, {{ .Code }}, {{ printf "%q" .Key }}, {{ printf "%q" .Message }}).
+			withFix({{ printf "%q" .Fix }}))
+	}
+{{- end }}
+	return issues
+{{- else }}
+	return d.validateType(DocumentType{{ .Code }})
+{{- end }}
+}
+
+// IsValid returns true if validation finds no errors; warnings are allowed
+func (d *{{ .Code }}Document) IsValid() bool {
+	return !d.Validate().HasErrors()
+}
+{{ end -}}
+`))
+
+var writingTemplate = template.Must(template.New("writing").Parse(`// Code generated by specgen from spec/v1; DO NOT EDIT.
+
+package bspec
+
+// writingStandards are the writing standards of spec/v1/WRITING_STANDARDS.md
+var writingStandards = WritingStandards{
+	{{- with .Standards }}
+	Title: {{ printf "%q" .Title }},
+	HeaderFields: []string{ {{- range $i, $field := .HeaderFields }}{{ if $i }}, {{ end }}{{ printf "%q" $field }}{{ end -}} },
+	Sections: []string{
+	{{- range .Sections }}
+		{{ printf "%q" . }},
+	{{- end }}
+	},
+	{{- if .OptionalSections }}
+	OptionalSections: []string{
+	{{- range .OptionalSections }}
+		{{ printf "%q" . }},
+	{{- end }}
+	},
+	{{- end }}
+	NormativeKeywords: []string{ {{- range $i, $keyword := .NormativeKeywords }}{{ if $i }}, {{ end }}{{ printf "%q" $keyword }}{{ end -}} },
+	Vocabulary: []VocabularyRule{
+	{{- range .Vocabulary }}
+		{Avoid: {{ printf "%q" .Avoid }}, Use: []string{ {{- range $i, $use := .Use }}{{ if $i }}, {{ end }}{{ printf "%q" $use }}{{ end -}} }},
+	{{- end }}
+	},
+	MinSentenceWords: {{ .MinSentenceWords }},
+	MaxSentenceWords: {{ .MaxSentenceWords }},
+	Placeholders: []string{ {{- range $i, $placeholder := .Placeholders }}{{ if $i }}, {{ end }}{{ printf "%q" $placeholder }}{{ end -}} },
+	{{- end }}
+}
+`))
@@ -0,0 +1,164 @@
+package bspec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// lifecycleTransitions lists the statuses each status may move to
+var lifecycleTransitions = map[DocumentStatus][]DocumentStatus{
+	DocumentStatusDraft:      {DocumentStatusReview, DocumentStatusDeprecated},
+	DocumentStatusReview:     {DocumentStatusDraft, DocumentStatusAccepted, DocumentStatusDeprecated},
+	DocumentStatusAccepted:   {DocumentStatusReview, DocumentStatusDeprecated},
+	DocumentStatusDeprecated: {},
+}
+
+// promotions is the forward path of the lifecycle
+var promotions = map[DocumentStatus]DocumentStatus{
+	DocumentStatusDraft:  DocumentStatusReview,
+	DocumentStatusReview: DocumentStatusAccepted,
+}
+
+// DocumentStatuses returns the lifecycle statuses in lifecycle order
+func DocumentStatuses() []DocumentStatus {
+	return []DocumentStatus{DocumentStatusDraft, DocumentStatusReview, DocumentStatusAccepted, DocumentStatusDeprecated}
+}
+
+// IsValid returns true if the status is a lifecycle status
+func (s DocumentStatus) IsValid() bool {
+	_, ok := lifecycleTransitions[s]
+	return ok
+}
+
+// AllowedTransitions returns the statuses a document may move to from a status
+func AllowedTransitions(from DocumentStatus) []DocumentStatus {
+	return append([]DocumentStatus{}, lifecycleTransitions[from]...)
+}
+
+// CanTransition returns true if the lifecycle allows moving from one status to another
+func CanTransition(from, to DocumentStatus) bool {
+	for _, status := range lifecycleTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// NextStatus returns the status a document is promoted to: Draft to Review
+// and Review to Accepted
+func NextStatus(from DocumentStatus) (DocumentStatus, bool) {
+	next, ok := promotions[from]
+	return next, ok
+}
+
+// TransitionOptions carries the information a transition may require
+type TransitionOptions struct {
+	SupersededBy string // Document that replaces a deprecated document
+	Reason       string // Why a document is deprecated
+}
+
+// TransitionError lists why a document cannot move to a status
+type TransitionError struct {
+	ID       string
+	From     DocumentStatus
+	To       DocumentStatus
+	Problems []string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("cannot move %s from %s to %s: %s", e.ID, e.From, e.To, strings.Join(e.Problems, "; "))
+}
+
+// CheckTransition returns a *TransitionError if the lifecycle does not allow
+// the document to move to a status or a precondition of that status is not met:
+// Review requires an owner, Accepted requires reviewers and a changelog entry
+// for the current version, and Deprecated requires a superseding document or
+// a reason, given in the options or as the document's deprecation_reason.
+func (d *BaseBSpecDocument) CheckTransition(to DocumentStatus, opts TransitionOptions) error {
+	from := d.Status
+	if from == "" {
+		from = DocumentStatusDraft
+	}
+	transitionErr := &TransitionError{ID: d.ID, From: from, To: to}
+
+	switch {
+	case !to.IsValid():
+		transitionErr.Problems = append(transitionErr.Problems, fmt.Sprintf("unknown status %q", to))
+	case !from.IsValid():
+		transitionErr.Problems = append(transitionErr.Problems, fmt.Sprintf("current status %q is not a lifecycle status", from))
+	case !CanTransition(from, to):
+		transitionErr.Problems = append(transitionErr.Problems, fmt.Sprintf("allowed transitions from %s: %s", from, statusList(AllowedTransitions(from))))
+	}
+	if len(transitionErr.Problems) > 0 {
+		return transitionErr
+	}
+
+	switch to {
+	case DocumentStatusReview:
+		if strings.TrimSpace(d.Owner) == "" {
+			transitionErr.Problems = append(transitionErr.Problems, "owner is required")
+		}
+	case DocumentStatusAccepted:
+		if len(d.Reviewers) == 0 {
+			transitionErr.Problems = append(transitionErr.Problems, "reviewers must not be empty")
+		}
+		if !d.HasChangelogEntry(d.Version) {
+			transitionErr.Problems = append(transitionErr.Problems, fmt.Sprintf("changelog has no entry for version %s", d.Version))
+		}
+	case DocumentStatusDeprecated:
+		supersededBy := strings.TrimSpace(opts.SupersededBy)
+		switch {
+		case supersededBy == "" && strings.TrimSpace(opts.Reason) == "" && d.deprecationReason() == "":
+			transitionErr.Problems = append(transitionErr.Problems, "a superseding document or a reason is required")
+		case supersededBy != "" && supersededBy == d.ID:
+			transitionErr.Problems = append(transitionErr.Problems, "a document cannot supersede itself")
+		}
+	}
+	if len(transitionErr.Problems) > 0 {
+		return transitionErr
+	}
+	return nil
+}
+
+// Transition moves the document to a status after checking the transition
+func (d *BaseBSpecDocument) Transition(to DocumentStatus, opts TransitionOptions) error {
+	if err := d.CheckTransition(to, opts); err != nil {
+		return err
+	}
+	d.Status = to
+	if to == DocumentStatusDeprecated && strings.TrimSpace(opts.Reason) != "" {
+		reason := opts.Reason
+		d.DeprecationReason = &reason
+	}
+	return nil
+}
+
+// deprecationReason returns the trimmed deprecation_reason, or "" if unset
+func (d *BaseBSpecDocument) deprecationReason() string {
+	if d.DeprecationReason == nil {
+		return ""
+	}
+	return strings.TrimSpace(*d.DeprecationReason)
+}
+
+// HasChangelogEntry returns true if the changelog has an entry for a version
+func (d *BaseBSpecDocument) HasChangelogEntry(version string) bool {
+	for _, entry := range d.Changelog {
+		if entry.Version == version && strings.TrimSpace(entry.Changes) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func statusList(statuses []DocumentStatus) string {
+	if len(statuses) == 0 {
+		return "none"
+	}
+	names := make([]string, len(statuses))
+	for i, status := range statuses {
+		names[i] = string(status)
+	}
+	return strings.Join(names, ", ")
+}
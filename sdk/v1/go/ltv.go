@@ -1,60 +0,0 @@
-// Package bspec provides LTV document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.613420
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// LTVDocument represents a Lifetime Value (LTV) document
-// Domain: business-model
-//
-// Customer lifetime value models and drivers
-type LTVDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewLTVDocument creates a new LTV document with defaults
-func NewLTVDocument(id, title, owner string) *LTVDocument {
-	doc := &LTVDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeLTV,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainBusinessModel
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the LTV document and returns any validation errors
-func (d *LTVDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeLTV {
-		errors = append(errors, "document type must be LTV")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
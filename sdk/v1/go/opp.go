@@ -1,60 +0,0 @@
-// Package bspec provides OPP document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.608838
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// OPPDocument represents a Opportunities (OPP) document
-// Domain: market
-//
-// Identified market gaps and growth potential
-type OPPDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewOPPDocument creates a new OPP document with defaults
-func NewOPPDocument(id, title, owner string) *OPPDocument {
-	doc := &OPPDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeOPP,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainMarket
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the OPP document and returns any validation errors
-func (d *OPPDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeOPP {
-		errors = append(errors, "document type must be OPP")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides ORG document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.613896
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// ORGDocument represents a Organization (ORG) document
-// Domain: operations
-//
-// Reporting relationships and organizational design
-type ORGDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewORGDocument creates a new ORG document with defaults
-func NewORGDocument(id, title, owner string) *ORGDocument {
-	doc := &ORGDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeORG,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainOperations
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the ORG document and returns any validation errors
-func (d *ORGDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeORG {
-		errors = append(errors, "document type must be ORG")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides POL document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.614296
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// POLDocument represents a Policies (POL) document
-// Domain: operations
-//
-// Rules and guidelines governing behavior
-type POLDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewPOLDocument creates a new POL document with defaults
-func NewPOLDocument(id, title, owner string) *POLDocument {
-	doc := &POLDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypePOL,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainOperations
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the POL document and returns any validation errors
-func (d *POLDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypePOL {
-		errors = append(errors, "document type must be POL")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides POS document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.608526
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// POSDocument represents a Positioning (POS) document
-// Domain: market
-//
-// Unique value proposition and market position
-type POSDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewPOSDocument creates a new POS document with defaults
-func NewPOSDocument(id, title, owner string) *POSDocument {
-	doc := &POSDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypePOS,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainMarket
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the POS document and returns any validation errors
-func (d *POSDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypePOS {
-		errors = append(errors, "document type must be POS")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
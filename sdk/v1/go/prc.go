@@ -1,60 +0,0 @@
-// Package bspec provides PRC document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.613681
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// PRCDocument represents a Processes (PRC) document
-// Domain: operations
-//
-// Repeatable workflows that drive business outcomes
-type PRCDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewPRCDocument creates a new PRC document with defaults
-func NewPRCDocument(id, title, owner string) *PRCDocument {
-	doc := &PRCDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypePRC,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainOperations
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the PRC document and returns any validation errors
-func (d *PRCDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypePRC {
-		errors = append(errors, "document type must be PRC")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides PRT document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.613225
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// PRTDocument represents a Key Partnerships (PRT) document
-// Domain: business-model
-//
-// Strategic alliances and supplier relationships
-type PRTDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewPRTDocument creates a new PRT document with defaults
-func NewPRTDocument(id, title, owner string) *PRTDocument {
-	doc := &PRTDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypePRT,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainBusinessModel
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the PRT document and returns any validation errors
-func (d *PRTDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypePRT {
-		errors = append(errors, "document type must be PRT")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
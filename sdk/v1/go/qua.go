@@ -1,60 +0,0 @@
-// Package bspec provides QUA document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.611622
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// QUADocument represents a Quality Standards (QUA) document
-// Domain: product
-//
-// Quality metrics, testing, and assurance practices
-type QUADocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewQUADocument creates a new QUA document with defaults
-func NewQUADocument(id, title, owner string) *QUADocument {
-	doc := &QUADocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeQUA,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainProduct
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the QUA document and returns any validation errors
-func (d *QUADocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeQUA {
-		errors = append(errors, "document type must be QUA")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
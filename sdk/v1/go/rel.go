@@ -1,60 +0,0 @@
-// Package bspec provides REL document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.612927
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// RELDocument represents a Customer Relationships (REL) document
-// Domain: business-model
-//
-// How relationships are built and maintained
-type RELDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewRELDocument creates a new REL document with defaults
-func NewRELDocument(id, title, owner string) *RELDocument {
-	doc := &RELDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeREL,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainBusinessModel
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the REL document and returns any validation errors
-func (d *RELDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeREL {
-		errors = append(errors, "document type must be REL")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
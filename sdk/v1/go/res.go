@@ -1,60 +0,0 @@
-// Package bspec provides RES document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.613034
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// RESDocument represents a Key Resources (RES) document
-// Domain: business-model
-//
-// Critical assets required for the business model
-type RESDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewRESDocument creates a new RES document with defaults
-func NewRESDocument(id, title, owner string) *RESDocument {
-	doc := &RESDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeRES,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainBusinessModel
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the RES document and returns any validation errors
-func (d *RESDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeRES {
-		errors = append(errors, "document type must be RES")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides RND document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.617940
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// RNDDocument represents a Research (RND) document
-// Domain: growth
-//
-// Investigation and development activities
-type RNDDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewRNDDocument creates a new RND document with defaults
-func NewRNDDocument(id, title, owner string) *RNDDocument {
-	doc := &RNDDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeRND,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainGrowth
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the RND document and returns any validation errors
-func (d *RNDDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeRND {
-		errors = append(errors, "document type must be RND")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides SCL document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.617647
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// SCLDocument represents a Scaling (SCL) document
-// Domain: growth
-//
-// Operational scaling approach and constraints
-type SCLDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewSCLDocument creates a new SCL document with defaults
-func NewSCLDocument(id, title, owner string) *SCLDocument {
-	doc := &SCLDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeSCL,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainGrowth
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the SCL document and returns any validation errors
-func (d *SCLDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeSCL {
-		errors = append(errors, "document type must be SCL")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides SEC document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.615491
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// SECDocument represents a Security (SEC) document
-// Domain: technology
-//
-// Security architecture, controls, and compliance
-type SECDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewSECDocument creates a new SEC document with defaults
-func NewSECDocument(id, title, owner string) *SECDocument {
-	doc := &SECDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeSEC,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainTechnology
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the SEC document and returns any validation errors
-func (d *SECDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeSEC {
-		errors = append(errors, "document type must be SEC")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides SEG document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.608277
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// SEGDocument represents a Market Segments (SEG) document
-// Domain: market
-//
-// Customer categories and targeting strategy
-type SEGDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewSEGDocument creates a new SEG document with defaults
-func NewSEGDocument(id, title, owner string) *SEGDocument {
-	doc := &SEGDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeSEG,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainMarket
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the SEG document and returns any validation errors
-func (d *SEGDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeSEG {
-		errors = append(errors, "document type must be SEG")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides SLA document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.614398
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// SLADocument represents a Service Levels (SLA) document
-// Domain: operations
-//
-// Performance commitments and standards
-type SLADocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewSLADocument creates a new SLA document with defaults
-func NewSLADocument(id, title, owner string) *SLADocument {
-	doc := &SLADocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeSLA,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainOperations
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the SLA document and returns any validation errors
-func (d *SLADocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeSLA {
-		errors = append(errors, "document type must be SLA")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -0,0 +1,231 @@
+package bspec
+
+import (
+	"bufio"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// specDir is the specification the SDK is generated from
+const specDir = "../../../spec/v1"
+
+var specHeaderPattern = regexp.MustCompile(`^\*\*(Document Type Code|Document Type Name|Domain):\*\*\s*(.+)$`)
+
+// specType is the header of a spec/v1/<domain>/<CODE>-spec.md file
+type specType struct {
+	path      string
+	code      string
+	name      string
+	domain    string // Domain display name, e.g. "Risk & Governance"
+	directory string // Domain directory, e.g. "risk-governance"
+}
+
+func readSpecTypes(t *testing.T) map[string]specType {
+	t.Helper()
+	paths, err := filepath.Glob(filepath.Join(specDir, "*", "*-spec.md"))
+	if err != nil || len(paths) == 0 {
+		t.Fatalf("No specifications found in %s: %v", specDir, err)
+	}
+
+	types := make(map[string]specType)
+	for _, path := range paths {
+		spec := specType{
+			path:      path,
+			code:      strings.TrimSuffix(filepath.Base(path), "-spec.md"),
+			directory: filepath.Base(filepath.Dir(path)),
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", path, err)
+		}
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			// The header ends at the first section
+			if strings.HasPrefix(scanner.Text(), "## ") {
+				break
+			}
+			match := specHeaderPattern.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+			if match == nil {
+				continue
+			}
+			switch match[1] {
+			case "Document Type Code":
+				if match[2] != spec.code {
+					t.Errorf("%s: code %s does not match the file name", path, match[2])
+				}
+			case "Document Type Name":
+				spec.name = match[2]
+			case "Domain":
+				spec.domain = match[2]
+			}
+		}
+		file.Close()
+		types[spec.code] = spec
+	}
+	return types
+}
+
+// sdkDocumentTypes returns the DocumentType constants declared in types_gen.go
+func sdkDocumentTypes(t *testing.T) []DocumentType {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "types_gen.go", nil, 0)
+	if err != nil {
+		t.Fatalf("Failed to parse types_gen.go: %v", err)
+	}
+	var types []DocumentType
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			if ident, ok := value.Type.(*ast.Ident); ok && ident.Name == "DocumentType" {
+				for _, name := range value.Names {
+					types = append(types, DocumentType(strings.TrimPrefix(name.Name, "DocumentType")))
+				}
+			}
+		}
+	}
+	if len(types) == 0 {
+		t.Fatal("No DocumentType constants found in types_gen.go")
+	}
+	return types
+}
+
+func TestSpecTypesMatchSDK(t *testing.T) {
+	specs := readSpecTypes(t)
+	directories := make(map[string]BusinessDomain)
+	for _, info := range Domains() {
+		directories[info.SpecDirectory] = info.Domain
+	}
+
+	for code, spec := range specs {
+		docType := DocumentType(code)
+		domain, ok := directories[spec.directory]
+		if !ok {
+			t.Errorf("%s: directory %s is not a domain", spec.path, spec.directory)
+			continue
+		}
+		if header, ok := ParseBusinessDomain(spec.domain); !ok || header != domain {
+			t.Errorf("%s: domain %q does not match directory %s", spec.path, spec.domain, spec.directory)
+		}
+
+		if !docType.IsValid() {
+			t.Errorf("%s: not a valid document type", code)
+		}
+		if docType.IsLegacy() {
+			t.Errorf("%s: defined by the specification but marked legacy", code)
+		}
+		if got, ok := DomainForType(docType); !ok || got != domain {
+			t.Errorf("%s: DomainForType returns %q, the specification says %q", code, got, domain)
+		}
+		doc, err := NewTypedDocument(docType, code+"-example", "Example", "owner")
+		if err != nil {
+			t.Errorf("%s: no typed document: %v", code, err)
+		} else if doc.GetDomain() != domain {
+			t.Errorf("%s: typed document has domain %q, the specification says %q", code, doc.GetDomain(), domain)
+		} else if issues := doc.Validate().Filter(CodeTypeMismatch, CodeUnknownType, CodeLegacyType); len(issues) > 0 {
+			t.Errorf("%s: typed document fails type validation: %v", code, issues)
+		}
+
+		info := DefaultCatalog().GetDocumentType(code)
+		if info == nil {
+			t.Errorf("%s: missing from the catalog; run go generate", code)
+			continue
+		}
+		if info.Name != spec.name || info.Domain != spec.domain {
+			t.Errorf("%s: catalog has %q (%s), the specification %q (%s); run go generate", code, info.Name, info.Domain, spec.name, spec.domain)
+		}
+	}
+
+	if LegacyDocumentType("MSN") != nil {
+		t.Error("Expected no legacy catalog entry for a specification type")
+	}
+	if catalog := DocumentTypes(); len(catalog) != len(specs)+len(readmeDocumentTypes) {
+		t.Errorf("Catalog has %d document types, the specification %d and the README %d; run go generate", len(catalog), len(specs), len(readmeDocumentTypes))
+	}
+
+	// Every SDK type is in the specification, required by the README or an
+	// explicit legacy type
+	for _, docType := range sdkDocumentTypes(t) {
+		_, inSpec := specs[string(docType)]
+		switch {
+		case inSpec:
+		case isReadmeType(docType):
+			if !docType.IsValid() || docType.IsLegacy() || DefaultCatalog().GetDocumentType(string(docType)) == nil {
+				t.Errorf("%s: README type is not a current catalog type", docType)
+			}
+		case docType.IsLegacy():
+			if successor, ok := docType.Successor(); ok {
+				if _, ok := specs[string(successor)]; !ok {
+					t.Errorf("%s: successor %s is not in the specification", docType, successor)
+				}
+			}
+			info := LegacyDocumentType(string(docType))
+			domain, _ := DomainForType(docType)
+			if domainInfo, _ := LookupDomain(domain); info == nil || info.Name == "" || info.Domain != domainInfo.DisplayName {
+				t.Errorf("%s: unexpected legacy catalog entry %+v", docType, info)
+			}
+		default:
+			t.Errorf("%s: not in the specification and not marked legacy", docType)
+		}
+	}
+}
+
+// formatFolderPattern matches the folder list of spec/v1/format.md, e.g.
+// "09-risk/          # Risk & Governance (RSK, MIT, ...)"
+var formatFolderPattern = regexp.MustCompile(`^(\d\d-[a-z-]+)/\s+# (.+?) \(`)
+
+func TestDomainFoldersMatchFormat(t *testing.T) {
+	content, err := os.ReadFile(filepath.Join(specDir, "format.md"))
+	if err != nil {
+		t.Fatalf("Failed to read format.md: %v", err)
+	}
+	folders := make(map[string]string) // Display name to folder
+	for _, line := range strings.Split(string(content), "\n") {
+		if match := formatFolderPattern.FindStringSubmatch(line); match != nil {
+			folders[match[2]] = match[1]
+		}
+	}
+	if len(folders) == 0 {
+		t.Fatal("No folders found in format.md")
+	}
+
+	for _, info := range Domains() {
+		if info.Folder != folders[info.DisplayName] {
+			t.Errorf("%s: folder %q, format.md says %q", info.Domain, info.Folder, folders[info.DisplayName])
+		}
+		delete(folders, info.DisplayName)
+	}
+	for name, folder := range folders {
+		t.Errorf("%s: folder %s has no domain", name, folder)
+	}
+}
+
+func isReadmeType(docType DocumentType) bool {
+	for _, info := range readmeDocumentTypes {
+		if info.Code == string(docType) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestGetDomainUnknownType(t *testing.T) {
+	doc := &BaseBSpecDocument{Type: "XYZ"}
+	if domain := doc.GetDomain(); domain != "" {
+		t.Errorf("Expected no domain for an unknown type, got %q", domain)
+	}
+	doc.ID, doc.Title, doc.Owner = "XYZ-1", "Unknown", "owner"
+	doc.SetDefaults()
+	if issues := doc.Validate().Filter(CodeUnknownType); len(issues) != 1 || issues[0].Severity != SeverityError {
+		t.Errorf("Expected unknown type error, got %v", doc.Validate())
+	}
+}
@@ -1,60 +0,0 @@
-// Package bspec provides STA document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.617369
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// STADocument represents a Stakeholders (STA) document
-// Domain: risk
-//
-// Stakeholder mapping and management
-type STADocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewSTADocument creates a new STA document with defaults
-func NewSTADocument(id, title, owner string) *STADocument {
-	doc := &STADocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeSTA,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainRisk
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the STA document and returns any validation errors
-func (d *STADocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeSTA {
-		errors = append(errors, "document type must be STA")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides SVC document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.611111
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// SVCDocument represents a Services (SVC) document
-// Domain: product
-//
-// Service offerings and support capabilities
-type SVCDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewSVCDocument creates a new SVC document with defaults
-func NewSVCDocument(id, title, owner string) *SVCDocument {
-	doc := &SVCDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeSVC,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainProduct
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the SVC document and returns any validation errors
-func (d *SVCDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeSVC {
-		errors = append(errors, "document type must be SVC")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
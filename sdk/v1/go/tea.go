@@ -1,60 +0,0 @@
-// Package bspec provides TEA document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.614094
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// TEADocument represents a Teams (TEA) document
-// Domain: operations
-//
-// Team structures, compositions, and dynamics
-type TEADocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewTEADocument creates a new TEA document with defaults
-func NewTEADocument(id, title, owner string) *TEADocument {
-	doc := &TEADocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeTEA,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainOperations
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the TEA document and returns any validation errors
-func (d *TEADocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeTEA {
-		errors = append(errors, "document type must be TEA")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
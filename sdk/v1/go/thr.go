@@ -1,60 +0,0 @@
-// Package bspec provides THR document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.608944
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// THRDocument represents a Threats (THR) document
-// Domain: market
-//
-// External risks to market position and business model
-type THRDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewTHRDocument creates a new THR document with defaults
-func NewTHRDocument(id, title, owner string) *THRDocument {
-	doc := &THRDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeTHR,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainMarket
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the THR document and returns any validation errors
-func (d *THRDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeTHR {
-		errors = append(errors, "document type must be THR")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides TOO document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.614767
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// TOODocument represents a Tools (TOO) document
-// Domain: operations
-//
-// Software, systems, and operational tools
-type TOODocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewTOODocument creates a new TOO document with defaults
-func NewTOODocument(id, title, owner string) *TOODocument {
-	doc := &TOODocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeTOO,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainOperations
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the TOO document and returns any validation errors
-func (d *TOODocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeTOO {
-		errors = append(errors, "document type must be TOO")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -1,60 +0,0 @@
-// Package bspec provides TRN document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.608630
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// TRNDocument represents a Trends (TRN) document
-// Domain: market
-//
-// Market forces and changes shaping the industry
-type TRNDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewTRNDocument creates a new TRN document with defaults
-func NewTRNDocument(id, title, owner string) *TRNDocument {
-	doc := &TRNDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeTRN,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainMarket
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the TRN document and returns any validation errors
-func (d *TRNDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeTRN {
-		errors = append(errors, "document type must be TRN")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
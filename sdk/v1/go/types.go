@@ -1,5 +1,4 @@
 // Types for BSpec v1.0.0
-// Generated from BSpec JSON SDK at 2025-09-30T00:20:03.460731
 package bspec
 
 import "time"
@@ -31,11 +30,29 @@ type Domain struct {
 
 // DocumentTypeInfo represents a BSpec document type specification
 type DocumentTypeInfo struct {
-	Code     string `json:"code"`
-	Name     string `json:"name"`
-	Purpose  string `json:"purpose"`
-	Domain   string `json:"domain"`
-	Examples []DocumentExample `json:"examples"`
+	Code             string            `json:"code"`
+	Name             string            `json:"name"`
+	Purpose          string            `json:"purpose"`
+	Domain           string            `json:"domain"`
+	Examples         []DocumentExample `json:"examples"`
+	Dependencies     []string          `json:"dependencies,omitempty"`      // Types this type typically depends on
+	Enablements      []string          `json:"enablements,omitempty"`       // Types this type typically enables
+	Sections         []SectionTemplate `json:"sections,omitempty"`          // Section headings of the content template
+	Checklist        []string          `json:"checklist,omitempty"`         // Items of the validation checklist
+	QualityStandards []QualityStandard `json:"quality_standards,omitempty"` // Quality Standards checklists, by level
+}
+
+// QualityStandard is the Quality Standards checklist of a conformance level
+type QualityStandard struct {
+	Level ConformanceLevel `json:"level"`
+	Title string           `json:"title"` // e.g. "Bronze Level (Minimum Viable)"
+	Items []string         `json:"items"`
+}
+
+// SectionTemplate is a section heading of a document type's content template
+type SectionTemplate struct {
+	Level int    `json:"level"` // Heading level, 2 for "##"
+	Title string `json:"title"`
 }
 
 // DocumentExample represents an example document
@@ -76,12 +93,12 @@ type YAMLSchema struct {
 
 // DocumentIndexEntry represents an entry in the document index
 type DocumentIndexEntry struct {
-	ID       string `json:"id"`
-	Title    string `json:"title"`
-	Type     string `json:"type"`
-	Path     string `json:"path"`
-	Domain   string `json:"domain"`
-	Owner    string `json:"owner"`
-	Status   string `json:"status"`
-	Version  string `json:"version"`
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Type    string `json:"type"`
+	Path    string `json:"path"`
+	Domain  string `json:"domain"`
+	Owner   string `json:"owner"`
+	Status  string `json:"status"`
+	Version string `json:"version"`
 }
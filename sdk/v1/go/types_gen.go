@@ -0,0 +1,675 @@
+// Code generated by specgen from spec/v1; DO NOT EDIT.
+
+package bspec
+
+import "fmt"
+
+// Document types defined by the specification
+const (
+	// Strategic Foundation
+	DocumentTypeMOT DocumentType = "MOT"
+	DocumentTypeMSN DocumentType = "MSN"
+	DocumentTypeOBJ DocumentType = "OBJ"
+	DocumentTypePUR DocumentType = "PUR"
+	DocumentTypeSTR DocumentType = "STR"
+	DocumentTypeTHY DocumentType = "THY"
+	DocumentTypeVAL DocumentType = "VAL"
+	DocumentTypeVSN DocumentType = "VSN"
+
+	// Market & Environment
+	DocumentTypeCMP DocumentType = "CMP"
+	DocumentTypeECO DocumentType = "ECO"
+	DocumentTypeMAC DocumentType = "MAC"
+	DocumentTypeMKT DocumentType = "MKT"
+	DocumentTypeOPP DocumentType = "OPP"
+	DocumentTypePFO DocumentType = "PFO"
+	DocumentTypePOS DocumentType = "POS"
+	DocumentTypeREG DocumentType = "REG"
+	DocumentTypeSEG DocumentType = "SEG"
+	DocumentTypeSWO DocumentType = "SWO"
+	DocumentTypeTHR DocumentType = "THR"
+	DocumentTypeTRN DocumentType = "TRN"
+	DocumentTypeVCH DocumentType = "VCH"
+	DocumentTypeWRD DocumentType = "WRD"
+
+	// Customer & Value
+	DocumentTypeBEH DocumentType = "BEH"
+	DocumentTypeCIN DocumentType = "CIN"
+	DocumentTypeCJM DocumentType = "CJM"
+	DocumentTypeCSU DocumentType = "CSU"
+	DocumentTypeEMP DocumentType = "EMP"
+	DocumentTypeFEE DocumentType = "FEE"
+	DocumentTypeGAI DocumentType = "GAI"
+	DocumentTypeJTB DocumentType = "JTB"
+	DocumentTypePAI DocumentType = "PAI"
+	DocumentTypePER DocumentType = "PER"
+	DocumentTypeSTO DocumentType = "STO"
+	DocumentTypeSUR DocumentType = "SUR"
+	DocumentTypeUSE DocumentType = "USE"
+	DocumentTypeVPR DocumentType = "VPR"
+
+	// Product & Service
+	DocumentTypeEOL DocumentType = "EOL"
+	DocumentTypeFEA DocumentType = "FEA"
+	DocumentTypeINT DocumentType = "INT"
+	DocumentTypePRD DocumentType = "PRD"
+	DocumentTypePSP DocumentType = "PSP"
+	DocumentTypeQUA DocumentType = "QUA"
+	DocumentTypeREQ DocumentType = "REQ"
+	DocumentTypeROD DocumentType = "ROD"
+	DocumentTypeSUP DocumentType = "SUP"
+	DocumentTypeSVC DocumentType = "SVC"
+	DocumentTypeUXD DocumentType = "UXD"
+
+	// Business Model
+	DocumentTypeCHN DocumentType = "CHN"
+	DocumentTypeCST DocumentType = "CST"
+	DocumentTypeCUS DocumentType = "CUS"
+	DocumentTypeKAC DocumentType = "KAC"
+	DocumentTypeKPT DocumentType = "KPT"
+	DocumentTypeKRS DocumentType = "KRS"
+	DocumentTypePRI DocumentType = "PRI"
+	DocumentTypeREV DocumentType = "REV"
+	DocumentTypeVST DocumentType = "VST"
+
+	// Operations & Execution
+	DocumentTypeCAP DocumentType = "CAP"
+	DocumentTypeFAC DocumentType = "FAC"
+	DocumentTypeOPS DocumentType = "OPS"
+	DocumentTypeORG DocumentType = "ORG"
+	DocumentTypePOL DocumentType = "POL"
+	DocumentTypePPL DocumentType = "PPL"
+	DocumentTypePRO DocumentType = "PRO"
+	DocumentTypeROL DocumentType = "ROL"
+	DocumentTypeSKI DocumentType = "SKI"
+	DocumentTypeSLA DocumentType = "SLA"
+	DocumentTypeTEA DocumentType = "TEA"
+	DocumentTypeVND DocumentType = "VND"
+	DocumentTypeWFL DocumentType = "WFL"
+
+	// Technology & Data
+	DocumentTypeANA DocumentType = "ANA"
+	DocumentTypeAPI DocumentType = "API"
+	DocumentTypeARC DocumentType = "ARC"
+	DocumentTypeDAT DocumentType = "DAT"
+	DocumentTypeDEV DocumentType = "DEV"
+	DocumentTypeINF DocumentType = "INF"
+	DocumentTypeSEC DocumentType = "SEC"
+	DocumentTypeSYS DocumentType = "SYS"
+
+	// Financial & Investment
+	DocumentTypeAUD DocumentType = "AUD"
+	DocumentTypeBUD DocumentType = "BUD"
+	DocumentTypeFIN DocumentType = "FIN"
+	DocumentTypeFND DocumentType = "FND"
+	DocumentTypeFOR DocumentType = "FOR"
+	DocumentTypeINV DocumentType = "INV"
+	DocumentTypeMET DocumentType = "MET"
+	DocumentTypeREP DocumentType = "REP"
+	DocumentTypeTAX DocumentType = "TAX"
+	DocumentTypeVLU DocumentType = "VLU"
+
+	// Risk & Governance
+	DocumentTypeBCR DocumentType = "BCR"
+	DocumentTypeCOM DocumentType = "COM"
+	DocumentTypeCOS DocumentType = "COS"
+	DocumentTypeCRI DocumentType = "CRI"
+	DocumentTypeCTL DocumentType = "CTL"
+	DocumentTypeETH DocumentType = "ETH"
+	DocumentTypeGOV DocumentType = "GOV"
+	DocumentTypeINC DocumentType = "INC"
+	DocumentTypeINS DocumentType = "INS"
+	DocumentTypeLEG DocumentType = "LEG"
+	DocumentTypeMNA DocumentType = "MNA"
+	DocumentTypePRV DocumentType = "PRV"
+	DocumentTypeRSK DocumentType = "RSK"
+	DocumentTypeSTA DocumentType = "STA"
+
+	// Growth & Innovation
+	DocumentTypeADT DocumentType = "ADT"
+	DocumentTypeEXP DocumentType = "EXP"
+	DocumentTypeFUT DocumentType = "FUT"
+	DocumentTypeIGN DocumentType = "IGN"
+	DocumentTypeINN DocumentType = "INN"
+	DocumentTypeLEA DocumentType = "LEA"
+	DocumentTypeRND DocumentType = "RND"
+
+	// Learning & Decisions
+	DocumentTypeDEC DocumentType = "DEC"
+	DocumentTypeHYP DocumentType = "HYP"
+	DocumentTypeKNO DocumentType = "KNO"
+	DocumentTypeLRN DocumentType = "LRN"
+	DocumentTypeRET DocumentType = "RET"
+	DocumentTypeWIS DocumentType = "WIS"
+
+	// Brand & Marketing
+	DocumentTypeBPO DocumentType = "BPO"
+	DocumentTypeBRD DocumentType = "BRD"
+	DocumentTypeCAM DocumentType = "CAM"
+	DocumentTypeCNT DocumentType = "CNT"
+	DocumentTypeIFL DocumentType = "IFL"
+	DocumentTypeMCH DocumentType = "MCH"
+	DocumentTypeMSG DocumentType = "MSG"
+	DocumentTypePRF DocumentType = "PRF"
+	DocumentTypeSAL DocumentType = "SAL"
+	DocumentTypeSEO DocumentType = "SEO"
+	DocumentTypeSOC DocumentType = "SOC"
+	DocumentTypeTON DocumentType = "TON"
+	DocumentTypeVID DocumentType = "VID"
+)
+
+// Document types that the README requires and spec/v1 does not define
+const (
+	DocumentTypeMIT DocumentType = "MIT"
+)
+
+// Document types of earlier drafts of the specification
+const (
+	DocumentTypeACQ DocumentType = "ACQ"
+	DocumentTypeACT DocumentType = "ACT"
+	DocumentTypeBMC DocumentType = "BMC"
+	DocumentTypeCAC DocumentType = "CAC"
+	DocumentTypeGRW DocumentType = "GRW"
+	DocumentTypeGTM DocumentType = "GTM"
+	DocumentTypeGVN DocumentType = "GVN"
+	DocumentTypeLTV DocumentType = "LTV"
+	DocumentTypePRC DocumentType = "PRC"
+	DocumentTypePRT DocumentType = "PRT"
+	DocumentTypeREL DocumentType = "REL"
+	DocumentTypeRES DocumentType = "RES"
+	DocumentTypeSCL DocumentType = "SCL"
+	DocumentTypeTOO DocumentType = "TOO"
+	DocumentTypeUNT DocumentType = "UNT"
+)
+
+// documentTypeDomains maps the document types to their business domain
+var documentTypeDomains = map[DocumentType]BusinessDomain{
+	DocumentTypeMOT: BusinessDomainStrategic,
+	DocumentTypeMSN: BusinessDomainStrategic,
+	DocumentTypeOBJ: BusinessDomainStrategic,
+	DocumentTypePUR: BusinessDomainStrategic,
+	DocumentTypeSTR: BusinessDomainStrategic,
+	DocumentTypeTHY: BusinessDomainStrategic,
+	DocumentTypeVAL: BusinessDomainStrategic,
+	DocumentTypeVSN: BusinessDomainStrategic,
+	DocumentTypeCMP: BusinessDomainMarket,
+	DocumentTypeECO: BusinessDomainMarket,
+	DocumentTypeMAC: BusinessDomainMarket,
+	DocumentTypeMKT: BusinessDomainMarket,
+	DocumentTypeOPP: BusinessDomainMarket,
+	DocumentTypePFO: BusinessDomainMarket,
+	DocumentTypePOS: BusinessDomainMarket,
+	DocumentTypeREG: BusinessDomainMarket,
+	DocumentTypeSEG: BusinessDomainMarket,
+	DocumentTypeSWO: BusinessDomainMarket,
+	DocumentTypeTHR: BusinessDomainMarket,
+	DocumentTypeTRN: BusinessDomainMarket,
+	DocumentTypeVCH: BusinessDomainMarket,
+	DocumentTypeWRD: BusinessDomainMarket,
+	DocumentTypeBEH: BusinessDomainCustomer,
+	DocumentTypeCIN: BusinessDomainCustomer,
+	DocumentTypeCJM: BusinessDomainCustomer,
+	DocumentTypeCSU: BusinessDomainCustomer,
+	DocumentTypeEMP: BusinessDomainCustomer,
+	DocumentTypeFEE: BusinessDomainCustomer,
+	DocumentTypeGAI: BusinessDomainCustomer,
+	DocumentTypeJTB: BusinessDomainCustomer,
+	DocumentTypePAI: BusinessDomainCustomer,
+	DocumentTypePER: BusinessDomainCustomer,
+	DocumentTypeSTO: BusinessDomainCustomer,
+	DocumentTypeSUR: BusinessDomainCustomer,
+	DocumentTypeUSE: BusinessDomainCustomer,
+	DocumentTypeVPR: BusinessDomainCustomer,
+	DocumentTypeEOL: BusinessDomainProduct,
+	DocumentTypeFEA: BusinessDomainProduct,
+	DocumentTypeINT: BusinessDomainProduct,
+	DocumentTypePRD: BusinessDomainProduct,
+	DocumentTypePSP: BusinessDomainProduct,
+	DocumentTypeQUA: BusinessDomainProduct,
+	DocumentTypeREQ: BusinessDomainProduct,
+	DocumentTypeROD: BusinessDomainProduct,
+	DocumentTypeSUP: BusinessDomainProduct,
+	DocumentTypeSVC: BusinessDomainProduct,
+	DocumentTypeUXD: BusinessDomainProduct,
+	DocumentTypeCHN: BusinessDomainBusinessModel,
+	DocumentTypeCST: BusinessDomainBusinessModel,
+	DocumentTypeCUS: BusinessDomainBusinessModel,
+	DocumentTypeKAC: BusinessDomainBusinessModel,
+	DocumentTypeKPT: BusinessDomainBusinessModel,
+	DocumentTypeKRS: BusinessDomainBusinessModel,
+	DocumentTypePRI: BusinessDomainBusinessModel,
+	DocumentTypeREV: BusinessDomainBusinessModel,
+	DocumentTypeVST: BusinessDomainBusinessModel,
+	DocumentTypeCAP: BusinessDomainOperations,
+	DocumentTypeFAC: BusinessDomainOperations,
+	DocumentTypeOPS: BusinessDomainOperations,
+	DocumentTypeORG: BusinessDomainOperations,
+	DocumentTypePOL: BusinessDomainOperations,
+	DocumentTypePPL: BusinessDomainOperations,
+	DocumentTypePRO: BusinessDomainOperations,
+	DocumentTypeROL: BusinessDomainOperations,
+	DocumentTypeSKI: BusinessDomainOperations,
+	DocumentTypeSLA: BusinessDomainOperations,
+	DocumentTypeTEA: BusinessDomainOperations,
+	DocumentTypeVND: BusinessDomainOperations,
+	DocumentTypeWFL: BusinessDomainOperations,
+	DocumentTypeANA: BusinessDomainTechnology,
+	DocumentTypeAPI: BusinessDomainTechnology,
+	DocumentTypeARC: BusinessDomainTechnology,
+	DocumentTypeDAT: BusinessDomainTechnology,
+	DocumentTypeDEV: BusinessDomainTechnology,
+	DocumentTypeINF: BusinessDomainTechnology,
+	DocumentTypeSEC: BusinessDomainTechnology,
+	DocumentTypeSYS: BusinessDomainTechnology,
+	DocumentTypeAUD: BusinessDomainFinancial,
+	DocumentTypeBUD: BusinessDomainFinancial,
+	DocumentTypeFIN: BusinessDomainFinancial,
+	DocumentTypeFND: BusinessDomainFinancial,
+	DocumentTypeFOR: BusinessDomainFinancial,
+	DocumentTypeINV: BusinessDomainFinancial,
+	DocumentTypeMET: BusinessDomainFinancial,
+	DocumentTypeREP: BusinessDomainFinancial,
+	DocumentTypeTAX: BusinessDomainFinancial,
+	DocumentTypeVLU: BusinessDomainFinancial,
+	DocumentTypeBCR: BusinessDomainRisk,
+	DocumentTypeCOM: BusinessDomainRisk,
+	DocumentTypeCOS: BusinessDomainRisk,
+	DocumentTypeCRI: BusinessDomainRisk,
+	DocumentTypeCTL: BusinessDomainRisk,
+	DocumentTypeETH: BusinessDomainRisk,
+	DocumentTypeGOV: BusinessDomainRisk,
+	DocumentTypeINC: BusinessDomainRisk,
+	DocumentTypeINS: BusinessDomainRisk,
+	DocumentTypeLEG: BusinessDomainRisk,
+	DocumentTypeMNA: BusinessDomainRisk,
+	DocumentTypePRV: BusinessDomainRisk,
+	DocumentTypeRSK: BusinessDomainRisk,
+	DocumentTypeSTA: BusinessDomainRisk,
+	DocumentTypeADT: BusinessDomainGrowth,
+	DocumentTypeEXP: BusinessDomainGrowth,
+	DocumentTypeFUT: BusinessDomainGrowth,
+	DocumentTypeIGN: BusinessDomainGrowth,
+	DocumentTypeINN: BusinessDomainGrowth,
+	DocumentTypeLEA: BusinessDomainGrowth,
+	DocumentTypeRND: BusinessDomainGrowth,
+	DocumentTypeDEC: BusinessDomainLearning,
+	DocumentTypeHYP: BusinessDomainLearning,
+	DocumentTypeKNO: BusinessDomainLearning,
+	DocumentTypeLRN: BusinessDomainLearning,
+	DocumentTypeRET: BusinessDomainLearning,
+	DocumentTypeWIS: BusinessDomainLearning,
+	DocumentTypeBPO: BusinessDomainBrand,
+	DocumentTypeBRD: BusinessDomainBrand,
+	DocumentTypeCAM: BusinessDomainBrand,
+	DocumentTypeCNT: BusinessDomainBrand,
+	DocumentTypeIFL: BusinessDomainBrand,
+	DocumentTypeMCH: BusinessDomainBrand,
+	DocumentTypeMSG: BusinessDomainBrand,
+	DocumentTypePRF: BusinessDomainBrand,
+	DocumentTypeSAL: BusinessDomainBrand,
+	DocumentTypeSEO: BusinessDomainBrand,
+	DocumentTypeSOC: BusinessDomainBrand,
+	DocumentTypeTON: BusinessDomainBrand,
+	DocumentTypeVID: BusinessDomainBrand,
+	DocumentTypeMIT: BusinessDomainRisk,
+	DocumentTypeACQ: BusinessDomainGrowth,
+	DocumentTypeACT: BusinessDomainBusinessModel,
+	DocumentTypeBMC: BusinessDomainBusinessModel,
+	DocumentTypeCAC: BusinessDomainBusinessModel,
+	DocumentTypeGRW: BusinessDomainGrowth,
+	DocumentTypeGTM: BusinessDomainGrowth,
+	DocumentTypeGVN: BusinessDomainRisk,
+	DocumentTypeLTV: BusinessDomainBusinessModel,
+	DocumentTypePRC: BusinessDomainOperations,
+	DocumentTypePRT: BusinessDomainBusinessModel,
+	DocumentTypeREL: BusinessDomainBusinessModel,
+	DocumentTypeRES: BusinessDomainBusinessModel,
+	DocumentTypeSCL: BusinessDomainGrowth,
+	DocumentTypeTOO: BusinessDomainOperations,
+	DocumentTypeUNT: BusinessDomainBusinessModel,
+}
+
+// legacyDocumentTypes lists the legacy document types with their successor
+// in the specification where there is one
+var legacyDocumentTypes = map[DocumentType]DocumentType{
+	DocumentTypeACQ: "",
+	DocumentTypeACT: DocumentTypeKAC,
+	DocumentTypeBMC: "",
+	DocumentTypeCAC: "",
+	DocumentTypeGRW: "",
+	DocumentTypeGTM: "",
+	DocumentTypeGVN: DocumentTypeGOV,
+	DocumentTypeLTV: "",
+	DocumentTypePRC: DocumentTypePRO,
+	DocumentTypePRT: DocumentTypeKPT,
+	DocumentTypeREL: "",
+	DocumentTypeRES: DocumentTypeKRS,
+	DocumentTypeSCL: "",
+	DocumentTypeTOO: "",
+	DocumentTypeUNT: "",
+}
+
+// legacyCatalog describes the legacy document types, without the template
+// of a specification
+var legacyCatalog = []DocumentTypeInfo{
+	{Code: "ACQ", Name: "Acquisitions", Purpose: "M&A strategy and integration planning.", Domain: "Growth & Innovation"},
+	{Code: "ACT", Name: "Key Activities", Purpose: "Essential processes for value creation.", Domain: "Business Model"},
+	{Code: "BMC", Name: "Business Model Canvas", Purpose: "Complete business model overview.", Domain: "Business Model"},
+	{Code: "CAC", Name: "Customer Acquisition", Purpose: "Customer acquisition cost and strategies.", Domain: "Business Model"},
+	{Code: "GRW", Name: "Growth Model", Purpose: "How the business scales customers and revenue.", Domain: "Growth & Innovation"},
+	{Code: "GTM", Name: "Go-to-Market", Purpose: "Launch and customer acquisition strategy.", Domain: "Growth & Innovation"},
+	{Code: "GVN", Name: "Governance", Purpose: "Decision-making structure and oversight.", Domain: "Risk & Governance"},
+	{Code: "LTV", Name: "Lifetime Value", Purpose: "Customer lifetime value models and drivers.", Domain: "Business Model"},
+	{Code: "PRC", Name: "Processes", Purpose: "Repeatable workflows that drive business outcomes.", Domain: "Operations & Execution"},
+	{Code: "PRT", Name: "Key Partnerships", Purpose: "Strategic alliances and supplier relationships.", Domain: "Business Model"},
+	{Code: "REL", Name: "Customer Relationships", Purpose: "How relationships are built and maintained.", Domain: "Business Model"},
+	{Code: "RES", Name: "Key Resources", Purpose: "Critical assets required for the business model.", Domain: "Business Model"},
+	{Code: "SCL", Name: "Scaling", Purpose: "Operational scaling approach and constraints.", Domain: "Growth & Innovation"},
+	{Code: "TOO", Name: "Tools", Purpose: "Software, systems, and operational tools.", Domain: "Operations & Execution"},
+	{Code: "UNT", Name: "Unit Economics", Purpose: "Per-customer or per-unit financial metrics.", Domain: "Business Model"},
+}
+
+// expectedReferences lists the document types the validation of a type
+// expects its documents to reference
+var expectedReferences = map[DocumentType][]ExpectedReference{
+	DocumentTypeRSK: {
+		{Key: "related", Type: DocumentTypeMIT, Code: CodeMissingMitigationReference},
+	},
+	DocumentTypeMIT: {
+		{Key: "related", Type: DocumentTypeRSK, Code: CodeMissingRiskReference},
+	},
+}
+
+// NewTypedDocument creates the typed document of a document type with the
+// defaults of its type
+func NewTypedDocument(docType DocumentType, id, title, owner string) (Document, error) {
+	switch docType {
+	case DocumentTypeMOT:
+		return NewMOTDocument(id, title, owner), nil
+	case DocumentTypeMSN:
+		return NewMSNDocument(id, title, owner), nil
+	case DocumentTypeOBJ:
+		return NewOBJDocument(id, title, owner), nil
+	case DocumentTypePUR:
+		return NewPURDocument(id, title, owner), nil
+	case DocumentTypeSTR:
+		return NewSTRDocument(id, title, owner), nil
+	case DocumentTypeTHY:
+		return NewTHYDocument(id, title, owner), nil
+	case DocumentTypeVAL:
+		return NewVALDocument(id, title, owner), nil
+	case DocumentTypeVSN:
+		return NewVSNDocument(id, title, owner), nil
+	case DocumentTypeCMP:
+		return NewCMPDocument(id, title, owner), nil
+	case DocumentTypeECO:
+		return NewECODocument(id, title, owner), nil
+	case DocumentTypeMAC:
+		return NewMACDocument(id, title, owner), nil
+	case DocumentTypeMKT:
+		return NewMKTDocument(id, title, owner), nil
+	case DocumentTypeOPP:
+		return NewOPPDocument(id, title, owner), nil
+	case DocumentTypePFO:
+		return NewPFODocument(id, title, owner), nil
+	case DocumentTypePOS:
+		return NewPOSDocument(id, title, owner), nil
+	case DocumentTypeREG:
+		return NewREGDocument(id, title, owner), nil
+	case DocumentTypeSEG:
+		return NewSEGDocument(id, title, owner), nil
+	case DocumentTypeSWO:
+		return NewSWODocument(id, title, owner), nil
+	case DocumentTypeTHR:
+		return NewTHRDocument(id, title, owner), nil
+	case DocumentTypeTRN:
+		return NewTRNDocument(id, title, owner), nil
+	case DocumentTypeVCH:
+		return NewVCHDocument(id, title, owner), nil
+	case DocumentTypeWRD:
+		return NewWRDDocument(id, title, owner), nil
+	case DocumentTypeBEH:
+		return NewBEHDocument(id, title, owner), nil
+	case DocumentTypeCIN:
+		return NewCINDocument(id, title, owner), nil
+	case DocumentTypeCJM:
+		return NewCJMDocument(id, title, owner), nil
+	case DocumentTypeCSU:
+		return NewCSUDocument(id, title, owner), nil
+	case DocumentTypeEMP:
+		return NewEMPDocument(id, title, owner), nil
+	case DocumentTypeFEE:
+		return NewFEEDocument(id, title, owner), nil
+	case DocumentTypeGAI:
+		return NewGAIDocument(id, title, owner), nil
+	case DocumentTypeJTB:
+		return NewJTBDocument(id, title, owner), nil
+	case DocumentTypePAI:
+		return NewPAIDocument(id, title, owner), nil
+	case DocumentTypePER:
+		return NewPERDocument(id, title, owner), nil
+	case DocumentTypeSTO:
+		return NewSTODocument(id, title, owner), nil
+	case DocumentTypeSUR:
+		return NewSURDocument(id, title, owner), nil
+	case DocumentTypeUSE:
+		return NewUSEDocument(id, title, owner), nil
+	case DocumentTypeVPR:
+		return NewVPRDocument(id, title, owner), nil
+	case DocumentTypeEOL:
+		return NewEOLDocument(id, title, owner), nil
+	case DocumentTypeFEA:
+		return NewFEADocument(id, title, owner), nil
+	case DocumentTypeINT:
+		return NewINTDocument(id, title, owner), nil
+	case DocumentTypePRD:
+		return NewPRDDocument(id, title, owner), nil
+	case DocumentTypePSP:
+		return NewPSPDocument(id, title, owner), nil
+	case DocumentTypeQUA:
+		return NewQUADocument(id, title, owner), nil
+	case DocumentTypeREQ:
+		return NewREQDocument(id, title, owner), nil
+	case DocumentTypeROD:
+		return NewRODDocument(id, title, owner), nil
+	case DocumentTypeSUP:
+		return NewSUPDocument(id, title, owner), nil
+	case DocumentTypeSVC:
+		return NewSVCDocument(id, title, owner), nil
+	case DocumentTypeUXD:
+		return NewUXDDocument(id, title, owner), nil
+	case DocumentTypeCHN:
+		return NewCHNDocument(id, title, owner), nil
+	case DocumentTypeCST:
+		return NewCSTDocument(id, title, owner), nil
+	case DocumentTypeCUS:
+		return NewCUSDocument(id, title, owner), nil
+	case DocumentTypeKAC:
+		return NewKACDocument(id, title, owner), nil
+	case DocumentTypeKPT:
+		return NewKPTDocument(id, title, owner), nil
+	case DocumentTypeKRS:
+		return NewKRSDocument(id, title, owner), nil
+	case DocumentTypePRI:
+		return NewPRIDocument(id, title, owner), nil
+	case DocumentTypeREV:
+		return NewREVDocument(id, title, owner), nil
+	case DocumentTypeVST:
+		return NewVSTDocument(id, title, owner), nil
+	case DocumentTypeCAP:
+		return NewCAPDocument(id, title, owner), nil
+	case DocumentTypeFAC:
+		return NewFACDocument(id, title, owner), nil
+	case DocumentTypeOPS:
+		return NewOPSDocument(id, title, owner), nil
+	case DocumentTypeORG:
+		return NewORGDocument(id, title, owner), nil
+	case DocumentTypePOL:
+		return NewPOLDocument(id, title, owner), nil
+	case DocumentTypePPL:
+		return NewPPLDocument(id, title, owner), nil
+	case DocumentTypePRO:
+		return NewPRODocument(id, title, owner), nil
+	case DocumentTypeROL:
+		return NewROLDocument(id, title, owner), nil
+	case DocumentTypeSKI:
+		return NewSKIDocument(id, title, owner), nil
+	case DocumentTypeSLA:
+		return NewSLADocument(id, title, owner), nil
+	case DocumentTypeTEA:
+		return NewTEADocument(id, title, owner), nil
+	case DocumentTypeVND:
+		return NewVNDDocument(id, title, owner), nil
+	case DocumentTypeWFL:
+		return NewWFLDocument(id, title, owner), nil
+	case DocumentTypeANA:
+		return NewANADocument(id, title, owner), nil
+	case DocumentTypeAPI:
+		return NewAPIDocument(id, title, owner), nil
+	case DocumentTypeARC:
+		return NewARCDocument(id, title, owner), nil
+	case DocumentTypeDAT:
+		return NewDATDocument(id, title, owner), nil
+	case DocumentTypeDEV:
+		return NewDEVDocument(id, title, owner), nil
+	case DocumentTypeINF:
+		return NewINFDocument(id, title, owner), nil
+	case DocumentTypeSEC:
+		return NewSECDocument(id, title, owner), nil
+	case DocumentTypeSYS:
+		return NewSYSDocument(id, title, owner), nil
+	case DocumentTypeAUD:
+		return NewAUDDocument(id, title, owner), nil
+	case DocumentTypeBUD:
+		return NewBUDDocument(id, title, owner), nil
+	case DocumentTypeFIN:
+		return NewFINDocument(id, title, owner), nil
+	case DocumentTypeFND:
+		return NewFNDDocument(id, title, owner), nil
+	case DocumentTypeFOR:
+		return NewFORDocument(id, title, owner), nil
+	case DocumentTypeINV:
+		return NewINVDocument(id, title, owner), nil
+	case DocumentTypeMET:
+		return NewMETDocument(id, title, owner), nil
+	case DocumentTypeREP:
+		return NewREPDocument(id, title, owner), nil
+	case DocumentTypeTAX:
+		return NewTAXDocument(id, title, owner), nil
+	case DocumentTypeVLU:
+		return NewVLUDocument(id, title, owner), nil
+	case DocumentTypeBCR:
+		return NewBCRDocument(id, title, owner), nil
+	case DocumentTypeCOM:
+		return NewCOMDocument(id, title, owner), nil
+	case DocumentTypeCOS:
+		return NewCOSDocument(id, title, owner), nil
+	case DocumentTypeCRI:
+		return NewCRIDocument(id, title, owner), nil
+	case DocumentTypeCTL:
+		return NewCTLDocument(id, title, owner), nil
+	case DocumentTypeETH:
+		return NewETHDocument(id, title, owner), nil
+	case DocumentTypeGOV:
+		return NewGOVDocument(id, title, owner), nil
+	case DocumentTypeINC:
+		return NewINCDocument(id, title, owner), nil
+	case DocumentTypeINS:
+		return NewINSDocument(id, title, owner), nil
+	case DocumentTypeLEG:
+		return NewLEGDocument(id, title, owner), nil
+	case DocumentTypeMNA:
+		return NewMNADocument(id, title, owner), nil
+	case DocumentTypePRV:
+		return NewPRVDocument(id, title, owner), nil
+	case DocumentTypeRSK:
+		return NewRSKDocument(id, title, owner), nil
+	case DocumentTypeSTA:
+		return NewSTADocument(id, title, owner), nil
+	case DocumentTypeADT:
+		return NewADTDocument(id, title, owner), nil
+	case DocumentTypeEXP:
+		return NewEXPDocument(id, title, owner), nil
+	case DocumentTypeFUT:
+		return NewFUTDocument(id, title, owner), nil
+	case DocumentTypeIGN:
+		return NewIGNDocument(id, title, owner), nil
+	case DocumentTypeINN:
+		return NewINNDocument(id, title, owner), nil
+	case DocumentTypeLEA:
+		return NewLEADocument(id, title, owner), nil
+	case DocumentTypeRND:
+		return NewRNDDocument(id, title, owner), nil
+	case DocumentTypeDEC:
+		return NewDECDocument(id, title, owner), nil
+	case DocumentTypeHYP:
+		return NewHYPDocument(id, title, owner), nil
+	case DocumentTypeKNO:
+		return NewKNODocument(id, title, owner), nil
+	case DocumentTypeLRN:
+		return NewLRNDocument(id, title, owner), nil
+	case DocumentTypeRET:
+		return NewRETDocument(id, title, owner), nil
+	case DocumentTypeWIS:
+		return NewWISDocument(id, title, owner), nil
+	case DocumentTypeBPO:
+		return NewBPODocument(id, title, owner), nil
+	case DocumentTypeBRD:
+		return NewBRDDocument(id, title, owner), nil
+	case DocumentTypeCAM:
+		return NewCAMDocument(id, title, owner), nil
+	case DocumentTypeCNT:
+		return NewCNTDocument(id, title, owner), nil
+	case DocumentTypeIFL:
+		return NewIFLDocument(id, title, owner), nil
+	case DocumentTypeMCH:
+		return NewMCHDocument(id, title, owner), nil
+	case DocumentTypeMSG:
+		return NewMSGDocument(id, title, owner), nil
+	case DocumentTypePRF:
+		return NewPRFDocument(id, title, owner), nil
+	case DocumentTypeSAL:
+		return NewSALDocument(id, title, owner), nil
+	case DocumentTypeSEO:
+		return NewSEODocument(id, title, owner), nil
+	case DocumentTypeSOC:
+		return NewSOCDocument(id, title, owner), nil
+	case DocumentTypeTON:
+		return NewTONDocument(id, title, owner), nil
+	case DocumentTypeVID:
+		return NewVIDDocument(id, title, owner), nil
+	case DocumentTypeMIT:
+		return NewMITDocument(id, title, owner), nil
+	case DocumentTypeACQ:
+		return NewACQDocument(id, title, owner), nil
+	case DocumentTypeACT:
+		return NewACTDocument(id, title, owner), nil
+	case DocumentTypeBMC:
+		return NewBMCDocument(id, title, owner), nil
+	case DocumentTypeCAC:
+		return NewCACDocument(id, title, owner), nil
+	case DocumentTypeGRW:
+		return NewGRWDocument(id, title, owner), nil
+	case DocumentTypeGTM:
+		return NewGTMDocument(id, title, owner), nil
+	case DocumentTypeGVN:
+		return NewGVNDocument(id, title, owner), nil
+	case DocumentTypeLTV:
+		return NewLTVDocument(id, title, owner), nil
+	case DocumentTypePRC:
+		return NewPRCDocument(id, title, owner), nil
+	case DocumentTypePRT:
+		return NewPRTDocument(id, title, owner), nil
+	case DocumentTypeREL:
+		return NewRELDocument(id, title, owner), nil
+	case DocumentTypeRES:
+		return NewRESDocument(id, title, owner), nil
+	case DocumentTypeSCL:
+		return NewSCLDocument(id, title, owner), nil
+	case DocumentTypeTOO:
+		return NewTOODocument(id, title, owner), nil
+	case DocumentTypeUNT:
+		return NewUNTDocument(id, title, owner), nil
+	}
+	return nil, fmt.Errorf("unknown document type: %s", docType)
+}
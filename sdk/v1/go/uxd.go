@@ -1,60 +0,0 @@
-// Package bspec provides UXD document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.611730
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// UXDDocument represents a User Experience (UXD) document
-// Domain: product
-//
-// Design principles and user interface standards
-type UXDDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewUXDDocument creates a new UXD document with defaults
-func NewUXDDocument(id, title, owner string) *UXDDocument {
-	doc := &UXDDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeUXD,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainProduct
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the UXD document and returns any validation errors
-func (d *UXDDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeUXD {
-		errors = append(errors, "document type must be UXD")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
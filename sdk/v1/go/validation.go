@@ -0,0 +1,132 @@
+package bspec
+
+import "fmt"
+
+// Severity is how serious a validation issue is
+type Severity string
+
+const (
+	SeverityError   Severity = "error"   // The document does not conform
+	SeverityWarning Severity = "warning" // The document conforms but should be improved
+	SeverityInfo    Severity = "info"
+)
+
+// Validation issue codes
+const (
+	CodeRequiredField              = "required_field"
+	CodeUnknownType                = "unknown_type"
+	CodeLegacyType                 = "legacy_type"
+	CodeTypeMismatch               = "type_mismatch"
+	CodeInvalidID                  = "invalid_id"
+	CodeInvalidVersion             = "invalid_version"
+	CodeInvalidDate                = "invalid_date"
+	CodeInvalidValue               = "invalid_value"
+	CodeInvalidReference           = "invalid_reference"
+	CodeMissingSuccessCriteria     = "missing_success_criteria"
+	CodeMissingMetrics             = "missing_metrics"
+	CodeMissingRelated             = "missing_related"
+	CodeMissingMitigationReference = "missing_mitigation_reference"
+	CodeMissingRiskReference       = "missing_risk_reference"
+	CodeMissingSection             = "missing_section"
+	CodeEmptySection               = "empty_section"
+)
+
+// ValidationIssue is a problem found by a validator
+type ValidationIssue struct {
+	Code     string   `json:"code"`
+	Severity Severity `json:"severity"`
+	Field    string   `json:"field,omitempty"` // Frontmatter path, e.g. "key_results[1].target"
+	Line     int      `json:"line,omitempty"`  // Line of the document body, starting at 1
+	Message  string   `json:"message"`
+	Fix      string   `json:"fix,omitempty"` // Suggested fix
+}
+
+// newIssue creates a validation issue without a fix suggestion
+func newIssue(severity Severity, code, field, message string) ValidationIssue {
+	return ValidationIssue{Code: code, Severity: severity, Field: field, Message: message}
+}
+
+// withFix returns the issue with a fix suggestion
+func (i ValidationIssue) withFix(fix string) ValidationIssue {
+	i.Fix = fix
+	return i
+}
+
+// String formats the issue as "severity code: message"
+func (i ValidationIssue) String() string {
+	return fmt.Sprintf("%s %s: %s", i.Severity, i.Code, i.Message)
+}
+
+// ValidationIssues is the result of a validator
+type ValidationIssues []ValidationIssue
+
+// BySeverity returns the issues of a severity
+func (v ValidationIssues) BySeverity(severity Severity) ValidationIssues {
+	var issues ValidationIssues
+	for _, issue := range v {
+		if issue.Severity == severity {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// Errors returns the issues of error severity
+func (v ValidationIssues) Errors() ValidationIssues {
+	return v.BySeverity(SeverityError)
+}
+
+// Warnings returns the issues of warning severity
+func (v ValidationIssues) Warnings() ValidationIssues {
+	return v.BySeverity(SeverityWarning)
+}
+
+// HasErrors returns true if any issue is of error severity
+func (v ValidationIssues) HasErrors() bool {
+	return len(v.Errors()) > 0
+}
+
+// Count returns the number of issues of a severity
+func (v ValidationIssues) Count(severity Severity) int {
+	return len(v.BySeverity(severity))
+}
+
+// Filter returns the issues with one of the codes
+func (v ValidationIssues) Filter(codes ...string) ValidationIssues {
+	var issues ValidationIssues
+	for _, issue := range v {
+		if containsCode(codes, issue.Code) {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// Suppress returns the issues without the ones with one of the codes
+func (v ValidationIssues) Suppress(codes ...string) ValidationIssues {
+	var issues ValidationIssues
+	for _, issue := range v {
+		if !containsCode(codes, issue.Code) {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// Messages returns the messages of the issues
+func (v ValidationIssues) Messages() []string {
+	messages := make([]string, len(v))
+	for i, issue := range v {
+		messages[i] = issue.Message
+	}
+	return messages
+}
+
+func containsCode(codes []string, code string) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
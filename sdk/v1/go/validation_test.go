@@ -0,0 +1,78 @@
+package bspec
+
+import "testing"
+
+func TestValidationSeverity(t *testing.T) {
+	doc := NewRSKDocument("RSK-churn", "Churn", "cro")
+	issues := doc.Validate()
+	if len(issues) != 1 || issues[0].Code != CodeMissingMitigationReference || issues[0].Severity != SeverityWarning {
+		t.Fatalf("Expected only the missing mitigation warning, got %v", issues)
+	}
+	if issues[0].Field != "related" || issues[0].Fix == "" {
+		t.Errorf("Expected field and fix, got %+v", issues[0])
+	}
+	if !doc.IsValid() {
+		t.Error("Expected a document with only warnings to be valid")
+	}
+
+	doc.Version = "1.0"
+	if doc.IsValid() {
+		t.Error("Expected a document with an invalid version to be invalid")
+	}
+	if issue := doc.Validate().Filter(CodeInvalidVersion); len(issue) != 1 || issue[0].Field != "version" {
+		t.Errorf("Expected an invalid version issue, got %v", doc.Validate())
+	}
+
+	var typed Document = doc
+	if typed.IsValid() {
+		t.Error("Expected the Document interface to use the typed validation")
+	}
+}
+
+func TestValidationIssuesFilters(t *testing.T) {
+	doc := &BaseBSpecDocument{ID: "churn", Type: DocumentTypeGVN, Version: "one", Created: "2024/01/01"}
+	issues := doc.Validate()
+
+	counts := map[Severity]int{SeverityError: issues.Count(SeverityError), SeverityWarning: issues.Count(SeverityWarning)}
+	// title, owner and updated are missing; id, version and created are malformed
+	if counts[SeverityError] != 6 || counts[SeverityWarning] != 1 {
+		t.Errorf("Expected 6 errors and 1 warning, got %v: %v", counts, issues)
+	}
+	if required := issues.Filter(CodeRequiredField); len(required) != 3 {
+		t.Errorf("Expected 3 required field issues, got %v", required)
+	}
+	if remaining := issues.Suppress(CodeRequiredField, CodeInvalidDate); len(remaining) != 3 || remaining.Filter(CodeRequiredField) != nil {
+		t.Errorf("Expected the suppressed codes to be removed, got %v", remaining)
+	}
+
+	legacy := issues.Filter(CodeLegacyType)
+	if len(legacy) != 1 || legacy[0].Fix != "change type to GOV" {
+		t.Errorf("Expected a legacy type warning suggesting GOV, got %v", legacy)
+	}
+	if id := issues.Filter(CodeInvalidID); len(id) != 1 || id[0].Fix != "rename the id to GVN-churn" {
+		t.Errorf("Expected an id fix, got %v", id)
+	}
+	if messages := issues.Errors().Messages(); len(messages) != 6 {
+		t.Errorf("Expected 6 error messages, got %v", messages)
+	}
+}
+
+func TestExpectedReferences(t *testing.T) {
+	risk := ExpectedReferences(DocumentTypeRSK)
+	if len(risk) != 1 || risk[0].Type != DocumentTypeMIT || risk[0].Key != "related" {
+		t.Fatalf("Expected RSK to reference MIT in related, got %+v", risk)
+	}
+	if mitigation := ExpectedReferences(DocumentTypeMIT); len(mitigation) != 1 || mitigation[0].Type != DocumentTypeRSK {
+		t.Errorf("Expected MIT to reference RSK, got %+v", mitigation)
+	}
+
+	// The validation reports each expected reference that is missing
+	for _, docType := range []DocumentType{DocumentTypeRSK, DocumentTypeMIT} {
+		doc, _ := NewTypedDocument(docType, string(docType)+"-churn", "Churn", "cro")
+		for _, expected := range ExpectedReferences(docType) {
+			if len(doc.Validate().Filter(expected.Code)) != 1 {
+				t.Errorf("Expected %s validation to report %s", docType, expected.Code)
+			}
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package bspec
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// VersionBump is the part of a semantic version to increment
+type VersionBump string
+
+const (
+	VersionBumpMajor VersionBump = "major"
+	VersionBumpMinor VersionBump = "minor"
+	VersionBumpPatch VersionBump = "patch"
+)
+
+var semanticVersionPattern = regexp.MustCompile(`^(\d+)\.(\d+)\.(\d+)$`)
+
+// BumpVersion increments a semantic version, resetting the lower parts
+func BumpVersion(version string, bump VersionBump) (string, error) {
+	match := semanticVersionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return "", fmt.Errorf("version %q does not follow semantic versioning (e.g., '1.0.0')", version)
+	}
+	major, _ := strconv.Atoi(match[1])
+	minor, _ := strconv.Atoi(match[2])
+	patch, _ := strconv.Atoi(match[3])
+
+	switch bump {
+	case VersionBumpMajor:
+		major, minor, patch = major+1, 0, 0
+	case VersionBumpMinor:
+		minor, patch = minor+1, 0
+	case VersionBumpPatch:
+		patch++
+	default:
+		return "", fmt.Errorf("unknown version bump %q (use major, minor or patch)", bump)
+	}
+	return fmt.Sprintf("%d.%d.%d", major, minor, patch), nil
+}
@@ -1,60 +0,0 @@
-// Package bspec provides VND document type
-//
-// GENERATED CODE - DO NOT EDIT MANUALLY
-// Generated from BSpec v1.0.0 specification
-// Generated at: 2025-09-28T15:12:38.614538
-// Generator: go-generator v1.0.0
-
-package bspec
-
-// VNDDocument represents a Vendors (VND) document
-// Domain: operations
-//
-// Supplier and partner relationship management
-type VNDDocument struct {
-	BaseBSpecDocument
-
-
-
-
-}
-
-// NewVNDDocument creates a new VND document with defaults
-func NewVNDDocument(id, title, owner string) *VNDDocument {
-	doc := &VNDDocument{
-		BaseBSpecDocument: BaseBSpecDocument{
-			ID:    id,
-			Title: title,
-			Type:  DocumentTypeVND,
-			Owner: owner,
-		},
-	}
-
-	// Set document type specific defaults
-	doc.SetDefaults()
-	domain := BusinessDomainOperations
-	doc.Domain = &domain
-
-
-
-
-
-	return doc
-}
-
-// Validate validates the VND document and returns any validation errors
-func (d *VNDDocument) Validate() []string {
-	errors := d.BaseBSpecDocument.Validate()
-
-	// Ensure correct document type
-	if d.Type != DocumentTypeVND {
-		errors = append(errors, "document type must be VND")
-	}
-
-	// Document type specific validation
-
-
-
-
-	return errors
-}
\ No newline at end of file
@@ -0,0 +1,43 @@
+package bspec
+
+// WritingStandards are the professional writing standards of the
+// specification (spec/v1/WRITING_STANDARDS.md)
+type WritingStandards struct {
+	// Title is the format of the first heading of a specification file,
+	// e.g. "# {CODE}: {Full Name} Specification"
+	Title string `json:"title"`
+	// HeaderFields are the bold fields that follow the title, in order
+	HeaderFields []string `json:"header_fields"`
+	// Sections are the required "##" sections of a specification file, in
+	// order
+	Sections []string `json:"sections"`
+	// OptionalSections are the recommended sections that follow them
+	OptionalSections []string `json:"optional_sections,omitempty"`
+	// NormativeKeywords are the RFC 2119 keywords, e.g. "MUST NOT"
+	NormativeKeywords []string `json:"normative_keywords"`
+	// Vocabulary lists the informal phrases to replace
+	Vocabulary []VocabularyRule `json:"vocabulary"`
+	// MinSentenceWords and MaxSentenceWords bound the average sentence length
+	MinSentenceWords int `json:"min_sentence_words"`
+	MaxSentenceWords int `json:"max_sentence_words"`
+	// Placeholders are the texts that mark unfinished content, e.g. "TBD"
+	Placeholders []string `json:"placeholders"`
+}
+
+// VocabularyRule is an informal phrase and its professional replacements
+type VocabularyRule struct {
+	Avoid string   `json:"avoid"`
+	Use   []string `json:"use"`
+}
+
+// DefaultWritingStandards returns the writing standards built into the SDK
+func DefaultWritingStandards() WritingStandards {
+	standards := writingStandards
+	standards.HeaderFields = append([]string(nil), writingStandards.HeaderFields...)
+	standards.Sections = append([]string(nil), writingStandards.Sections...)
+	standards.OptionalSections = append([]string(nil), writingStandards.OptionalSections...)
+	standards.NormativeKeywords = append([]string(nil), writingStandards.NormativeKeywords...)
+	standards.Vocabulary = append([]VocabularyRule(nil), writingStandards.Vocabulary...)
+	standards.Placeholders = append([]string(nil), writingStandards.Placeholders...)
+	return standards
+}
@@ -0,0 +1,35 @@
+// Code generated by specgen from spec/v1; DO NOT EDIT.
+
+package bspec
+
+// writingStandards are the writing standards of spec/v1/WRITING_STANDARDS.md
+var writingStandards = WritingStandards{
+	Title:        "# {CODE}: {Full Name} Specification",
+	HeaderFields: []string{"Document Type Code", "Document Type Name", "Domain", "Version", "Status", "Last Updated"},
+	Sections: []string{
+		"Abstract",
+		"Purpose and Scope",
+		"Document Metadata Schema",
+		"Content Structure Template",
+		"Quality Standards",
+		"Implementation Guidelines",
+		"Validation Requirements",
+	},
+	OptionalSections: []string{
+		"Industry Variations",
+		"Common Implementation Patterns",
+		"Relationship Guidelines",
+	},
+	NormativeKeywords: []string{"MUST", "SHALL", "MUST NOT", "SHALL NOT", "SHOULD", "RECOMMENDED", "SHOULD NOT", "NOT RECOMMENDED", "MAY", "OPTIONAL"},
+	Vocabulary: []VocabularyRule{
+		{Avoid: "figure out", Use: []string{"determine", "establish", "define"}},
+		{Avoid: "deal with", Use: []string{"address", "manage", "handle"}},
+		{Avoid: "make sure", Use: []string{"ensure", "verify", "confirm"}},
+		{Avoid: "help", Use: []string{"enable", "facilitate", "support"}},
+		{Avoid: "get", Use: []string{"obtain", "acquire", "secure"}},
+		{Avoid: "really important", Use: []string{"critical", "essential", "vital"}},
+	},
+	MinSentenceWords: 15,
+	MaxSentenceWords: 25,
+	Placeholders:     []string{"TBD", "Coming soon"},
+}